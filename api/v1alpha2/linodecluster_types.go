@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"github.com/linode/linodego/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -27,6 +28,14 @@ const (
 	// with LinodeCluster before removing it from the apiserver.
 	ClusterFinalizer = "linodecluster.infrastructure.cluster.x-k8s.io"
 	ConditionPaused  = "Paused"
+
+	// APIServerFirewallLabel is set on the LinodeFirewall managed for the apiServerAllowedCIDRs of a LinodeCluster,
+	// with the name of the LinodeCluster as value.
+	APIServerFirewallLabel = "linodecluster.infrastructure.cluster.x-k8s.io/apiserver-firewall"
+
+	// SharedIPReservedAnnotation is set on the LinodeCluster to the shared IPv4 address reserved by CAPL. Only this
+	// address is released when the LinodeCluster is deleted, an address set by the user in sharedIPv4 is kept.
+	SharedIPReservedAnnotation = "linodecluster.infrastructure.cluster.x-k8s.io/shared-ip-reserved"
 )
 
 // LinodeClusterSpec defines the desired state of LinodeCluster
@@ -56,7 +65,7 @@ type LinodeClusterSpec struct {
 	VPCID *int `json:"vpcID,omitempty"`
 
 	// nodeBalancerFirewallRef is a reference to a NodeBalancer Firewall object. This makes the linode use the specified NodeBalancer Firewall.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Changing or removing it swaps or detaches the firewall of the existing NodeBalancer.
 	// +optional
 	NodeBalancerFirewallRef *corev1.ObjectReference `json:"nodeBalancerFirewallRef,omitempty"`
 
@@ -65,10 +74,49 @@ type LinodeClusterSpec struct {
 	// +optional
 	ObjectStore *ObjectStore `json:"objectStore,omitempty"`
 
+	// bootstrapDataDelivery selects how bootstrap data exceeding the Metadata limit is delivered to the instances.
+	// ObjectStore uploads it to the objectStore, Endpoint serves it once from the bootstrap data endpoint of the
+	// manager. Defaults to ObjectStore.
+	// +kubebuilder:validation:Enum=ObjectStore;Endpoint
+	// +optional
+	BootstrapDataDelivery BootstrapDataDelivery `json:"bootstrapDataDelivery,omitempty"`
+
 	// credentialsRef is a reference to a Secret that contains the credentials to use for provisioning this cluster. If not
 	//  supplied, then the credentials of the controller will be used.
 	// +optional
 	CredentialsRef *corev1.SecretReference `json:"credentialsRef,omitempty"`
+
+	// failureDomains is a list of failure domains to spread the LinodeMachines of the cluster across. Each failure
+	// domain is backed by a LinodePlacementGroup in the region of the LinodeCluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	FailureDomains []LinodeFailureDomain `json:"failureDomains,omitempty"`
+
+	// monthlyBudget is the monthly cost of the cluster in US dollars above which the BudgetExceeded condition is set.
+	// It is compared with the estimated cost of the cluster, which requires cost estimation to be enabled on the
+	// controller manager.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,2})?$`
+	// +optional
+	MonthlyBudget string `json:"monthlyBudget,omitempty"`
+}
+
+// LinodeFailureDomain defines a failure domain backed by a LinodePlacementGroup.
+type LinodeFailureDomain struct {
+	// name is the name of the failure domain, which Machines reference through spec.failureDomain.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +required
+	Name string `json:"name,omitempty"`
+
+	// placementGroupRef is a reference to the LinodePlacementGroup that Linodes in this failure domain are placed in.
+	// +required
+	PlacementGroupRef *corev1.ObjectReference `json:"placementGroupRef,omitempty"`
+
+	// controlPlane determines if this failure domain is suitable for use by control plane machines.
+	// +kubebuilder:default=true
+	// +optional
+	ControlPlane *bool `json:"controlPlane,omitempty"`
 }
 
 // LinodeClusterStatus defines the observed state of LinodeCluster
@@ -96,6 +144,60 @@ type LinodeClusterStatus struct {
 	// for logging and human consumption.
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// failureDomains is the list of failure domains published to Cluster API once their
+	// LinodePlacementGroups are ready.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	FailureDomains []clusterv1.FailureDomain `json:"failureDomains,omitempty"`
+
+	// estimatedCost is the cost of the Linode resources of the cluster, estimated from the list prices of the Linode API.
+	// +optional
+	EstimatedCost *ClusterCostEstimate `json:"estimatedCost,omitempty"`
+
+	// nodeBalancerFirewallID is the ID of the firewall attached to the NodeBalancer by CAPL.
+	// +optional
+	NodeBalancerFirewallID *int `json:"nodeBalancerFirewallID,omitempty"`
+
+	// nodeBalancerBackends is the health of the backends of the NodeBalancer configs, as last polled from the Linode API.
+	// +optional
+	// +listType=atomic
+	NodeBalancerBackends []NodeBalancerBackendStatus `json:"nodeBalancerBackends,omitempty"`
+}
+
+// NodeBalancerBackendStatus is the health of a backend node of a NodeBalancer config.
+type NodeBalancerBackendStatus struct {
+	// configID is the ID of the NodeBalancer config of the backend.
+	ConfigID int `json:"configID"`
+
+	// port is the port of the NodeBalancer config of the backend.
+	Port int `json:"port"`
+
+	// address is the ip:port address of the backend.
+	Address string `json:"address"`
+
+	// status is the health of the backend reported by the NodeBalancer health checks: UP, DOWN or unknown.
+	Status string `json:"status"`
+
+	// machine is the name of the LinodeMachine backing the backend, if any.
+	// +optional
+	Machine string `json:"machine,omitempty"`
+}
+
+// ClusterCostEstimate is the estimated cost of the Linode resources of a cluster in US dollars.
+type ClusterCostEstimate struct {
+	// hourly is the estimated hourly cost of the cluster.
+	// +optional
+	Hourly string `json:"hourly,omitempty"`
+
+	// monthly is the estimated monthly cost of the cluster, capped for each resource at its monthly price.
+	// +optional
+	Monthly string `json:"monthly,omitempty"`
+
+	// lastUpdated is the time the cost was last estimated.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -104,6 +206,7 @@ type LinodeClusterStatus struct {
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this LinodeCluster belongs"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Cluster infrastructure is ready for Linode instances"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.ControlPlaneEndpoint",description="API Endpoint",priority=1
+// +kubebuilder:printcolumn:name="Monthly Cost",type="string",JSONPath=".status.estimatedCost.monthly",description="Estimated monthly cost in US dollars",priority=1
 // +kubebuilder:storageversion
 
 // LinodeCluster is the Schema for the linodeclusters API
@@ -172,7 +275,7 @@ func (lc *LinodeCluster) IsPaused() bool {
 // NetworkSpec encapsulates Linode networking resources.
 type NetworkSpec struct {
 	// loadBalancerType is the type of load balancer to use, defaults to NodeBalancer if not otherwise set.
-	// +kubebuilder:validation:Enum=NodeBalancer;dns;external
+	// +kubebuilder:validation:Enum=NodeBalancer;dns;external;sharedIP
 	// +kubebuilder:default=NodeBalancer
 	// +optional
 	LoadBalancerType string `json:"loadBalancerType,omitempty"`
@@ -180,10 +283,15 @@ type NetworkSpec struct {
 	// dnsProvider is the provider who manages the domain.
 	// Ignored if the LoadBalancerType is set to anything other than dns
 	// If not set, defaults linode dns
-	// +kubebuilder:validation:Enum=linode;akamai
+	// +kubebuilder:validation:Enum=linode;akamai;rfc2136
 	// +optional
 	DNSProvider string `json:"dnsProvider,omitempty"`
 
+	// dnsRFC2136 configures the nameserver receiving the dynamic updates of the rfc2136 dnsProvider.
+	// Ignored if the dnsProvider is set to anything other than rfc2136
+	// +optional
+	DNSRFC2136 *DNSRFC2136Config `json:"dnsRFC2136,omitempty"`
+
 	// dnsRootDomain is the root domain used to create a DNS entry for the control-plane endpoint.
 	// Ignored if the LoadBalancerType is set to anything other than dns
 	// +optional
@@ -214,14 +322,39 @@ type NetworkSpec struct {
 	// +optional
 	ApiserverLoadBalancerPort int `json:"apiserverLoadBalancerPort,omitempty"`
 
+	// apiserverNodeBalancerConfig are the settings of the api server NodeBalancer config.
+	// If omitted, the api server port is balanced over TCP in round robin with connection health checks.
+	// The api server only serves HTTPS, which the http and http_body health checks of NodeBalancers don't speak.
+	// +kubebuilder:validation:XValidation:rule="!has(self.healthCheck) || !(self.healthCheck.type in ['http', 'http_body'])",message="http health checks are not supported by the api server, which only serves HTTPS"
+	// +optional
+	ApiserverNodeBalancerConfig *LinodeNBConfigSettings `json:"apiserverNodeBalancerConfig,omitempty"`
+
 	// nodeBalancerID is the id of NodeBalancer.
 	// +optional
 	NodeBalancerID *int `json:"nodeBalancerID,omitempty"`
 
+	// sharedIPv4 is the reserved IPv4 address used as the control plane endpoint.
+	// It is assigned to the first control plane node and shared with every other control plane node.
+	// Ignored if the LoadBalancerType is set to anything other than sharedIP
+	// If not set, CAPL will reserve an IPv4 address in the region of the cluster
+	// +optional
+	SharedIPv4 string `json:"sharedIPv4,omitempty"`
+
 	// nodeBalancerFirewallID is the id of NodeBalancer Firewall.
+	// Changing or removing it swaps or detaches the firewall of the existing NodeBalancer.
 	// +optional
 	NodeBalancerFirewallID *int `json:"nodeBalancerFirewallID,omitempty"`
 
+	// apiServerAllowedCIDRs is the list of CIDRs allowed to reach the NodeBalancer ports.
+	// If set, CAPL manages a LinodeFirewall attached to the NodeBalancer which only admits these CIDRs
+	// and the addresses of the machines of the cluster, and keeps it updated as they change.
+	// It cannot be combined with nodeBalancerFirewallID or nodeBalancerFirewallRef.
+	// Ignored if the LoadBalancerType is set to anything other than NodeBalancer
+	// +kubebuilder:validation:MaxItems=255
+	// +listType=set
+	// +optional
+	APIServerAllowedCIDRs []string `json:"apiServerAllowedCIDRs,omitempty"`
+
 	// apiserverNodeBalancerConfigID is the config ID of api server NodeBalancer config.
 	// +optional
 	ApiserverNodeBalancerConfigID *int `json:"apiserverNodeBalancerConfigID,omitempty"`
@@ -257,6 +390,29 @@ type NetworkSpec struct {
 	EnableVPCBackends bool `json:"enableVPCBackends,omitempty"`
 }
 
+// DNSRFC2136Config configures a nameserver accepting RFC 2136 dynamic updates, such as BIND or PowerDNS.
+type DNSRFC2136Config struct {
+	// nameserver is the host:port address of the nameserver. The port defaults to 53.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Nameserver string `json:"nameserver"`
+
+	// tsigKeyName is the name of the TSIG key signing the updates. Updates are not signed if it is not set.
+	// +optional
+	TSIGKeyName string `json:"tsigKeyName,omitempty"`
+
+	// tsigAlgorithm is the algorithm of the TSIG key.
+	// +kubebuilder:validation:Enum=hmac-sha1;hmac-sha224;hmac-sha256;hmac-sha384;hmac-sha512
+	// +kubebuilder:default=hmac-sha256
+	// +optional
+	TSIGAlgorithm string `json:"tsigAlgorithm,omitempty"`
+
+	// tsigSecretRef is a reference to a Secret containing the base64 encoded secret of the TSIG key in its tsigSecret
+	// key.
+	// +optional
+	TSIGSecretRef *corev1.SecretReference `json:"tsigSecretRef,omitempty"`
+}
+
 type LinodeNBPortConfig struct {
 	// port configured on the NodeBalancer. It must be valid port range (1-65535).
 	// +kubebuilder:validation:Minimum=1
@@ -267,8 +423,104 @@ type LinodeNBPortConfig struct {
 	// nodeBalancerConfigID is the config ID of port's NodeBalancer config.
 	// +optional
 	NodeBalancerConfigID *int `json:"nodeBalancerConfigID,omitempty"`
+
+	LinodeNBConfigSettings `json:",inline"`
 }
 
+// LinodeNBConfigSettings are the settings of a NodeBalancer config.
+// Changes are applied to the existing NodeBalancer config in place.
+type LinodeNBConfigSettings struct {
+	// protocol is the protocol used by the NodeBalancer config.
+	// If omitted, defaults to tcp.
+	// +kubebuilder:validation:Enum=tcp;http
+	// +optional
+	Protocol linodego.ConfigProtocol `json:"protocol,omitempty"`
+
+	// algorithm is the algorithm used to balance connections over the backend nodes.
+	// If omitted, defaults to roundrobin.
+	// +kubebuilder:validation:Enum=roundrobin;leastconn;source
+	// +optional
+	Algorithm linodego.ConfigAlgorithm `json:"algorithm,omitempty"`
+
+	// stickiness controls how subsequent connections of a client are routed to the same backend node.
+	// http_cookie requires the http protocol.
+	// If omitted, the Linode default is used.
+	// +kubebuilder:validation:Enum=none;table;http_cookie
+	// +optional
+	Stickiness linodego.ConfigStickiness `json:"stickiness,omitempty"`
+
+	// proxyProtocol is the version of the PROXY protocol sent to the backend nodes.
+	// It is only supported by the tcp protocol.
+	// If omitted, defaults to none.
+	// +kubebuilder:validation:Enum=none;v1;v2
+	// +optional
+	ProxyProtocol linodego.ConfigProxyProtocol `json:"proxyProtocol,omitempty"`
+
+	// healthCheck configures the active health checks of the backend nodes.
+	// If omitted, the backend nodes are checked by opening a TCP connection.
+	// +optional
+	HealthCheck *LinodeNBHealthCheck `json:"healthCheck,omitempty"`
+
+	// targetPort is the port of the backend nodes the traffic is forwarded to.
+	// If omitted, defaults to the port of the NodeBalancer config.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	TargetPort int `json:"targetPort,omitempty"`
+}
+
+// LinodeNBHealthCheck configures the active health checks of a NodeBalancer config.
+// +kubebuilder:validation:XValidation:rule="!(self.type in ['http', 'http_body']) || has(self.path)",message="path is required for http health checks"
+// +kubebuilder:validation:XValidation:rule="self.type != 'http_body' || has(self.body)",message="body is required for http_body health checks"
+// +kubebuilder:validation:XValidation:rule="!has(self.timeoutSeconds) || !has(self.intervalSeconds) || self.timeoutSeconds < self.intervalSeconds",message="timeoutSeconds must be lower than intervalSeconds"
+type LinodeNBHealthCheck struct {
+	// type of the health check. connection checks open a TCP connection, http checks request
+	// the path and expect a 2xx or 3xx response, and http_body checks expect the body in the response.
+	// +kubebuilder:validation:Enum=none;connection;http;http_body
+	// +required
+	Type linodego.ConfigCheck `json:"type,omitempty"`
+
+	// path requested by http and http_body health checks, such as /healthz.
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// body is the regular expression the response of http_body health checks must match.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	Body string `json:"body,omitempty"`
+
+	// intervalSeconds is the number of seconds between health checks.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	IntervalSeconds int `json:"intervalSeconds,omitempty"`
+
+	// timeoutSeconds is the number of seconds to wait for a health check to succeed.
+	// It must be lower than the interval.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+
+	// attempts is the number of failed health checks after which a backend node is taken out of rotation.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// BootstrapDataDelivery is the mechanism delivering bootstrap data exceeding the Metadata limit to the instances.
+type BootstrapDataDelivery string
+
+const (
+	// BootstrapDataDeliveryObjectStore uploads the bootstrap data to the Cluster Object Store.
+	BootstrapDataDeliveryObjectStore BootstrapDataDelivery = "ObjectStore"
+	// BootstrapDataDeliveryEndpoint serves the bootstrap data from the bootstrap data endpoint of the manager.
+	BootstrapDataDeliveryEndpoint BootstrapDataDelivery = "Endpoint"
+)
+
 // ObjectStore defines a supporting Object Storage bucket for cluster operations. This is currently used for
 // bootstrapping (e.g. Cloud-init).
 type ObjectStore struct {
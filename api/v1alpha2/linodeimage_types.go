@@ -0,0 +1,293 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImageFinalizer allows ReconcileLinodeImage to clean up Linode resources associated
+	// with LinodeImage before removing it from the apiserver.
+	ImageFinalizer = "linodeimage.infrastructure.cluster.x-k8s.io"
+)
+
+// LinodeImageSpec defines the desired state of LinodeImage
+type LinodeImageSpec struct {
+	// region is the Linode region the Image is uploaded to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	Region string `json:"region,omitempty"`
+
+	// label is the label of the Image. If not specified, the name of the LinodeImage is used.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// description is the description of the Image.
+	// +kubebuilder:validation:MaxLength=65000
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// cloudInit marks the Image as compatible with cloud-init and the Metadata service.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	CloudInit bool `json:"cloudInit,omitempty"`
+
+	// tags is a list of tags to apply to the Image.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// source is where the raw disk image is uploaded from.
+	// The image must be a gzip compressed raw disk image (.img.gz).
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	Source LinodeImageSource `json:"source,omitzero"`
+
+	// replicaRegions is a list of additional regions the Image is replicated to.
+	// The region the Image was uploaded to is always kept.
+	// +listType=set
+	// +optional
+	ReplicaRegions []string `json:"replicaRegions,omitempty"`
+
+	// retain allows you to keep the Image after the LinodeImage object is deleted.
+	// If set to true, the controller will not delete the Image resource in Linode.
+	// Defaults to false.
+	// +optional
+	// +kubebuilder:default=false
+	Retain bool `json:"retain,omitempty"`
+
+	// credentialsRef is a reference to a Secret that contains the credentials to use for provisioning this Image.
+	// If not supplied, then the credentials of the controller will be used.
+	// +optional
+	CredentialsRef *corev1.SecretReference `json:"credentialsRef,omitempty"`
+}
+
+// LinodeImageSource defines where the raw disk image of a LinodeImage is uploaded from.
+// Exactly one of url or objectStorage must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.objectStorage)",message="exactly one of url or objectStorage must be set"
+type LinodeImageSource struct {
+	// url is an HTTP(S) URL the image is downloaded from.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// objectStorage is an object in a LinodeObjectStorageBucket the image is downloaded from.
+	// +optional
+	ObjectStorage *LinodeImageObjectStorageSource `json:"objectStorage,omitempty"`
+}
+
+// LinodeImageObjectStorageSource references an object in a LinodeObjectStorageBucket.
+type LinodeImageObjectStorageSource struct {
+	// bucketRef is a reference to the LinodeObjectStorageBucket holding the image.
+	// The access key referenced by the bucket's accessKeyRef is used to read the object.
+	// +required
+	BucketRef corev1.ObjectReference `json:"bucketRef,omitzero"`
+
+	// key is the key of the object in the bucket.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Key string `json:"key,omitempty"`
+}
+
+// LinodeImageRegionStatus is the replication status of an Image in a region.
+type LinodeImageRegionStatus struct {
+	// region is the Linode region.
+	// +required
+	Region string `json:"region"`
+
+	// status is the status of the Image in the region.
+	// +required
+	Status string `json:"status"`
+}
+
+// LinodeImageStatus defines the observed state of LinodeImage
+type LinodeImageStatus struct {
+	// conditions define the current service state of the LinodeImage.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// ready is true when the Image is available in every requested region.
+	// +optional
+	// +kubebuilder:default=false
+	Ready bool `json:"ready"`
+
+	// imageID is the ID of the Image, e.g. private/12345.
+	// +optional
+	ImageID *string `json:"imageID,omitempty"`
+
+	// size is the size of the Image in megabytes as reported by the Linode API.
+	// +optional
+	Size int `json:"size,omitempty"`
+
+	// regions is the replication status of the Image per region.
+	// +listType=map
+	// +listMapKey=region
+	// +optional
+	Regions []LinodeImageRegionStatus `json:"regions,omitempty"`
+
+	// failureReason will be set in the event that there is a terminal problem
+	// reconciling the Image and will contain a succinct value suitable
+	// for machine interpretation.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the Image's spec or the configuration of
+	// the controller, and that manual intervention is required. Examples
+	// of terminal errors would be invalid combinations of settings in the
+	// spec, values that are unsupported by the controller, or the
+	// responsible controller itself being critically misconfigured.
+	//
+	// Any transient errors that occur during the reconciliation of Images
+	// can be added as events to the Image object and/or logged in the
+	// controller's output.
+	// +optional
+	FailureReason *LinodeImageStatusError `json:"failureReason,omitempty"`
+
+	// failureMessage will be set in the event that there is a terminal problem
+	// reconciling the Image and will contain a more verbose string suitable
+	// for logging and human consumption.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the Image's spec or the configuration of
+	// the controller, and that manual intervention is required. Examples
+	// of terminal errors would be invalid combinations of settings in the
+	// spec, values that are unsupported by the controller, or the
+	// responsible controller itself being critically misconfigured.
+	//
+	// Any transient errors that occur during the reconciliation of Images
+	// can be added as events to the Image object and/or logged in the
+	// controller's output.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodeimages,scope=Namespaced,categories=cluster-api,shortName=limg
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.imageID",description="Image ID"
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.size",description="Image size in MB"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Image is ready"
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move-hierarchy=true"
+
+// LinodeImage is the Schema for the linodeimages API
+type LinodeImage struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeImage.
+	// +required
+	Spec LinodeImageSpec `json:"spec,omitzero,omitempty"`
+
+	// status is the observed state of the LinodeImage.
+	// +optional
+	Status LinodeImageStatus `json:"status,omitempty"`
+}
+
+func (li *LinodeImage) GetConditions() []metav1.Condition {
+	for i := range li.Status.Conditions {
+		if li.Status.Conditions[i].Reason == "" {
+			li.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return li.Status.Conditions
+}
+
+func (li *LinodeImage) SetConditions(conditions []metav1.Condition) {
+	li.Status.Conditions = conditions
+}
+
+func (li *LinodeImage) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range li.Status.Conditions {
+		if li.Status.Conditions[i].Type == cond.Type {
+			li.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	li.Status.Conditions = append(li.Status.Conditions, cond)
+}
+
+func (li *LinodeImage) GetCondition(condType string) *metav1.Condition {
+	for i := range li.Status.Conditions {
+		if li.Status.Conditions[i].Type == condType {
+			return &li.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func (li *LinodeImage) IsPaused() bool {
+	for i := range li.Status.Conditions {
+		if li.Status.Conditions[i].Type == ConditionPaused {
+			return li.Status.Conditions[i].Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeImageList contains a list of LinodeImage
+type LinodeImageList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeImage.
+	Items []LinodeImage `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeImage{}, &LinodeImageList{})
+}
+
+// LinodeImageStatusError defines errors states for Image objects.
+type LinodeImageStatusError string
+
+const (
+	// CreateImageError indicates that an error was encountered
+	// when trying to create or upload the Image.
+	CreateImageError LinodeImageStatusError = "CreateError"
+
+	// UpdateImageError indicates that an error was encountered
+	// when trying to update or replicate the Image.
+	UpdateImageError LinodeImageStatusError = "UpdateError"
+
+	// DeleteImageError indicates that an error was encountered
+	// when trying to delete the Image.
+	DeleteImageError LinodeImageStatusError = "DeleteError"
+)
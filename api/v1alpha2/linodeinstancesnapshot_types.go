@@ -0,0 +1,211 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LinodeInstanceSnapshotSpec defines the desired state of LinodeInstanceSnapshot
+type LinodeInstanceSnapshotSpec struct {
+	// linodeMachineRef is a reference to the LinodeMachine whose instance is snapshotted.
+	// Backups must be enabled on the instance.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	LinodeMachineRef corev1.ObjectReference `json:"linodeMachineRef,omitzero"`
+
+	// label is the label of the snapshot. If not specified, the name of the LinodeInstanceSnapshot is used.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// credentialsRef is a reference to a Secret that contains the credentials to use for taking the snapshot.
+	// If not supplied, then the credentials of the LinodeMachine are used, falling back to the credentials of the controller.
+	// +optional
+	CredentialsRef *corev1.SecretReference `json:"credentialsRef,omitempty"`
+}
+
+// LinodeInstanceSnapshotStatus defines the observed state of LinodeInstanceSnapshot
+type LinodeInstanceSnapshotStatus struct {
+	// conditions define the current service state of the LinodeInstanceSnapshot.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// ready is true when the snapshot completed and can be restored from.
+	// +optional
+	// +kubebuilder:default=false
+	Ready bool `json:"ready"`
+
+	// backupID is the ID of the backup holding the snapshot.
+	// +optional
+	BackupID *int `json:"backupID,omitempty"`
+
+	// instanceID is the ID of the Linode instance the snapshot was taken of.
+	// +optional
+	InstanceID *int `json:"instanceID,omitempty"`
+
+	// region is the region of the Linode instance the snapshot was taken of.
+	// Instances can only be restored from the snapshot in the same region.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// status is the status of the snapshot as reported by the Linode API.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// size is the total size of the disks in the snapshot in megabytes.
+	// +optional
+	Size int `json:"size,omitempty"`
+
+	// finished is the time at which the snapshot completed.
+	// +optional
+	Finished *metav1.Time `json:"finished,omitempty"`
+
+	// failureReason will be set in the event that there is a terminal problem
+	// reconciling the snapshot and will contain a succinct value suitable
+	// for machine interpretation.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the snapshot's spec or the configuration of
+	// the controller, and that manual intervention is required.
+	// +optional
+	FailureReason *LinodeInstanceSnapshotStatusError `json:"failureReason,omitempty"`
+
+	// failureMessage will be set in the event that there is a terminal problem
+	// reconciling the snapshot and will contain a more verbose string suitable
+	// for logging and human consumption.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the snapshot's spec or the configuration of
+	// the controller, and that manual intervention is required.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodeinstancesnapshots,scope=Namespaced,categories=cluster-api,shortName=lisnap
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Backup",type="integer",JSONPath=".status.backupID",description="Backup ID"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.status",description="Snapshot status"
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.size",description="Snapshot size in MB"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Snapshot is ready"
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move-hierarchy=true"
+
+// LinodeInstanceSnapshot is the Schema for the linodeinstancesnapshots API
+type LinodeInstanceSnapshot struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeInstanceSnapshot.
+	// +required
+	Spec LinodeInstanceSnapshotSpec `json:"spec,omitzero,omitempty"`
+
+	// status is the observed state of the LinodeInstanceSnapshot.
+	// +optional
+	Status LinodeInstanceSnapshotStatus `json:"status,omitempty"`
+}
+
+func (lis *LinodeInstanceSnapshot) GetConditions() []metav1.Condition {
+	for i := range lis.Status.Conditions {
+		if lis.Status.Conditions[i].Reason == "" {
+			lis.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return lis.Status.Conditions
+}
+
+func (lis *LinodeInstanceSnapshot) SetConditions(conditions []metav1.Condition) {
+	lis.Status.Conditions = conditions
+}
+
+func (lis *LinodeInstanceSnapshot) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range lis.Status.Conditions {
+		if lis.Status.Conditions[i].Type == cond.Type {
+			lis.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	lis.Status.Conditions = append(lis.Status.Conditions, cond)
+}
+
+func (lis *LinodeInstanceSnapshot) GetCondition(condType string) *metav1.Condition {
+	for i := range lis.Status.Conditions {
+		if lis.Status.Conditions[i].Type == condType {
+			return &lis.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func (lis *LinodeInstanceSnapshot) IsPaused() bool {
+	for i := range lis.Status.Conditions {
+		if lis.Status.Conditions[i].Type == ConditionPaused {
+			return lis.Status.Conditions[i].Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeInstanceSnapshotList contains a list of LinodeInstanceSnapshot
+type LinodeInstanceSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeInstanceSnapshot.
+	Items []LinodeInstanceSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeInstanceSnapshot{}, &LinodeInstanceSnapshotList{})
+}
+
+// LinodeInstanceSnapshotStatusError defines errors states for LinodeInstanceSnapshot objects.
+type LinodeInstanceSnapshotStatusError string
+
+const (
+	// CreateInstanceSnapshotError indicates that an error was encountered
+	// when trying to take the snapshot.
+	CreateInstanceSnapshotError LinodeInstanceSnapshotStatusError = "CreateError"
+
+	// UpdateInstanceSnapshotError indicates that an error was encountered
+	// when trying to fetch the status of the snapshot.
+	UpdateInstanceSnapshotError LinodeInstanceSnapshotStatusError = "UpdateError"
+)
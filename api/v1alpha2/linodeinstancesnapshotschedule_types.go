@@ -0,0 +1,165 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// InstanceSnapshotScheduleLabel is set on the LinodeInstanceSnapshots created by a LinodeInstanceSnapshotSchedule
+	// to the name of the schedule.
+	InstanceSnapshotScheduleLabel = "linodeinstancesnapshotschedule.infrastructure.cluster.x-k8s.io/name"
+)
+
+// LinodeInstanceSnapshotScheduleSpec defines the desired state of LinodeInstanceSnapshotSchedule
+type LinodeInstanceSnapshotScheduleSpec struct {
+	// linodeMachineRef is a reference to the LinodeMachine whose instance is snapshotted.
+	// Backups must be enabled on the instance.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	LinodeMachineRef corev1.ObjectReference `json:"linodeMachineRef,omitzero"`
+
+	// interval is the time between two snapshots.
+	// +required
+	Interval metav1.Duration `json:"interval,omitzero"`
+
+	// retentionLimit is the number of LinodeInstanceSnapshots to keep. The oldest ones are deleted first.
+	// Linode only keeps the most recent manual snapshot of an instance, older LinodeInstanceSnapshots are kept
+	// as a record only and are no longer ready.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=3
+	// +optional
+	RetentionLimit int32 `json:"retentionLimit,omitempty"`
+
+	// suspend stops new snapshots from being taken. Retention is still enforced.
+	// Defaults to false.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// credentialsRef is a reference to a Secret that contains the credentials to use for taking the snapshots.
+	// If not supplied, then the credentials of the LinodeMachine are used, falling back to the credentials of the controller.
+	// +optional
+	CredentialsRef *corev1.SecretReference `json:"credentialsRef,omitempty"`
+}
+
+// LinodeInstanceSnapshotScheduleStatus defines the observed state of LinodeInstanceSnapshotSchedule
+type LinodeInstanceSnapshotScheduleStatus struct {
+	// conditions define the current service state of the LinodeInstanceSnapshotSchedule.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// lastSnapshotTime is the time at which the last LinodeInstanceSnapshot was created.
+	// +optional
+	LastSnapshotTime *metav1.Time `json:"lastSnapshotTime,omitempty"`
+
+	// lastSnapshotName is the name of the last LinodeInstanceSnapshot that was created.
+	// +optional
+	LastSnapshotName string `json:"lastSnapshotName,omitempty"`
+
+	// nextSnapshotTime is the time at which the next LinodeInstanceSnapshot is created.
+	// +optional
+	NextSnapshotTime *metav1.Time `json:"nextSnapshotTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodeinstancesnapshotschedules,scope=Namespaced,categories=cluster-api,shortName=lisnaps
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Interval",type="string",JSONPath=".spec.interval",description="Time between snapshots"
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend",description="Snapshots are suspended"
+// +kubebuilder:printcolumn:name="Last Snapshot",type="date",JSONPath=".status.lastSnapshotTime",description="Time of the last snapshot"
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move-hierarchy=true"
+
+// LinodeInstanceSnapshotSchedule is the Schema for the linodeinstancesnapshotschedules API.
+// It periodically creates LinodeInstanceSnapshots of a LinodeMachine.
+type LinodeInstanceSnapshotSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeInstanceSnapshotSchedule.
+	// +required
+	Spec LinodeInstanceSnapshotScheduleSpec `json:"spec,omitzero,omitempty"`
+
+	// status is the observed state of the LinodeInstanceSnapshotSchedule.
+	// +optional
+	Status LinodeInstanceSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+func (liss *LinodeInstanceSnapshotSchedule) GetConditions() []metav1.Condition {
+	for i := range liss.Status.Conditions {
+		if liss.Status.Conditions[i].Reason == "" {
+			liss.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return liss.Status.Conditions
+}
+
+func (liss *LinodeInstanceSnapshotSchedule) SetConditions(conditions []metav1.Condition) {
+	liss.Status.Conditions = conditions
+}
+
+func (liss *LinodeInstanceSnapshotSchedule) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range liss.Status.Conditions {
+		if liss.Status.Conditions[i].Type == cond.Type {
+			liss.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	liss.Status.Conditions = append(liss.Status.Conditions, cond)
+}
+
+func (liss *LinodeInstanceSnapshotSchedule) GetCondition(condType string) *metav1.Condition {
+	for i := range liss.Status.Conditions {
+		if liss.Status.Conditions[i].Type == condType {
+			return &liss.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeInstanceSnapshotScheduleList contains a list of LinodeInstanceSnapshotSchedule
+type LinodeInstanceSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeInstanceSnapshotSchedule.
+	Items []LinodeInstanceSnapshotSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeInstanceSnapshotSchedule{}, &LinodeInstanceSnapshotScheduleList{})
+}
@@ -31,9 +31,24 @@ const (
 	// with LinodeMachine before removing it from the apiserver.
 	MachineFinalizer       = "linodemachine.infrastructure.cluster.x-k8s.io"
 	DefaultConditionReason = "None"
+
+	// RebuildAnnotation requests an in-place rebuild of the Linode instance backing a LinodeMachine.
+	// It is removed by the controller once the rebuild has been started.
+	RebuildAnnotation = "linodemachine.infrastructure.cluster.x-k8s.io/rebuild"
+
+	// AdoptInstanceAnnotation requests the adoption of an existing Linode instance instead of the creation of a new one.
+	// Its value is the ID or the label of the instance. adoptInstance takes precedence over it.
+	AdoptInstanceAnnotation = "linodemachine.infrastructure.cluster.x-k8s.io/adopt-instance"
+
+	// UpgradeInterfacesAnnotation requests the upgrade of the legacy configuration interfaces of the Linode instance
+	// backing a LinodeMachine to Linode interfaces. It is removed by the controller once the upgrade is done.
+	UpgradeInterfacesAnnotation = "linodemachine.infrastructure.cluster.x-k8s.io/upgrade-interfaces"
 )
 
 // LinodeMachineSpec defines the desired state of LinodeMachine
+// +kubebuilder:validation:XValidation:rule="self.type == oldSelf.type || (has(self.allowInPlaceResize) && self.allowInPlaceResize)",message="type is immutable unless allowInPlaceResize is enabled"
+// +kubebuilder:validation:XValidation:rule="!(has(self.image) && has(self.imageRef))",message="image and imageRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.backupID) && has(self.backupRef))",message="backupID and backupRef are mutually exclusive"
 type LinodeMachineSpec struct {
 	// providerID is the unique identifier as specified by the cloud provider.
 	// +optional
@@ -50,11 +65,23 @@ type LinodeMachineSpec struct {
 	Region string `json:"region,omitempty"`
 
 	// type is the Linode instance type to create.
+	// The type can only be changed when allowInPlaceResize is enabled.
 	// +kubebuilder:validation:MinLength=1
 	// +required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	Type string `json:"type,omitempty"`
 
+	// allowInPlaceResize enables changing the type of an existing instance. The instance is shut down,
+	// resized to the new type and booted again instead of being replaced.
+	// Defaults to false.
+	// +optional
+	AllowInPlaceResize bool `json:"allowInPlaceResize,omitempty"`
+
+	// autoResizeDisk enables resizing the disk of the instance to the storage of the new type during
+	// an in-place resize. This only has an effect if the instance has no more than one disk and one swap disk.
+	// Defaults to false.
+	// +optional
+	AutoResizeDisk bool `json:"autoResizeDisk,omitempty"`
+
 	// group is the Linode group to create the instance in.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
@@ -82,20 +109,34 @@ type LinodeMachineSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	BackupID int `json:"backupID,omitempty"`
 
+	// backupRef is a reference to a LinodeInstanceSnapshot to restore the instance from.
+	// The backup ID reported in the LinodeInstanceSnapshot status is used once the snapshot is ready.
+	// The instance must be in the same region as the snapshot. Mutually exclusive with backupID.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	BackupRef *corev1.ObjectReference `json:"backupRef,omitempty"`
+
 	// image is the Linode image to use for the instance.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	Image string `json:"image,omitempty"`
 
-	// interfaces is a list of legacy network interfaces to use for the instance.
+	// imageRef is a reference to a LinodeImage to use for the instance.
+	// The image ID reported in the LinodeImage status is used once it is available in the region of the instance.
+	// Mutually exclusive with image.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	ImageRef *corev1.ObjectReference `json:"imageRef,omitempty"`
+
+	// interfaces is a list of legacy network interfaces to use for the instance.
+	// The interfaces of a running instance can only be changed when the LegacyInterfaceUpdates feature gate is enabled.
+	// +optional
 	// +listType=atomic
 	Interfaces []InstanceConfigInterfaceCreateOptions `json:"interfaces,omitempty"`
 
 	// linodeInterfaces is a list of Linode network interfaces to use for the instance. Requires Linode Interfaces beta opt-in to use.
+	// The interfaces of a running instance are created, updated and deleted to match the list.
 	// +optional
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// +kubebuilder:object:generate=true
 	// +listType=atomic
 	LinodeInterfaces []LinodeInterfaceCreateOptions `json:"linodeInterfaces,omitempty"`
@@ -159,14 +200,22 @@ type LinodeMachineSpec struct {
 	// +optional
 	FirewallRef *corev1.ObjectReference `json:"firewallRef,omitempty"`
 
+	// volumeRefs is a list of references to LinodeVolume objects. The Volumes are attached to the
+	// instance before it is first booted and detached when the LinodeMachine is deleted.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=atomic
+	// +optional
+	VolumeRefs []corev1.ObjectReference `json:"volumeRefs,omitempty"`
+
 	// vpcRef is a reference to a LinodeVPC resource. If specified, this takes precedence over
 	// the cluster-level VPC configuration for multi-region support.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Changing it moves the VPC interface of a running instance, see interfaces.
 	// +optional
 	VPCRef *corev1.ObjectReference `json:"vpcRef,omitempty"`
 
 	// vpcID is the ID of an existing VPC in Linode. This allows using a VPC that is not managed by CAPL.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Changing it moves the VPC interface of a running instance, see interfaces.
 	// +optional
 	VPCID *int `json:"vpcID,omitempty"`
 
@@ -187,10 +236,56 @@ type LinodeMachineSpec struct {
 	// interfaceGeneration is the generation of the interface to use for the cluster's
 	// nodes in interface / linodeInterface are not specified for a LinodeMachine.
 	// If not set, defaults to "legacy_config".
+	// Changing it from "legacy_config" to "linode" upgrades the interfaces of a running instance.
 	// +optional
 	// +kubebuilder:validation:Enum=legacy_config;linode
 	// +kubebuilder:default=legacy_config
 	InterfaceGeneration linodego.InterfaceGeneration `json:"interfaceGeneration,omitempty"`
+
+	// adoptInstance selects an existing Linode instance to adopt instead of creating a new one.
+	// The instance must match the region, type and interfaces of the LinodeMachine, it is not rebuilt.
+	// It can't be set in a LinodeMachineTemplate.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	AdoptInstance *InstanceAdoptionSelector `json:"adoptInstance,omitempty"`
+
+	// gracefulShutdown shuts the instance down before it is deleted, instead of deleting it while it is running.
+	// +optional
+	GracefulShutdown *GracefulShutdown `json:"gracefulShutdown,omitempty"`
+}
+
+// GracefulShutdown configures the shutdown of an instance before it is deleted.
+type GracefulShutdown struct {
+	// timeout is how long to wait for the instance to be offline after shutting it down. The instance is deleted
+	// anyway once it expires.
+	// Defaults to 5 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// drainNodeBalancer sets the NodeBalancer backends of the instance to drain mode before shutting it down, so that
+	// they stop receiving new connections.
+	// Defaults to false.
+	// +optional
+	DrainNodeBalancer bool `json:"drainNodeBalancer,omitempty"`
+
+	// drainDuration is how long to wait after setting the NodeBalancer backends of the instance to drain mode before
+	// shutting it down, for the open connections to finish.
+	// Defaults to 0.
+	// +optional
+	DrainDuration *metav1.Duration `json:"drainDuration,omitempty"`
+}
+
+// InstanceAdoptionSelector selects an existing Linode instance by ID or label.
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.label)",message="exactly one of id or label must be set"
+type InstanceAdoptionSelector struct {
+	// id is the ID of the Linode instance.
+	// +optional
+	ID *int `json:"id,omitempty"`
+
+	// label is the label of the Linode instance.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Label string `json:"label,omitempty"`
 }
 
 // IPv6CreateOptions defines the IPv6 options for the instance.
@@ -619,6 +714,12 @@ type LinodeMachineStatus struct {
 	// +optional
 	// +listType=set
 	Tags []string `json:"tags,omitempty"`
+
+	// rebuildInterfaces are the network interfaces of the instance configuration captured before an in-place
+	// rebuild, which deletes all configurations of the instance. They are restored once the instance is rebuilt.
+	// +optional
+	// +listType=atomic
+	RebuildInterfaces []InstanceConfigInterfaceCreateOptions `json:"rebuildInterfaces,omitempty"`
 }
 
 // +kubebuilder:object:root=true
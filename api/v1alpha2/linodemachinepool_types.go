@@ -0,0 +1,214 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MachinePoolFinalizer allows ReconcileLinodeMachinePool to clean up the Linode instances of the pool
+	// before removing it from the apiserver.
+	MachinePoolFinalizer = "linodemachinepool.infrastructure.cluster.x-k8s.io"
+
+	// MachinePoolTemplateHashLabel is set on the LinodeMachinePoolMachines to the hash of the template they were
+	// created from.
+	MachinePoolTemplateHashLabel = "linodemachinepool.infrastructure.cluster.x-k8s.io/template-hash"
+
+	// MachinePoolOwnerTagAnnotation is set on the LinodeMachinePoolMachines to the owner tag of their instance, so that
+	// instances are only claimed again once the cluster is moved to another management cluster.
+	MachinePoolOwnerTagAnnotation = "linodemachinepool.infrastructure.cluster.x-k8s.io/owner-tag"
+)
+
+// LinodeMachinePoolSpec defines the desired state of LinodeMachinePool
+type LinodeMachinePoolSpec struct {
+	// providerIDList are the identification IDs of the Linode instances of the pool.
+	// +optional
+	// +listType=atomic
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// machineTemplateRef is a reference to the LinodeMachineTemplate the Linode instances of the pool are created from.
+	// If no namespace is provided, the namespace of the LinodeMachinePool is used.
+	// Referencing another LinodeMachineTemplate replaces the instances according to the strategy.
+	// +required
+	MachineTemplateRef corev1.ObjectReference `json:"machineTemplateRef,omitzero"`
+
+	// strategy defines how the instances are replaced when the referenced LinodeMachineTemplate changes.
+	// +optional
+	Strategy LinodeMachinePoolStrategy `json:"strategy,omitempty,omitzero"`
+
+	// credentialsRef is a reference to a Secret that contains the credentials to use for provisioning the instances.
+	// If not supplied then the credentials of the LinodeCluster will be used.
+	// +optional
+	CredentialsRef *corev1.SecretReference `json:"credentialsRef,omitempty"`
+}
+
+// LinodeMachinePoolStrategy defines the rolling replacement of the instances of a LinodeMachinePool.
+// +kubebuilder:validation:XValidation:rule="(has(self.maxSurge) ? self.maxSurge : 1) > 0 || (has(self.maxUnavailable) ? self.maxUnavailable : 0) > 0",message="maxSurge and maxUnavailable can't both be 0"
+type LinodeMachinePoolStrategy struct {
+	// maxSurge is the maximum number of instances that can be created above the desired number of replicas
+	// while replacing outdated instances. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+
+	// maxUnavailable is the maximum number of instances that can be unavailable while replacing outdated
+	// instances. Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+}
+
+// LinodeMachinePoolStatus defines the observed state of LinodeMachinePool
+type LinodeMachinePoolStatus struct {
+	// conditions define the current service state of the LinodeMachinePool.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// ready is true once the desired number of instances of the pool were first running.
+	// It stays true while the pool is scaled or its instances are replaced.
+	// +optional
+	// +kubebuilder:default=false
+	Ready bool `json:"ready"`
+
+	// replicas is the number of Linode instances of the pool.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// infrastructureMachineKind is the kind of the infrastructure resources backing the instances of the pool.
+	// +optional
+	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+
+	// failureReason will be set in the event that there is a terminal problem
+	// reconciling the LinodeMachinePool and will contain a succinct value suitable
+	// for machine interpretation.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the LinodeMachinePool's spec or the configuration of
+	// the controller, and that manual intervention is required.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// failureMessage will be set in the event that there is a terminal problem
+	// reconciling the LinodeMachinePool and will contain a more verbose string suitable
+	// for logging and human consumption.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the LinodeMachinePool's spec or the configuration of
+	// the controller, and that manual intervention is required.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodemachinepools,scope=Namespaced,categories=cluster-api,shortName=lmp
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this LinodeMachinePool belongs"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of instances"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine pool ready status"
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move-hierarchy=true"
+
+// LinodeMachinePool is the Schema for the linodemachinepools API
+type LinodeMachinePool struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeMachinePool.
+	// +required
+	Spec LinodeMachinePoolSpec `json:"spec,omitzero,omitempty"`
+
+	// status is the observed state of the LinodeMachinePool.
+	// +optional
+	Status LinodeMachinePoolStatus `json:"status,omitempty"`
+}
+
+func (lmp *LinodeMachinePool) GetConditions() []metav1.Condition {
+	for i := range lmp.Status.Conditions {
+		if lmp.Status.Conditions[i].Reason == "" {
+			lmp.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return lmp.Status.Conditions
+}
+
+func (lmp *LinodeMachinePool) SetConditions(conditions []metav1.Condition) {
+	lmp.Status.Conditions = conditions
+}
+
+func (lmp *LinodeMachinePool) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range lmp.Status.Conditions {
+		if lmp.Status.Conditions[i].Type == cond.Type {
+			lmp.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	lmp.Status.Conditions = append(lmp.Status.Conditions, cond)
+}
+
+func (lmp *LinodeMachinePool) GetCondition(condType string) *metav1.Condition {
+	for i := range lmp.Status.Conditions {
+		if lmp.Status.Conditions[i].Type == condType {
+			return &lmp.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func (lmp *LinodeMachinePool) IsPaused() bool {
+	for i := range lmp.Status.Conditions {
+		if lmp.Status.Conditions[i].Type == ConditionPaused {
+			return lmp.Status.Conditions[i].Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeMachinePoolList contains a list of LinodeMachinePool
+type LinodeMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeMachinePool.
+	Items []LinodeMachinePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeMachinePool{}, &LinodeMachinePoolList{})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"github.com/linode/linodego/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// LinodeMachinePoolMachineSpec defines the desired state of LinodeMachinePoolMachine
+type LinodeMachinePoolMachineSpec struct {
+	// providerID is the unique identifier as specified by the cloud provider.
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// instanceID is the Linode instance ID for this machine.
+	// +optional
+	InstanceID *int `json:"instanceID,omitempty"`
+}
+
+// LinodeMachinePoolMachineStatus defines the observed state of LinodeMachinePoolMachine
+type LinodeMachinePoolMachineStatus struct {
+	// conditions define the current service state of the LinodeMachinePoolMachine.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// ready is true when the Linode instance is running.
+	// +optional
+	// +kubebuilder:default=false
+	Ready bool `json:"ready"`
+
+	// latestModelApplied is true when the instance was created from the current template of the LinodeMachinePool.
+	// +optional
+	LatestModelApplied bool `json:"latestModelApplied,omitempty"`
+
+	// addresses contains the Linode instance associated addresses.
+	// +optional
+	// +listType=map
+	// +listMapKey=address
+	Addresses []clusterv1.MachineAddress `json:"addresses,omitempty"`
+
+	// instanceState is the state of the Linode instance for this machine.
+	// +optional
+	InstanceState *linodego.InstanceStatus `json:"instanceState,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodemachinepoolmachines,scope=Namespaced,categories=cluster-api,shortName=lmpm
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this LinodeMachinePoolMachine belongs"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.instanceState",description="Linode instance state"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine ready status"
+// +kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=".spec.providerID",description="Provider ID"
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move-hierarchy=true"
+
+// LinodeMachinePoolMachine is the Schema for the linodemachinepoolmachines API
+type LinodeMachinePoolMachine struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeMachinePoolMachine.
+	// +optional
+	Spec LinodeMachinePoolMachineSpec `json:"spec,omitzero,omitempty"`
+
+	// status is the observed state of the LinodeMachinePoolMachine.
+	// +optional
+	Status LinodeMachinePoolMachineStatus `json:"status,omitempty"`
+}
+
+func (lmpm *LinodeMachinePoolMachine) GetConditions() []metav1.Condition {
+	for i := range lmpm.Status.Conditions {
+		if lmpm.Status.Conditions[i].Reason == "" {
+			lmpm.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return lmpm.Status.Conditions
+}
+
+func (lmpm *LinodeMachinePoolMachine) SetConditions(conditions []metav1.Condition) {
+	lmpm.Status.Conditions = conditions
+}
+
+func (lmpm *LinodeMachinePoolMachine) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range lmpm.Status.Conditions {
+		if lmpm.Status.Conditions[i].Type == cond.Type {
+			lmpm.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	lmpm.Status.Conditions = append(lmpm.Status.Conditions, cond)
+}
+
+func (lmpm *LinodeMachinePoolMachine) GetCondition(condType string) *metav1.Condition {
+	for i := range lmpm.Status.Conditions {
+		if lmpm.Status.Conditions[i].Type == condType {
+			return &lmpm.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeMachinePoolMachineList contains a list of LinodeMachinePoolMachine
+type LinodeMachinePoolMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeMachinePoolMachine.
+	Items []LinodeMachinePoolMachine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeMachinePoolMachine{}, &LinodeMachinePoolMachineList{})
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemediationPhase is the phase of a LinodeMachineRemediation.
+// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+type RemediationPhase string
+
+const (
+	// RemediationPhaseRunning is set once the rebuild of the instance has been requested.
+	RemediationPhaseRunning RemediationPhase = "Running"
+	// RemediationPhaseSucceeded is set once the instance has been rebuilt and is running again.
+	RemediationPhaseSucceeded RemediationPhase = "Succeeded"
+	// RemediationPhaseFailed is set when the rebuild failed or did not finish in time.
+	RemediationPhaseFailed RemediationPhase = "Failed"
+)
+
+// LinodeMachineRemediationSpec defines the desired state of LinodeMachineRemediation
+type LinodeMachineRemediationSpec struct {
+	// timeout is how long to wait for the instance to be rebuilt before the remediation is marked as failed.
+	// Defaults to 30 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// LinodeMachineRemediationStatus defines the observed state of LinodeMachineRemediation
+type LinodeMachineRemediationStatus struct {
+	// conditions define the current service state of the LinodeMachineRemediation.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// phase is the phase of the remediation.
+	// +optional
+	Phase RemediationPhase `json:"phase,omitempty"`
+
+	// lastRemediated is the time at which the rebuild of the instance was requested.
+	// +optional
+	LastRemediated *metav1.Time `json:"lastRemediated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodemachineremediations,scope=Namespaced,categories=cluster-api,shortName=lmr
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Remediation phase"
+// +kubebuilder:printcolumn:name="Last Remediated",type="date",JSONPath=".status.lastRemediated",description="Time the rebuild was requested"
+
+// LinodeMachineRemediation is the Schema for the linodemachineremediations API.
+// It is created by a Cluster API MachineHealthCheck for an unhealthy Machine and rebuilds the
+// Linode instance of the Machine in place.
+type LinodeMachineRemediation struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeMachineRemediation.
+	// +optional
+	Spec LinodeMachineRemediationSpec `json:"spec,omitempty"`
+
+	// status is the observed state of the LinodeMachineRemediation.
+	// +optional
+	Status LinodeMachineRemediationStatus `json:"status,omitempty"`
+}
+
+func (lmr *LinodeMachineRemediation) GetConditions() []metav1.Condition {
+	for i := range lmr.Status.Conditions {
+		if lmr.Status.Conditions[i].Reason == "" {
+			lmr.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return lmr.Status.Conditions
+}
+
+func (lmr *LinodeMachineRemediation) SetConditions(conditions []metav1.Condition) {
+	lmr.Status.Conditions = conditions
+}
+
+func (lmr *LinodeMachineRemediation) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range lmr.Status.Conditions {
+		if lmr.Status.Conditions[i].Type == cond.Type {
+			lmr.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	lmr.Status.Conditions = append(lmr.Status.Conditions, cond)
+}
+
+func (lmr *LinodeMachineRemediation) GetCondition(condType string) *metav1.Condition {
+	for i := range lmr.Status.Conditions {
+		if lmr.Status.Conditions[i].Type == condType {
+			return &lmr.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeMachineRemediationList contains a list of LinodeMachineRemediation
+type LinodeMachineRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeMachineRemediation.
+	// +optional
+	Items []LinodeMachineRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeMachineRemediation{}, &LinodeMachineRemediationList{})
+}
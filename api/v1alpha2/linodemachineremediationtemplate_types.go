@@ -0,0 +1,70 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LinodeMachineRemediationTemplateSpec defines the desired state of LinodeMachineRemediationTemplate
+type LinodeMachineRemediationTemplateSpec struct {
+	// template defines the specification for a LinodeMachineRemediation.
+	// +required
+	Template LinodeMachineRemediationTemplateResource `json:"template,omitempty,omitzero"`
+}
+
+// LinodeMachineRemediationTemplateResource describes the data needed to create a LinodeMachineRemediation from a template.
+type LinodeMachineRemediationTemplateResource struct {
+	// spec is the specification of the desired behavior of the remediation.
+	// +optional
+	Spec LinodeMachineRemediationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodemachineremediationtemplates,scope=Namespaced,categories=cluster-api,shortName=lmrt
+
+// LinodeMachineRemediationTemplate is the Schema for the linodemachineremediationtemplates API.
+// It is referenced by the remediation templateRef of a Cluster API MachineHealthCheck.
+type LinodeMachineRemediationTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeMachineRemediationTemplate.
+	// +optional
+	Spec LinodeMachineRemediationTemplateSpec `json:"spec,omitzero,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeMachineRemediationTemplateList contains a list of LinodeMachineRemediationTemplate
+type LinodeMachineRemediationTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeMachineRemediationTemplate.
+	// +optional
+	Items []LinodeMachineRemediationTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeMachineRemediationTemplate{}, &LinodeMachineRemediationTemplateList{})
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VolumeFinalizer allows ReconcileLinodeVolume to clean up Linode resources associated
+	// with LinodeVolume before removing it from the apiserver.
+	VolumeFinalizer = "linodevolume.infrastructure.cluster.x-k8s.io"
+)
+
+// LinodeVolumeSpec defines the desired state of LinodeVolume
+type LinodeVolumeSpec struct {
+	// volumeID is the ID of the Block Storage Volume.
+	// If set on creation, the existing Volume is adopted instead of creating a new one.
+	// +optional
+	VolumeID *int `json:"volumeID,omitempty"`
+
+	// region is the Linode region to create the Volume in.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +required
+	Region string `json:"region,omitempty"`
+
+	// size of the Volume in resource.Quantity notation, e.g. 20Gi. Volumes can only be grown,
+	// the size is rounded up to the nearest whole gibibyte.
+	// +required
+	Size resource.Quantity `json:"size,omitempty"`
+
+	// label is the label of the Volume. If not specified, the name of the LinodeVolume is used.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// tags is a list of tags to apply to the Volume.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// encryption controls whether the Volume is encrypted at rest.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	Encryption string `json:"encryption,omitempty"`
+
+	// retain allows you to keep the Volume after the LinodeVolume object is deleted.
+	// This is useful for data that must outlive the cluster, the retained Volume can be
+	// adopted again later by setting volumeID.
+	// If set to true, the controller will not delete the Volume resource in Linode.
+	// Defaults to false.
+	// +optional
+	// +kubebuilder:default=false
+	Retain bool `json:"retain,omitempty"`
+
+	// credentialsRef is a reference to a Secret that contains the credentials to use for provisioning this Volume.
+	// If not supplied, then the credentials of the controller will be used.
+	// +optional
+	CredentialsRef *corev1.SecretReference `json:"credentialsRef,omitempty"`
+}
+
+// LinodeVolumeStatus defines the observed state of LinodeVolume
+type LinodeVolumeStatus struct {
+	// conditions define the current service state of the LinodeVolume.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// ready is true when the provider resource is ready.
+	// +optional
+	// +kubebuilder:default=false
+	Ready bool `json:"ready"`
+
+	// size is the current size of the Volume in gigabytes as reported by the Linode API.
+	// +optional
+	Size int `json:"size,omitempty"`
+
+	// linodeID is the ID of the Linode the Volume is currently attached to.
+	// +optional
+	LinodeID *int `json:"linodeID,omitempty"`
+
+	// filesystemPath is the path of the Volume on the Linode it is attached to.
+	// +optional
+	FilesystemPath string `json:"filesystemPath,omitempty"`
+
+	// failureReason will be set in the event that there is a terminal problem
+	// reconciling the Volume and will contain a succinct value suitable
+	// for machine interpretation.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the Volume's spec or the configuration of
+	// the controller, and that manual intervention is required. Examples
+	// of terminal errors would be invalid combinations of settings in the
+	// spec, values that are unsupported by the controller, or the
+	// responsible controller itself being critically misconfigured.
+	//
+	// Any transient errors that occur during the reconciliation of Volumes
+	// can be added as events to the Volume object and/or logged in the
+	// controller's output.
+	// +optional
+	FailureReason *LinodeVolumeStatusError `json:"failureReason,omitempty"`
+
+	// failureMessage will be set in the event that there is a terminal problem
+	// reconciling the Volume and will contain a more verbose string suitable
+	// for logging and human consumption.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the Volume's spec or the configuration of
+	// the controller, and that manual intervention is required. Examples
+	// of terminal errors would be invalid combinations of settings in the
+	// spec, values that are unsupported by the controller, or the
+	// responsible controller itself being critically misconfigured.
+	//
+	// Any transient errors that occur during the reconciliation of Volumes
+	// can be added as events to the Volume object and/or logged in the
+	// controller's output.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=linodevolumes,scope=Namespaced,categories=cluster-api,shortName=lvol
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.size",description="Volume size in GB"
+// +kubebuilder:printcolumn:name="Linode",type="integer",JSONPath=".status.linodeID",description="Linode the Volume is attached to"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Volume is ready"
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move-hierarchy=true"
+
+// LinodeVolume is the Schema for the linodevolumes API
+type LinodeVolume struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the LinodeVolume.
+	// +required
+	Spec LinodeVolumeSpec `json:"spec,omitzero,omitempty"`
+
+	// status is the observed state of the LinodeVolume.
+	// +optional
+	Status LinodeVolumeStatus `json:"status,omitempty"`
+}
+
+func (lv *LinodeVolume) GetConditions() []metav1.Condition {
+	for i := range lv.Status.Conditions {
+		if lv.Status.Conditions[i].Reason == "" {
+			lv.Status.Conditions[i].Reason = DefaultConditionReason
+		}
+	}
+
+	return lv.Status.Conditions
+}
+
+func (lv *LinodeVolume) SetConditions(conditions []metav1.Condition) {
+	lv.Status.Conditions = conditions
+}
+
+func (lv *LinodeVolume) SetCondition(cond metav1.Condition) {
+	if cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	for i := range lv.Status.Conditions {
+		if lv.Status.Conditions[i].Type == cond.Type {
+			lv.Status.Conditions[i] = cond
+
+			return
+		}
+	}
+	lv.Status.Conditions = append(lv.Status.Conditions, cond)
+}
+
+func (lv *LinodeVolume) GetCondition(condType string) *metav1.Condition {
+	for i := range lv.Status.Conditions {
+		if lv.Status.Conditions[i].Type == condType {
+			return &lv.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func (lv *LinodeVolume) IsPaused() bool {
+	for i := range lv.Status.Conditions {
+		if lv.Status.Conditions[i].Type == ConditionPaused {
+			return lv.Status.Conditions[i].Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// LinodeVolumeList contains a list of LinodeVolume
+type LinodeVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a list of LinodeVolume.
+	Items []LinodeVolume `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LinodeVolume{}, &LinodeVolumeList{})
+}
+
+// LinodeVolumeStatusError defines errors states for Volume objects.
+type LinodeVolumeStatusError string
+
+const (
+	// CreateVolumeError indicates that an error was encountered
+	// when trying to create the Volume.
+	CreateVolumeError LinodeVolumeStatusError = "CreateError"
+
+	// UpdateVolumeError indicates that an error was encountered
+	// when trying to update the Volume.
+	UpdateVolumeError LinodeVolumeStatusError = "UpdateError"
+
+	// DeleteVolumeError indicates that an error was encountered
+	// when trying to delete the Volume.
+	DeleteVolumeError LinodeVolumeStatusError = "DeleteError"
+)
@@ -150,6 +150,45 @@ func (in *BucketAccessRef) DeepCopy() *BucketAccessRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCostEstimate) DeepCopyInto(out *ClusterCostEstimate) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCostEstimate.
+func (in *ClusterCostEstimate) DeepCopy() *ClusterCostEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCostEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRFC2136Config) DeepCopyInto(out *DNSRFC2136Config) {
+	*out = *in
+	if in.TSIGSecretRef != nil {
+		in, out := &in.TSIGSecretRef, &out.TSIGSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRFC2136Config.
+func (in *DNSRFC2136Config) DeepCopy() *DNSRFC2136Config {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRFC2136Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
 	*out = *in
@@ -277,6 +316,31 @@ func (in *GeneratedSecret) DeepCopy() *GeneratedSecret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GracefulShutdown) DeepCopyInto(out *GracefulShutdown) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DrainDuration != nil {
+		in, out := &in.DrainDuration, &out.DrainDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GracefulShutdown.
+func (in *GracefulShutdown) DeepCopy() *GracefulShutdown {
+	if in == nil {
+		return nil
+	}
+	out := new(GracefulShutdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPv6CreateOptions) DeepCopyInto(out *IPv6CreateOptions) {
 	*out = *in
@@ -307,6 +371,26 @@ func (in *IPv6CreateOptions) DeepCopy() *IPv6CreateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAdoptionSelector) DeepCopyInto(out *InstanceAdoptionSelector) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAdoptionSelector.
+func (in *InstanceAdoptionSelector) DeepCopy() *InstanceAdoptionSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAdoptionSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceConfigInterfaceCreateOptions) DeepCopyInto(out *InstanceConfigInterfaceCreateOptions) {
 	*out = *in
@@ -547,6 +631,13 @@ func (in *LinodeClusterSpec) DeepCopyInto(out *LinodeClusterSpec) {
 		*out = new(v1.SecretReference)
 		**out = **in
 	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]LinodeFailureDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeClusterSpec.
@@ -579,6 +670,28 @@ func (in *LinodeClusterStatus) DeepCopyInto(out *LinodeClusterStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]v1beta2.FailureDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EstimatedCost != nil {
+		in, out := &in.EstimatedCost, &out.EstimatedCost
+		*out = new(ClusterCostEstimate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeBalancerFirewallID != nil {
+		in, out := &in.NodeBalancerFirewallID, &out.NodeBalancerFirewallID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeBalancerBackends != nil {
+		in, out := &in.NodeBalancerBackends, &out.NodeBalancerBackends
+		*out = make([]NodeBalancerBackendStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeClusterStatus.
@@ -681,6 +794,31 @@ func (in *LinodeClusterTemplateSpec) DeepCopy() *LinodeClusterTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeFailureDomain) DeepCopyInto(out *LinodeFailureDomain) {
+	*out = *in
+	if in.PlacementGroupRef != nil {
+		in, out := &in.PlacementGroupRef, &out.PlacementGroupRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeFailureDomain.
+func (in *LinodeFailureDomain) DeepCopy() *LinodeFailureDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeFailureDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinodeFirewall) DeepCopyInto(out *LinodeFirewall) {
 	*out = *in
@@ -816,94 +954,936 @@ func (in *LinodeFirewallStatus) DeepCopyInto(out *LinodeFirewallStatus) {
 		*out = new(FirewallStatusError)
 		**out = **in
 	}
-	if in.FailureMessage != nil {
-		in, out := &in.FailureMessage, &out.FailureMessage
-		*out = new(string)
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeFirewallStatus.
+func (in *LinodeFirewallStatus) DeepCopy() *LinodeFirewallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeFirewallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImage) DeepCopyInto(out *LinodeImage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImage.
+func (in *LinodeImage) DeepCopy() *LinodeImage {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImageList) DeepCopyInto(out *LinodeImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImageList.
+func (in *LinodeImageList) DeepCopy() *LinodeImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImageObjectStorageSource) DeepCopyInto(out *LinodeImageObjectStorageSource) {
+	*out = *in
+	out.BucketRef = in.BucketRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImageObjectStorageSource.
+func (in *LinodeImageObjectStorageSource) DeepCopy() *LinodeImageObjectStorageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImageObjectStorageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImageRegionStatus) DeepCopyInto(out *LinodeImageRegionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImageRegionStatus.
+func (in *LinodeImageRegionStatus) DeepCopy() *LinodeImageRegionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImageRegionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImageSource) DeepCopyInto(out *LinodeImageSource) {
+	*out = *in
+	if in.ObjectStorage != nil {
+		in, out := &in.ObjectStorage, &out.ObjectStorage
+		*out = new(LinodeImageObjectStorageSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImageSource.
+func (in *LinodeImageSource) DeepCopy() *LinodeImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImageSpec) DeepCopyInto(out *LinodeImageSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Source.DeepCopyInto(&out.Source)
+	if in.ReplicaRegions != nil {
+		in, out := &in.ReplicaRegions, &out.ReplicaRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImageSpec.
+func (in *LinodeImageSpec) DeepCopy() *LinodeImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeImageStatus) DeepCopyInto(out *LinodeImageStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]LinodeImageRegionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(LinodeImageStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeImageStatus.
+func (in *LinodeImageStatus) DeepCopy() *LinodeImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshot) DeepCopyInto(out *LinodeInstanceSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshot.
+func (in *LinodeInstanceSnapshot) DeepCopy() *LinodeInstanceSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeInstanceSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotList) DeepCopyInto(out *LinodeInstanceSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeInstanceSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotList.
+func (in *LinodeInstanceSnapshotList) DeepCopy() *LinodeInstanceSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeInstanceSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotSchedule) DeepCopyInto(out *LinodeInstanceSnapshotSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotSchedule.
+func (in *LinodeInstanceSnapshotSchedule) DeepCopy() *LinodeInstanceSnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeInstanceSnapshotSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotScheduleList) DeepCopyInto(out *LinodeInstanceSnapshotScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeInstanceSnapshotSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotScheduleList.
+func (in *LinodeInstanceSnapshotScheduleList) DeepCopy() *LinodeInstanceSnapshotScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeInstanceSnapshotScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotScheduleSpec) DeepCopyInto(out *LinodeInstanceSnapshotScheduleSpec) {
+	*out = *in
+	out.LinodeMachineRef = in.LinodeMachineRef
+	out.Interval = in.Interval
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotScheduleSpec.
+func (in *LinodeInstanceSnapshotScheduleSpec) DeepCopy() *LinodeInstanceSnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotScheduleStatus) DeepCopyInto(out *LinodeInstanceSnapshotScheduleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSnapshotTime != nil {
+		in, out := &in.LastSnapshotTime, &out.LastSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextSnapshotTime != nil {
+		in, out := &in.NextSnapshotTime, &out.NextSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotScheduleStatus.
+func (in *LinodeInstanceSnapshotScheduleStatus) DeepCopy() *LinodeInstanceSnapshotScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotSpec) DeepCopyInto(out *LinodeInstanceSnapshotSpec) {
+	*out = *in
+	out.LinodeMachineRef = in.LinodeMachineRef
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotSpec.
+func (in *LinodeInstanceSnapshotSpec) DeepCopy() *LinodeInstanceSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInstanceSnapshotStatus) DeepCopyInto(out *LinodeInstanceSnapshotStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackupID != nil {
+		in, out := &in.BackupID, &out.BackupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(int)
+		**out = **in
+	}
+	if in.Finished != nil {
+		in, out := &in.Finished, &out.Finished
+		*out = (*in).DeepCopy()
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(LinodeInstanceSnapshotStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInstanceSnapshotStatus.
+func (in *LinodeInstanceSnapshotStatus) DeepCopy() *LinodeInstanceSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInstanceSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeInterfaceCreateOptions) DeepCopyInto(out *LinodeInterfaceCreateOptions) {
+	*out = *in
+	if in.FirewallID != nil {
+		in, out := &in.FirewallID, &out.FirewallID
+		*out = new(int)
+		**out = **in
+	}
+	if in.FirewallIDLegacy != nil {
+		in, out := &in.FirewallIDLegacy, &out.FirewallIDLegacy
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultRoute != nil {
+		in, out := &in.DefaultRoute, &out.DefaultRoute
+		*out = new(InterfaceDefaultRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultRouteLegacy != nil {
+		in, out := &in.DefaultRouteLegacy, &out.DefaultRouteLegacy
+		*out = new(InterfaceDefaultRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(PublicInterfaceCreateOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(VPCInterfaceCreateOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(VLANInterface)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInterfaceCreateOptions.
+func (in *LinodeInterfaceCreateOptions) DeepCopy() *LinodeInterfaceCreateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeInterfaceCreateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachine) DeepCopyInto(out *LinodeMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachine.
+func (in *LinodeMachine) DeepCopy() *LinodeMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachineList) DeepCopyInto(out *LinodeMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineList.
+func (in *LinodeMachineList) DeepCopy() *LinodeMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePool) DeepCopyInto(out *LinodeMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePool.
+func (in *LinodeMachinePool) DeepCopy() *LinodeMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolList) DeepCopyInto(out *LinodeMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolList.
+func (in *LinodeMachinePoolList) DeepCopy() *LinodeMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolMachine) DeepCopyInto(out *LinodeMachinePoolMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolMachine.
+func (in *LinodeMachinePoolMachine) DeepCopy() *LinodeMachinePoolMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachinePoolMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolMachineList) DeepCopyInto(out *LinodeMachinePoolMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeMachinePoolMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolMachineList.
+func (in *LinodeMachinePoolMachineList) DeepCopy() *LinodeMachinePoolMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachinePoolMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolMachineSpec) DeepCopyInto(out *LinodeMachinePoolMachineSpec) {
+	*out = *in
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolMachineSpec.
+func (in *LinodeMachinePoolMachineSpec) DeepCopy() *LinodeMachinePoolMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolMachineStatus) DeepCopyInto(out *LinodeMachinePoolMachineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1beta2.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceState != nil {
+		in, out := &in.InstanceState, &out.InstanceState
+		*out = new(v2.InstanceStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolMachineStatus.
+func (in *LinodeMachinePoolMachineStatus) DeepCopy() *LinodeMachinePoolMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolSpec) DeepCopyInto(out *LinodeMachinePoolSpec) {
+	*out = *in
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.MachineTemplateRef = in.MachineTemplateRef
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolSpec.
+func (in *LinodeMachinePoolSpec) DeepCopy() *LinodeMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolStatus) DeepCopyInto(out *LinodeMachinePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolStatus.
+func (in *LinodeMachinePoolStatus) DeepCopy() *LinodeMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachinePoolStrategy) DeepCopyInto(out *LinodeMachinePoolStrategy) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachinePoolStrategy.
+func (in *LinodeMachinePoolStrategy) DeepCopy() *LinodeMachinePoolStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachinePoolStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachineRemediation) DeepCopyInto(out *LinodeMachineRemediation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediation.
+func (in *LinodeMachineRemediation) DeepCopy() *LinodeMachineRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachineRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachineRemediation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachineRemediationList) DeepCopyInto(out *LinodeMachineRemediationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeMachineRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationList.
+func (in *LinodeMachineRemediationList) DeepCopy() *LinodeMachineRemediationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachineRemediationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeMachineRemediationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachineRemediationSpec) DeepCopyInto(out *LinodeMachineRemediationSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeFirewallStatus.
-func (in *LinodeFirewallStatus) DeepCopy() *LinodeFirewallStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationSpec.
+func (in *LinodeMachineRemediationSpec) DeepCopy() *LinodeMachineRemediationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LinodeFirewallStatus)
+	out := new(LinodeMachineRemediationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LinodeInterfaceCreateOptions) DeepCopyInto(out *LinodeInterfaceCreateOptions) {
+func (in *LinodeMachineRemediationStatus) DeepCopyInto(out *LinodeMachineRemediationStatus) {
 	*out = *in
-	if in.FirewallID != nil {
-		in, out := &in.FirewallID, &out.FirewallID
-		*out = new(int)
-		**out = **in
-	}
-	if in.FirewallIDLegacy != nil {
-		in, out := &in.FirewallIDLegacy, &out.FirewallIDLegacy
-		*out = new(int)
-		**out = **in
-	}
-	if in.DefaultRoute != nil {
-		in, out := &in.DefaultRoute, &out.DefaultRoute
-		*out = new(InterfaceDefaultRoute)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DefaultRouteLegacy != nil {
-		in, out := &in.DefaultRouteLegacy, &out.DefaultRouteLegacy
-		*out = new(InterfaceDefaultRoute)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Public != nil {
-		in, out := &in.Public, &out.Public
-		*out = new(PublicInterfaceCreateOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.VPC != nil {
-		in, out := &in.VPC, &out.VPC
-		*out = new(VPCInterfaceCreateOptions)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.VLAN != nil {
-		in, out := &in.VLAN, &out.VLAN
-		*out = new(VLANInterface)
-		(*in).DeepCopyInto(*out)
+	if in.LastRemediated != nil {
+		in, out := &in.LastRemediated, &out.LastRemediated
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeInterfaceCreateOptions.
-func (in *LinodeInterfaceCreateOptions) DeepCopy() *LinodeInterfaceCreateOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationStatus.
+func (in *LinodeMachineRemediationStatus) DeepCopy() *LinodeMachineRemediationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LinodeInterfaceCreateOptions)
+	out := new(LinodeMachineRemediationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LinodeMachine) DeepCopyInto(out *LinodeMachine) {
+func (in *LinodeMachineRemediationTemplate) DeepCopyInto(out *LinodeMachineRemediationTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachine.
-func (in *LinodeMachine) DeepCopy() *LinodeMachine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationTemplate.
+func (in *LinodeMachineRemediationTemplate) DeepCopy() *LinodeMachineRemediationTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(LinodeMachine)
+	out := new(LinodeMachineRemediationTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LinodeMachine) DeepCopyObject() runtime.Object {
+func (in *LinodeMachineRemediationTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -911,37 +1891,69 @@ func (in *LinodeMachine) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LinodeMachineList) DeepCopyInto(out *LinodeMachineList) {
+func (in *LinodeMachineRemediationTemplateList) DeepCopyInto(out *LinodeMachineRemediationTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LinodeMachine, len(*in))
+		*out = make([]LinodeMachineRemediationTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineList.
-func (in *LinodeMachineList) DeepCopy() *LinodeMachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationTemplateList.
+func (in *LinodeMachineRemediationTemplateList) DeepCopy() *LinodeMachineRemediationTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(LinodeMachineList)
+	out := new(LinodeMachineRemediationTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LinodeMachineList) DeepCopyObject() runtime.Object {
+func (in *LinodeMachineRemediationTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachineRemediationTemplateResource) DeepCopyInto(out *LinodeMachineRemediationTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationTemplateResource.
+func (in *LinodeMachineRemediationTemplateResource) DeepCopy() *LinodeMachineRemediationTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachineRemediationTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeMachineRemediationTemplateSpec) DeepCopyInto(out *LinodeMachineRemediationTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineRemediationTemplateSpec.
+func (in *LinodeMachineRemediationTemplateSpec) DeepCopy() *LinodeMachineRemediationTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeMachineRemediationTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinodeMachineSpec) DeepCopyInto(out *LinodeMachineSpec) {
 	*out = *in
@@ -965,6 +1977,16 @@ func (in *LinodeMachineSpec) DeepCopyInto(out *LinodeMachineSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BackupRef != nil {
+		in, out := &in.BackupRef, &out.BackupRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
 	if in.Interfaces != nil {
 		in, out := &in.Interfaces, &out.Interfaces
 		*out = make([]InstanceConfigInterfaceCreateOptions, len(*in))
@@ -1019,6 +2041,11 @@ func (in *LinodeMachineSpec) DeepCopyInto(out *LinodeMachineSpec) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.VolumeRefs != nil {
+		in, out := &in.VolumeRefs, &out.VolumeRefs
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.VPCRef != nil {
 		in, out := &in.VPCRef, &out.VPCRef
 		*out = new(v1.ObjectReference)
@@ -1039,6 +2066,16 @@ func (in *LinodeMachineSpec) DeepCopyInto(out *LinodeMachineSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AdoptInstance != nil {
+		in, out := &in.AdoptInstance, &out.AdoptInstance
+		*out = new(InstanceAdoptionSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracefulShutdown != nil {
+		in, out := &in.GracefulShutdown, &out.GracefulShutdown
+		*out = new(GracefulShutdown)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineSpec.
@@ -1086,6 +2123,13 @@ func (in *LinodeMachineStatus) DeepCopyInto(out *LinodeMachineStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RebuildInterfaces != nil {
+		in, out := &in.RebuildInterfaces, &out.RebuildInterfaces
+		*out = make([]InstanceConfigInterfaceCreateOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeMachineStatus.
@@ -1216,6 +2260,41 @@ func (in *LinodeMachineTemplateStatus) DeepCopy() *LinodeMachineTemplateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeNBConfigSettings) DeepCopyInto(out *LinodeNBConfigSettings) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(LinodeNBHealthCheck)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeNBConfigSettings.
+func (in *LinodeNBConfigSettings) DeepCopy() *LinodeNBConfigSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeNBConfigSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeNBHealthCheck) DeepCopyInto(out *LinodeNBHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeNBHealthCheck.
+func (in *LinodeNBHealthCheck) DeepCopy() *LinodeNBHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeNBHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinodeNBPortConfig) DeepCopyInto(out *LinodeNBPortConfig) {
 	*out = *in
@@ -1224,6 +2303,7 @@ func (in *LinodeNBPortConfig) DeepCopyInto(out *LinodeNBPortConfig) {
 		*out = new(int)
 		**out = **in
 	}
+	in.LinodeNBConfigSettings.DeepCopyInto(&out.LinodeNBConfigSettings)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeNBPortConfig.
@@ -1765,6 +2845,133 @@ func (in *LinodeVPCStatus) DeepCopy() *LinodeVPCStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeVolume) DeepCopyInto(out *LinodeVolume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeVolume.
+func (in *LinodeVolume) DeepCopy() *LinodeVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeVolume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeVolumeList) DeepCopyInto(out *LinodeVolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinodeVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeVolumeList.
+func (in *LinodeVolumeList) DeepCopy() *LinodeVolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeVolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinodeVolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeVolumeSpec) DeepCopyInto(out *LinodeVolumeSpec) {
+	*out = *in
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(int)
+		**out = **in
+	}
+	out.Size = in.Size.DeepCopy()
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeVolumeSpec.
+func (in *LinodeVolumeSpec) DeepCopy() *LinodeVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinodeVolumeStatus) DeepCopyInto(out *LinodeVolumeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LinodeID != nil {
+		in, out := &in.LinodeID, &out.LinodeID
+		*out = new(int)
+		**out = **in
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(LinodeVolumeStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinodeVolumeStatus.
+func (in *LinodeVolumeStatus) DeepCopy() *LinodeVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinodeVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkAddresses) DeepCopyInto(out *NetworkAddresses) {
 	*out = *in
@@ -1801,6 +3008,16 @@ func (in *NetworkAddresses) DeepCopy() *NetworkAddresses {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
+	if in.DNSRFC2136 != nil {
+		in, out := &in.DNSRFC2136, &out.DNSRFC2136
+		*out = new(DNSRFC2136Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApiserverNodeBalancerConfig != nil {
+		in, out := &in.ApiserverNodeBalancerConfig, &out.ApiserverNodeBalancerConfig
+		*out = new(LinodeNBConfigSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeBalancerID != nil {
 		in, out := &in.NodeBalancerID, &out.NodeBalancerID
 		*out = new(int)
@@ -1811,6 +3028,11 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.APIServerAllowedCIDRs != nil {
+		in, out := &in.APIServerAllowedCIDRs, &out.APIServerAllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ApiserverNodeBalancerConfigID != nil {
 		in, out := &in.ApiserverNodeBalancerConfigID, &out.ApiserverNodeBalancerConfigID
 		*out = new(int)
@@ -1835,6 +3057,21 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeBalancerBackendStatus) DeepCopyInto(out *NodeBalancerBackendStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeBalancerBackendStatus.
+func (in *NodeBalancerBackendStatus) DeepCopy() *NodeBalancerBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeBalancerBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStore) DeepCopyInto(out *ObjectStore) {
 	*out = *in
@@ -2,6 +2,7 @@ package clients
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/dns"
@@ -24,6 +25,10 @@ type LinodeClient interface {
 	LinodeFirewallClient
 	LinodeTokenClient
 	LinodeInterfacesClient
+	LinodeVolumeClient
+	LinodeEventClient
+	LinodeIPClient
+	LinodeImageClient
 
 	OnAfterResponse(m func(response *http.Response) error)
 }
@@ -33,10 +38,10 @@ type AkamClient interface {
 }
 
 type AkamEdgeDNSClient interface {
-	GetRecord(context.Context, dns.GetRecordRequest) (*dns.GetRecordResponse, error)
-	CreateRecord(context.Context, dns.CreateRecordRequest) error
-	UpdateRecord(context.Context, dns.UpdateRecordRequest) error
-	DeleteRecord(context.Context, dns.DeleteRecordRequest) error
+	GetRecord(ctx context.Context, params dns.GetRecordRequest) (*dns.GetRecordResponse, error)
+	CreateRecord(ctx context.Context, params dns.CreateRecordRequest) error
+	UpdateRecord(ctx context.Context, params dns.UpdateRecordRequest) error
+	DeleteRecord(ctx context.Context, params dns.DeleteRecordRequest) error
 }
 
 // LinodeInstanceClient defines the methods that interact with Linode's Instance service.
@@ -45,15 +50,23 @@ type LinodeInstanceClient interface {
 	ListInstances(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Instance, error)
 	CreateInstance(ctx context.Context, opts linodego.InstanceCreateOptions) (*linodego.Instance, error)
 	BootInstance(ctx context.Context, linodeID int, copts linodego.InstanceBootOptions) error
+	ShutdownInstance(ctx context.Context, linodeID int) error
+	ResizeInstance(ctx context.Context, linodeID int, opts linodego.InstanceResizeOptions) error
+	RebuildInstance(ctx context.Context, linodeID int, opts linodego.InstanceRebuildOptions) (*linodego.Instance, error)
+	CreateInstanceSnapshot(ctx context.Context, linodeID int, opts linodego.InstanceSnapshotCreateOptions) (*linodego.InstanceSnapshot, error)
+	GetInstanceBackups(ctx context.Context, linodeID int) (*linodego.InstanceBackupsResponse, error)
 	ListInstanceConfigs(ctx context.Context, linodeID int, opts *linodego.ListOptions) ([]linodego.InstanceConfig, error)
 	UpdateInstanceConfig(ctx context.Context, linodeID int, configID int, opts linodego.InstanceConfigUpdateOptions) (*linodego.InstanceConfig, error)
 	UpdateInstance(ctx context.Context, linodeId int, opts linodego.InstanceUpdateOptions) (*linodego.Instance, error)
 	CreateInstanceDisk(ctx context.Context, linodeID int, opts linodego.InstanceDiskCreateOptions) (*linodego.InstanceDisk, error)
+	ResizeInstanceDisk(ctx context.Context, linodeID int, diskID int, opts linodego.InstanceDiskResizeOptions) error
 	GetInstance(ctx context.Context, linodeID int) (*linodego.Instance, error)
 	DeleteInstance(ctx context.Context, linodeID int) error
 	GetRegion(ctx context.Context, regionID string) (*linodego.Region, error)
 	GetImage(ctx context.Context, imageID string) (*linodego.Image, error)
 	GetType(ctx context.Context, typeID string) (*linodego.LinodeType, error)
+	GetRegionAvailability(ctx context.Context, regionID string) ([]linodego.RegionAvailability, error)
+	GetAccountAvailability(ctx context.Context, regionID string) (*linodego.AccountAvailability, error)
 	ListInstanceFirewalls(ctx context.Context, linodeID int, opts *linodego.ListOptions) ([]linodego.Firewall, error)
 	UpdateInstanceFirewalls(ctx context.Context, linodeID int, opts linodego.InstanceFirewallUpdateOptions) ([]linodego.Firewall, error)
 }
@@ -73,13 +86,18 @@ type LinodeVPCClient interface {
 type LinodeNodeBalancerClient interface {
 	CreateNodeBalancer(ctx context.Context, opts linodego.NodeBalancerCreateOptions) (*linodego.NodeBalancer, error)
 	GetNodeBalancer(ctx context.Context, nodebalancerID int) (*linodego.NodeBalancer, error)
+	UpdateNodeBalancer(ctx context.Context, nodebalancerID int, opts linodego.NodeBalancerUpdateOptions) (*linodego.NodeBalancer, error)
 	ListNodeBalancers(ctx context.Context, opts *linodego.ListOptions) ([]linodego.NodeBalancer, error)
 	ListNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *linodego.ListOptions) ([]linodego.NodeBalancerNode, error)
+	ListNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *linodego.ListOptions) ([]linodego.Firewall, error)
+	ListNodeBalancerTypes(ctx context.Context, opts *linodego.ListOptions) ([]linodego.NodeBalancerType, error)
 	GetNodeBalancerConfig(ctx context.Context, nodebalancerID int, configID int) (*linodego.NodeBalancerConfig, error)
 	CreateNodeBalancerConfig(ctx context.Context, nodebalancerID int, opts linodego.NodeBalancerConfigCreateOptions) (*linodego.NodeBalancerConfig, error)
+	UpdateNodeBalancerConfig(ctx context.Context, nodebalancerID int, configID int, opts linodego.NodeBalancerConfigUpdateOptions) (*linodego.NodeBalancerConfig, error)
 	DeleteNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, nodeID int) error
 	DeleteNodeBalancer(ctx context.Context, nodebalancerID int) error
 	CreateNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, opts linodego.NodeBalancerNodeCreateOptions) (*linodego.NodeBalancerNode, error)
+	UpdateNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, nodeID int, opts linodego.NodeBalancerNodeUpdateOptions) (*linodego.NodeBalancerNode, error)
 }
 
 // LinodeObjectStorageClient defines the methods that interact with Linode's Object Storage service.
@@ -120,6 +138,8 @@ type LinodeFirewallClient interface {
 	GetFirewall(ctx context.Context, firewallID int) (*linodego.Firewall, error)
 	ListFirewalls(ctx context.Context, options *linodego.ListOptions) ([]linodego.Firewall, error)
 	GetFirewallDevice(ctx context.Context, firewallID, deviceID int) (*linodego.FirewallDevice, error)
+	ListFirewallDevices(ctx context.Context, firewallID int, opts *linodego.ListOptions) ([]linodego.FirewallDevice, error)
+	CreateFirewallDevice(ctx context.Context, firewallID int, opts linodego.FirewallDeviceCreateOptions) (*linodego.FirewallDevice, error)
 	GetFirewallRules(ctx context.Context, firewallID int) (*linodego.FirewallRules, error)
 	UpdateFirewall(ctx context.Context, firewallID int, opts linodego.FirewallUpdateOptions) (*linodego.Firewall, error)
 	UpdateFirewallRules(ctx context.Context, firewallID int, rules linodego.FirewallRulesUpdateOptions) (*linodego.FirewallRules, error)
@@ -131,6 +151,48 @@ type LinodeFirewallClient interface {
 type LinodeInterfacesClient interface {
 	ListInterfaces(ctx context.Context, linodeID int, opts *linodego.ListOptions) ([]linodego.LinodeInterface, error)
 	ListInterfaceFirewalls(ctx context.Context, linodeID int, interfaceID int, opts *linodego.ListOptions) ([]linodego.Firewall, error)
+	CreateInterface(ctx context.Context, linodeID int, opts linodego.LinodeInterfaceCreateOptions) (*linodego.LinodeInterface, error)
+	UpdateInterface(ctx context.Context, linodeID int, interfaceID int, opts linodego.LinodeInterfaceUpdateOptions) (*linodego.LinodeInterface, error)
+	DeleteInterface(ctx context.Context, linodeID int, interfaceID int) error
+	UpgradeInterfaces(ctx context.Context, linodeID int, opts linodego.LinodeInterfacesUpgradeOptions) (*linodego.LinodeInterfacesUpgrade, error)
+}
+
+// LinodeVolumeClient defines the methods that interact with Linode's Block Storage Volume service.
+type LinodeVolumeClient interface {
+	GetVolume(ctx context.Context, volumeID int) (*linodego.Volume, error)
+	ListVolumes(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Volume, error)
+	CreateVolume(ctx context.Context, opts linodego.VolumeCreateOptions) (*linodego.Volume, error)
+	UpdateVolume(ctx context.Context, volumeID int, opts linodego.VolumeUpdateOptions) (*linodego.Volume, error)
+	ResizeVolume(ctx context.Context, volumeID int, opts linodego.VolumeResizeOptions) error
+	AttachVolume(ctx context.Context, volumeID int, opts *linodego.VolumeAttachOptions) (*linodego.Volume, error)
+	DetachVolume(ctx context.Context, volumeID int) error
+	DeleteVolume(ctx context.Context, volumeID int) error
+}
+
+// LinodeIPClient defines the methods that interact with Linode's Networking IP service.
+type LinodeIPClient interface {
+	GetIPAddress(ctx context.Context, address string) (*linodego.InstanceIP, error)
+	ListReservedIPAddresses(ctx context.Context, opts *linodego.ListOptions) ([]linodego.InstanceIP, error)
+	ReserveIPAddress(ctx context.Context, opts linodego.ReserveIPOptions) (*linodego.InstanceIP, error)
+	DeleteReservedIPAddress(ctx context.Context, address string) error
+	InstancesAssignIPs(ctx context.Context, opts linodego.LinodesAssignIPsOptions) error
+	ShareIPAddresses(ctx context.Context, opts linodego.IPAddressesShareOptions) error
+}
+
+// LinodeImageClient defines the methods that interact with Linode's Image service.
+type LinodeImageClient interface {
+	ListImages(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Image, error)
+	CreateImageUpload(ctx context.Context, opts linodego.ImageCreateUploadOptions) (*linodego.Image, string, error)
+	UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) error
+	UpdateImage(ctx context.Context, imageID string, opts linodego.ImageUpdateOptions) (*linodego.Image, error)
+	ReplicateImage(ctx context.Context, imageID string, opts linodego.ImageReplicateOptions) (*linodego.Image, error)
+	DeleteImage(ctx context.Context, imageID string) error
+}
+
+// LinodeEventClient defines the methods that interact with Linode's Account Events service.
+type LinodeEventClient interface {
+	ListEvents(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Event, error)
+	GetEvent(ctx context.Context, eventID int) (*linodego.Event, error)
 }
 
 type K8sClient interface {
@@ -151,6 +213,16 @@ type S3PresignClient interface {
 	PresignGetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*awssigner.PresignedHTTPRequest, error)
 }
 
+// LinodeTokenClient defines the method that sets the token of a Linode client. The token is set in place, callers
+// should keep using the client they have rather than the one returned, which has none of its wrappers.
 type LinodeTokenClient interface {
 	SetToken(token string) *linodego.Client
 }
+
+// BootstrapDataEndpoint serves the bootstrap data of machines from the manager.
+type BootstrapDataEndpoint interface {
+	// Store makes the bootstrap data of a machine available until it is revoked, and returns the URL to fetch it from.
+	Store(ctx context.Context, machine client.Object, data []byte) (string, error)
+	// Revoke makes the bootstrap data of a machine unavailable.
+	Revoke(ctx context.Context, machine client.Object) error
+}
@@ -27,6 +27,7 @@ import (
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/observability/wrappers/linodeclient"
 )
 
 // ClusterScopeParams defines the input parameters used to create a new Scope.
@@ -35,6 +36,8 @@ type ClusterScopeParams struct {
 	Cluster           *clusterv1.Cluster
 	LinodeCluster     *infrav1alpha2.LinodeCluster
 	LinodeMachineList infrav1alpha2.LinodeMachineList
+	// ManagementClusterID identifies the management cluster in the DNS ownership records of the cluster.
+	ManagementClusterID string
 }
 
 func validateClusterScopeParams(params ClusterScopeParams) error {
@@ -55,7 +58,7 @@ func NewClusterScope(ctx context.Context, linodeClientConfig, dnsClientConfig Cl
 		return nil, err
 	}
 
-	linodeClient, err := CreateLinodeClient(linodeClientConfig)
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRegarding(params.LinodeCluster))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -65,24 +68,32 @@ func NewClusterScope(ctx context.Context, linodeClientConfig, dnsClientConfig Cl
 		return nil, fmt.Errorf("failed to init patch helper: %w", err)
 	}
 
-	akamDomainsClient, err := setUpEdgeDNSInterface()
+	var akamDomainsClient clients.AkamClient
+	akamDomainsClient, err = setUpEdgeDNSInterface()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create akamai dns client: %w", err)
 	}
-	linodeDomainsClient, err := CreateLinodeClient(dnsClientConfig, WithRetryCount(0))
+	dnsDryRunInterceptor := dnsClientConfig.DryRunInterceptor(params.LinodeCluster)
+	if dnsDryRunInterceptor != nil {
+		akamDomainsClient = linodeclient.NewAkamClientWithDryRun(akamDomainsClient, dnsDryRunInterceptor)
+	}
+	linodeDomainsClient, err := CreateLinodeClient(dnsClientConfig, WithRetryCount(0), WithRegarding(params.LinodeCluster))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
 
 	return &ClusterScope{
-		Client:              params.Client,
-		Cluster:             params.Cluster,
-		LinodeClient:        linodeClient,
-		LinodeDomainsClient: linodeDomainsClient,
-		AkamaiDomainsClient: akamDomainsClient,
-		LinodeCluster:       params.LinodeCluster,
-		LinodeMachines:      params.LinodeMachineList,
-		PatchHelper:         helper,
+		Client:               params.Client,
+		Cluster:              params.Cluster,
+		TokenHash:            GetHash(linodeClientConfig.Token),
+		LinodeClient:         linodeClient,
+		LinodeDomainsClient:  linodeDomainsClient,
+		AkamaiDomainsClient:  akamDomainsClient,
+		LinodeCluster:        params.LinodeCluster,
+		LinodeMachines:       params.LinodeMachineList,
+		PatchHelper:          helper,
+		ManagementClusterID:  params.ManagementClusterID,
+		DNSDryRunInterceptor: dnsDryRunInterceptor,
 	}, nil
 }
 
@@ -90,12 +101,17 @@ func NewClusterScope(ctx context.Context, linodeClientConfig, dnsClientConfig Cl
 type ClusterScope struct {
 	Client              clients.K8sClient
 	PatchHelper         *patch.Helper
+	TokenHash           string
 	LinodeClient        clients.LinodeClient
 	Cluster             *clusterv1.Cluster
 	LinodeCluster       *infrav1alpha2.LinodeCluster
 	LinodeMachines      infrav1alpha2.LinodeMachineList
 	AkamaiDomainsClient clients.AkamClient
 	LinodeDomainsClient clients.LinodeClient
+	ManagementClusterID string
+	// DNSDryRunInterceptor intercepts the RFC 2136 updates of the DNS records of the cluster in dry-run mode, the
+	// domains clients are intercepted on their own.
+	DNSDryRunInterceptor func(ctx context.Context, method string, params map[string]interface{})
 }
 
 // PatchObject persists the cluster configuration and status.
@@ -138,18 +154,34 @@ func (s *ClusterScope) RemoveCredentialsRefFinalizer(ctx context.Context) error
 		toFinalizer(s.LinodeCluster))
 }
 
+// GetDNSRFC2136TSIGSecret returns the base64 encoded secret of the TSIG key signing the updates of the rfc2136 DNS
+// provider.
+func (s *ClusterScope) GetDNSRFC2136TSIGSecret(ctx context.Context) (string, error) {
+	rfc2136 := s.LinodeCluster.Spec.Network.DNSRFC2136
+	if rfc2136 == nil || rfc2136.TSIGSecretRef == nil {
+		return "", errors.New("no TSIG secret configured for the rfc2136 DNS provider")
+	}
+	secret, err := getCredentialDataFromRef(ctx, s.Client, *rfc2136.TSIGSecretRef, s.LinodeCluster.GetNamespace(), "tsigSecret")
+	if err != nil {
+		return "", fmt.Errorf("TSIG secret from secret ref: %w", err)
+	}
+
+	return string(secret), nil
+}
+
 func (s *ClusterScope) SetCredentialRefTokenForLinodeClients(ctx context.Context) error {
 	if s.LinodeCluster.Spec.CredentialsRef != nil {
 		apiToken, err := getCredentialDataFromRef(ctx, s.Client, *s.LinodeCluster.Spec.CredentialsRef, s.LinodeCluster.GetNamespace(), "apiToken")
 		if err != nil {
 			return fmt.Errorf("credentials from secret ref: %w", err)
 		}
-		s.LinodeClient = s.LinodeClient.SetToken(string(apiToken))
+		s.LinodeClient.SetToken(string(apiToken))
+		s.TokenHash = GetHash(string(apiToken))
 		dnsToken, err := getCredentialDataFromRef(ctx, s.Client, *s.LinodeCluster.Spec.CredentialsRef, s.LinodeCluster.GetNamespace(), "dnsToken")
 		if err != nil || len(dnsToken) == 0 {
 			dnsToken = apiToken
 		}
-		s.LinodeDomainsClient = s.LinodeDomainsClient.SetToken(string(dnsToken))
+		s.LinodeDomainsClient.SetToken(string(dnsToken))
 		return nil
 	}
 	return nil
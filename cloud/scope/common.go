@@ -22,6 +22,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/linode/linodego/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -43,6 +45,8 @@ const (
 
 type Option struct {
 	set func(client *linodego.Client)
+	// regarding is the object the calls intercepted in dry-run mode are recorded on.
+	regarding runtime.Object
 }
 
 func WithRetryCount(c int) Option {
@@ -53,12 +57,24 @@ func WithRetryCount(c int) Option {
 	}
 }
 
+// WithRegarding sets the object the calls intercepted in dry-run mode are recorded on as Events.
+func WithRegarding(obj runtime.Object) Option {
+	return Option{regarding: obj}
+}
+
 type ClientConfig struct {
 	Token               string
 	BaseUrl             string
 	RootCertificatePath string
 
 	Timeout time.Duration
+
+	// DryRun intercepts the calls mutating Linode, Object Storage and DNS resources, which are logged and recorded by
+	// DryRunRecorder instead. The objects on behalf of which resources were created or deleted are added to
+	// DryRunObjects, so that their synthetic state is not persisted.
+	DryRun         bool
+	DryRunRecorder events.EventRecorder
+	DryRunObjects  *linodeclient.DryRunObjects
 }
 
 func CreateLinodeClient(config ClientConfig, opts ...Option) (clients.LinodeClient, error) {
@@ -107,20 +123,68 @@ func CreateLinodeClient(config ClientConfig, opts ...Option) (clients.LinodeClie
 	}
 	newClient.SetUserAgent(fmt.Sprintf("CAPL/%s", version.GetVersion()))
 
+	var regarding runtime.Object
 	for _, opt := range opts {
-		opt.set(&newClient)
+		if opt.set != nil {
+			opt.set(&newClient)
+		}
+		if opt.regarding != nil {
+			regarding = opt.regarding
+		}
 	}
 
-	return linodeclient.NewLinodeClientWithTracing(
+	var linodeClient clients.LinodeClient = linodeclient.NewLinodeClientWithTracing(
 		&newClient,
 		linodeclient.DefaultDecorator(),
-	), nil
+	)
+	if interceptor := config.DryRunInterceptor(regarding); interceptor != nil {
+		linodeClient = linodeclient.NewLinodeClientWithDryRun(linodeClient, interceptor)
+	}
+
+	return linodeClient, nil
+}
+
+// DryRunInterceptor returns the interceptor of the calls mutating resources in dry-run mode, which records them as
+// Events on the regarding object. It returns nil when dry-run mode is disabled.
+func (c ClientConfig) DryRunInterceptor(regarding runtime.Object) func(ctx context.Context, method string, params map[string]interface{}) {
+	if !c.DryRun {
+		return nil
+	}
+
+	return linodeclient.DryRunInterceptor(c.DryRunRecorder, c.DryRunObjects, regarding)
+}
+
+// S3ClientWithDryRun returns an S3 client intercepting the calls of s3Client mutating objects, or s3Client itself
+// when the interceptor is nil.
+func S3ClientWithDryRun(s3Client clients.S3Client, interceptor func(ctx context.Context, method string, params map[string]interface{})) clients.S3Client {
+	if interceptor == nil {
+		return s3Client
+	}
+
+	return linodeclient.NewS3ClientWithDryRun(s3Client, interceptor)
 }
 
 // S3ClientBuilder constructs S3 clients from an Object Store credentials secret.
 // CreateS3Clients is the production implementation; tests substitute a fake.
 type S3ClientBuilder func(context.Context, *corev1.Secret) (clients.S3Client, clients.S3PresignClient, error)
 
+// WithDryRun returns a builder of S3 clients intercepting the calls mutating objects, or the builder itself when the
+// interceptor is nil.
+func (b S3ClientBuilder) WithDryRun(interceptor func(ctx context.Context, method string, params map[string]interface{})) S3ClientBuilder {
+	if interceptor == nil {
+		return b
+	}
+
+	return func(ctx context.Context, objectStoreCredentials *corev1.Secret) (clients.S3Client, clients.S3PresignClient, error) {
+		s3Client, presignClient, err := b(ctx, objectStoreCredentials)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return S3ClientWithDryRun(s3Client, interceptor), presignClient, nil
+	}
+}
+
 func CreateS3Clients(ctx context.Context, objectStoreCredentials *corev1.Secret) (clients.S3Client, clients.S3PresignClient, error) {
 	config, err := awsconfig.LoadDefaultConfig(ctx,
 		awsconfig.WithRegion("auto"),
@@ -3,19 +3,30 @@ package scope
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/linode/linodego/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
 	"github.com/linode/cluster-api-provider-linode/mock"
+	"github.com/linode/cluster-api-provider-linode/observability/wrappers/linodeclient"
 )
 
 // Test_createLinodeClient tests the createLinodeClient function. Checks if the client does not error out.
@@ -68,6 +79,116 @@ func TestCreateLinodeClient(t *testing.T) {
 	}
 }
 
+func TestCreateLinodeClientDryRun(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s %s in dry-run mode", r.Method, r.URL.Path)
+		}
+		assert.Equal(t, "Bearer rotated-key", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "existing"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := events.NewFakeRecorder(10)
+	linodeMachine := &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{Name: "test-machine"}}
+	linodeClient, err := CreateLinodeClient(ClientConfig{
+		Token:          "test-key",
+		BaseUrl:        server.URL,
+		DryRun:         true,
+		DryRunRecorder: recorder,
+	}, WithRetryCount(0), WithRegarding(linodeMachine))
+	require.NoError(t, err)
+	// Setting the token must not drop the dry-run wrapper.
+	linodeClient.SetToken("rotated-key")
+
+	instance, err := linodeClient.GetInstance(t.Context(), 123)
+	require.NoError(t, err)
+	assert.Equal(t, "existing", instance.Label)
+
+	instance, err = linodeClient.CreateInstance(t.Context(), linodego.InstanceCreateOptions{
+		Label:    "test-machine",
+		Region:   "us-ord",
+		RootPass: "hunter2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test-machine", instance.Label)
+	assert.Equal(t, "us-ord", instance.Region)
+	require.NoError(t, linodeClient.DeleteInstance(t.Context(), 123))
+
+	event := <-recorder.Events
+	assert.Contains(t, event, "CreateInstance")
+	assert.Contains(t, event, `"label":"test-machine"`)
+	assert.NotContains(t, event, "hunter2")
+	assert.Contains(t, <-recorder.Events, "DeleteInstance")
+}
+
+func TestDryRunObjects(t *testing.T) {
+	t.Parallel()
+
+	created := &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "default", UID: "created-uid"}}
+	updated := &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{Name: "updated", Namespace: "default", UID: "updated-uid"}}
+
+	objects := linodeclient.NewDryRunObjects()
+	config := ClientConfig{DryRun: true, DryRunObjects: objects}
+	config.DryRunInterceptor(updated)(t.Context(), "UpdateInstance", nil)
+	config.DryRunInterceptor(created)(t.Context(), "CreateInstance", nil)
+	assert.True(t, objects.Contains(created))
+	assert.False(t, objects.Contains(updated))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, infrav1alpha2.AddToScheme(scheme))
+	k8sClient := objects.Client(fake.NewClientBuilder().WithScheme(scheme).WithObjects(created.DeepCopy(), updated.DeepCopy()).Build())
+
+	// Only the writes of the objects on behalf of which a resource was created are not persisted.
+	for _, obj := range []*infrav1alpha2.LinodeMachine{created, updated} {
+		patched := obj.DeepCopy()
+		patched.Labels = map[string]string{"patched": "true"}
+		require.NoError(t, k8sClient.Patch(t.Context(), patched, client.MergeFrom(obj)))
+	}
+	for obj, wantLabels := range map[*infrav1alpha2.LinodeMachine]map[string]string{
+		created: nil,
+		updated: {"patched": "true"},
+	} {
+		got := &infrav1alpha2.LinodeMachine{}
+		require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(obj), got))
+		assert.Equal(t, wantLabels, got.Labels, obj.Name)
+	}
+}
+
+func TestS3ClientBuilderWithDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockS3Client := mock.NewMockS3Client(ctrl)
+	mockS3Client.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{}, nil)
+	builder := S3ClientBuilder(func(context.Context, *corev1.Secret) (clients.S3Client, clients.S3PresignClient, error) {
+		return mockS3Client, nil, nil
+	})
+
+	// Without an interceptor the clients are left as is.
+	s3Client, _, err := builder.WithDryRun(nil)(t.Context(), &corev1.Secret{})
+	require.NoError(t, err)
+	assert.Same(t, mockS3Client, s3Client)
+
+	recorder := events.NewFakeRecorder(10)
+	linodeMachine := &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{Name: "test-machine"}}
+	interceptor := ClientConfig{DryRun: true, DryRunRecorder: recorder}.DryRunInterceptor(linodeMachine)
+	s3Client, _, err = builder.WithDryRun(interceptor)(t.Context(), &corev1.Secret{})
+	require.NoError(t, err)
+
+	_, err = s3Client.HeadObject(t.Context(), &s3.HeadObjectInput{Bucket: ptr.To("test-bucket"), Key: ptr.To("test-key")})
+	require.NoError(t, err)
+	_, err = s3Client.PutObject(t.Context(), &s3.PutObjectInput{Bucket: ptr.To("test-bucket"), Key: ptr.To("test-key")})
+	require.NoError(t, err)
+
+	event := <-recorder.Events
+	assert.Contains(t, event, "PutObject")
+	assert.Contains(t, event, `"Key":"test-key"`)
+}
+
 // Test_getCredentialDataFromRef tests the getCredentialDataFromRef function.
 func TestGetCredentialDataFromRef(t *testing.T) {
 	t.Parallel()
@@ -496,3 +617,258 @@ func Test_toFinalizer(t *testing.T) {
 		})
 	}
 }
+
+// credentialsScope is implemented by the scopes of the objects referencing a credentials Secret.
+type credentialsScope interface {
+	AddFinalizer(ctx context.Context) error
+	AddCredentialsRefFinalizer(ctx context.Context) error
+	RemoveCredentialsRefFinalizer(ctx context.Context) error
+	SetCredentialRefTokenForLinodeClients(ctx context.Context) error
+}
+
+// newCredentialsScopeFunc creates the scope of an object with the finalizers and the credentials Secret reference,
+// which is nil when the object has none. It returns the object along with the scope.
+type newCredentialsScopeFunc func(ctx context.Context, k8sClient clients.K8sClient, finalizers []string, credentialsRef *corev1.SecretReference) (credentialsScope, client.Object, error)
+
+// credentialsScopes are the scopes of the objects which only reference a credentials Secret, which behave alike.
+var credentialsScopes = []struct {
+	name      string
+	newScope  newCredentialsScopeFunc
+	finalizer string
+	// newScopeWithoutObject creates the scope without its object, which is refused with requiredError.
+	newScopeWithoutObject func(ctx context.Context, k8sClient clients.K8sClient) error
+	requiredError         string
+}{
+	{
+		name: "VolumeScope",
+		newScope: func(ctx context.Context, k8sClient clients.K8sClient, finalizers []string, credentialsRef *corev1.SecretReference) (credentialsScope, client.Object, error) {
+			linodeVolume := &infrav1alpha2.LinodeVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-volume", Namespace: "test", Finalizers: finalizers},
+				Spec:       infrav1alpha2.LinodeVolumeSpec{CredentialsRef: credentialsRef},
+			}
+			scope, err := NewVolumeScope(ctx, ClientConfig{Token: "test-key"}, VolumeScopeParams{Client: k8sClient, LinodeVolume: linodeVolume})
+			return scope, linodeVolume, err
+		},
+		finalizer: infrav1alpha2.VolumeFinalizer,
+		newScopeWithoutObject: func(ctx context.Context, k8sClient clients.K8sClient) error {
+			_, err := NewVolumeScope(ctx, ClientConfig{Token: "test-key"}, VolumeScopeParams{Client: k8sClient})
+			return err
+		},
+		requiredError: "linodeVolume is required when creating a VolumeScope",
+	},
+	{
+		name: "ImageScope",
+		newScope: func(ctx context.Context, k8sClient clients.K8sClient, finalizers []string, credentialsRef *corev1.SecretReference) (credentialsScope, client.Object, error) {
+			linodeImage := &infrav1alpha2.LinodeImage{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-image", Namespace: "test", Finalizers: finalizers},
+				Spec:       infrav1alpha2.LinodeImageSpec{CredentialsRef: credentialsRef},
+			}
+			scope, err := NewImageScope(ctx, ClientConfig{Token: "test-key"}, ImageScopeParams{Client: k8sClient, LinodeImage: linodeImage})
+			return scope, linodeImage, err
+		},
+		finalizer: infrav1alpha2.ImageFinalizer,
+		newScopeWithoutObject: func(ctx context.Context, k8sClient clients.K8sClient) error {
+			_, err := NewImageScope(ctx, ClientConfig{Token: "test-key"}, ImageScopeParams{Client: k8sClient})
+			return err
+		},
+		requiredError: "linodeImage is required when creating an ImageScope",
+	},
+}
+
+func TestCredentialsScopes(t *testing.T) {
+	t.Parallel()
+
+	for _, credentialsScope := range credentialsScopes {
+		t.Run(credentialsScope.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("Error - object is required", func(t *testing.T) {
+				t.Parallel()
+				err := credentialsScope.newScopeWithoutObject(t.Context(), mock.NewMockK8sClient(gomock.NewController(t)))
+				require.EqualError(t, err, credentialsScope.requiredError)
+			})
+			t.Run("Error - patch helper cannot be created", func(t *testing.T) {
+				t.Parallel()
+				mockK8sClient := mock.NewMockK8sClient(gomock.NewController(t))
+				mockK8sClient.EXPECT().Scheme().Return(runtime.NewScheme())
+				_, _, err := credentialsScope.newScope(t.Context(), mockK8sClient, nil, nil)
+				require.ErrorContains(t, err, "failed to init patch helper:")
+			})
+			t.Run("AddFinalizer", func(t *testing.T) {
+				t.Parallel()
+				testScopeAddFinalizer(t, credentialsScope.newScope, credentialsScope.finalizer)
+			})
+			t.Run("CredentialsRefFinalizer", func(t *testing.T) {
+				t.Parallel()
+				testScopeCredentialsRefFinalizer(t, credentialsScope.newScope)
+			})
+			t.Run("SetCredentialRefTokenForLinodeClients", func(t *testing.T) {
+				t.Parallel()
+				testScopeSetCredentialRefToken(t, credentialsScope.newScope)
+			})
+		})
+	}
+}
+
+// expectScheme expects the scheme of the client to be requested, which patch helpers do on creation and on patch.
+func expectScheme(mockK8sClient *mock.MockK8sClient, times int) {
+	mockK8sClient.EXPECT().Scheme().DoAndReturn(func() *runtime.Scheme {
+		s := runtime.NewScheme()
+		_ = infrav1alpha2.AddToScheme(s)
+		return s
+	}).Times(times)
+}
+
+// testScopeAddFinalizer tests the AddFinalizer method of a scope, which adds the finalizer of its object.
+func testScopeAddFinalizer(t *testing.T, newScope newCredentialsScopeFunc, finalizer string) {
+	t.Helper()
+
+	tests := []struct {
+		name       string
+		finalizers []string
+		expects    func(mock *mock.MockK8sClient)
+	}{
+		{
+			name: "Success - finalizer should be added to the object",
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 2)
+				mock.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "No-op - finalizer is already present",
+			finalizers: []string{finalizer},
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			testcase.expects(mockK8sClient)
+
+			scope, obj, err := newScope(t.Context(), mockK8sClient, testcase.finalizers, nil)
+			require.NoError(t, err)
+			require.NoError(t, scope.AddFinalizer(t.Context()))
+			assert.Equal(t, []string{finalizer}, obj.GetFinalizers())
+		})
+	}
+}
+
+// testScopeCredentialsRefFinalizer tests the methods of a scope adding and removing the finalizer of the credentials
+// Secret its object references.
+func testScopeCredentialsRefFinalizer(t *testing.T, newScope newCredentialsScopeFunc) {
+	t.Helper()
+
+	credentialsRef := &corev1.SecretReference{Name: "example", Namespace: "test"}
+	for _, method := range []struct {
+		name string
+		call func(scope credentialsScope, ctx context.Context) error
+	}{
+		{"AddCredentialsRefFinalizer", credentialsScope.AddCredentialsRefFinalizer},
+		{"RemoveCredentialsRefFinalizer", credentialsScope.RemoveCredentialsRefFinalizer},
+	} {
+		tests := []struct {
+			name           string
+			credentialsRef *corev1.SecretReference
+			expects        func(mock *mock.MockK8sClient)
+		}{
+			{
+				name:           "Success - credentials Secret is updated",
+				credentialsRef: credentialsRef,
+				expects: func(mock *mock.MockK8sClient) {
+					expectScheme(mock, 1)
+					mock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+						*obj = corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "test"},
+							Data:       map[string][]byte{"apiToken": []byte("example")},
+						}
+						return nil
+					})
+					mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				},
+			},
+			{
+				name: "No-op - no credentials Secret",
+				expects: func(mock *mock.MockK8sClient) {
+					expectScheme(mock, 1)
+				},
+			},
+		}
+		for _, tt := range tests {
+			testcase := tt
+			t.Run(method.name+"/"+testcase.name, func(t *testing.T) {
+				t.Parallel()
+
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockK8sClient := mock.NewMockK8sClient(ctrl)
+				testcase.expects(mockK8sClient)
+
+				scope, _, err := newScope(t.Context(), mockK8sClient, nil, testcase.credentialsRef)
+				require.NoError(t, err)
+				require.NoError(t, method.call(scope, t.Context()))
+			})
+		}
+	}
+}
+
+// testScopeSetCredentialRefToken tests the SetCredentialRefTokenForLinodeClients method of a scope, which reads the
+// token from the credentials Secret its object references.
+func testScopeSetCredentialRefToken(t *testing.T, newScope newCredentialsScopeFunc) {
+	t.Helper()
+
+	tests := []struct {
+		name          string
+		expects       func(mock *mock.MockK8sClient)
+		expectedError string
+	}{
+		{
+			name: "Success - Validate getCredentialDataFromRef() returns some apiKey data",
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+				mock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+					*obj = corev1.Secret{Data: map[string][]byte{"apiToken": []byte("example-api-token")}}
+					return nil
+				})
+			},
+		},
+		{
+			name: "Error - Get an error when getting the credentials secret",
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+				mock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("test error"))
+			},
+			expectedError: "credentials from secret ref: get credentials secret test-namespace/test-name: test error",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			testcase.expects(mockK8sClient)
+
+			scope, _, err := newScope(t.Context(), mockK8sClient, nil, &corev1.SecretReference{Namespace: "test-namespace", Name: "test-name"})
+			require.NoError(t, err)
+			err = scope.SetCredentialRefTokenForLinodeClients(t.Context())
+			if testcase.expectedError != "" {
+				require.ErrorContains(t, err, testcase.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
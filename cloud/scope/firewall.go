@@ -31,16 +31,20 @@ import (
 type FirewallScope struct {
 	Client         clients.K8sClient
 	PatchHelper    *patch.Helper
+	TokenHash      string
 	LinodeClient   clients.LinodeClient
 	LinodeFirewall *infrav1alpha2.LinodeFirewall
 	Cluster        *clusterv1.Cluster
+	// ManagementClusterID identifies the management cluster in the owner tags of the firewall.
+	ManagementClusterID string
 }
 
 // FirewallScopeParams defines the input parameters used to create a new Scope.
 type FirewallScopeParams struct {
-	Client         clients.K8sClient
-	LinodeFirewall *infrav1alpha2.LinodeFirewall
-	Cluster        *clusterv1.Cluster
+	Client              clients.K8sClient
+	LinodeFirewall      *infrav1alpha2.LinodeFirewall
+	Cluster             *clusterv1.Cluster
+	ManagementClusterID string
 }
 
 func validateFirewallScopeParams(params FirewallScopeParams) error {
@@ -59,7 +63,7 @@ func NewFirewallScope(ctx context.Context, linodeClientConfig ClientConfig, para
 	if err := validateFirewallScopeParams(params); err != nil {
 		return nil, err
 	}
-	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0))
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeFirewall))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -70,11 +74,13 @@ func NewFirewallScope(ctx context.Context, linodeClientConfig ClientConfig, para
 	}
 
 	return &FirewallScope{
-		Client:         params.Client,
-		LinodeClient:   linodeClient,
-		LinodeFirewall: params.LinodeFirewall,
-		PatchHelper:    helper,
-		Cluster:        params.Cluster,
+		Client:              params.Client,
+		TokenHash:           GetHash(linodeClientConfig.Token),
+		LinodeClient:        linodeClient,
+		LinodeFirewall:      params.LinodeFirewall,
+		PatchHelper:         helper,
+		Cluster:             params.Cluster,
+		ManagementClusterID: params.ManagementClusterID,
 	}, nil
 }
 
@@ -131,7 +137,8 @@ func (s *FirewallScope) SetCredentialRefTokenForLinodeClients(ctx context.Contex
 		if err != nil {
 			return fmt.Errorf("credentials from secret ref: %w", err)
 		}
-		s.LinodeClient = s.LinodeClient.SetToken(string(apiToken))
+		s.LinodeClient.SetToken(string(apiToken))
+		s.TokenHash = GetHash(string(apiToken))
 		return nil
 	}
 	return nil
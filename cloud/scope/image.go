@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+)
+
+// ImageScope defines the basic context for an actuator to operate upon.
+type ImageScope struct {
+	Client       clients.K8sClient
+	PatchHelper  *patch.Helper
+	LinodeClient clients.LinodeClient
+	S3Clients    S3ClientBuilder
+	LinodeImage  *infrav1alpha2.LinodeImage
+	Cluster      *clusterv1.Cluster
+}
+
+// ImageScopeParams defines the input parameters used to create a new Scope.
+type ImageScopeParams struct {
+	Client      clients.K8sClient
+	LinodeImage *infrav1alpha2.LinodeImage
+	Cluster     *clusterv1.Cluster
+}
+
+func validateImageScope(params ImageScopeParams) error {
+	if params.LinodeImage == nil {
+		return errors.New("linodeImage is required when creating an ImageScope")
+	}
+
+	return nil
+}
+
+// PatchObject persists the image configuration and status.
+func (s *ImageScope) PatchObject(ctx context.Context) error {
+	return s.PatchHelper.Patch(ctx, s.LinodeImage)
+}
+
+// Close closes the current scope persisting the image configuration and status.
+func (s *ImageScope) Close(ctx context.Context) error {
+	return s.PatchObject(ctx)
+}
+
+// AddFinalizer adds a finalizer if not present and immediately patches the
+// object to avoid any race conditions.
+func (s *ImageScope) AddFinalizer(ctx context.Context) error {
+	if controllerutil.AddFinalizer(s.LinodeImage, infrav1alpha2.ImageFinalizer) {
+		return s.Close(ctx)
+	}
+
+	return nil
+}
+
+func (s *ImageScope) AddCredentialsRefFinalizer(ctx context.Context) error {
+	if s.LinodeImage.Spec.CredentialsRef == nil {
+		return nil
+	}
+
+	return addCredentialsFinalizer(ctx, s.Client,
+		*s.LinodeImage.Spec.CredentialsRef, s.LinodeImage.GetNamespace(),
+		toFinalizer(s.LinodeImage))
+}
+
+func (s *ImageScope) RemoveCredentialsRefFinalizer(ctx context.Context) error {
+	if s.LinodeImage.Spec.CredentialsRef == nil {
+		return nil
+	}
+
+	return removeCredentialsFinalizer(ctx, s.Client,
+		*s.LinodeImage.Spec.CredentialsRef, s.LinodeImage.GetNamespace(),
+		toFinalizer(s.LinodeImage))
+}
+
+// NewImageScope creates a new Scope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+//
+//nolint:dupl // This is pretty much the same as Volume, maybe a candidate to use generics later.
+func NewImageScope(ctx context.Context, linodeClientConfig ClientConfig, params ImageScopeParams) (*ImageScope, error) {
+	if err := validateImageScope(params); err != nil {
+		return nil, err
+	}
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeImage))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create linode client: %w", err)
+	}
+
+	helper, err := patch.NewHelper(params.LinodeImage, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	return &ImageScope{
+		Client:       params.Client,
+		LinodeClient: linodeClient,
+		S3Clients:    CreateS3Clients,
+		LinodeImage:  params.LinodeImage,
+		PatchHelper:  helper,
+		Cluster:      params.Cluster,
+	}, nil
+}
+
+func (s *ImageScope) SetCredentialRefTokenForLinodeClients(ctx context.Context) error {
+	if s.LinodeImage.Spec.CredentialsRef != nil {
+		// TODO: This key is hard-coded (for now) to match the externally-managed `manager-credentials` Secret.
+		apiToken, err := getCredentialDataFromRef(ctx, s.Client, *s.LinodeImage.Spec.CredentialsRef, s.LinodeImage.GetNamespace(), "apiToken")
+		if err != nil {
+			return fmt.Errorf("credentials from secret ref: %w", err)
+		}
+		s.LinodeClient.SetToken(string(apiToken))
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+)
+
+// InstanceSnapshotScope defines the basic context for an actuator to operate upon.
+type InstanceSnapshotScope struct {
+	Client                 clients.K8sClient
+	PatchHelper            *patch.Helper
+	LinodeClient           clients.LinodeClient
+	LinodeInstanceSnapshot *infrav1alpha2.LinodeInstanceSnapshot
+	LinodeMachine          *infrav1alpha2.LinodeMachine
+	Cluster                *clusterv1.Cluster
+}
+
+// InstanceSnapshotScopeParams defines the input parameters used to create a new Scope.
+type InstanceSnapshotScopeParams struct {
+	Client                 clients.K8sClient
+	LinodeInstanceSnapshot *infrav1alpha2.LinodeInstanceSnapshot
+	LinodeMachine          *infrav1alpha2.LinodeMachine
+	Cluster                *clusterv1.Cluster
+}
+
+func validateInstanceSnapshotScope(params InstanceSnapshotScopeParams) error {
+	if params.LinodeInstanceSnapshot == nil {
+		return errors.New("linodeInstanceSnapshot is required when creating an InstanceSnapshotScope")
+	}
+
+	return nil
+}
+
+// PatchObject persists the instance snapshot configuration and status.
+func (s *InstanceSnapshotScope) PatchObject(ctx context.Context) error {
+	return s.PatchHelper.Patch(ctx, s.LinodeInstanceSnapshot)
+}
+
+// Close closes the current scope persisting the instance snapshot configuration and status.
+func (s *InstanceSnapshotScope) Close(ctx context.Context) error {
+	return s.PatchObject(ctx)
+}
+
+// NewInstanceSnapshotScope creates a new Scope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+func NewInstanceSnapshotScope(ctx context.Context, linodeClientConfig ClientConfig, params InstanceSnapshotScopeParams) (*InstanceSnapshotScope, error) {
+	if err := validateInstanceSnapshotScope(params); err != nil {
+		return nil, err
+	}
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeInstanceSnapshot))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create linode client: %w", err)
+	}
+
+	helper, err := patch.NewHelper(params.LinodeInstanceSnapshot, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	return &InstanceSnapshotScope{
+		Client:                 params.Client,
+		LinodeClient:           linodeClient,
+		LinodeInstanceSnapshot: params.LinodeInstanceSnapshot,
+		LinodeMachine:          params.LinodeMachine,
+		PatchHelper:            helper,
+		Cluster:                params.Cluster,
+	}, nil
+}
+
+// SetCredentialRefTokenForLinodeClients overrides the controller credentials with the ones referenced by the
+// snapshot, or by the LinodeMachine if the snapshot does not reference any.
+func (s *InstanceSnapshotScope) SetCredentialRefTokenForLinodeClients(ctx context.Context) error {
+	credentialsRef := s.LinodeInstanceSnapshot.Spec.CredentialsRef
+	namespace := s.LinodeInstanceSnapshot.GetNamespace()
+	if credentialsRef == nil && s.LinodeMachine != nil {
+		credentialsRef = s.LinodeMachine.Spec.CredentialsRef
+		namespace = s.LinodeMachine.GetNamespace()
+	}
+	if credentialsRef == nil {
+		return nil
+	}
+
+	// TODO: This key is hard-coded (for now) to match the externally-managed `manager-credentials` Secret.
+	apiToken, err := getCredentialDataFromRef(ctx, s.Client, *credentialsRef, namespace, "apiToken")
+	if err != nil {
+		return fmt.Errorf("credentials from secret ref: %w", err)
+	}
+	s.LinodeClient.SetToken(string(apiToken))
+
+	return nil
+}
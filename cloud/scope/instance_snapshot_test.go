@@ -0,0 +1,132 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func TestInstanceSnapshotSetCredentialRefTokenForLinodeClients(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                   string
+		LinodeInstanceSnapshot *infrav1alpha2.LinodeInstanceSnapshot
+		LinodeMachine          *infrav1alpha2.LinodeMachine
+		expects                func(mock *mock.MockK8sClient)
+	}{
+		{
+			name: "Success - Use the credentials of the snapshot",
+			LinodeInstanceSnapshot: &infrav1alpha2.LinodeInstanceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "snapshot-namespace"},
+				Spec: infrav1alpha2.LinodeInstanceSnapshotSpec{
+					CredentialsRef: &corev1.SecretReference{Name: "snapshot-credentials"},
+				},
+			},
+			LinodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "machine-namespace"},
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					CredentialsRef: &corev1.SecretReference{Name: "machine-credentials"},
+				},
+			},
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+				mock.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "snapshot-namespace", Name: "snapshot-credentials"}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+						*obj = corev1.Secret{Data: map[string][]byte{"apiToken": []byte("example-api-token")}}
+						return nil
+					})
+			},
+		},
+		{
+			name: "Success - Fall back to the credentials of the LinodeMachine",
+			LinodeInstanceSnapshot: &infrav1alpha2.LinodeInstanceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "snapshot-namespace"},
+			},
+			LinodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "machine-namespace"},
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					CredentialsRef: &corev1.SecretReference{Name: "machine-credentials"},
+				},
+			},
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+				mock.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "machine-namespace", Name: "machine-credentials"}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+						*obj = corev1.Secret{Data: map[string][]byte{"apiToken": []byte("example-api-token")}}
+						return nil
+					})
+			},
+		},
+		{
+			name: "Success - No credentials referenced",
+			LinodeInstanceSnapshot: &infrav1alpha2.LinodeInstanceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "snapshot-namespace"},
+			},
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+			},
+		},
+		{
+			name: "Success - Neither the snapshot nor the LinodeMachine reference credentials",
+			LinodeInstanceSnapshot: &infrav1alpha2.LinodeInstanceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "snapshot-namespace"},
+			},
+			LinodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "machine-namespace"},
+			},
+			expects: func(mock *mock.MockK8sClient) {
+				expectScheme(mock, 1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+
+			testcase.expects(mockK8sClient)
+
+			instanceSnapshotScope, err := NewInstanceSnapshotScope(
+				t.Context(),
+				ClientConfig{Token: "test-key"},
+				InstanceSnapshotScopeParams{
+					Client:                 mockK8sClient,
+					LinodeInstanceSnapshot: testcase.LinodeInstanceSnapshot,
+					LinodeMachine:          testcase.LinodeMachine,
+				},
+			)
+			require.NoError(t, err)
+			// The Secret of the snapshot, or else of the LinodeMachine, is the only one read
+			require.NoError(t, instanceSnapshotScope.SetCredentialRefTokenForLinodeClients(t.Context()))
+		})
+	}
+}
@@ -21,6 +21,10 @@ type MachineScopeParams struct {
 	Machine       *clusterv1.Machine
 	LinodeCluster *infrav1alpha2.LinodeCluster
 	LinodeMachine *infrav1alpha2.LinodeMachine
+	// BootstrapDataEndpoint is nil unless the bootstrap data endpoint of the manager is enabled.
+	BootstrapDataEndpoint clients.BootstrapDataEndpoint
+	// ManagementClusterID identifies the management cluster in the owner tags of the instance.
+	ManagementClusterID string
 }
 
 type MachineScope struct {
@@ -33,6 +37,9 @@ type MachineScope struct {
 	LinodeClient  clients.LinodeClient
 	LinodeCluster *infrav1alpha2.LinodeCluster
 	LinodeMachine *infrav1alpha2.LinodeMachine
+
+	BootstrapDataEndpoint clients.BootstrapDataEndpoint
+	ManagementClusterID   string
 }
 
 func validateMachineScopeParams(params MachineScopeParams) error {
@@ -56,7 +63,7 @@ func NewMachineScope(_ context.Context, linodeClientConfig ClientConfig, params
 	if err := validateMachineScopeParams(params); err != nil {
 		return nil, err
 	}
-	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0))
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeMachine))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -68,7 +75,7 @@ func NewMachineScope(_ context.Context, linodeClientConfig ClientConfig, params
 
 	return &MachineScope{
 		Client:        params.Client,
-		S3Clients:     CreateS3Clients,
+		S3Clients:     S3ClientBuilder(CreateS3Clients).WithDryRun(linodeClientConfig.DryRunInterceptor(params.LinodeMachine)),
 		PatchHelper:   helper,
 		Cluster:       params.Cluster,
 		Machine:       params.Machine,
@@ -76,6 +83,9 @@ func NewMachineScope(_ context.Context, linodeClientConfig ClientConfig, params
 		LinodeClient:  linodeClient,
 		LinodeCluster: params.LinodeCluster,
 		LinodeMachine: params.LinodeMachine,
+
+		BootstrapDataEndpoint: params.BootstrapDataEndpoint,
+		ManagementClusterID:   params.ManagementClusterID,
 	}, nil
 }
 
@@ -101,8 +111,16 @@ func (s *MachineScope) AddFinalizer(ctx context.Context) error {
 
 // GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
 func (m *MachineScope) GetBootstrapData(ctx context.Context) ([]byte, error) {
+	data, _, err := m.GetBootstrapDataWithFormat(ctx)
+
+	return data, err
+}
+
+// GetBootstrapDataWithFormat returns the bootstrap data and its format from the secret in the Machine's
+// bootstrap.dataSecretName. The format is empty if the secret has no format key.
+func (m *MachineScope) GetBootstrapDataWithFormat(ctx context.Context) ([]byte, string, error) {
 	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
-		return nil, fmt.Errorf(
+		return nil, "", fmt.Errorf(
 			"bootstrap data secret is nil for LinodeMachine %s/%s",
 			m.LinodeMachine.Namespace,
 			m.LinodeMachine.Name,
@@ -112,7 +130,7 @@ func (m *MachineScope) GetBootstrapData(ctx context.Context) ([]byte, error) {
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.LinodeMachine.Namespace, Name: *m.Machine.Spec.Bootstrap.DataSecretName}
 	if err := m.Client.Get(ctx, key, secret); err != nil {
-		return nil, fmt.Errorf(
+		return nil, "", fmt.Errorf(
 			"failed to retrieve bootstrap data secret for LinodeMachine %s/%s",
 			m.LinodeMachine.Namespace,
 			m.LinodeMachine.Name,
@@ -121,14 +139,14 @@ func (m *MachineScope) GetBootstrapData(ctx context.Context) ([]byte, error) {
 
 	value, ok := secret.Data["value"]
 	if !ok {
-		return []byte{}, fmt.Errorf(
+		return []byte{}, "", fmt.Errorf(
 			"bootstrap data secret value key is missing for LinodeMachine %s/%s",
 			m.LinodeMachine.Namespace,
 			m.LinodeMachine.Name,
 		)
 	}
 
-	return value, nil
+	return value, string(secret.Data["format"]), nil
 }
 
 func (m *MachineScope) GetObjectStoreCredentials(ctx context.Context, ref corev1.SecretReference) (*corev1.Secret, error) {
@@ -186,7 +204,7 @@ func (s *MachineScope) SetCredentialRefTokenForLinodeClients(ctx context.Context
 	if err != nil {
 		return fmt.Errorf("credentials from secret ref: %w", err)
 	}
-	s.LinodeClient = s.LinodeClient.SetToken(string(apiToken))
+	s.LinodeClient.SetToken(string(apiToken))
 	s.TokenHash = GetHash(string(apiToken))
 	return nil
 }
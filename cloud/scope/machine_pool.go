@@ -0,0 +1,195 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+)
+
+// MachinePoolScopeParams defines the input parameters used to create a new MachinePoolScope.
+type MachinePoolScopeParams struct {
+	Client            clients.K8sClient
+	Cluster           *clusterv1.Cluster
+	MachinePool       *clusterv1.MachinePool
+	LinodeCluster     *infrav1alpha2.LinodeCluster
+	LinodeMachinePool *infrav1alpha2.LinodeMachinePool
+	// LinodeMachineTemplate is the template the instances of the pool are created from.
+	LinodeMachineTemplate *infrav1alpha2.LinodeMachineTemplate
+	// BootstrapDataEndpoint is nil unless the bootstrap data endpoint of the manager is enabled.
+	BootstrapDataEndpoint clients.BootstrapDataEndpoint
+	// ManagementClusterID identifies the management cluster in the owner tags of the instances.
+	ManagementClusterID string
+}
+
+// MachinePoolScope defines the basic context for an actuator to operate upon.
+type MachinePoolScope struct {
+	Client            clients.K8sClient
+	S3Clients         S3ClientBuilder
+	PatchHelper       *patch.Helper
+	Cluster           *clusterv1.Cluster
+	MachinePool       *clusterv1.MachinePool
+	TokenHash         string
+	LinodeClient      clients.LinodeClient
+	LinodeCluster     *infrav1alpha2.LinodeCluster
+	LinodeMachinePool *infrav1alpha2.LinodeMachinePool
+	// LinodeMachineTemplate is the template the instances of the pool are created from.
+	LinodeMachineTemplate *infrav1alpha2.LinodeMachineTemplate
+
+	BootstrapDataEndpoint clients.BootstrapDataEndpoint
+	ManagementClusterID   string
+}
+
+func validateMachinePoolScopeParams(params MachinePoolScopeParams) error {
+	if params.Cluster == nil {
+		return errors.New("cluster is required when creating a MachinePoolScope")
+	}
+	if params.MachinePool == nil {
+		return errors.New("machinePool is required when creating a MachinePoolScope")
+	}
+	if params.LinodeCluster == nil {
+		return errors.New("linodeCluster is required when creating a MachinePoolScope")
+	}
+	if params.LinodeMachinePool == nil {
+		return errors.New("linodeMachinePool is required when creating a MachinePoolScope")
+	}
+	if params.LinodeMachineTemplate == nil {
+		return errors.New("linodeMachineTemplate is required when creating a MachinePoolScope")
+	}
+
+	return nil
+}
+
+// NewMachinePoolScope creates a new MachinePoolScope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+func NewMachinePoolScope(_ context.Context, linodeClientConfig ClientConfig, params MachinePoolScopeParams) (*MachinePoolScope, error) {
+	if err := validateMachinePoolScopeParams(params); err != nil {
+		return nil, err
+	}
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeMachinePool))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create linode client: %w", err)
+	}
+
+	helper, err := patch.NewHelper(params.LinodeMachinePool, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	return &MachinePoolScope{
+		Client:            params.Client,
+		S3Clients:         S3ClientBuilder(CreateS3Clients).WithDryRun(linodeClientConfig.DryRunInterceptor(params.LinodeMachinePool)),
+		PatchHelper:       helper,
+		Cluster:           params.Cluster,
+		MachinePool:       params.MachinePool,
+		TokenHash:         GetHash(linodeClientConfig.Token),
+		LinodeClient:      linodeClient,
+		LinodeCluster:     params.LinodeCluster,
+		LinodeMachinePool: params.LinodeMachinePool,
+
+		LinodeMachineTemplate: params.LinodeMachineTemplate,
+		BootstrapDataEndpoint: params.BootstrapDataEndpoint,
+		ManagementClusterID:   params.ManagementClusterID,
+	}, nil
+}
+
+// PatchObject persists the machine pool configuration and status.
+func (s *MachinePoolScope) PatchObject(ctx context.Context) error {
+	return s.PatchHelper.Patch(ctx, s.LinodeMachinePool)
+}
+
+// Close closes the current scope persisting the machine pool configuration and status.
+func (s *MachinePoolScope) Close(ctx context.Context) error {
+	return s.PatchObject(ctx)
+}
+
+// AddFinalizer adds a finalizer if not present and immediately patches the
+// object to avoid any race conditions.
+func (s *MachinePoolScope) AddFinalizer(ctx context.Context) error {
+	if controllerutil.AddFinalizer(s.LinodeMachinePool, infrav1alpha2.MachinePoolFinalizer) {
+		return s.Close(ctx)
+	}
+
+	return nil
+}
+
+// SetCredentialRefTokenForLinodeClients overrides the controller credentials with the ones referenced by the
+// LinodeMachinePool, or by the LinodeCluster if the pool does not reference any.
+func (s *MachinePoolScope) SetCredentialRefTokenForLinodeClients(ctx context.Context) error {
+	var (
+		credentialRef    *corev1.SecretReference
+		defaultNamespace string
+	)
+	switch {
+	case s.LinodeMachinePool.Spec.CredentialsRef != nil:
+		credentialRef = s.LinodeMachinePool.Spec.CredentialsRef
+		defaultNamespace = s.LinodeMachinePool.GetNamespace()
+	default:
+		credentialRef = s.LinodeCluster.Spec.CredentialsRef
+		defaultNamespace = s.LinodeCluster.GetNamespace()
+	}
+	// TODO: This key is hard-coded (for now) to match the externally-managed `manager-credentials` Secret.
+	apiToken, err := getCredentialDataFromRef(ctx, s.Client, *credentialRef, defaultNamespace, "apiToken")
+	if err != nil {
+		return fmt.Errorf("credentials from secret ref: %w", err)
+	}
+	s.LinodeClient.SetToken(string(apiToken))
+	s.TokenHash = GetHash(string(apiToken))
+	return nil
+}
+
+// MachineScope returns a MachineScope for provisioning the instance of a LinodeMachinePoolMachine from the
+// LinodeMachineTemplate of the pool. The returned scope has no patch helper, the LinodeMachine and Machine it holds are not persisted.
+func (s *MachinePoolScope) MachineScope(machine *infrav1alpha2.LinodeMachinePoolMachine) *MachineScope {
+	return &MachineScope{
+		Client:    s.Client,
+		S3Clients: s.S3Clients,
+		Cluster:   s.Cluster,
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      machine.Name,
+				Namespace: machine.Namespace,
+				Labels:    machine.Labels,
+			},
+			Spec: *s.MachinePool.Spec.Template.Spec.DeepCopy(),
+		},
+		TokenHash:     s.TokenHash,
+		LinodeClient:  s.LinodeClient,
+		LinodeCluster: s.LinodeCluster,
+		LinodeMachine: &infrav1alpha2.LinodeMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      machine.Name,
+				Namespace: machine.Namespace,
+				UID:       machine.UID,
+				Labels:    machine.Labels,
+			},
+			Spec: *s.LinodeMachineTemplate.Spec.Template.Spec.DeepCopy(),
+		},
+		BootstrapDataEndpoint: s.BootstrapDataEndpoint,
+		ManagementClusterID:   s.ManagementClusterID,
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+
+	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
+)
+
+func TestValidateMachinePoolScopeParams(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		params  MachinePoolScopeParams
+		wantErr bool
+	}{
+		{
+			name: "Valid MachinePoolScopeParams",
+			params: MachinePoolScopeParams{
+				Cluster:           &clusterv1.Cluster{},
+				MachinePool:       &clusterv1.MachinePool{},
+				LinodeCluster:     &infrav1alpha2.LinodeCluster{},
+				LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{},
+
+				LinodeMachineTemplate: &infrav1alpha2.LinodeMachineTemplate{},
+			},
+		},
+		{
+			name:    "Invalid MachinePoolScopeParams - empty MachinePoolScopeParams",
+			params:  MachinePoolScopeParams{},
+			wantErr: true,
+		},
+		{
+			name: "Invalid MachinePoolScopeParams - no MachinePool in MachinePoolScopeParams",
+			params: MachinePoolScopeParams{
+				Cluster:           &clusterv1.Cluster{},
+				LinodeCluster:     &infrav1alpha2.LinodeCluster{},
+				LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid MachinePoolScopeParams - no LinodeMachinePool in MachinePoolScopeParams",
+			params: MachinePoolScopeParams{
+				Cluster:       &clusterv1.Cluster{},
+				MachinePool:   &clusterv1.MachinePool{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{},
+
+				LinodeMachineTemplate: &infrav1alpha2.LinodeMachineTemplate{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid MachinePoolScopeParams - no LinodeMachineTemplate in MachinePoolScopeParams",
+			params: MachinePoolScopeParams{
+				Cluster:           &clusterv1.Cluster{},
+				MachinePool:       &clusterv1.MachinePool{},
+				LinodeCluster:     &infrav1alpha2.LinodeCluster{},
+				LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			if err := validateMachinePoolScopeParams(testcase.params); (err != nil) != testcase.wantErr {
+				t.Errorf("validateMachinePoolScopeParams() error = %v, wantErr %v", err, testcase.wantErr)
+			}
+		})
+	}
+}
+
+func TestMachinePoolScopeAddFinalizer(t *testing.T) {
+	t.Parallel()
+
+	NewSuite(t, mock.MockK8sClient{}).Run(
+		Call("scheme", func(ctx context.Context, mck Mock) {
+			mck.K8sClient.EXPECT().Scheme().DoAndReturn(func() *runtime.Scheme {
+				s := runtime.NewScheme()
+				infrav1alpha2.AddToScheme(s)
+				return s
+			}).AnyTimes()
+		}),
+		OneOf(
+			Path(
+				Call("able to patch", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				}),
+				Result("finalizer added", func(ctx context.Context, mck Mock) {
+					mpScope, err := NewMachinePoolScope(ctx, ClientConfig{Token: "apiToken"}, MachinePoolScopeParams{
+						Client:            mck.K8sClient,
+						Cluster:           &clusterv1.Cluster{},
+						MachinePool:       &clusterv1.MachinePool{},
+						LinodeCluster:     &infrav1alpha2.LinodeCluster{},
+						LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{},
+
+						LinodeMachineTemplate: &infrav1alpha2.LinodeMachineTemplate{},
+					})
+					require.NoError(t, err)
+					require.NoError(t, mpScope.AddFinalizer(ctx))
+					assert.Equal(t, []string{infrav1alpha2.MachinePoolFinalizer}, mpScope.LinodeMachinePool.Finalizers)
+				}),
+			),
+			Path(
+				Call("unable to patch", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).Return(errors.New("fail")).AnyTimes()
+				}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					mpScope, err := NewMachinePoolScope(ctx, ClientConfig{Token: "apiToken"}, MachinePoolScopeParams{
+						Client:            mck.K8sClient,
+						Cluster:           &clusterv1.Cluster{},
+						MachinePool:       &clusterv1.MachinePool{},
+						LinodeCluster:     &infrav1alpha2.LinodeCluster{},
+						LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{},
+
+						LinodeMachineTemplate: &infrav1alpha2.LinodeMachineTemplate{},
+					})
+					require.NoError(t, err)
+					assert.Error(t, mpScope.AddFinalizer(ctx))
+				}),
+			),
+		),
+	)
+}
+
+func TestMachinePoolScopeMachineScope(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	mockLinodeClient := mock.NewMockLinodeClient(ctrl)
+	mockEndpoint := mock.NewMockBootstrapDataEndpoint(ctrl)
+	mpScope := &MachinePoolScope{
+		Client:       mockK8sClient,
+		TokenHash:    "token-hash",
+		LinodeClient: mockLinodeClient,
+		Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+		MachinePool: &clusterv1.MachinePool{
+			Spec: clusterv1.MachinePoolSpec{
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To("bootstrap-data")},
+						Version:   "v1.33.0",
+					},
+				},
+			},
+		},
+		LinodeCluster:     &infrav1alpha2.LinodeCluster{ObjectMeta: metav1.ObjectMeta{Name: "linode-cluster"}},
+		LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{},
+		LinodeMachineTemplate: &infrav1alpha2.LinodeMachineTemplate{
+			Spec: infrav1alpha2.LinodeMachineTemplateSpec{
+				Template: infrav1alpha2.LinodeMachineTemplateResource{
+					Spec: infrav1alpha2.LinodeMachineSpec{Region: "us-ord", Type: "g6-standard-2", Tags: []string{"tag"}},
+				},
+			},
+		},
+		BootstrapDataEndpoint: mockEndpoint,
+		ManagementClusterID:   "management-cluster",
+	}
+	machine := &infrav1alpha2.LinodeMachinePoolMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool-abcde",
+			Namespace: "default",
+			UID:       "uid",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+		},
+	}
+
+	mScope := mpScope.MachineScope(machine)
+
+	// The clients and the cluster objects are shared with the pool.
+	assert.Same(t, mockK8sClient, mScope.Client)
+	assert.Same(t, mockLinodeClient, mScope.LinodeClient)
+	assert.Same(t, mpScope.Cluster, mScope.Cluster)
+	assert.Same(t, mpScope.LinodeCluster, mScope.LinodeCluster)
+	assert.Equal(t, "token-hash", mScope.TokenHash)
+	assert.Equal(t, mockEndpoint, mScope.BootstrapDataEndpoint)
+	assert.Equal(t, "management-cluster", mScope.ManagementClusterID)
+	assert.Nil(t, mScope.PatchHelper)
+
+	// The synthetic Machine and LinodeMachine are named after the LinodeMachinePoolMachine, the LinodeMachine shares
+	// its UID so that the bootstrap data and the instance are keyed on it.
+	assert.Equal(t, "pool-abcde", mScope.Machine.Name)
+	assert.Equal(t, "default", mScope.Machine.Namespace)
+	assert.Equal(t, machine.Labels, mScope.Machine.Labels)
+	assert.Equal(t, "bootstrap-data", *mScope.Machine.Spec.Bootstrap.DataSecretName)
+	assert.Equal(t, "v1.33.0", mScope.Machine.Spec.Version)
+	assert.Equal(t, "pool-abcde", mScope.LinodeMachine.Name)
+	assert.Equal(t, "default", mScope.LinodeMachine.Namespace)
+	assert.Equal(t, machine.UID, mScope.LinodeMachine.UID)
+	assert.Equal(t, machine.Labels, mScope.LinodeMachine.Labels)
+	assert.Equal(t, mpScope.LinodeMachineTemplate.Spec.Template.Spec, mScope.LinodeMachine.Spec)
+
+	// The templates of the pool must not be modified through the returned scope.
+	mScope.LinodeMachine.Spec.Region = "us-mia"
+	mScope.LinodeMachine.Spec.Tags[0] = "modified"
+	*mScope.Machine.Spec.Bootstrap.DataSecretName = "modified"
+	assert.Equal(t, "us-ord", mpScope.LinodeMachineTemplate.Spec.Template.Spec.Region)
+	assert.Equal(t, []string{"tag"}, mpScope.LinodeMachineTemplate.Spec.Template.Spec.Tags)
+	assert.Equal(t, "bootstrap-data", *mpScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName)
+}
@@ -358,6 +358,60 @@ func TestMachineScopeGetBootstrapData(t *testing.T) {
 	)
 }
 
+func TestMachineScopeGetBootstrapDataWithFormat(t *testing.T) {
+	t.Parallel()
+
+	NewSuite(t, mock.MockK8sClient{}).Run(
+		OneOf(
+			Path(
+				Call("secret with format", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj *corev1.Secret, opts ...client.GetOption) error {
+							*obj = corev1.Secret{Data: map[string][]byte{"value": []byte("{}"), "format": []byte("ignition")}}
+							return nil
+						})
+				}),
+				Result("format returned", func(ctx context.Context, mck Mock) {
+					mScope := MachineScope{
+						Client: mck.K8sClient,
+						Machine: &clusterv1.Machine{
+							Spec: clusterv1.MachineSpec{Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To("test-data")}},
+						},
+						LinodeMachine: &infrav1alpha2.LinodeMachine{},
+					}
+
+					data, format, err := mScope.GetBootstrapDataWithFormat(ctx)
+					require.NoError(t, err)
+					assert.Equal(t, []byte("{}"), data)
+					assert.Equal(t, "ignition", format)
+				}),
+			),
+			Path(
+				Call("secret without format", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj *corev1.Secret, opts ...client.GetOption) error {
+							*obj = corev1.Secret{Data: map[string][]byte{"value": []byte("test-data")}}
+							return nil
+						})
+				}),
+				Result("empty format", func(ctx context.Context, mck Mock) {
+					mScope := MachineScope{
+						Client: mck.K8sClient,
+						Machine: &clusterv1.Machine{
+							Spec: clusterv1.MachineSpec{Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To("test-data")}},
+						},
+						LinodeMachine: &infrav1alpha2.LinodeMachine{},
+					}
+
+					_, format, err := mScope.GetBootstrapDataWithFormat(ctx)
+					require.NoError(t, err)
+					assert.Empty(t, format)
+				}),
+			),
+		),
+	)
+}
+
 func TestMachineAddCredentialsRefFinalizer(t *testing.T) {
 	t.Parallel()
 	type fields struct {
@@ -27,6 +27,8 @@ type ObjectStorageBucketScope struct {
 	Logger       logr.Logger
 	LinodeClient clients.LinodeClient
 	PatchHelper  *patch.Helper
+	// DryRunInterceptor intercepts the calls of the S3 clients of the bucket mutating objects in dry-run mode.
+	DryRunInterceptor func(ctx context.Context, method string, params map[string]interface{})
 }
 
 const (
@@ -60,7 +62,7 @@ func NewObjectStorageBucketScope(ctx context.Context, linodeClientConfig ClientC
 		linodeClientConfig.Token = string(apiToken)
 	}
 	linodeClientConfig.Timeout = clientTimeout
-	linodeClient, err := CreateLinodeClient(linodeClientConfig)
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRegarding(params.Bucket))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -76,6 +78,8 @@ func NewObjectStorageBucketScope(ctx context.Context, linodeClientConfig ClientC
 		Logger:       *params.Logger,
 		LinodeClient: linodeClient,
 		PatchHelper:  patchHelper,
+
+		DryRunInterceptor: linodeClientConfig.DryRunInterceptor(params.Bucket),
 	}, nil
 }
 
@@ -50,7 +50,7 @@ func NewObjectStorageKeyScope(ctx context.Context, linodeClientConfig ClientConf
 		return nil, err
 	}
 	linodeClientConfig.Timeout = clientTimeout
-	linodeClient, err := CreateLinodeClient(linodeClientConfig)
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRegarding(params.Key))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -179,7 +179,7 @@ func (s *ObjectStorageKeyScope) SetCredentialRefTokenForLinodeClients(ctx contex
 		if err != nil {
 			return fmt.Errorf("credentials from secret ref: %w", err)
 		}
-		s.LinodeClient = s.LinodeClient.SetToken(string(apiToken))
+		s.LinodeClient.SetToken(string(apiToken))
 		return nil
 	}
 	return nil
@@ -101,7 +101,7 @@ func NewPlacementGroupScope(ctx context.Context, linodeClientConfig ClientConfig
 	if err := validatePlacementGroupScope(params); err != nil {
 		return nil, err
 	}
-	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0))
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodePlacementGroup))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -127,7 +127,7 @@ func (s *PlacementGroupScope) SetCredentialRefTokenForLinodeClients(ctx context.
 		if err != nil {
 			return fmt.Errorf("credentials from secret ref: %w", err)
 		}
-		s.LinodeClient = s.LinodeClient.SetToken(string(apiToken))
+		s.LinodeClient.SetToken(string(apiToken))
 		return nil
 	}
 	return nil
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+)
+
+// VolumeScope defines the basic context for an actuator to operate upon.
+type VolumeScope struct {
+	Client       clients.K8sClient
+	PatchHelper  *patch.Helper
+	LinodeClient clients.LinodeClient
+	LinodeVolume *infrav1alpha2.LinodeVolume
+	Cluster      *clusterv1.Cluster
+}
+
+// VolumeScopeParams defines the input parameters used to create a new Scope.
+type VolumeScopeParams struct {
+	Client       clients.K8sClient
+	LinodeVolume *infrav1alpha2.LinodeVolume
+	Cluster      *clusterv1.Cluster
+}
+
+func validateVolumeScope(params VolumeScopeParams) error {
+	if params.LinodeVolume == nil {
+		return errors.New("linodeVolume is required when creating a VolumeScope")
+	}
+
+	return nil
+}
+
+// PatchObject persists the volume configuration and status.
+func (s *VolumeScope) PatchObject(ctx context.Context) error {
+	return s.PatchHelper.Patch(ctx, s.LinodeVolume)
+}
+
+// Close closes the current scope persisting the volume configuration and status.
+func (s *VolumeScope) Close(ctx context.Context) error {
+	return s.PatchObject(ctx)
+}
+
+// AddFinalizer adds a finalizer if not present and immediately patches the
+// object to avoid any race conditions.
+func (s *VolumeScope) AddFinalizer(ctx context.Context) error {
+	if controllerutil.AddFinalizer(s.LinodeVolume, infrav1alpha2.VolumeFinalizer) {
+		return s.Close(ctx)
+	}
+
+	return nil
+}
+
+func (s *VolumeScope) AddCredentialsRefFinalizer(ctx context.Context) error {
+	if s.LinodeVolume.Spec.CredentialsRef == nil {
+		return nil
+	}
+
+	return addCredentialsFinalizer(ctx, s.Client,
+		*s.LinodeVolume.Spec.CredentialsRef, s.LinodeVolume.GetNamespace(),
+		toFinalizer(s.LinodeVolume))
+}
+
+func (s *VolumeScope) RemoveCredentialsRefFinalizer(ctx context.Context) error {
+	if s.LinodeVolume.Spec.CredentialsRef == nil {
+		return nil
+	}
+
+	return removeCredentialsFinalizer(ctx, s.Client,
+		*s.LinodeVolume.Spec.CredentialsRef, s.LinodeVolume.GetNamespace(),
+		toFinalizer(s.LinodeVolume))
+}
+
+// NewVolumeScope creates a new Scope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+//
+//nolint:dupl // This is pretty much the same as PlacementGroup, maybe a candidate to use generics later.
+func NewVolumeScope(ctx context.Context, linodeClientConfig ClientConfig, params VolumeScopeParams) (*VolumeScope, error) {
+	if err := validateVolumeScope(params); err != nil {
+		return nil, err
+	}
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeVolume))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create linode client: %w", err)
+	}
+
+	helper, err := patch.NewHelper(params.LinodeVolume, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	return &VolumeScope{
+		Client:       params.Client,
+		LinodeClient: linodeClient,
+		LinodeVolume: params.LinodeVolume,
+		PatchHelper:  helper,
+		Cluster:      params.Cluster,
+	}, nil
+}
+
+func (s *VolumeScope) SetCredentialRefTokenForLinodeClients(ctx context.Context) error {
+	if s.LinodeVolume.Spec.CredentialsRef != nil {
+		// TODO: This key is hard-coded (for now) to match the externally-managed `manager-credentials` Secret.
+		apiToken, err := getCredentialDataFromRef(ctx, s.Client, *s.LinodeVolume.Spec.CredentialsRef, s.LinodeVolume.GetNamespace(), "apiToken")
+		if err != nil {
+			return fmt.Errorf("credentials from secret ref: %w", err)
+		}
+		s.LinodeClient.SetToken(string(apiToken))
+		return nil
+	}
+	return nil
+}
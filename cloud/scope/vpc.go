@@ -33,16 +33,20 @@ import (
 type VPCScope struct {
 	Client       clients.K8sClient
 	PatchHelper  *patch.Helper
+	TokenHash    string
 	LinodeClient clients.LinodeClient
 	LinodeVPC    *infrav1alpha2.LinodeVPC
 	Cluster      *clusterv1.Cluster
+	// ManagementClusterID identifies the management cluster in the description of the VPC.
+	ManagementClusterID string
 }
 
 // VPCScopeParams defines the input parameters used to create a new Scope.
 type VPCScopeParams struct {
-	Client    clients.K8sClient
-	LinodeVPC *infrav1alpha2.LinodeVPC
-	Cluster   *clusterv1.Cluster
+	Client              clients.K8sClient
+	LinodeVPC           *infrav1alpha2.LinodeVPC
+	Cluster             *clusterv1.Cluster
+	ManagementClusterID string
 }
 
 func validateVPCScopeParams(params VPCScopeParams) error {
@@ -61,7 +65,7 @@ func NewVPCScope(ctx context.Context, linodeClientConfig ClientConfig, params VP
 	if err := validateVPCScopeParams(params); err != nil {
 		return nil, err
 	}
-	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0))
+	linodeClient, err := CreateLinodeClient(linodeClientConfig, WithRetryCount(0), WithRegarding(params.LinodeVPC))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create linode client: %w", err)
 	}
@@ -72,11 +76,13 @@ func NewVPCScope(ctx context.Context, linodeClientConfig ClientConfig, params VP
 	}
 
 	return &VPCScope{
-		Client:       params.Client,
-		LinodeClient: linodeClient,
-		LinodeVPC:    params.LinodeVPC,
-		PatchHelper:  helper,
-		Cluster:      params.Cluster,
+		Client:              params.Client,
+		TokenHash:           GetHash(linodeClientConfig.Token),
+		LinodeClient:        linodeClient,
+		LinodeVPC:           params.LinodeVPC,
+		PatchHelper:         helper,
+		Cluster:             params.Cluster,
+		ManagementClusterID: params.ManagementClusterID,
 	}, nil
 }
 
@@ -127,7 +133,8 @@ func (s *VPCScope) SetCredentialRefTokenForLinodeClients(ctx context.Context) er
 		if err != nil {
 			return fmt.Errorf("credentials from secret ref: %w", err)
 		}
-		s.LinodeClient = s.LinodeClient.SetToken(string(apiToken))
+		s.LinodeClient.SetToken(string(apiToken))
+		s.TokenHash = GetHash(string(apiToken))
 		return nil
 	}
 	return nil
@@ -2,17 +2,13 @@ package services
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net"
 	"net/netip"
-	"slices"
 	"sort"
 	"strings"
 	"sync"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/dns"
 	"github.com/go-logr/logr"
 	"github.com/linode/linodego/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +19,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/linode/cluster-api-provider-linode/api/v1alpha2"
-	"github.com/linode/cluster-api-provider-linode/clients"
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
 	"github.com/linode/cluster-api-provider-linode/util"
 	rutil "github.com/linode/cluster-api-provider-linode/util/reconciler"
 )
 
+const (
+	// dnsRegistryHeritage prefixes the TXT records registering the owner of the records of a subdomain, which follow
+	// the format of the external-dns TXT registry so that both can share a zone.
+	dnsRegistryHeritage    = "heritage=cluster-api-provider-linode"
+	dnsRegistryOwnerKey    = "cluster-api-provider-linode/owner"
+	dnsRegistryResourceKey = "cluster-api-provider-linode/resource"
+	dnsRegistryUIDKey      = "cluster-api-provider-linode/uid"
+)
+
+// ErrDNSOwnerConflict is returned when the records of the subdomain of a cluster are owned by another entity.
+var ErrDNSOwnerConflict = errors.New("DNS records are owned by another entity")
+
 type DNSEntries struct {
 	options []DNSOptions
 	mux     sync.RWMutex
@@ -41,7 +48,37 @@ type DNSOptions struct {
 	DNSTTLSec     int
 }
 
-// EnsureDNSEntries ensures the domainrecord on Linode Cloud Manager is created, updated, or deleted based on operation passed
+// DNSProvider manages the records of the control plane endpoint of a cluster in the zone of its dnsRootDomain.
+type DNSProvider interface {
+	// EnsureRecords creates the records of the DNS entries which do not exist yet, and deletes the stale A and AAAA
+	// records of the subdomain of the cluster.
+	EnsureRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error
+	// ListRecords returns the records of the subdomain of the cluster.
+	ListRecords(ctx context.Context, cscope *scope.ClusterScope) ([]DNSOptions, error)
+	// DeleteRecords deletes the records of the DNS entries along with the stale A and AAAA records of the subdomain of
+	// the cluster.
+	DeleteRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error
+}
+
+// dnsProviders are the DNS providers by the name used in the dnsProvider of the LinodeCluster.
+var dnsProviders = map[string]DNSProvider{
+	"":        linodeDNSProvider{},
+	"linode":  linodeDNSProvider{},
+	"akamai":  akamaiDNSProvider{},
+	"rfc2136": rfc2136DNSProvider{},
+}
+
+// GetDNSProvider returns the DNS provider of the cluster.
+func GetDNSProvider(cscope *scope.ClusterScope) (DNSProvider, error) {
+	provider, ok := dnsProviders[cscope.LinodeCluster.Spec.Network.DNSProvider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported DNS provider %q", cscope.LinodeCluster.Spec.Network.DNSProvider)
+	}
+
+	return provider, nil
+}
+
+// EnsureDNSEntries ensures the domain records of the DNS provider are created, updated, or deleted based on operation passed
 func EnsureDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation string) error {
 	// Get the public IP that was assigned
 	var dnss DNSEntries
@@ -54,91 +91,22 @@ func EnsureDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation
 		return nil
 	}
 
-	if cscope.LinodeCluster.Spec.Network.DNSProvider == "akamai" {
-		if err := deleteStaleAkamaiEntries(ctx, cscope, dnsEntries); err != nil {
-			return err
-		}
-		for _, dnsEntry := range dnsEntries {
-			if err := EnsureAkamaiDNSEntries(ctx, cscope, operation, dnsEntry); err != nil {
-				return err
-			}
-		}
-	} else {
-		if err := EnsureLinodeDNSEntries(ctx, cscope, operation, dnsEntries); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func resetAkamaiRecord(ctx context.Context, cscope *scope.ClusterScope, recordResponse *dns.GetRecordResponse, machineIPList []string, rootDomain string) error {
-	freshEntries := make([]string, 0)
-	for _, ip := range recordResponse.Target {
-		ip = strings.Replace(ip, ":0:0:", "::", 8) //nolint:mnd // 8 for 8 octet
-		if slices.Contains(machineIPList, ip) {
-			freshEntries = append(freshEntries, ip)
-		}
-	}
-	if len(freshEntries) == 0 {
-		return cscope.AkamaiDomainsClient.DeleteRecord(ctx, dns.DeleteRecordRequest{
-			Zone:       rootDomain,
-			Name:       recordResponse.Name,
-			RecordType: recordResponse.RecordType,
-		})
-	}
-
-	recordResponse.Target = freshEntries
-	return cscope.AkamaiDomainsClient.UpdateRecord(ctx, dns.UpdateRecordRequest{
-		Record: &dns.RecordBody{
-			Name:       recordResponse.Name,
-			RecordType: recordResponse.RecordType,
-			TTL:        recordResponse.TTL,
-			Active:     recordResponse.Active,
-			Target:     recordResponse.Target,
-		},
-		Zone: rootDomain,
-	})
-}
-
-func deleteStaleAkamaiEntries(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
-	ipv4IPs, ipv6IPs := getDNSMachineIPs(dnsEntries)
-	rootDomain := cscope.LinodeCluster.Spec.Network.DNSRootDomain
-	fqdn := getSubDomain(cscope) + "." + rootDomain
-
-	// A record
-	aRecord, err := cscope.AkamaiDomainsClient.GetRecord(ctx, dns.GetRecordRequest{
-		Zone:       rootDomain,
-		Name:       fqdn,
-		RecordType: string(linodego.RecordTypeA)})
+	provider, err := GetDNSProvider(cscope)
 	if err != nil {
-		if !strings.Contains(err.Error(), "Not Found") {
-			return err
-		}
-	}
-	if aRecord != nil {
-		if err := resetAkamaiRecord(ctx, cscope, aRecord, ipv4IPs, rootDomain); err != nil {
-			return err
-		}
+		return err
 	}
-
-	// AAAA record
-	aaaaRecord, err := cscope.AkamaiDomainsClient.GetRecord(ctx, dns.GetRecordRequest{
-		Zone:       rootDomain,
-		Name:       fqdn,
-		RecordType: string(linodego.RecordTypeAAAA)})
+	records, err := provider.ListRecords(ctx, cscope)
 	if err != nil {
-		if !strings.Contains(err.Error(), "Not Found") {
-			return err
-		}
+		return err
 	}
-	if aaaaRecord != nil {
-		if err := resetAkamaiRecord(ctx, cscope, aaaaRecord, ipv6IPs, rootDomain); err != nil {
-			return err
-		}
+	if err := verifyDNSRecordOwner(cscope, records, operation != "delete"); err != nil {
+		return err
+	}
+	if operation == "delete" {
+		return provider.DeleteRecords(ctx, cscope, dnsEntries)
 	}
 
-	return nil
+	return provider.EnsureRecords(ctx, cscope, dnsEntries)
 }
 
 func getDNSMachineIPs(dnsEntries []DNSOptions) (ipv4IPs, ipv6IPs []string) {
@@ -153,183 +121,6 @@ func getDNSMachineIPs(dnsEntries []DNSOptions) (ipv4IPs, ipv6IPs []string) {
 	return ipv4IPs, ipv6IPs
 }
 
-func deleteStaleLinodeEntries(ctx context.Context, cscope *scope.ClusterScope, domainRecords []linodego.DomainRecord, domainID int, dnsEntries []DNSOptions) error {
-	ipv4IPs, ipv6IPs := getDNSMachineIPs(dnsEntries)
-	if len(domainRecords) > 0 {
-		for _, record := range domainRecords {
-			if record.Type == linodego.RecordTypeTXT {
-				continue
-			}
-			if !slices.Contains(ipv4IPs, record.Target) && !slices.Contains(ipv6IPs, record.Target) {
-				if err := cscope.LinodeDomainsClient.DeleteDomainRecord(ctx, domainID, record.ID); err != nil {
-					return err
-				}
-			}
-		}
-	}
-	return nil
-}
-
-// EnsureLinodeDNSEntries ensures the domainrecord on Linode Cloud Manager is created, updated, or deleted based on operation passed
-func EnsureLinodeDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation string, dnsEntries []DNSOptions) error {
-	// Get domainID from domain name
-	domainID, err := GetDomainID(ctx, cscope)
-	if err != nil {
-		return err
-	}
-
-	filter, err := json.Marshal(map[string]interface{}{"name": getSubDomain(cscope)})
-	if err != nil {
-		return err
-	}
-
-	listOptions := linodego.NewListOptions(0, string(filter))
-	listOptions.PageSize = 500 // set a high page size to avoid multiple requests
-
-	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, listOptions)
-	if err != nil {
-		return err
-	}
-
-	if err := deleteStaleLinodeEntries(ctx, cscope, domainRecords, domainID, dnsEntries); err != nil {
-		return err
-	}
-
-	for _, dnsEntry := range dnsEntries {
-		if operation == "delete" {
-			if err := DeleteDomainRecord(ctx, cscope, domainID, dnsEntry); err != nil {
-				return err
-			}
-		} else {
-			if err := CreateDomainRecord(ctx, cscope, domainID, dnsEntry); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
-
-// EnsureAkamaiDNSEntries ensures the domainrecord on Akamai EDGE DNS is created, updated, or deleted based on operation passed
-func EnsureAkamaiDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation string, dnsEntry DNSOptions) error {
-	linodeCluster := cscope.LinodeCluster
-	linodeClusterNetworkSpec := linodeCluster.Spec.Network
-	rootDomain := linodeClusterNetworkSpec.DNSRootDomain
-	akaDNSClient := cscope.AkamaiDomainsClient
-	fqdn := getSubDomain(cscope) + "." + rootDomain
-
-	// Get the record for the root domain and fqdn
-	record, err := akaDNSClient.GetRecord(ctx, dns.GetRecordRequest{
-		Zone:       rootDomain,
-		Name:       fqdn,
-		RecordType: string(dnsEntry.DNSRecordType)})
-
-	if err != nil {
-		if !strings.Contains(err.Error(), "Not Found") {
-			return err
-		}
-		// Record was not found - if operation is not "create", nothing to do
-		if operation != "create" {
-			return nil
-		}
-		// Create record
-		return createAkamaiEntry(ctx, akaDNSClient, dnsEntry, fqdn, rootDomain)
-	}
-
-	if record == nil {
-		return fmt.Errorf("akamai dns returned empty dns record")
-	}
-	recordBody := &dns.RecordBody{
-		Name:       record.Name,
-		RecordType: record.RecordType,
-		TTL:        record.TTL,
-		Active:     record.Active,
-		Target:     record.Target,
-	}
-	// if operation is delete and we got the record, delete it
-	if operation == "delete" {
-		return deleteAkamaiEntry(ctx, cscope, recordBody, dnsEntry)
-	}
-	// if operation is create and we got the record, update it
-	// Check if the target already exists in the target list
-	for _, target := range recordBody.Target {
-		if recordBody.RecordType == "TXT" {
-			if strings.Contains(target, dnsEntry.Target) {
-				return nil
-			}
-		} else {
-			if slices.Equal(net.ParseIP(target), net.ParseIP(dnsEntry.Target)) {
-				return nil
-			}
-		}
-	}
-	// Target doesn't exist so lets append it to the existing list and update it
-	recordBody.Target = append(recordBody.Target, dnsEntry.Target)
-	return akaDNSClient.UpdateRecord(ctx, dns.UpdateRecordRequest{
-		Record: recordBody,
-		Zone:   rootDomain,
-	})
-}
-
-func createAkamaiEntry(ctx context.Context, akamClient clients.AkamClient, dnsEntry DNSOptions, fqdn, rootDomain string) error {
-	return akamClient.CreateRecord(
-		ctx,
-		dns.CreateRecordRequest{
-			Record: &dns.RecordBody{
-				Name:       fqdn,
-				RecordType: string(dnsEntry.DNSRecordType),
-				TTL:        dnsEntry.DNSTTLSec,
-				Target:     []string{dnsEntry.Target},
-			},
-			Zone: rootDomain,
-		},
-	)
-}
-
-func deleteAkamaiEntry(ctx context.Context, cscope *scope.ClusterScope, recordBody *dns.RecordBody, dnsEntry DNSOptions) error {
-	linodeCluster := cscope.LinodeCluster
-	linodeClusterNetworkSpec := linodeCluster.Spec.Network
-	rootDomain := linodeClusterNetworkSpec.DNSRootDomain
-	// If record is A/AAAA type, verify ownership
-	if dnsEntry.DNSRecordType != linodego.RecordTypeTXT {
-		isOwner, err := IsAkamaiDomainRecordOwner(ctx, cscope)
-		if err != nil {
-			return err
-		}
-		if !isOwner {
-			return fmt.Errorf("the domain record is not owned by this entity. wont delete")
-		}
-	}
-	switch {
-	case len(recordBody.Target) > 1:
-		recordBody.Target = removeElement(
-			recordBody.Target,
-			// Linode DNS API formats the IPv6 IPs using :: for :0:0: while the address from the LinodeMachine status keeps it as is
-			// So we need to match that
-			strings.Replace(dnsEntry.Target, "::", ":0:0:", 8), //nolint:mnd // 8 for 8 octest
-		)
-		return cscope.AkamaiDomainsClient.UpdateRecord(ctx, dns.UpdateRecordRequest{
-			Record: recordBody,
-			Zone:   rootDomain,
-		})
-	default:
-		return cscope.AkamaiDomainsClient.DeleteRecord(ctx, dns.DeleteRecordRequest{
-			Zone:       rootDomain,
-			Name:       recordBody.Name,
-			RecordType: recordBody.RecordType,
-		})
-	}
-}
-
-func removeElement(stringList []string, elemToRemove string) []string {
-	for index, element := range stringList {
-		if element == elemToRemove {
-			stringList = slices.Delete(stringList, index, index+1)
-			continue
-		}
-	}
-	return stringList
-}
-
 func isCapiMachineReady(ctx context.Context, capiMachine *v1beta2.Machine, k8sClient client.Client) (bool, error) {
 	ref := metav1.GetControllerOf(capiMachine)
 	if ref == nil || ref.Kind != "KubeadmControlPlane" {
@@ -425,138 +216,132 @@ func (d *DNSEntries) getDNSEntriesToEnsure(ctx context.Context, cscope *scope.Cl
 		d.options = append(d.options, options...)
 	}
 	d.options = append(d.options, DNSOptions{subDomain, cscope.LinodeCluster.Name, linodego.RecordTypeTXT, dnsTTLSec})
+	// The ownership record comes last so that it is only deleted after the records it protects
+	d.options = append(d.options, DNSOptions{subDomain, getDNSRegistryRecord(cscope), linodego.RecordTypeTXT, dnsTTLSec})
 
 	return d.options, errors.Join(encounteredErrors...)
 }
 
-// GetDomainID gets the domains linode id
-func GetDomainID(ctx context.Context, cscope *scope.ClusterScope) (int, error) {
-	rootDomain := cscope.LinodeCluster.Spec.Network.DNSRootDomain
-	filter, err := json.Marshal(map[string]string{"domain": rootDomain})
-	if err != nil {
-		return 0, err
-	}
-	domains, err := cscope.LinodeDomainsClient.ListDomains(ctx, linodego.NewListOptions(0, string(filter)))
-	if err != nil {
-		return 0, err
-	}
-	if len(domains) != 1 || domains[0].Domain != rootDomain {
-		return 0, fmt.Errorf("domain %s not found in list of domains owned by this account", rootDomain)
+func getSubDomain(cscope *scope.ClusterScope) (subDomain string) {
+	if cscope.LinodeCluster.Spec.Network.DNSSubDomainOverride != "" {
+		subDomain = cscope.LinodeCluster.Spec.Network.DNSSubDomainOverride
+	} else {
+		uniqueID := ""
+		if cscope.LinodeCluster.Spec.Network.DNSUniqueIdentifier != "" {
+			uniqueID = "-" + cscope.LinodeCluster.Spec.Network.DNSUniqueIdentifier
+		}
+		subDomain = cscope.LinodeCluster.Name + uniqueID
 	}
+	return subDomain
+}
 
-	return domains[0].ID, nil
+// getDNSRegistryRecord returns the target of the TXT record registering the cluster as the owner of the records of its
+// subdomain, identified by the ID of the management cluster, its namespace and name, and its UID.
+func getDNSRegistryRecord(cscope *scope.ClusterScope) string {
+	return fmt.Sprintf("%s,%s=%s,%s=%s,%s=%s", dnsRegistryHeritage,
+		dnsRegistryOwnerKey, cscope.ManagementClusterID,
+		dnsRegistryResourceKey, dnsRegistryResource(cscope),
+		dnsRegistryUIDKey, cscope.LinodeCluster.UID)
 }
 
-func CreateDomainRecord(ctx context.Context, cscope *scope.ClusterScope, domainID int, dnsEntry DNSOptions) error {
-	// Check if domain record exists for this IP and name combo
-	filter, err := json.Marshal(map[string]interface{}{"name": dnsEntry.Hostname, "target": dnsEntry.Target, "type": dnsEntry.DNSRecordType})
-	if err != nil {
-		return err
-	}
+func dnsRegistryResource(cscope *scope.ClusterScope) string {
+	return fmt.Sprintf("linodecluster/%s/%s", cscope.LinodeCluster.Namespace, cscope.LinodeCluster.Name)
+}
 
-	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, linodego.NewListOptions(0, string(filter)))
-	if err != nil {
-		return err
+// isDNSRegistryRecordOf returns whether the target of an ownership record registers the cluster. Besides the current
+// ownership record of the cluster, records with the namespace and name of the cluster are accepted when either the ID
+// of the management cluster or the UID matches: the UID changes when the cluster is moved by clusterctl, and the ID of
+// the management cluster when it is set explicitly.
+func isDNSRegistryRecordOf(cscope *scope.ClusterScope, target string) bool {
+	if target == getDNSRegistryRecord(cscope) {
+		return true
 	}
 
-	// If record doesnt exist, create it
-	if len(domainRecords) == 0 {
-		if _, err := cscope.LinodeDomainsClient.CreateDomainRecord(
-			ctx,
-			domainID,
-			linodego.DomainRecordCreateOptions{
-				Type:   dnsEntry.DNSRecordType,
-				Name:   dnsEntry.Hostname,
-				Target: dnsEntry.Target,
-				TTLSec: dnsEntry.DNSTTLSec,
-			},
-		); err != nil {
-			return err
-		}
+	fields := strings.Split(target, ",")
+	if len(fields) == 0 || fields[0] != dnsRegistryHeritage {
+		return false
 	}
-	return nil
-}
-
-func DeleteDomainRecord(ctx context.Context, cscope *scope.ClusterScope, domainID int, dnsEntry DNSOptions) error {
-	// Check if domain record exists for this IP and name combo
-	filter, err := json.Marshal(map[string]interface{}{"name": dnsEntry.Hostname, "target": dnsEntry.Target, "type": dnsEntry.DNSRecordType})
-	if err != nil {
-		return err
+	values := make(map[string]string, len(fields)-1)
+	for _, field := range fields[1:] {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			values[key] = value
+		}
 	}
-
-	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, linodego.NewListOptions(0, string(filter)))
-	if err != nil {
-		return err
+	if values[dnsRegistryResourceKey] != dnsRegistryResource(cscope) {
+		return false
 	}
 
-	// Nothing to do if records dont exist
-	if len(domainRecords) == 0 {
-		return nil
-	}
+	return values[dnsRegistryOwnerKey] == cscope.ManagementClusterID || values[dnsRegistryUIDKey] == string(cscope.LinodeCluster.UID)
+}
 
-	// If record is A/AAAA type, verify ownership
-	if dnsEntry.DNSRecordType != linodego.RecordTypeTXT {
-		isOwner, err := IsLinodeDomainRecordOwner(ctx, cscope, dnsEntry.Hostname, domainID)
-		if err != nil {
-			return err
+// getDNSRegistryOwnerRecord returns the ownership record of the cluster among the records, which may predate its
+// current ownership record. It returns an ErrDNSOwnerConflict if they are registered to another entity instead, e.g.
+// another management cluster or external-dns, and an empty target if they are not registered at all.
+func getDNSRegistryOwnerRecord(cscope *scope.ClusterScope, records []DNSOptions) (string, error) {
+	var owners []string
+	for _, record := range records {
+		target := strings.Trim(record.Target, `"`)
+		if record.DNSRecordType != linodego.RecordTypeTXT || !strings.HasPrefix(target, "heritage=") {
+			continue
 		}
-		if !isOwner {
-			return fmt.Errorf("the domain record is not owned by this entity. wont delete")
+		if isDNSRegistryRecordOf(cscope, target) {
+			return target, nil
 		}
+		owners = append(owners, target)
+	}
+	if len(owners) > 0 {
+		return "", fmt.Errorf("%w: %s is registered to %s", ErrDNSOwnerConflict, getSubDomain(cscope), strings.Join(owners, ", "))
 	}
 
-	// Delete record
-	return cscope.LinodeDomainsClient.DeleteDomainRecord(ctx, domainID, domainRecords[0].ID)
+	return "", nil
 }
 
-func IsLinodeDomainRecordOwner(ctx context.Context, cscope *scope.ClusterScope, hostname string, domainID int) (bool, error) {
-	// Check if domain record exists
-	filter, err := json.Marshal(map[string]interface{}{"name": hostname, "type": linodego.RecordTypeTXT})
-	if err != nil {
-		return false, err
-	}
+// isDNSRegistryOwner returns whether the records contain the ownership record of the cluster. It returns an
+// ErrDNSOwnerConflict if they are registered to another entity instead, e.g. another management cluster or external-dns.
+func isDNSRegistryOwner(cscope *scope.ClusterScope, records []DNSOptions) (bool, error) {
+	target, err := getDNSRegistryOwnerRecord(cscope, records)
 
-	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, linodego.NewListOptions(0, string(filter)))
-	if err != nil {
-		return false, err
-	}
+	return target != "", err
+}
 
-	// If record exists, update it
-	if len(domainRecords) == 0 {
-		return false, fmt.Errorf("no txt record %s found", hostname)
+// staleDNSRegistryRecords returns the ownership records of the cluster other than its current one, which are replaced
+// by the current one once the cluster was moved or the ID of the management cluster changed.
+func staleDNSRegistryRecords(cscope *scope.ClusterScope, records []DNSOptions) []DNSOptions {
+	registry := getDNSRegistryRecord(cscope)
+	var stale []DNSOptions
+	for _, record := range records {
+		target := strings.Trim(record.Target, `"`)
+		if record.DNSRecordType != linodego.RecordTypeTXT || target == registry || !isDNSRegistryRecordOf(cscope, target) {
+			continue
+		}
+		record.Target = target
+		stale = append(stale, record)
 	}
 
-	return true, nil
+	return stale
 }
 
-func IsAkamaiDomainRecordOwner(ctx context.Context, cscope *scope.ClusterScope) (bool, error) {
-	linodeCluster := cscope.LinodeCluster
-	linodeClusterNetworkSpec := linodeCluster.Spec.Network
-	rootDomain := linodeClusterNetworkSpec.DNSRootDomain
-	akaDNSClient := cscope.AkamaiDomainsClient
-	fqdn := getSubDomain(cscope) + "." + rootDomain
-
-	recordBody, err := akaDNSClient.GetRecord(ctx, dns.GetRecordRequest{
-		Zone:       rootDomain,
-		Name:       fqdn,
-		RecordType: string(linodego.RecordTypeTXT),
-	})
-	if err != nil || recordBody == nil {
-		return false, fmt.Errorf("no txt record %s found", fqdn)
+// verifyDNSRecordOwner returns an ErrDNSOwnerConflict unless the records of the subdomain of the cluster are registered
+// to it. Unregistered records can be claimed if they hold no A or AAAA record, or if claimExisting is set and they hold
+// the TXT record of the cluster written before ownership records were introduced.
+func verifyDNSRecordOwner(cscope *scope.ClusterScope, records []DNSOptions, claimExisting bool) error {
+	isOwner, err := isDNSRegistryOwner(cscope, records)
+	if err != nil || isOwner {
+		return err
 	}
 
-	return true, nil
-}
-
-func getSubDomain(cscope *scope.ClusterScope) (subDomain string) {
-	if cscope.LinodeCluster.Spec.Network.DNSSubDomainOverride != "" {
-		subDomain = cscope.LinodeCluster.Spec.Network.DNSSubDomainOverride
-	} else {
-		uniqueID := ""
-		if cscope.LinodeCluster.Spec.Network.DNSUniqueIdentifier != "" {
-			uniqueID = "-" + cscope.LinodeCluster.Spec.Network.DNSUniqueIdentifier
+	hasAddresses, hasClusterRecord := false, false
+	for _, record := range records {
+		switch record.DNSRecordType {
+		case linodego.RecordTypeA, linodego.RecordTypeAAAA:
+			hasAddresses = true
+		case linodego.RecordTypeTXT:
+			hasClusterRecord = hasClusterRecord || strings.Trim(record.Target, `"`) == cscope.LinodeCluster.Name
 		}
-		subDomain = cscope.LinodeCluster.Name + uniqueID
 	}
-	return subDomain
+	if !hasAddresses || (claimExisting && hasClusterRecord) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s has records without an ownership record", ErrDNSOwnerConflict, getSubDomain(cscope))
 }
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/dns"
+	"github.com/linode/linodego/v2"
+
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+// akamaiDNSProvider manages the records of the cluster in Akamai Edge DNS.
+type akamaiDNSProvider struct{}
+
+func (akamaiDNSProvider) EnsureRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	return ensureAkamaiDNSEntries(ctx, cscope, "create", dnsEntries)
+}
+
+func (akamaiDNSProvider) DeleteRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	return ensureAkamaiDNSEntries(ctx, cscope, "delete", dnsEntries)
+}
+
+func (akamaiDNSProvider) ListRecords(ctx context.Context, cscope *scope.ClusterScope) ([]DNSOptions, error) {
+	rootDomain := cscope.LinodeCluster.Spec.Network.DNSRootDomain
+	fqdn := getSubDomain(cscope) + "." + rootDomain
+
+	var records []DNSOptions
+	for _, recordType := range []linodego.DomainRecordType{linodego.RecordTypeA, linodego.RecordTypeAAAA, linodego.RecordTypeTXT} {
+		record, err := cscope.AkamaiDomainsClient.GetRecord(ctx, dns.GetRecordRequest{
+			Zone:       rootDomain,
+			Name:       fqdn,
+			RecordType: string(recordType),
+		})
+		if err != nil {
+			if !strings.Contains(err.Error(), "Not Found") {
+				return nil, err
+			}
+			continue
+		}
+		if record == nil {
+			continue
+		}
+		for _, target := range record.Target {
+			records = append(records, DNSOptions{getSubDomain(cscope), target, recordType, record.TTL})
+		}
+	}
+
+	return records, nil
+}
+
+func ensureAkamaiDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation string, dnsEntries []DNSOptions) error {
+	if err := deleteStaleAkamaiEntries(ctx, cscope, dnsEntries); err != nil {
+		return err
+	}
+	for _, dnsEntry := range dnsEntries {
+		if err := EnsureAkamaiDNSEntries(ctx, cscope, operation, dnsEntry); err != nil {
+			return err
+		}
+	}
+
+	// Previous ownership records of the cluster are only deleted once the current one was written
+	records, err := akamaiDNSProvider{}.ListRecords(ctx, cscope)
+	if err != nil {
+		return err
+	}
+	for _, stale := range staleDNSRegistryRecords(cscope, records) {
+		if err := EnsureAkamaiDNSEntries(ctx, cscope, "delete", stale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resetAkamaiRecord(ctx context.Context, cscope *scope.ClusterScope, recordResponse *dns.GetRecordResponse, machineIPList []string, rootDomain string) error {
+	freshEntries := make([]string, 0)
+	for _, ip := range recordResponse.Target {
+		ip = strings.Replace(ip, ":0:0:", "::", 8) //nolint:mnd // 8 for 8 octet
+		if slices.Contains(machineIPList, ip) {
+			freshEntries = append(freshEntries, ip)
+		}
+	}
+	if len(freshEntries) == 0 {
+		return cscope.AkamaiDomainsClient.DeleteRecord(ctx, dns.DeleteRecordRequest{
+			Zone:       rootDomain,
+			Name:       recordResponse.Name,
+			RecordType: recordResponse.RecordType,
+		})
+	}
+
+	recordResponse.Target = freshEntries
+	return cscope.AkamaiDomainsClient.UpdateRecord(ctx, dns.UpdateRecordRequest{
+		Record: &dns.RecordBody{
+			Name:       recordResponse.Name,
+			RecordType: recordResponse.RecordType,
+			TTL:        recordResponse.TTL,
+			Active:     recordResponse.Active,
+			Target:     recordResponse.Target,
+		},
+		Zone: rootDomain,
+	})
+}
+
+func deleteStaleAkamaiEntries(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	// Stale records are only deleted once they are registered to the cluster
+	records, err := akamaiDNSProvider{}.ListRecords(ctx, cscope)
+	if err != nil {
+		return err
+	}
+	isOwner, err := isDNSRegistryOwner(cscope, records)
+	if err != nil || !isOwner {
+		return err
+	}
+	ipv4IPs, ipv6IPs := getDNSMachineIPs(dnsEntries)
+	rootDomain := cscope.LinodeCluster.Spec.Network.DNSRootDomain
+	fqdn := getSubDomain(cscope) + "." + rootDomain
+
+	// A record
+	aRecord, err := cscope.AkamaiDomainsClient.GetRecord(ctx, dns.GetRecordRequest{
+		Zone:       rootDomain,
+		Name:       fqdn,
+		RecordType: string(linodego.RecordTypeA)})
+	if err != nil {
+		if !strings.Contains(err.Error(), "Not Found") {
+			return err
+		}
+	}
+	if aRecord != nil {
+		if err := resetAkamaiRecord(ctx, cscope, aRecord, ipv4IPs, rootDomain); err != nil {
+			return err
+		}
+	}
+
+	// AAAA record
+	aaaaRecord, err := cscope.AkamaiDomainsClient.GetRecord(ctx, dns.GetRecordRequest{
+		Zone:       rootDomain,
+		Name:       fqdn,
+		RecordType: string(linodego.RecordTypeAAAA)})
+	if err != nil {
+		if !strings.Contains(err.Error(), "Not Found") {
+			return err
+		}
+	}
+	if aaaaRecord != nil {
+		if err := resetAkamaiRecord(ctx, cscope, aaaaRecord, ipv6IPs, rootDomain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EnsureAkamaiDNSEntries ensures the domainrecord on Akamai EDGE DNS is created, updated, or deleted based on operation passed
+func EnsureAkamaiDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation string, dnsEntry DNSOptions) error {
+	linodeCluster := cscope.LinodeCluster
+	linodeClusterNetworkSpec := linodeCluster.Spec.Network
+	rootDomain := linodeClusterNetworkSpec.DNSRootDomain
+	akaDNSClient := cscope.AkamaiDomainsClient
+	fqdn := getSubDomain(cscope) + "." + rootDomain
+
+	// Get the record for the root domain and fqdn
+	record, err := akaDNSClient.GetRecord(ctx, dns.GetRecordRequest{
+		Zone:       rootDomain,
+		Name:       fqdn,
+		RecordType: string(dnsEntry.DNSRecordType)})
+
+	if err != nil {
+		if !strings.Contains(err.Error(), "Not Found") {
+			return err
+		}
+		// Record was not found - if operation is not "create", nothing to do
+		if operation != "create" {
+			return nil
+		}
+		// Create record
+		return createAkamaiEntry(ctx, akaDNSClient, dnsEntry, fqdn, rootDomain)
+	}
+
+	if record == nil {
+		return fmt.Errorf("akamai dns returned empty dns record")
+	}
+	recordBody := &dns.RecordBody{
+		Name:       record.Name,
+		RecordType: record.RecordType,
+		TTL:        record.TTL,
+		Active:     record.Active,
+		Target:     record.Target,
+	}
+	// if operation is delete and we got the record, delete it
+	if operation == "delete" {
+		return deleteAkamaiEntry(ctx, cscope, recordBody, dnsEntry)
+	}
+	// if operation is create and we got the record, update it
+	// Check if the target already exists in the target list
+	for _, target := range recordBody.Target {
+		if recordBody.RecordType == "TXT" {
+			// Edge DNS returns the targets of TXT records quoted
+			if strings.Trim(target, `"`) == dnsEntry.Target {
+				return nil
+			}
+		} else {
+			if slices.Equal(net.ParseIP(target), net.ParseIP(dnsEntry.Target)) {
+				return nil
+			}
+		}
+	}
+	// Target doesn't exist so lets append it to the existing list and update it
+	recordBody.Target = append(recordBody.Target, dnsEntry.Target)
+	return akaDNSClient.UpdateRecord(ctx, dns.UpdateRecordRequest{
+		Record: recordBody,
+		Zone:   rootDomain,
+	})
+}
+
+func createAkamaiEntry(ctx context.Context, akamClient clients.AkamClient, dnsEntry DNSOptions, fqdn, rootDomain string) error {
+	return akamClient.CreateRecord(
+		ctx,
+		dns.CreateRecordRequest{
+			Record: &dns.RecordBody{
+				Name:       fqdn,
+				RecordType: string(dnsEntry.DNSRecordType),
+				TTL:        dnsEntry.DNSTTLSec,
+				Target:     []string{dnsEntry.Target},
+			},
+			Zone: rootDomain,
+		},
+	)
+}
+
+func deleteAkamaiEntry(ctx context.Context, cscope *scope.ClusterScope, recordBody *dns.RecordBody, dnsEntry DNSOptions) error {
+	linodeCluster := cscope.LinodeCluster
+	linodeClusterNetworkSpec := linodeCluster.Spec.Network
+	rootDomain := linodeClusterNetworkSpec.DNSRootDomain
+	// If record is A/AAAA type, verify ownership
+	if dnsEntry.DNSRecordType != linodego.RecordTypeTXT {
+		isOwner, err := IsAkamaiDomainRecordOwner(ctx, cscope)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return fmt.Errorf("the domain record is not owned by this entity. wont delete")
+		}
+	}
+	switch {
+	case len(recordBody.Target) > 1:
+		recordBody.Target = removeElement(
+			recordBody.Target,
+			// Linode DNS API formats the IPv6 IPs using :: for :0:0: while the address from the LinodeMachine status keeps it as is
+			// So we need to match that
+			strings.Replace(dnsEntry.Target, "::", ":0:0:", 8), //nolint:mnd // 8 for 8 octest
+		)
+		return cscope.AkamaiDomainsClient.UpdateRecord(ctx, dns.UpdateRecordRequest{
+			Record: recordBody,
+			Zone:   rootDomain,
+		})
+	default:
+		return cscope.AkamaiDomainsClient.DeleteRecord(ctx, dns.DeleteRecordRequest{
+			Zone:       rootDomain,
+			Name:       recordBody.Name,
+			RecordType: recordBody.RecordType,
+		})
+	}
+}
+
+func removeElement(stringList []string, elemToRemove string) []string {
+	for index, element := range stringList {
+		if strings.Trim(element, `"`) == elemToRemove {
+			stringList = slices.Delete(stringList, index, index+1)
+			continue
+		}
+	}
+	return stringList
+}
+
+// IsAkamaiDomainRecordOwner returns whether the records of the subdomain of the cluster are registered to it by its
+// ownership record.
+func IsAkamaiDomainRecordOwner(ctx context.Context, cscope *scope.ClusterScope) (bool, error) {
+	linodeCluster := cscope.LinodeCluster
+	linodeClusterNetworkSpec := linodeCluster.Spec.Network
+	rootDomain := linodeClusterNetworkSpec.DNSRootDomain
+	akaDNSClient := cscope.AkamaiDomainsClient
+	fqdn := getSubDomain(cscope) + "." + rootDomain
+
+	recordBody, err := akaDNSClient.GetRecord(ctx, dns.GetRecordRequest{
+		Zone:       rootDomain,
+		Name:       fqdn,
+		RecordType: string(linodego.RecordTypeTXT),
+	})
+	if err != nil || recordBody == nil {
+		return false, fmt.Errorf("no txt record %s found", fqdn)
+	}
+
+	records := make([]DNSOptions, 0, len(recordBody.Target))
+	for _, target := range recordBody.Target {
+		records = append(records, DNSOptions{getSubDomain(cscope), target, linodego.RecordTypeTXT, recordBody.TTL})
+	}
+
+	return isDNSRegistryOwner(cscope, records)
+}
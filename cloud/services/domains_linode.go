@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/linode/linodego/v2"
+
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+// linodeDNSProvider manages the records of the cluster in Linode Domains.
+type linodeDNSProvider struct{}
+
+func (linodeDNSProvider) EnsureRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	return EnsureLinodeDNSEntries(ctx, cscope, "create", dnsEntries)
+}
+
+func (linodeDNSProvider) DeleteRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	return EnsureLinodeDNSEntries(ctx, cscope, "delete", dnsEntries)
+}
+
+func (linodeDNSProvider) ListRecords(ctx context.Context, cscope *scope.ClusterScope) ([]DNSOptions, error) {
+	domainID, err := GetDomainID(ctx, cscope)
+	if err != nil {
+		return nil, err
+	}
+	domainRecords, err := listLinodeDomainRecords(ctx, cscope, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return linodeDNSOptions(domainRecords), nil
+}
+
+func linodeDNSOptions(domainRecords []linodego.DomainRecord) []DNSOptions {
+	records := make([]DNSOptions, 0, len(domainRecords))
+	for _, record := range domainRecords {
+		records = append(records, DNSOptions{record.Name, record.Target, record.Type, record.TTLSec})
+	}
+
+	return records
+}
+
+// listLinodeDomainRecords returns the domain records of the subdomain of the cluster.
+func listLinodeDomainRecords(ctx context.Context, cscope *scope.ClusterScope, domainID int) ([]linodego.DomainRecord, error) {
+	filter, err := json.Marshal(map[string]interface{}{"name": getSubDomain(cscope)})
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := linodego.NewListOptions(0, string(filter))
+	listOptions.PageSize = 500 // set a high page size to avoid multiple requests
+
+	return cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, listOptions)
+}
+
+func deleteStaleLinodeEntries(ctx context.Context, cscope *scope.ClusterScope, domainRecords []linodego.DomainRecord, domainID int, dnsEntries []DNSOptions) error {
+	// Stale records are only deleted once they are registered to the cluster
+	isOwner, err := isDNSRegistryOwner(cscope, linodeDNSOptions(domainRecords))
+	if err != nil || !isOwner {
+		return err
+	}
+	ipv4IPs, ipv6IPs := getDNSMachineIPs(dnsEntries)
+	if len(domainRecords) > 0 {
+		for _, record := range domainRecords {
+			if record.Type == linodego.RecordTypeTXT {
+				continue
+			}
+			if !slices.Contains(ipv4IPs, record.Target) && !slices.Contains(ipv6IPs, record.Target) {
+				if err := cscope.LinodeDomainsClient.DeleteDomainRecord(ctx, domainID, record.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// EnsureLinodeDNSEntries ensures the domainrecord on Linode Cloud Manager is created, updated, or deleted based on operation passed
+func EnsureLinodeDNSEntries(ctx context.Context, cscope *scope.ClusterScope, operation string, dnsEntries []DNSOptions) error {
+	// Get domainID from domain name
+	domainID, err := GetDomainID(ctx, cscope)
+	if err != nil {
+		return err
+	}
+
+	domainRecords, err := listLinodeDomainRecords(ctx, cscope, domainID)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteStaleLinodeEntries(ctx, cscope, domainRecords, domainID, dnsEntries); err != nil {
+		return err
+	}
+
+	for _, dnsEntry := range dnsEntries {
+		if operation == "delete" {
+			if err := DeleteDomainRecord(ctx, cscope, domainID, dnsEntry); err != nil {
+				return err
+			}
+		} else {
+			if err := CreateDomainRecord(ctx, cscope, domainID, dnsEntry); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Previous ownership records of the cluster are only deleted once the current one was written
+	for _, stale := range staleDNSRegistryRecords(cscope, linodeDNSOptions(domainRecords)) {
+		if err := DeleteDomainRecord(ctx, cscope, domainID, stale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDomainID gets the domains linode id
+func GetDomainID(ctx context.Context, cscope *scope.ClusterScope) (int, error) {
+	rootDomain := cscope.LinodeCluster.Spec.Network.DNSRootDomain
+	filter, err := json.Marshal(map[string]string{"domain": rootDomain})
+	if err != nil {
+		return 0, err
+	}
+	domains, err := cscope.LinodeDomainsClient.ListDomains(ctx, linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return 0, err
+	}
+	if len(domains) != 1 || domains[0].Domain != rootDomain {
+		return 0, fmt.Errorf("domain %s not found in list of domains owned by this account", rootDomain)
+	}
+
+	return domains[0].ID, nil
+}
+
+func CreateDomainRecord(ctx context.Context, cscope *scope.ClusterScope, domainID int, dnsEntry DNSOptions) error {
+	// Check if domain record exists for this IP and name combo
+	filter, err := json.Marshal(map[string]interface{}{"name": dnsEntry.Hostname, "target": dnsEntry.Target, "type": dnsEntry.DNSRecordType})
+	if err != nil {
+		return err
+	}
+
+	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return err
+	}
+
+	// If record doesnt exist, create it
+	if len(domainRecords) == 0 {
+		if _, err := cscope.LinodeDomainsClient.CreateDomainRecord(
+			ctx,
+			domainID,
+			linodego.DomainRecordCreateOptions{
+				Type:   dnsEntry.DNSRecordType,
+				Name:   dnsEntry.Hostname,
+				Target: dnsEntry.Target,
+				TTLSec: dnsEntry.DNSTTLSec,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func DeleteDomainRecord(ctx context.Context, cscope *scope.ClusterScope, domainID int, dnsEntry DNSOptions) error {
+	// Check if domain record exists for this IP and name combo
+	filter, err := json.Marshal(map[string]interface{}{"name": dnsEntry.Hostname, "target": dnsEntry.Target, "type": dnsEntry.DNSRecordType})
+	if err != nil {
+		return err
+	}
+
+	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return err
+	}
+
+	// Nothing to do if records dont exist
+	if len(domainRecords) == 0 {
+		return nil
+	}
+
+	// If record is A/AAAA type, verify ownership
+	if dnsEntry.DNSRecordType != linodego.RecordTypeTXT {
+		isOwner, err := IsLinodeDomainRecordOwner(ctx, cscope, dnsEntry.Hostname, domainID)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return fmt.Errorf("the domain record is not owned by this entity. wont delete")
+		}
+	}
+
+	// Delete record
+	return cscope.LinodeDomainsClient.DeleteDomainRecord(ctx, domainID, domainRecords[0].ID)
+}
+
+// IsLinodeDomainRecordOwner returns whether the records of the hostname are registered to the cluster by its ownership
+// record.
+func IsLinodeDomainRecordOwner(ctx context.Context, cscope *scope.ClusterScope, hostname string, domainID int) (bool, error) {
+	// Check if domain record exists
+	filter, err := json.Marshal(map[string]interface{}{"name": hostname, "type": linodego.RecordTypeTXT})
+	if err != nil {
+		return false, err
+	}
+
+	domainRecords, err := cscope.LinodeDomainsClient.ListDomainRecords(ctx, domainID, linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return false, err
+	}
+
+	// If record exists, update it
+	if len(domainRecords) == 0 {
+		return false, fmt.Errorf("no txt record %s found", hostname)
+	}
+
+	return isDNSRegistryOwner(cscope, linodeDNSOptions(domainRecords))
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	mdns "github.com/miekg/dns"
+
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+const (
+	rfc2136DefaultPort          = "53"
+	rfc2136DefaultTSIGAlgorithm = "hmac-sha256"
+	// rfc2136TSIGFudge is the clock skew in seconds allowed between CAPL and the nameserver when verifying signatures.
+	rfc2136TSIGFudge = 300
+)
+
+// rfc2136DNSProvider manages the records of the cluster on a nameserver accepting RFC 2136 dynamic updates, signed
+// with TSIG when a key is configured.
+type rfc2136DNSProvider struct{}
+
+func (p rfc2136DNSProvider) EnsureRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	records, err := p.ListRecords(ctx, cscope)
+	if err != nil {
+		return err
+	}
+	registry, err := getDNSRegistryOwnerRecord(cscope, records)
+	if err != nil {
+		return err
+	}
+
+	update := new(mdns.Msg)
+	update.SetUpdate(rfc2136Zone(cscope))
+	for _, dnsEntry := range dnsEntries {
+		if slices.ContainsFunc(records, func(record DNSOptions) bool { return sameDNSRecord(record, dnsEntry) }) {
+			continue
+		}
+		rr, err := rfc2136RR(cscope, dnsEntry)
+		if err != nil {
+			return err
+		}
+		update.Insert([]mdns.RR{rr})
+	}
+	// Stale records are only deleted once they are registered to the cluster, which the nameserver verifies
+	// atomically with the update. Previous ownership records of the cluster are replaced by the current one.
+	if registry != "" {
+		stale, err := staleRFC2136RRs(cscope, records, dnsEntries)
+		if err != nil {
+			return err
+		}
+		if len(stale) > 0 {
+			owner, err := rfc2136RegistryRR(cscope, registry)
+			if err != nil {
+				return err
+			}
+			update.Used([]mdns.RR{owner})
+			update.Remove(stale)
+		}
+	}
+
+	if len(update.Ns) == 0 {
+		return nil
+	}
+	_, err = exchangeRFC2136(ctx, cscope, update)
+
+	return err
+}
+
+func (p rfc2136DNSProvider) DeleteRecords(ctx context.Context, cscope *scope.ClusterScope, dnsEntries []DNSOptions) error {
+	records, err := p.ListRecords(ctx, cscope)
+	if err != nil {
+		return err
+	}
+
+	removed, err := staleRFC2136RRs(cscope, records, dnsEntries)
+	if err != nil {
+		return err
+	}
+	for _, dnsEntry := range dnsEntries {
+		if !slices.ContainsFunc(records, func(record DNSOptions) bool { return sameDNSRecord(record, dnsEntry) }) {
+			continue
+		}
+		rr, err := rfc2136RR(cscope, dnsEntry)
+		if err != nil {
+			return err
+		}
+		removed = append(removed, rr)
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	registry, err := getDNSRegistryOwnerRecord(cscope, records)
+	if err != nil {
+		return err
+	}
+	if registry == "" {
+		return errors.New("the domain record is not owned by this entity. wont delete")
+	}
+	owner, err := rfc2136RegistryRR(cscope, registry)
+	if err != nil {
+		return err
+	}
+
+	update := new(mdns.Msg)
+	update.SetUpdate(rfc2136Zone(cscope))
+	// The records are only deleted if the ownership record of the cluster exists, which the nameserver verifies
+	// atomically with the update
+	update.Used([]mdns.RR{owner})
+	update.Remove(removed)
+	_, err = exchangeRFC2136(ctx, cscope, update)
+
+	return err
+}
+
+func (rfc2136DNSProvider) ListRecords(ctx context.Context, cscope *scope.ClusterScope) ([]DNSOptions, error) {
+	fqdn := rfc2136FQDN(cscope)
+
+	var records []DNSOptions
+	for _, rrType := range []uint16{mdns.TypeA, mdns.TypeAAAA, mdns.TypeTXT} {
+		query := new(mdns.Msg)
+		query.SetQuestion(fqdn, rrType)
+		query.RecursionDesired = false
+		resp, err := exchangeRFC2136(ctx, cscope, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, answer := range resp.Answer {
+			if answer.Header().Rrtype != rrType || !strings.EqualFold(answer.Header().Name, fqdn) {
+				continue
+			}
+			ttl := int(answer.Header().Ttl)
+			switch rr := answer.(type) {
+			case *mdns.A:
+				records = append(records, DNSOptions{getSubDomain(cscope), rr.A.String(), linodego.RecordTypeA, ttl})
+			case *mdns.AAAA:
+				records = append(records, DNSOptions{getSubDomain(cscope), rr.AAAA.String(), linodego.RecordTypeAAAA, ttl})
+			case *mdns.TXT:
+				for _, txt := range rr.Txt {
+					records = append(records, DNSOptions{getSubDomain(cscope), txt, linodego.RecordTypeTXT, ttl})
+				}
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// staleRFC2136RRs returns the A and AAAA records which do not belong to the DNS entries, and the previous ownership
+// records of the cluster.
+func staleRFC2136RRs(cscope *scope.ClusterScope, records, dnsEntries []DNSOptions) ([]mdns.RR, error) {
+	var stale []mdns.RR
+	for _, record := range staleDNSRegistryRecords(cscope, records) {
+		rr, err := rfc2136RR(cscope, record)
+		if err != nil {
+			return nil, err
+		}
+		stale = append(stale, rr)
+	}
+	for _, record := range records {
+		if record.DNSRecordType == linodego.RecordTypeTXT {
+			continue
+		}
+		if slices.ContainsFunc(dnsEntries, func(dnsEntry DNSOptions) bool { return sameDNSRecord(record, dnsEntry) }) {
+			continue
+		}
+		rr, err := rfc2136RR(cscope, record)
+		if err != nil {
+			return nil, err
+		}
+		stale = append(stale, rr)
+	}
+
+	return stale, nil
+}
+
+// sameDNSRecord returns whether both DNS entries have the same type and target, comparing IPs by value.
+func sameDNSRecord(a, b DNSOptions) bool {
+	if a.DNSRecordType != b.DNSRecordType {
+		return false
+	}
+	if a.DNSRecordType == linodego.RecordTypeTXT {
+		return a.Target == b.Target
+	}
+	addrA, errA := netip.ParseAddr(a.Target)
+	addrB, errB := netip.ParseAddr(b.Target)
+
+	return errA == nil && errB == nil && addrA == addrB
+}
+
+func rfc2136RR(cscope *scope.ClusterScope, dnsEntry DNSOptions) (mdns.RR, error) {
+	header := mdns.RR_Header{
+		Name:  rfc2136FQDN(cscope),
+		Class: mdns.ClassINET,
+		Ttl:   uint32(dnsEntry.DNSTTLSec), //nolint:gosec // TTLs are validated to be positive
+	}
+	switch dnsEntry.DNSRecordType {
+	case linodego.RecordTypeA:
+		header.Rrtype = mdns.TypeA
+		return &mdns.A{Hdr: header, A: net.ParseIP(dnsEntry.Target)}, nil
+	case linodego.RecordTypeAAAA:
+		header.Rrtype = mdns.TypeAAAA
+		return &mdns.AAAA{Hdr: header, AAAA: net.ParseIP(dnsEntry.Target)}, nil
+	case linodego.RecordTypeTXT:
+		header.Rrtype = mdns.TypeTXT
+		return &mdns.TXT{Hdr: header, Txt: []string{dnsEntry.Target}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported DNS record type %s", dnsEntry.DNSRecordType)
+	}
+}
+
+// rfc2136RegistryRR returns the ownership record of the cluster with the target found on the nameserver.
+func rfc2136RegistryRR(cscope *scope.ClusterScope, target string) (mdns.RR, error) {
+	return rfc2136RR(cscope, DNSOptions{getSubDomain(cscope), target, linodego.RecordTypeTXT, 0})
+}
+
+// exchangeRFC2136 sends the message to the nameserver, signing it with the TSIG key if one is configured.
+func exchangeRFC2136(ctx context.Context, cscope *scope.ClusterScope, msg *mdns.Msg) (*mdns.Msg, error) {
+	config := cscope.LinodeCluster.Spec.Network.DNSRFC2136
+	if config == nil {
+		return nil, errors.New("dnsRFC2136 must be set to use the rfc2136 DNS provider")
+	}
+
+	// Updates are only logged and recorded in dry-run mode, and succeed as if the nameserver accepted them
+	if msg.Opcode == mdns.OpcodeUpdate && cscope.DNSDryRunInterceptor != nil {
+		cscope.DNSDryRunInterceptor(ctx, "RFC2136Update", map[string]interface{}{
+			"zone":          msg.Question[0].Name,
+			"prerequisites": rfc2136RRStrings(msg.Answer),
+			"updates":       rfc2136RRStrings(msg.Ns),
+		})
+		resp := new(mdns.Msg)
+		resp.SetReply(msg)
+
+		return resp, nil
+	}
+
+	// TCP is used as signed updates carrying the ownership record easily exceed the size of a UDP message
+	client := &mdns.Client{Net: "tcp"}
+	if config.TSIGKeyName != "" {
+		secret, err := cscope.GetDNSRFC2136TSIGSecret(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keyName := mdns.CanonicalName(config.TSIGKeyName)
+		client.TsigSecret = map[string]string{keyName: secret}
+		msg.SetTsig(keyName, mdns.Fqdn(cmp.Or(config.TSIGAlgorithm, rfc2136DefaultTSIGAlgorithm)), rfc2136TSIGFudge, time.Now().Unix())
+	}
+
+	resp, _, err := client.ExchangeContext(ctx, msg, rfc2136Nameserver(config.Nameserver))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach nameserver %s: %w", config.Nameserver, err)
+	}
+	// A name without records is not an error when querying them
+	if resp.Rcode == mdns.RcodeNameError && msg.Opcode == mdns.OpcodeQuery {
+		return resp, nil
+	}
+	if resp.Rcode != mdns.RcodeSuccess {
+		return nil, fmt.Errorf("nameserver %s answered %s", config.Nameserver, mdns.RcodeToString[resp.Rcode])
+	}
+
+	return resp, nil
+}
+
+// rfc2136RRStrings returns the records in zone file format, with the class telling whether they are added or removed.
+func rfc2136RRStrings(rrs []mdns.RR) []string {
+	records := make([]string, 0, len(rrs))
+	for _, rr := range rrs {
+		records = append(records, rr.String())
+	}
+
+	return records
+}
+
+func rfc2136Nameserver(nameserver string) string {
+	if _, _, err := net.SplitHostPort(nameserver); err != nil {
+		return net.JoinHostPort(nameserver, rfc2136DefaultPort)
+	}
+
+	return nameserver
+}
+
+func rfc2136Zone(cscope *scope.ClusterScope) string {
+	return mdns.Fqdn(cscope.LinodeCluster.Spec.Network.DNSRootDomain)
+}
+
+func rfc2136FQDN(cscope *scope.ClusterScope) string {
+	return mdns.Fqdn(getSubDomain(cscope) + "." + cscope.LinodeCluster.Spec.Network.DNSRootDomain)
+}
@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	mdns "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+const (
+	testTSIGKeyName = "capl."
+	testFQDN        = "test-cluster-test-hash.example.com."
+)
+
+var testTSIGSecret = base64.StdEncoding.EncodeToString([]byte("capl-test-tsig-secret"))
+
+// testNameserver is an authoritative nameserver applying RFC 2136 dynamic updates to an in-memory zone. It only
+// accepts messages signed with the test TSIG key.
+type testNameserver struct {
+	mu      sync.Mutex
+	records []mdns.RR
+	address string
+}
+
+func startTestNameserver(t *testing.T, records ...mdns.RR) *testNameserver {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	nameserver := &testNameserver{records: records, address: listener.Addr().String()}
+
+	started := make(chan struct{})
+	server := &mdns.Server{
+		Listener:          listener,
+		Handler:           nameserver,
+		TsigSecret:        map[string]string{testTSIGKeyName: testTSIGSecret},
+		MsgAcceptFunc:     func(mdns.Header) mdns.MsgAcceptAction { return mdns.MsgAccept },
+		NotifyStartedFunc: func() { close(started) },
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	<-started
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	return nameserver
+}
+
+func (n *testNameserver) ServeDNS(w mdns.ResponseWriter, req *mdns.Msg) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	resp := new(mdns.Msg)
+	resp.SetReply(req)
+	switch {
+	case req.IsTsig() == nil || w.TsigStatus() != nil:
+		resp.Rcode = mdns.RcodeNotAuth
+	case req.Opcode == mdns.OpcodeQuery:
+		for _, rr := range n.records {
+			if rr.Header().Name == req.Question[0].Name && rr.Header().Rrtype == req.Question[0].Qtype {
+				resp.Answer = append(resp.Answer, rr)
+			}
+		}
+	case req.Opcode == mdns.OpcodeUpdate:
+		resp.Rcode = n.update(req)
+	}
+	if req.IsTsig() != nil {
+		resp.SetTsig(testTSIGKeyName, mdns.HmacSHA256, rfc2136TSIGFudge, time.Now().Unix())
+	}
+	_ = w.WriteMsg(resp)
+}
+
+func (n *testNameserver) update(req *mdns.Msg) int {
+	for _, prereq := range req.Answer {
+		if !slices.ContainsFunc(n.records, func(rr mdns.RR) bool { return sameRR(rr, prereq) }) {
+			return mdns.RcodeNXRrset
+		}
+	}
+	for _, rr := range req.Ns {
+		switch rr.Header().Class {
+		case mdns.ClassNONE:
+			n.records = slices.DeleteFunc(n.records, func(record mdns.RR) bool { return sameRR(record, rr) })
+		case mdns.ClassINET:
+			if !slices.ContainsFunc(n.records, func(record mdns.RR) bool { return sameRR(record, rr) }) {
+				n.records = append(n.records, rr)
+			}
+		}
+	}
+
+	return mdns.RcodeSuccess
+}
+
+func (n *testNameserver) Records() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	records := make([]string, 0, len(n.records))
+	for _, rr := range n.records {
+		record := mdns.Copy(rr)
+		record.Header().Ttl = 0
+		records = append(records, record.String())
+	}
+	slices.Sort(records)
+
+	return records
+}
+
+// sameRR returns whether both records have the same name, type and data, regardless of their class and TTL.
+func sameRR(a, b mdns.RR) bool {
+	a, b = mdns.Copy(a), mdns.Copy(b)
+	a.Header().Class, b.Header().Class = mdns.ClassINET, mdns.ClassINET
+
+	return mdns.IsDuplicate(a, b)
+}
+
+func testRR(t *testing.T, record string) mdns.RR {
+	t.Helper()
+
+	rr, err := mdns.NewRR(record)
+	require.NoError(t, err)
+
+	return rr
+}
+
+func TestRFC2136DNSEntries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		operation     string
+		records       []string
+		tsigKeyName   string
+		dryRun        bool
+		wantRecords   []string
+		wantDryRun    []string
+		expectedError string
+	}{
+		{
+			name:        "records created",
+			operation:   "create",
+			tsigKeyName: "capl",
+			wantRecords: []string{
+				testFQDN + "\t0\tIN\tA\t10.10.10.10",
+				testFQDN + "\t0\tIN\tAAAA\tfd00::1",
+				testFQDN + "\t0\tIN\tTXT\t\"" + testDNSRegistryRecord + "\"",
+				testFQDN + "\t0\tIN\tTXT\t\"test-cluster\"",
+			},
+		},
+		{
+			name:        "stale records removed",
+			operation:   "create",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.20.20.20",
+				testFQDN + " 30 IN A 10.10.10.10",
+				testFQDN + " 30 IN TXT \"test-cluster\"",
+				testFQDN + " 30 IN TXT \"" + testDNSRegistryRecord + "\"",
+				"other.example.com. 30 IN A 10.20.20.20",
+			},
+			wantRecords: []string{
+				"other.example.com.\t0\tIN\tA\t10.20.20.20",
+				testFQDN + "\t0\tIN\tA\t10.10.10.10",
+				testFQDN + "\t0\tIN\tAAAA\tfd00::1",
+				testFQDN + "\t0\tIN\tTXT\t\"" + testDNSRegistryRecord + "\"",
+				testFQDN + "\t0\tIN\tTXT\t\"test-cluster\"",
+			},
+		},
+		{
+			name:        "records of the cluster claimed without removing stale records",
+			operation:   "create",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.20.20.20",
+				testFQDN + " 30 IN TXT \"test-cluster\"",
+			},
+			wantRecords: []string{
+				testFQDN + "\t0\tIN\tA\t10.10.10.10",
+				testFQDN + "\t0\tIN\tA\t10.20.20.20",
+				testFQDN + "\t0\tIN\tAAAA\tfd00::1",
+				testFQDN + "\t0\tIN\tTXT\t\"" + testDNSRegistryRecord + "\"",
+				testFQDN + "\t0\tIN\tTXT\t\"test-cluster\"",
+			},
+		},
+		{
+			name:        "ownership record of the cluster replaced after its move",
+			operation:   "create",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.10.10.10",
+				testFQDN + " 30 IN AAAA fd00::1",
+				testFQDN + " 30 IN TXT \"test-cluster\"",
+				testFQDN + " 30 IN TXT \"" + testMovedDNSRegistryRecord + "\"",
+			},
+			wantRecords: []string{
+				testFQDN + "\t0\tIN\tA\t10.10.10.10",
+				testFQDN + "\t0\tIN\tAAAA\tfd00::1",
+				testFQDN + "\t0\tIN\tTXT\t\"" + testDNSRegistryRecord + "\"",
+				testFQDN + "\t0\tIN\tTXT\t\"test-cluster\"",
+			},
+		},
+		{
+			name:        "records of the cluster deleted after its move",
+			operation:   "delete",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.10.10.10",
+				testFQDN + " 30 IN TXT \"test-cluster\"",
+				testFQDN + " 30 IN TXT \"" + testMovedDNSRegistryRecord + "\"",
+			},
+			wantRecords: []string{},
+		},
+		{
+			name:        "records of another owner not touched",
+			operation:   "create",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.20.20.20",
+				testFQDN + " 30 IN TXT \"heritage=external-dns,external-dns/owner=default\"",
+			},
+			wantRecords: []string{
+				testFQDN + "\t0\tIN\tA\t10.20.20.20",
+				testFQDN + "\t0\tIN\tTXT\t\"heritage=external-dns,external-dns/owner=default\"",
+			},
+			expectedError: "registered to heritage=external-dns,external-dns/owner=default",
+		},
+		{
+			name:        "records deleted",
+			operation:   "delete",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.10.10.10",
+				testFQDN + " 30 IN AAAA fd00:0:0::1",
+				testFQDN + " 30 IN TXT \"test-cluster\"",
+				testFQDN + " 30 IN TXT \"" + testDNSRegistryRecord + "\"",
+			},
+			wantRecords: []string{},
+		},
+		{
+			name:        "records without ownership record not deleted",
+			operation:   "delete",
+			tsigKeyName: "capl",
+			records: []string{
+				testFQDN + " 30 IN A 10.10.10.10",
+				testFQDN + " 30 IN TXT \"test-cluster\"",
+			},
+			wantRecords: []string{
+				testFQDN + "\t0\tIN\tA\t10.10.10.10",
+				testFQDN + "\t0\tIN\tTXT\t\"test-cluster\"",
+			},
+			expectedError: "without an ownership record",
+		},
+		{
+			name:        "updates intercepted in dry-run mode",
+			operation:   "create",
+			tsigKeyName: "capl",
+			dryRun:      true,
+			wantRecords: []string{},
+			wantDryRun:  []string{"RFC2136Update"},
+		},
+		{
+			name:          "unsigned updates are refused",
+			operation:     "create",
+			wantRecords:   []string{},
+			expectedError: "NOTAUTH",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			records := make([]mdns.RR, 0, len(testcase.records))
+			for _, record := range testcase.records {
+				records = append(records, testRR(t, record))
+			}
+			nameserver := startTestNameserver(t, records...)
+
+			ctrl := gomock.NewController(t)
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			mockK8sClient.EXPECT().Scheme().Return(nil).AnyTimes()
+			mockK8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					switch obj := obj.(type) {
+					case *clusterv1.Machine:
+						obj.Name = key.Name
+						obj.Namespace = key.Namespace
+						obj.UID = "test-uid"
+						obj.Status.Conditions = []metav1.Condition{{Type: clusterv1.ReadyCondition, Status: metav1.ConditionTrue}}
+					case *corev1.Secret:
+						obj.Data = map[string][]byte{"tsigSecret": []byte(testTSIGSecret)}
+					}
+					return nil
+				}).AnyTimes()
+
+			var intercepted []string
+			clusterScope := &scope.ClusterScope{
+				Client:              mockK8sClient,
+				ManagementClusterID: "test-management-cluster",
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default", UID: "test-uid"},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType:    "dns",
+							DNSProvider:         "rfc2136",
+							DNSRootDomain:       "example.com",
+							DNSUniqueIdentifier: "test-hash",
+							DNSRFC2136: &infrav1alpha2.DNSRFC2136Config{
+								Nameserver:    nameserver.address,
+								TSIGKeyName:   testcase.tsigKeyName,
+								TSIGSecretRef: &corev1.SecretReference{Name: "tsig"},
+							},
+						},
+					},
+				},
+				LinodeMachines: infrav1alpha2.LinodeMachineList{
+					Items: []infrav1alpha2.LinodeMachine{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-machine",
+							Namespace: "default",
+							OwnerReferences: []metav1.OwnerReference{{
+								APIVersion: clusterv1.GroupVersion.String(),
+								Kind:       "Machine",
+								Name:       "test-machine",
+								UID:        "test-uid",
+							}},
+						},
+						Status: infrav1alpha2.LinodeMachineStatus{
+							Addresses: []clusterv1.MachineAddress{
+								{Type: clusterv1.MachineExternalIP, Address: "10.10.10.10"},
+								{Type: clusterv1.MachineExternalIP, Address: "fd00::1"},
+							},
+						},
+					}},
+				},
+			}
+
+			if testcase.dryRun {
+				clusterScope.DNSDryRunInterceptor = func(_ context.Context, method string, _ map[string]interface{}) {
+					intercepted = append(intercepted, method)
+				}
+			}
+
+			err := EnsureDNSEntries(t.Context(), clusterScope, testcase.operation)
+			if testcase.expectedError != "" {
+				assert.ErrorContains(t, err, testcase.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testcase.wantRecords, nameserver.Records())
+			assert.Equal(t, testcase.wantDryRun, intercepted)
+		})
+	}
+}
+
+func TestRFC2136Nameserver(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "ns1.example.com:53", rfc2136Nameserver("ns1.example.com"))
+	assert.Equal(t, "ns1.example.com:5353", rfc2136Nameserver("ns1.example.com:5353"))
+	assert.Equal(t, "[2001:db8::53]:53", rfc2136Nameserver("2001:db8::53"))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	kcpv1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
@@ -24,6 +25,15 @@ import (
 	"github.com/linode/cluster-api-provider-linode/util"
 )
 
+// testDNSRegistryRecord is the ownership record of the default/test-cluster LinodeCluster in the test-management-cluster.
+const testDNSRegistryRecord = "heritage=cluster-api-provider-linode,cluster-api-provider-linode/owner=test-management-cluster," +
+	"cluster-api-provider-linode/resource=linodecluster/default/test-cluster,cluster-api-provider-linode/uid=test-uid"
+
+// testMovedDNSRegistryRecord is the ownership record of the default/test-cluster LinodeCluster before it was moved by
+// clusterctl, which changed its UID.
+const testMovedDNSRegistryRecord = "heritage=cluster-api-provider-linode,cluster-api-provider-linode/owner=test-management-cluster," +
+	"cluster-api-provider-linode/resource=linodecluster/default/test-cluster,cluster-api-provider-linode/uid=source-uid"
+
 var unhealthyStatus = &clusterv1.MachineStatus{
 	Conditions: []metav1.Condition{
 		{
@@ -67,14 +77,16 @@ func TestAddIPToEdgeDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -134,14 +146,16 @@ func TestAddIPToEdgeDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -252,14 +266,16 @@ func TestAddIPToEdgeDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -365,14 +381,17 @@ func TestRemoveIPFromEdgeDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
+				ManagementClusterID: "test-management-cluster",
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -420,12 +439,23 @@ func TestRemoveIPFromEdgeDNS(t *testing.T) {
 			},
 			listOfIPS: []string{"10.10.10.10", "10.10.10.11", "10.10.10.12"},
 			expects: func(mockClient *mock.MockAkamClient) {
-				mockClient.EXPECT().GetRecord(gomock.Any(), gomock.Any()).Return(&dns.GetRecordResponse{
-					Name:       "test-machine",
-					RecordType: "A",
-					TTL:        30,
-					Target:     []string{"10.10.10.10"},
-				}, nil).AnyTimes()
+				mockClient.EXPECT().GetRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, params dns.GetRecordRequest) (*dns.GetRecordResponse, error) {
+						if params.RecordType == "TXT" {
+							return &dns.GetRecordResponse{
+								Name:       "test-machine",
+								RecordType: "TXT",
+								TTL:        30,
+								Target:     []string{`"test-cluster"`, `"` + testDNSRegistryRecord + `"`},
+							}, nil
+						}
+						return &dns.GetRecordResponse{
+							Name:       "test-machine",
+							RecordType: "A",
+							TTL:        30,
+							Target:     []string{"10.10.10.10"},
+						}, nil
+					}).AnyTimes()
 				mockClient.EXPECT().UpdateRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				mockClient.EXPECT().DeleteRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			},
@@ -440,14 +470,16 @@ func TestRemoveIPFromEdgeDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -544,14 +576,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -738,14 +772,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -815,14 +851,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -893,14 +931,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -965,14 +1005,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1044,14 +1086,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1115,14 +1159,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1185,14 +1231,16 @@ func TestAddIPToDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1286,14 +1334,17 @@ func TestEnsureLinodeDNSDeletesRecordForDeletingCAPIMachine(t *testing.T) {
 	clusterScope := &scope.ClusterScope{
 		Cluster: &clusterv1.Cluster{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "test-cluster",
-				UID:  "test-uid",
+				Name:      "test-cluster",
+				Namespace: "default",
+				UID:       "test-uid",
 			},
 		},
+		ManagementClusterID: "test-management-cluster",
 		LinodeCluster: &infrav1alpha2.LinodeCluster{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "test-cluster",
-				UID:  "test-uid",
+				Name:      "test-cluster",
+				Namespace: "default",
+				UID:       "test-uid",
 			},
 			Spec: infrav1alpha2.LinodeClusterSpec{
 				Network: infrav1alpha2.NetworkSpec{
@@ -1351,7 +1402,7 @@ func TestEnsureLinodeDNSDeletesRecordForDeletingCAPIMachine(t *testing.T) {
 	mockDNSClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return([]linodego.Domain{{
 		ID:     1,
 		Domain: "lkedevs.net",
-	}}, nil)
+	}}, nil).Times(2)
 	mockDNSClient.EXPECT().ListDomainRecords(gomock.Any(), 1, gomock.Any()).DoAndReturn(
 		func(_ context.Context, _ int, opts *linodego.ListOptions) ([]linodego.DomainRecord, error) {
 			if strings.Contains(opts.Filter, `"name":"test-cluster-test-hash"`) && !strings.Contains(opts.Filter, `"target"`) {
@@ -1374,6 +1425,12 @@ func TestEnsureLinodeDNSDeletesRecordForDeletingCAPIMachine(t *testing.T) {
 						Name:   "test-cluster-test-hash",
 						Target: "test-cluster",
 					},
+					{
+						ID:     103,
+						Type:   linodego.RecordTypeTXT,
+						Name:   "test-cluster-test-hash",
+						Target: testDNSRegistryRecord,
+					},
 				}, nil
 			}
 			return []linodego.DomainRecord{{ID: 101}}, nil
@@ -1410,6 +1467,100 @@ func TestEnsureLinodeDNSDeletesRecordForDeletingCAPIMachine(t *testing.T) {
 	require.NoError(t, EnsureDNSEntries(t.Context(), clusterScope, "create"))
 }
 
+func TestEnsureLinodeDNSReplacesOwnershipRecordAfterMove(t *testing.T) {
+	t.Parallel()
+
+	clusterScope := &scope.ClusterScope{
+		ManagementClusterID: "test-management-cluster",
+		LinodeCluster: &infrav1alpha2.LinodeCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-cluster",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+			Spec: infrav1alpha2.LinodeClusterSpec{
+				Network: infrav1alpha2.NetworkSpec{
+					LoadBalancerType:    "dns",
+					DNSRootDomain:       "lkedevs.net",
+					DNSUniqueIdentifier: "test-hash",
+				},
+			},
+		},
+		LinodeMachines: infrav1alpha2.LinodeMachineList{
+			Items: []infrav1alpha2.LinodeMachine{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "active-machine",
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: "cluster.x-k8s.io/v1beta1",
+						Kind:       "Machine",
+						Name:       "active-machine",
+						UID:        "active-machine-uid",
+					}},
+				},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Addresses: []clusterv1.MachineAddress{{
+						Type:    clusterv1.MachineExternalIP,
+						Address: "10.20.20.20",
+					}},
+				},
+			}},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDNSClient := mock.NewMockLinodeClient(ctrl)
+	clusterScope.LinodeDomainsClient = mockDNSClient
+	mockDNSClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return([]linodego.Domain{{
+		ID:     1,
+		Domain: "lkedevs.net",
+	}}, nil).AnyTimes()
+	mockDNSClient.EXPECT().ListDomainRecords(gomock.Any(), 1, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, opts *linodego.ListOptions) ([]linodego.DomainRecord, error) {
+			switch {
+			case !strings.Contains(opts.Filter, `"target"`):
+				return []linodego.DomainRecord{
+					{ID: 101, Type: linodego.RecordTypeA, Name: "test-cluster-test-hash", Target: "10.20.20.20"},
+					{ID: 102, Type: linodego.RecordTypeTXT, Name: "test-cluster-test-hash", Target: "test-cluster"},
+					{ID: 103, Type: linodego.RecordTypeTXT, Name: "test-cluster-test-hash", Target: testMovedDNSRegistryRecord},
+				}, nil
+			case strings.Contains(opts.Filter, testDNSRegistryRecord):
+				return nil, nil
+			case strings.Contains(opts.Filter, testMovedDNSRegistryRecord):
+				return []linodego.DomainRecord{{ID: 103}}, nil
+			default:
+				return []linodego.DomainRecord{{ID: 101}}, nil
+			}
+		}).AnyTimes()
+	// The current ownership record is written before the one written before the move is deleted
+	gomock.InOrder(
+		mockDNSClient.EXPECT().CreateDomainRecord(gomock.Any(), 1, linodego.DomainRecordCreateOptions{
+			Type:   linodego.RecordTypeTXT,
+			Name:   "test-cluster-test-hash",
+			Target: testDNSRegistryRecord,
+			TTLSec: 30,
+		}).Return(&linodego.DomainRecord{}, nil),
+		mockDNSClient.EXPECT().DeleteDomainRecord(gomock.Any(), 1, 103).Return(nil),
+	)
+
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	clusterScope.Client = mockK8sClient
+	mockK8sClient.EXPECT().Scheme().Return(nil).AnyTimes()
+	mockK8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			if machine, ok := obj.(*clusterv1.Machine); ok {
+				machine.Name = key.Name
+				machine.Namespace = key.Namespace
+				machine.UID = "active-machine-uid"
+				machine.Status.Conditions = []metav1.Condition{{Type: clusterv1.ReadyCondition, Status: metav1.ConditionTrue}}
+			}
+			return nil
+		}).AnyTimes()
+
+	require.NoError(t, EnsureDNSEntries(t.Context(), clusterScope, "create"))
+}
+
 func TestDeleteIPFromDNS(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1424,14 +1575,17 @@ func TestDeleteIPFromDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
+				ManagementClusterID: "test-management-cluster",
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1490,6 +1644,13 @@ func TestDeleteIPFromDNS(t *testing.T) {
 						Name:   "test-cluster",
 						TTLSec: 30,
 					},
+					{
+						ID:     1235,
+						Type:   "TXT",
+						Name:   "test-cluster",
+						Target: testDNSRegistryRecord,
+						TTLSec: 30,
+					},
 				}, nil).AnyTimes()
 				mockClient.EXPECT().DeleteDomainRecord(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			},
@@ -1503,14 +1664,17 @@ func TestDeleteIPFromDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
+				ManagementClusterID: "test-management-cluster",
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1569,6 +1733,13 @@ func TestDeleteIPFromDNS(t *testing.T) {
 						Name:   "test-cluster",
 						TTLSec: 30,
 					},
+					{
+						ID:     1235,
+						Type:   "TXT",
+						Name:   "test-cluster",
+						Target: testDNSRegistryRecord,
+						TTLSec: 30,
+					},
 				}, nil).AnyTimes()
 				mockClient.EXPECT().DeleteDomainRecord(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to delete record")).AnyTimes()
 			},
@@ -1582,14 +1753,16 @@ func TestDeleteIPFromDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1627,14 +1800,16 @@ func TestDeleteIPFromDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1692,14 +1867,16 @@ func TestDeleteIPFromDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1762,14 +1939,16 @@ func TestDeleteIPFromDNS(t *testing.T) {
 			clusterScope: &scope.ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-cluster",
-						UID:  "test-uid",
+						Name:      "test-cluster",
+						Namespace: "default",
+						UID:       "test-uid",
 					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
 						Network: infrav1alpha2.NetworkSpec{
@@ -1879,3 +2058,159 @@ func mockCAPIMachine(mockK8sClient *mock.MockK8sClient, ownerRefs []metav1.Owner
 			return nil
 		}).AnyTimes()
 }
+
+func TestVerifyDNSRecordOwner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		records       []DNSOptions
+		claimExisting bool
+		expectedError string
+	}{
+		{
+			name: "registered to the cluster",
+			records: []DNSOptions{
+				{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+				{"test-cluster", `"` + testDNSRegistryRecord + `"`, linodego.RecordTypeTXT, 30},
+			},
+		},
+		{
+			name: "no records",
+		},
+		{
+			name: "records of the cluster created before ownership records",
+			records: []DNSOptions{
+				{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+				{"test-cluster", "test-cluster", linodego.RecordTypeTXT, 30},
+			},
+			claimExisting: true,
+		},
+		{
+			name: "records of the cluster created before ownership records not claimed",
+			records: []DNSOptions{
+				{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+				{"test-cluster", "test-cluster", linodego.RecordTypeTXT, 30},
+			},
+			expectedError: "without an ownership record",
+		},
+		{
+			name: "registered to the cluster before it was moved",
+			records: []DNSOptions{
+				{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+				{"test-cluster", `"` + testMovedDNSRegistryRecord + `"`, linodego.RecordTypeTXT, 30},
+			},
+		},
+		{
+			name: "registered to the cluster by a previous management cluster ID",
+			records: []DNSOptions{
+				{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+				{"test-cluster", "heritage=cluster-api-provider-linode,cluster-api-provider-linode/owner=previous," +
+					"cluster-api-provider-linode/resource=linodecluster/default/test-cluster,cluster-api-provider-linode/uid=test-uid", linodego.RecordTypeTXT, 30},
+			},
+		},
+		{
+			name: "registered to a cluster with the same UID in another namespace",
+			records: []DNSOptions{
+				{"test-cluster", "heritage=cluster-api-provider-linode,cluster-api-provider-linode/owner=test-management-cluster," +
+					"cluster-api-provider-linode/resource=linodecluster/other/test-cluster,cluster-api-provider-linode/uid=test-uid", linodego.RecordTypeTXT, 30},
+			},
+			expectedError: "resource=linodecluster/other/test-cluster",
+		},
+		{
+			name: "registered to another management cluster",
+			records: []DNSOptions{
+				{"test-cluster", "heritage=cluster-api-provider-linode,cluster-api-provider-linode/owner=other," +
+					"cluster-api-provider-linode/resource=linodecluster/default/test-cluster,cluster-api-provider-linode/uid=other-uid", linodego.RecordTypeTXT, 30},
+			},
+			claimExisting: true,
+			expectedError: "registered to heritage=cluster-api-provider-linode,cluster-api-provider-linode/owner=other",
+		},
+		{
+			name: "registered to external-dns",
+			records: []DNSOptions{
+				{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+				{"test-cluster", "heritage=external-dns,external-dns/owner=default", linodego.RecordTypeTXT, 30},
+			},
+			claimExisting: true,
+			expectedError: "registered to heritage=external-dns",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			clusterScope := &scope.ClusterScope{
+				ManagementClusterID: "test-management-cluster",
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default", UID: "test-uid"},
+				},
+			}
+			err := verifyDNSRecordOwner(clusterScope, testcase.records, testcase.claimExisting)
+			if testcase.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrDNSOwnerConflict)
+				assert.ErrorContains(t, err, testcase.expectedError)
+			}
+		})
+	}
+}
+
+func TestStaleDNSRegistryRecords(t *testing.T) {
+	t.Parallel()
+
+	clusterScope := &scope.ClusterScope{
+		ManagementClusterID: "test-management-cluster",
+		LinodeCluster: &infrav1alpha2.LinodeCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default", UID: "test-uid"},
+		},
+	}
+	stale := staleDNSRegistryRecords(clusterScope, []DNSOptions{
+		{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+		{"test-cluster", "test-cluster", linodego.RecordTypeTXT, 30},
+		{"test-cluster", `"` + testDNSRegistryRecord + `"`, linodego.RecordTypeTXT, 30},
+		{"test-cluster", `"` + testMovedDNSRegistryRecord + `"`, linodego.RecordTypeTXT, 30},
+		{"test-cluster", "heritage=external-dns,external-dns/owner=default", linodego.RecordTypeTXT, 30},
+	})
+	assert.Equal(t, []DNSOptions{{"test-cluster", testMovedDNSRegistryRecord, linodego.RecordTypeTXT, 30}}, stale)
+}
+
+func TestVerifyDNSRecordOwnerAfterMove(t *testing.T) {
+	t.Parallel()
+
+	newClusterScope := func(uid types.UID, managementClusterID string) *scope.ClusterScope {
+		return &scope.ClusterScope{
+			ManagementClusterID: managementClusterID,
+			LinodeCluster: &infrav1alpha2.LinodeCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default", UID: uid},
+			},
+		}
+	}
+	// The records written by the management cluster the cluster is moved from
+	records := []DNSOptions{
+		{"test-cluster", "10.10.10.10", linodego.RecordTypeA, 30},
+		{"test-cluster", "test-cluster", linodego.RecordTypeTXT, 30},
+		{"test-cluster", getDNSRegistryRecord(newClusterScope("source-uid", "test-management-cluster")), linodego.RecordTypeTXT, 30},
+	}
+
+	t.Run("management cluster ID is kept", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, verifyDNSRecordOwner(newClusterScope("target-uid", "test-management-cluster"), records, true))
+	})
+
+	t.Run("management cluster ID changes", func(t *testing.T) {
+		t.Parallel()
+
+		err := verifyDNSRecordOwner(newClusterScope("target-uid", "other-management-cluster"), records, true)
+		require.ErrorIs(t, err, ErrDNSOwnerConflict)
+	})
+
+	t.Run("records taken over once the ownership record is removed", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, verifyDNSRecordOwner(newClusterScope("target-uid", "other-management-cluster"), records[:2], true))
+	})
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+// ImageSourceURL returns the URL the raw disk image of a LinodeImage is downloaded from.
+// For objects in a LinodeObjectStorageBucket a presigned URL is generated with the access key of the bucket.
+func ImageSourceURL(ctx context.Context, imageScope *scope.ImageScope) (string, error) {
+	source := imageScope.LinodeImage.Spec.Source
+	if source.URL != "" {
+		return source.URL, nil
+	}
+	if source.ObjectStorage == nil {
+		return "", errors.New("image source has neither url nor objectStorage set")
+	}
+
+	namespace := source.ObjectStorage.BucketRef.Namespace
+	if namespace == "" {
+		namespace = imageScope.LinodeImage.Namespace
+	}
+	bucket := &infrav1alpha2.LinodeObjectStorageBucket{}
+	if err := imageScope.Client.Get(ctx, types.NamespacedName{Name: source.ObjectStorage.BucketRef.Name, Namespace: namespace}, bucket); err != nil {
+		return "", fmt.Errorf("failed to get bucket: %w", err)
+	}
+	if !bucket.Status.Ready || bucket.Status.Hostname == nil {
+		return "", fmt.Errorf("bucket %s/%s is not ready", namespace, bucket.Name)
+	}
+	if bucket.Spec.AccessKeyRef == nil {
+		return "", fmt.Errorf("bucket %s/%s has no accessKeyRef", namespace, bucket.Name)
+	}
+
+	keyNamespace := bucket.Spec.AccessKeyRef.Namespace
+	if keyNamespace == "" {
+		keyNamespace = bucket.Namespace
+	}
+	keySecret := &corev1.Secret{}
+	if err := imageScope.Client.Get(ctx, types.NamespacedName{Name: bucket.Spec.AccessKeyRef.Name + "-obj-key", Namespace: keyNamespace}, keySecret); err != nil {
+		return "", fmt.Errorf("failed to get bucket access key secret: %w", err)
+	}
+
+	// The generated access key secret does not contain the endpoint by default, so derive it from the bucket hostname
+	// which has the form {bucket}.{endpoint}.
+	credentials := keySecret.DeepCopy()
+	if len(credentials.Data["endpoint"]) == 0 {
+		if credentials.Data == nil {
+			credentials.Data = map[string][]byte{}
+		}
+		credentials.Data["endpoint"] = []byte("https://" + strings.TrimPrefix(*bucket.Status.Hostname, bucket.Name+"."))
+	}
+
+	_, presignClient, err := imageScope.S3Clients(ctx, credentials)
+	if err != nil {
+		return "", fmt.Errorf("create clients: %w", err)
+	}
+	if presignClient == nil {
+		return "", errors.New("create clients: S3 client builder returned nil presign client")
+	}
+
+	req, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket.Name),
+		Key:    aws.String(source.ObjectStorage.Key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("generate presigned URL: %w", err)
+	}
+	if req == nil || req.URL == "" {
+		return "", errors.New("empty presigned URL")
+	}
+
+	return req.URL, nil
+}
+
+const (
+	// imageDownloadDialTimeout is the time allowed to connect to the source of an image.
+	imageDownloadDialTimeout = 30 * time.Second
+	// imageDownloadTLSHandshakeTimeout is the time allowed for the TLS handshake with the source of an image.
+	imageDownloadTLSHandshakeTimeout = 10 * time.Second
+	// imageDownloadResponseHeaderTimeout is the time allowed for the source of an image to start responding.
+	imageDownloadResponseHeaderTimeout = 30 * time.Second
+)
+
+// imageDownloadClient downloads images from their source. The transfer itself is only bounded by the context, as
+// images can take a long time to download.
+var imageDownloadClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: imageDownloadDialTimeout}).DialContext,
+		TLSHandshakeTimeout:   imageDownloadTLSHandshakeTimeout,
+		ResponseHeaderTimeout: imageDownloadResponseHeaderTimeout,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return checkImageSourceURL(req.URL)
+	},
+}
+
+// checkImageSourceURL refuses to download images over anything but HTTP(S).
+func checkImageSourceURL(sourceURL *url.URL) error {
+	if sourceURL.Scheme != "https" && sourceURL.Scheme != "http" {
+		return fmt.Errorf("unsupported image source URL scheme %q", sourceURL.Scheme)
+	}
+
+	return nil
+}
+
+// UploadImage streams the raw disk image from sourceURL to the upload URL returned when the Image was created,
+// counting the bytes transferred so far.
+func UploadImage(ctx context.Context, linodeClient clients.LinodeClient, sourceURL, uploadURL string, transferred *atomic.Int64) error {
+	parsedURL, err := url.Parse(sourceURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse image source URL: %w", err)
+	}
+	if err := checkImageSourceURL(parsedURL); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create image download request: %w", err)
+	}
+	resp, err := imageDownloadClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download image: unexpected status %s", resp.Status)
+	}
+
+	if err := linodeClient.UploadImageToURL(ctx, uploadURL, &countingReader{Reader: resp.Body, count: transferred}); err != nil {
+		return fmt.Errorf("failed to upload image: %w", err)
+	}
+
+	return nil
+}
+
+// countingReader counts the bytes read from a Reader.
+type countingReader struct {
+	io.Reader
+	count *atomic.Int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.count.Add(int64(n))
+
+	return n, err
+}
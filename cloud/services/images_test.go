@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	awssigner "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func TestImageSourceURL(t *testing.T) {
+	t.Parallel()
+
+	readyBucket := func(namespace string, accessKeyRef *corev1.ObjectReference) infrav1alpha2.LinodeObjectStorageBucket {
+		return infrav1alpha2.LinodeObjectStorageBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "images", Namespace: namespace},
+			Spec:       infrav1alpha2.LinodeObjectStorageBucketSpec{AccessKeyRef: accessKeyRef},
+			Status: infrav1alpha2.LinodeObjectStorageBucketStatus{
+				Ready:    true,
+				Hostname: ptr.To("images.us-ord-1.linodeobjects.com"),
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		source infrav1alpha2.LinodeImageSource
+		bucket *infrav1alpha2.LinodeObjectStorageBucket
+		// keySecret is the generated Secret of the access key of the bucket
+		keySecret *corev1.Secret
+		// expectedKey and expectedEndpoint are the Secret and endpoint the presign client is created with
+		expectedKey      types.NamespacedName
+		expectedEndpoint string
+		expectedURL      string
+		expectedError    string
+	}{
+		{
+			name:        "Success - URL source",
+			source:      infrav1alpha2.LinodeImageSource{URL: "https://example.com/image.img.gz"},
+			expectedURL: "https://example.com/image.img.gz",
+		},
+		{
+			name:          "Error - No source",
+			expectedError: "image source has neither url nor objectStorage set",
+		},
+		{
+			name: "Success - Object presigned with the access key of the bucket",
+			source: infrav1alpha2.LinodeImageSource{ObjectStorage: &infrav1alpha2.LinodeImageObjectStorageSource{
+				BucketRef: corev1.ObjectReference{Name: "images", Namespace: "buckets"},
+				Key:       "image.img.gz",
+			}},
+			bucket:           ptr.To(readyBucket("buckets", &corev1.ObjectReference{Name: "images-key", Namespace: "keys"})),
+			keySecret:        &corev1.Secret{Data: map[string][]byte{"access": []byte("access"), "secret": []byte("secret")}},
+			expectedKey:      types.NamespacedName{Name: "images-key-obj-key", Namespace: "keys"},
+			expectedEndpoint: "https://us-ord-1.linodeobjects.com",
+			expectedURL:      "https://presigned.example.com",
+		},
+		{
+			name: "Success - Namespaces default to the ones of the image and bucket, endpoint of the access key is kept",
+			source: infrav1alpha2.LinodeImageSource{ObjectStorage: &infrav1alpha2.LinodeImageObjectStorageSource{
+				BucketRef: corev1.ObjectReference{Name: "images"},
+				Key:       "image.img.gz",
+			}},
+			bucket:           ptr.To(readyBucket("images", &corev1.ObjectReference{Name: "images-key"})),
+			keySecret:        &corev1.Secret{Data: map[string][]byte{"endpoint": []byte("https://custom.example.com")}},
+			expectedKey:      types.NamespacedName{Name: "images-key-obj-key", Namespace: "images"},
+			expectedEndpoint: "https://custom.example.com",
+			expectedURL:      "https://presigned.example.com",
+		},
+		{
+			name: "Error - Bucket without access key",
+			source: infrav1alpha2.LinodeImageSource{ObjectStorage: &infrav1alpha2.LinodeImageObjectStorageSource{
+				BucketRef: corev1.ObjectReference{Name: "images", Namespace: "buckets"},
+				Key:       "image.img.gz",
+			}},
+			bucket:        ptr.To(readyBucket("buckets", nil)),
+			expectedError: "bucket buckets/images has no accessKeyRef",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			if testcase.bucket != nil {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKeyFromObject(testcase.bucket), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeObjectStorageBucket{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *infrav1alpha2.LinodeObjectStorageBucket, _ ...client.GetOption) error {
+						testcase.bucket.DeepCopyInto(obj)
+						return nil
+					})
+			}
+			if testcase.keySecret != nil {
+				mockK8sClient.EXPECT().Get(gomock.Any(), testcase.expectedKey, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+						testcase.keySecret.DeepCopyInto(obj)
+						return nil
+					})
+			}
+			mockPresignClient := mock.NewMockS3PresignClient(ctrl)
+			mockPresignClient.EXPECT().PresignGetObject(gomock.Any(), &s3.GetObjectInput{Bucket: ptr.To("images"), Key: ptr.To("image.img.gz")}).
+				Return(&awssigner.PresignedHTTPRequest{URL: "https://presigned.example.com"}, nil).AnyTimes()
+
+			imageScope := &scope.ImageScope{
+				Client: mockK8sClient,
+				S3Clients: func(_ context.Context, credentials *corev1.Secret) (clients.S3Client, clients.S3PresignClient, error) {
+					assert.Equal(t, testcase.expectedEndpoint, string(credentials.Data["endpoint"]))
+					return nil, mockPresignClient, nil
+				},
+				LinodeImage: &infrav1alpha2.LinodeImage{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-image", Namespace: "images"},
+					Spec:       infrav1alpha2.LinodeImageSpec{Source: testcase.source},
+				},
+			}
+
+			sourceURL, err := ImageSourceURL(t.Context(), imageScope)
+			if testcase.expectedError != "" {
+				require.EqualError(t, err, testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectedURL, sourceURL)
+		})
+	}
+}
+
+func TestUploadImage(t *testing.T) {
+	t.Parallel()
+
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.img.gz":
+			_, _ = w.Write([]byte("image"))
+		case "/redirect":
+			http.Redirect(w, r, "ftp://example.com/image.img.gz", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(source.Close)
+
+	tests := []struct {
+		name          string
+		sourceURL     string
+		expects       func(*mock.MockLinodeClient)
+		expectedBytes int64
+		expectedError error
+	}{
+		{
+			name:      "Success - Upload image",
+			sourceURL: source.URL + "/image.img.gz",
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().UploadImageToURL(gomock.Any(), "https://upload.example.com", gomock.Any()).
+					DoAndReturn(func(_, _ any, image io.Reader) error {
+						_, err := io.ReadAll(image)
+						return err
+					})
+			},
+			expectedBytes: 5,
+		},
+		{
+			name:          "Error - Unsupported scheme",
+			sourceURL:     "file:///etc/passwd",
+			expects:       func(*mock.MockLinodeClient) {},
+			expectedError: errors.New(`unsupported image source URL scheme "file"`),
+		},
+		{
+			name:          "Error - Redirect to an unsupported scheme",
+			sourceURL:     source.URL + "/redirect",
+			expects:       func(*mock.MockLinodeClient) {},
+			expectedError: errors.New(`unsupported image source URL scheme "ftp"`),
+		},
+		{
+			name:          "Error - Image not found",
+			sourceURL:     source.URL + "/missing.img.gz",
+			expects:       func(*mock.MockLinodeClient) {},
+			expectedError: errors.New("unexpected status 404 Not Found"),
+		},
+		{
+			name:      "Error - Upload fails",
+			sourceURL: source.URL + "/image.img.gz",
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().UploadImageToURL(gomock.Any(), "https://upload.example.com", gomock.Any()).Return(errors.New("upload failed"))
+			},
+			expectedError: errors.New("failed to upload image: upload failed"),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockLinodeClient(ctrl)
+			testcase.expects(mockClient)
+
+			var transferred atomic.Int64
+			err := UploadImage(t.Context(), mockClient, testcase.sourceURL, "https://upload.example.com", &transferred)
+			if testcase.expectedError != nil {
+				assert.ErrorContains(t, err, testcase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testcase.expectedBytes, transferred.Load())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -32,6 +33,21 @@ func DetermineAPIServerLBPort(clusterScope *scope.ClusterScope) int {
 	return DefaultApiserverLBPort
 }
 
+// DetermineAPIServerTargetPort returns the port of the backend nodes the API server
+// load balancer forwards to, which defaults to the load balancer port.
+func DetermineAPIServerTargetPort(clusterScope *scope.ClusterScope) int {
+	return NodeBalancerTargetPort(DetermineAPIServerLBPort(clusterScope), clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfig)
+}
+
+// NodeBalancerTargetPort returns the port of the backend nodes of a NodeBalancer config,
+// which defaults to the port of the config.
+func NodeBalancerTargetPort(port int, settings *v1alpha2.LinodeNBConfigSettings) int {
+	if settings != nil && settings.TargetPort != 0 {
+		return settings.TargetPort
+	}
+	return port
+}
+
 // ShouldUseVPC decides whether VPC IPs/backends should be preferred and a VPC-scoped
 // NodeBalancer should be created. It requires both the feature flag and a VPC reference/ID.
 func ShouldUseVPC(clusterScope *scope.ClusterScope) bool {
@@ -120,6 +136,29 @@ func selectSubnet[T any](subnetName string, subnets []T, getProps func(T) (strin
 	return id, nil
 }
 
+// EnsureNodeBalancerOwnerTags replaces the owner tags of the NodeBalancer of the cluster with the ones of the
+// management cluster, once the cluster was moved from another management cluster.
+func EnsureNodeBalancerOwnerTags(ctx context.Context, clusterScope *scope.ClusterScope, logger logr.Logger) error {
+	nbID := clusterScope.LinodeCluster.Spec.Network.NodeBalancerID
+	if clusterScope.ManagementClusterID == "" || nbID == nil || *nbID == 0 {
+		return nil
+	}
+	nodeBalancer, err := clusterScope.LinodeClient.GetNodeBalancer(ctx, *nbID)
+	if err != nil {
+		logger.Info("Failed to get NodeBalancer", "error", err.Error())
+
+		return err
+	}
+	tags := util.ClaimOwnerTags(nodeBalancer.Tags, clusterScope.ManagementClusterID)
+	if slices.Equal(tags, nodeBalancer.Tags) {
+		return nil
+	}
+	logger.Info("Claiming NodeBalancer for the management cluster", "id", *nbID)
+	_, err = clusterScope.LinodeClient.UpdateNodeBalancer(ctx, *nbID, linodego.NodeBalancerUpdateOptions{Tags: tags})
+
+	return err
+}
+
 // EnsureNodeBalancer creates a new NodeBalancer if one doesn't exist or returns the existing NodeBalancer
 func EnsureNodeBalancer(ctx context.Context, clusterScope *scope.ClusterScope, logger logr.Logger) (*linodego.NodeBalancer, error) {
 	nbID := clusterScope.LinodeCluster.Spec.Network.NodeBalancerID
@@ -138,7 +177,7 @@ func EnsureNodeBalancer(ctx context.Context, clusterScope *scope.ClusterScope, l
 	createConfig := linodego.NodeBalancerCreateOptions{
 		Label:  util.Pointer(clusterScope.LinodeCluster.Name),
 		Region: clusterScope.LinodeCluster.Spec.Region,
-		Tags:   []string{string(clusterScope.LinodeCluster.UID)},
+		Tags:   append([]string{string(clusterScope.LinodeCluster.UID)}, util.GetOwnerTags(clusterScope.ManagementClusterID)...),
 	}
 
 	// if enableVPCBackends is true and vpcRef or vpcID is set, create the NodeBalancer in the specified VPC
@@ -174,6 +213,14 @@ func EnsureNodeBalancer(ctx context.Context, clusterScope *scope.ClusterScope, l
 			return nil, err
 		}
 		createConfig.FirewallID = firewallID
+	} else if len(clusterScope.LinodeCluster.Spec.Network.APIServerAllowedCIDRs) > 0 {
+		// Use the LinodeFirewall managed for the API server allowlist
+		firewallID, err := getLinodeFirewallID(ctx, clusterScope, logger, APIServerFirewallName(clusterScope.LinodeCluster), clusterScope.LinodeCluster.Namespace)
+		if err != nil {
+			logger.Error(err, "Failed to fetch API server LinodeFirewall ID")
+			return nil, err
+		}
+		createConfig.FirewallID = firewallID
 	}
 
 	nb, err := clusterScope.LinodeClient.CreateNodeBalancer(ctx, createConfig)
@@ -271,6 +318,10 @@ func getFirewallID(ctx context.Context, clusterScope *scope.ClusterScope, logger
 		namespace = clusterScope.LinodeCluster.Namespace
 	}
 
+	return getLinodeFirewallID(ctx, clusterScope, logger, name, namespace)
+}
+
+func getLinodeFirewallID(ctx context.Context, clusterScope *scope.ClusterScope, logger logr.Logger, name, namespace string) (int, error) {
 	logger = logger.WithValues("firewallName", name, "firewallNamespace", namespace)
 
 	linodeFirewall := &v1alpha2.LinodeFirewall{
@@ -294,6 +345,39 @@ func getFirewallID(ctx context.Context, clusterScope *scope.ClusterScope, logger
 	return *linodeFirewall.Spec.FirewallID, nil
 }
 
+// APIServerFirewallName returns the name of the LinodeFirewall managed for the apiServerAllowedCIDRs of a LinodeCluster
+func APIServerFirewallName(linodeCluster *v1alpha2.LinodeCluster) string {
+	return linodeCluster.Name + "-apiserver"
+}
+
+// AttachNodeBalancerFirewall adds the NodeBalancer to the devices of the firewall
+func AttachNodeBalancerFirewall(ctx context.Context, clusterScope *scope.ClusterScope, firewallID int) error {
+	_, err := clusterScope.LinodeClient.CreateFirewallDevice(ctx, firewallID, linodego.FirewallDeviceCreateOptions{
+		ID:   *clusterScope.LinodeCluster.Spec.Network.NodeBalancerID,
+		Type: linodego.FirewallDeviceNodeBalancer,
+	})
+
+	return err
+}
+
+// DetachNodeBalancerFirewall removes the NodeBalancer from the devices of the firewall
+func DetachNodeBalancerFirewall(ctx context.Context, clusterScope *scope.ClusterScope, firewallID int) error {
+	devices, err := clusterScope.LinodeClient.ListFirewallDevices(ctx, firewallID, nil)
+	if err != nil {
+		return util.IgnoreLinodeAPIError(err, http.StatusNotFound)
+	}
+	for _, device := range devices {
+		if device.Entity.Type != linodego.FirewallDeviceNodeBalancer || device.Entity.ID != *clusterScope.LinodeCluster.Spec.Network.NodeBalancerID {
+			continue
+		}
+		if err := clusterScope.LinodeClient.DeleteFirewallDevice(ctx, firewallID, device.ID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // EnsureNodeBalancerConfigs creates NodeBalancer configs if it does not exist or returns the existing NodeBalancerConfig
 func EnsureNodeBalancerConfigs(
 	ctx context.Context,
@@ -318,12 +402,7 @@ func EnsureNodeBalancerConfigs(
 		apiserverLinodeNBConfig, err = clusterScope.LinodeClient.CreateNodeBalancerConfig(
 			ctx,
 			*clusterScope.LinodeCluster.Spec.Network.NodeBalancerID,
-			linodego.NodeBalancerConfigCreateOptions{
-				Port:      apiLBPort,
-				Protocol:  linodego.ProtocolTCP,
-				Algorithm: linodego.AlgorithmRoundRobin,
-				Check:     linodego.CheckConnection,
-			},
+			nodeBalancerConfigOptions(apiLBPort, clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfig),
 		)
 		if err != nil {
 			logger.Info("Failed to create Linode NodeBalancer config", "error", err.Error())
@@ -339,12 +418,7 @@ func EnsureNodeBalancerConfigs(
 	}
 
 	for _, portConfig := range clusterScope.LinodeCluster.Spec.Network.AdditionalPorts {
-		portCreateConfig := linodego.NodeBalancerConfigCreateOptions{
-			Port:      portConfig.Port,
-			Protocol:  linodego.ProtocolTCP,
-			Algorithm: linodego.AlgorithmRoundRobin,
-			Check:     linodego.CheckConnection,
-		}
+		portCreateConfig := nodeBalancerConfigOptions(portConfig.Port, &portConfig.LinodeNBConfigSettings)
 		nbConfig, err := clusterScope.LinodeClient.CreateNodeBalancerConfig(
 			ctx,
 			*clusterScope.LinodeCluster.Spec.Network.NodeBalancerID,
@@ -360,17 +434,133 @@ func EnsureNodeBalancerConfigs(
 	return nbConfigs, nil
 }
 
+// EnsureNodeBalancerConfigSettings updates the existing NodeBalancer configs in place
+// when their settings differ from the ones in the LinodeCluster spec
+func EnsureNodeBalancerConfigSettings(
+	ctx context.Context,
+	clusterScope *scope.ClusterScope,
+	logger logr.Logger,
+) error {
+	network := clusterScope.LinodeCluster.Spec.Network
+	if network.NodeBalancerID == nil || network.ApiserverNodeBalancerConfigID == nil {
+		return nil
+	}
+
+	apiLBPort := DetermineAPIServerLBPort(clusterScope)
+	if err := ensureNodeBalancerConfigSettings(ctx, clusterScope, logger, *network.ApiserverNodeBalancerConfigID,
+		nodeBalancerConfigOptions(apiLBPort, network.ApiserverNodeBalancerConfig)); err != nil {
+		return err
+	}
+
+	for _, portConfig := range network.AdditionalPorts {
+		if portConfig.NodeBalancerConfigID == nil {
+			continue
+		}
+		if err := ensureNodeBalancerConfigSettings(ctx, clusterScope, logger, *portConfig.NodeBalancerConfigID,
+			nodeBalancerConfigOptions(portConfig.Port, &portConfig.LinodeNBConfigSettings)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ensureNodeBalancerConfigSettings(
+	ctx context.Context,
+	clusterScope *scope.ClusterScope,
+	logger logr.Logger,
+	configID int,
+	opts linodego.NodeBalancerConfigCreateOptions,
+) error {
+	nodeBalancerID := *clusterScope.LinodeCluster.Spec.Network.NodeBalancerID
+	nbConfig, err := clusterScope.LinodeClient.GetNodeBalancerConfig(ctx, nodeBalancerID, configID)
+	if err != nil {
+		logger.Info("Failed to get Linode NodeBalancer config", "error", err.Error())
+		return err
+	}
+	if nodeBalancerConfigInSync(nbConfig, opts) {
+		return nil
+	}
+
+	// The port of an existing config is left untouched
+	opts.Port = nbConfig.Port
+	logger.Info("Updating Linode NodeBalancer config", "configID", configID)
+	if _, err := clusterScope.LinodeClient.UpdateNodeBalancerConfig(ctx, nodeBalancerID, configID, linodego.NodeBalancerConfigUpdateOptions(opts)); err != nil {
+		logger.Info("Failed to update Linode NodeBalancer config", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// nodeBalancerConfigOptions returns the options of a NodeBalancer config balancing the given port
+// with the given settings. Settings which are not set fall back to a TCP config balancing connections
+// in round robin with connection health checks.
+func nodeBalancerConfigOptions(port int, settings *v1alpha2.LinodeNBConfigSettings) linodego.NodeBalancerConfigCreateOptions {
+	opts := linodego.NodeBalancerConfigCreateOptions{
+		Port:          port,
+		Protocol:      linodego.ProtocolTCP,
+		ProxyProtocol: linodego.ProxyProtocolNone,
+		Algorithm:     linodego.AlgorithmRoundRobin,
+		Check:         linodego.CheckConnection,
+	}
+	if settings == nil {
+		return opts
+	}
+
+	if settings.Protocol != "" {
+		opts.Protocol = settings.Protocol
+	}
+	if settings.ProxyProtocol != "" {
+		opts.ProxyProtocol = settings.ProxyProtocol
+	}
+	if settings.Algorithm != "" {
+		opts.Algorithm = settings.Algorithm
+	}
+	opts.Stickiness = settings.Stickiness
+	if healthCheck := settings.HealthCheck; healthCheck != nil {
+		opts.Check = healthCheck.Type
+		opts.CheckPath = healthCheck.Path
+		opts.CheckBody = healthCheck.Body
+		opts.CheckInterval = healthCheck.IntervalSeconds
+		opts.CheckTimeout = healthCheck.TimeoutSeconds
+		opts.CheckAttempts = healthCheck.Attempts
+	}
+
+	return opts
+}
+
+// nodeBalancerConfigInSync reports whether a NodeBalancer config matches the given options.
+// Options which are not set are left to the Linode defaults and not compared.
+func nodeBalancerConfigInSync(nbConfig *linodego.NodeBalancerConfig, opts linodego.NodeBalancerConfigCreateOptions) bool {
+	switch {
+	case nbConfig.Protocol != opts.Protocol,
+		nbConfig.ProxyProtocol != opts.ProxyProtocol,
+		nbConfig.Algorithm != opts.Algorithm,
+		nbConfig.Check != opts.Check,
+		opts.Stickiness != "" && nbConfig.Stickiness != opts.Stickiness,
+		opts.CheckPath != "" && nbConfig.CheckPath != opts.CheckPath,
+		opts.CheckBody != "" && nbConfig.CheckBody != opts.CheckBody,
+		opts.CheckInterval != 0 && nbConfig.CheckInterval != opts.CheckInterval,
+		opts.CheckTimeout != 0 && nbConfig.CheckTimeout != opts.CheckTimeout,
+		opts.CheckAttempts != 0 && nbConfig.CheckAttempts != opts.CheckAttempts:
+		return false
+	default:
+		return true
+	}
+}
+
 func processAndCreateNodeBalancerNodes(ctx context.Context, ipAddress string, clusterScope *scope.ClusterScope, nodeBalancerNodes []linodego.NodeBalancerNode, subnetID int) error {
-	apiserverLBPort := DetermineAPIServerLBPort(clusterScope)
+	apiserverTargetPort := DetermineAPIServerTargetPort(clusterScope)
 
-	// Set the port number and NB config ID for standard ports
+	// Set the backend port number and NB config ID for standard ports
 	portsToBeAdded := make([]map[string]int, 0, 1+len(clusterScope.LinodeCluster.Spec.Network.AdditionalPorts))
-	standardPort := map[string]int{"configID": *clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfigID, "port": apiserverLBPort}
+	standardPort := map[string]int{"configID": *clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfigID, "port": apiserverTargetPort}
 	portsToBeAdded = append(portsToBeAdded, standardPort)
 
-	// Set the port number and NB config ID for any additional ports
+	// Set the backend port number and NB config ID for any additional ports
 	for _, portConfig := range clusterScope.LinodeCluster.Spec.Network.AdditionalPorts {
-		portsToBeAdded = append(portsToBeAdded, map[string]int{"configID": *portConfig.NodeBalancerConfigID, "port": portConfig.Port})
+		portsToBeAdded = append(portsToBeAdded, map[string]int{"configID": *portConfig.NodeBalancerConfigID, "port": NodeBalancerTargetPort(portConfig.Port, &portConfig.LinodeNBConfigSettings)})
 	}
 
 	// Cycle through all ports to be added
@@ -379,8 +569,8 @@ func processAndCreateNodeBalancerNodes(ctx context.Context, ipAddress string, cl
 		ipPortComboExists := false
 
 		for _, nodes := range nodeBalancerNodes {
-			// Create the node if the IP:Port combination does not exist
-			if nodes.Address == ipPortCombo {
+			// Create the node if the IP:Port combination does not exist in the config
+			if nodes.ConfigID == ports["configID"] && nodes.Address == ipPortCombo {
 				ipPortComboExists = true
 				break
 			}
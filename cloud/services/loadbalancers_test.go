@@ -1098,9 +1098,10 @@ func TestProcessAndCreateNodeBalancerNodes(t *testing.T) {
 			},
 			nodeBalancerNodes: []linodego.NodeBalancerNode{
 				{
-					ID:      789,
-					Address: "192.168.1.10:6443", // Node with this address already exists
-					Label:   "test-cluster",
+					ID:       789,
+					ConfigID: 456,
+					Address:  "192.168.1.10:6443", // Node with this address already exists
+					Label:    "test-cluster",
 				},
 			},
 			subnetID: 0,
@@ -1474,6 +1475,77 @@ func TestEnsureNodeBalancerConfigs(t *testing.T) {
 			expectedConfigs: nil,
 			expectedError:   errors.New("config not found"),
 		},
+		{
+			name: "Success - Create NodeBalancerConfigs with custom settings",
+			clusterScope: &scope.ClusterScope{
+				LinodeClient: nil,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							NodeBalancerID: ptr.To(1234),
+							ApiserverNodeBalancerConfig: &infrav1alpha2.LinodeNBConfigSettings{
+								Algorithm: linodego.AlgorithmLeastConn,
+								HealthCheck: &infrav1alpha2.LinodeNBHealthCheck{
+									Type:            linodego.CheckConnection,
+									IntervalSeconds: 10,
+									TimeoutSeconds:  5,
+									Attempts:        3,
+								},
+							},
+							AdditionalPorts: []infrav1alpha2.LinodeNBPortConfig{
+								{
+									Port: DefaultKonnectivityLBPort,
+									LinodeNBConfigSettings: infrav1alpha2.LinodeNBConfigSettings{
+										Stickiness:    linodego.StickinessTable,
+										ProxyProtocol: linodego.ProxyProtocolV2,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedConfigs: []*linodego.NodeBalancerConfig{
+				{
+					Port: DefaultApiserverLBPort,
+					ID:   4567,
+				},
+				{
+					Port: DefaultKonnectivityLBPort,
+					ID:   2345,
+				},
+			},
+			expects: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().CreateNodeBalancerConfig(gomock.Any(), 1234, linodego.NodeBalancerConfigCreateOptions{
+					Port:          DefaultApiserverLBPort,
+					Protocol:      linodego.ProtocolTCP,
+					ProxyProtocol: linodego.ProxyProtocolNone,
+					Algorithm:     linodego.AlgorithmLeastConn,
+					Check:         linodego.CheckConnection,
+					CheckInterval: 10,
+					CheckTimeout:  5,
+					CheckAttempts: 3,
+				}).Return(&linodego.NodeBalancerConfig{
+					Port: DefaultApiserverLBPort,
+					ID:   4567,
+				}, nil)
+				mockLinodeClient.EXPECT().CreateNodeBalancerConfig(gomock.Any(), 1234, linodego.NodeBalancerConfigCreateOptions{
+					Port:          DefaultKonnectivityLBPort,
+					Protocol:      linodego.ProtocolTCP,
+					ProxyProtocol: linodego.ProxyProtocolV2,
+					Algorithm:     linodego.AlgorithmRoundRobin,
+					Stickiness:    linodego.StickinessTable,
+					Check:         linodego.CheckConnection,
+				}).Return(&linodego.NodeBalancerConfig{
+					Port: DefaultKonnectivityLBPort,
+					ID:   2345,
+				}, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		testcase := tt
@@ -1500,6 +1572,128 @@ func TestEnsureNodeBalancerConfigs(t *testing.T) {
 	}
 }
 
+func TestEnsureNodeBalancerConfigSettings(t *testing.T) {
+	t.Parallel()
+
+	inSyncConfig := linodego.NodeBalancerConfig{
+		Port:          DefaultApiserverLBPort,
+		Protocol:      linodego.ProtocolTCP,
+		ProxyProtocol: linodego.ProxyProtocolNone,
+		Algorithm:     linodego.AlgorithmRoundRobin,
+		Stickiness:    linodego.StickinessTable,
+		Check:         linodego.CheckConnection,
+		CheckInterval: 5,
+		CheckTimeout:  3,
+		CheckAttempts: 2,
+	}
+
+	tests := []struct {
+		name          string
+		network       infrav1alpha2.NetworkSpec
+		expectedError error
+		expects       func(*mock.MockLinodeClient)
+	}{
+		{
+			name:    "Success - NodeBalancer not created yet",
+			network: infrav1alpha2.NetworkSpec{},
+			expects: func(mockLinodeClient *mock.MockLinodeClient) {},
+		},
+		{
+			name: "Success - Default settings are in sync",
+			network: infrav1alpha2.NetworkSpec{
+				NodeBalancerID:                ptr.To(1234),
+				ApiserverNodeBalancerConfigID: ptr.To(5678),
+			},
+			expects: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetNodeBalancerConfig(gomock.Any(), 1234, 5678).Return(&inSyncConfig, nil)
+			},
+		},
+		{
+			name: "Success - Changed settings are updated in place",
+			network: infrav1alpha2.NetworkSpec{
+				NodeBalancerID:                ptr.To(1234),
+				ApiserverNodeBalancerConfigID: ptr.To(5678),
+				AdditionalPorts: []infrav1alpha2.LinodeNBPortConfig{
+					{
+						Port:                 DefaultKonnectivityLBPort,
+						NodeBalancerConfigID: ptr.To(2345),
+						LinodeNBConfigSettings: infrav1alpha2.LinodeNBConfigSettings{
+							Algorithm: linodego.AlgorithmSource,
+							HealthCheck: &infrav1alpha2.LinodeNBHealthCheck{
+								Type:     linodego.CheckHTTP,
+								Path:     "/healthz",
+								Attempts: 2,
+							},
+						},
+					},
+				},
+			},
+			expects: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetNodeBalancerConfig(gomock.Any(), 1234, 5678).Return(&inSyncConfig, nil)
+				konnectivityConfig := inSyncConfig
+				konnectivityConfig.Port = DefaultKonnectivityLBPort
+				mockLinodeClient.EXPECT().GetNodeBalancerConfig(gomock.Any(), 1234, 2345).Return(&konnectivityConfig, nil)
+				mockLinodeClient.EXPECT().UpdateNodeBalancerConfig(gomock.Any(), 1234, 2345, linodego.NodeBalancerConfigUpdateOptions{
+					Port:          DefaultKonnectivityLBPort,
+					Protocol:      linodego.ProtocolTCP,
+					ProxyProtocol: linodego.ProxyProtocolNone,
+					Algorithm:     linodego.AlgorithmSource,
+					Check:         linodego.CheckHTTP,
+					CheckPath:     "/healthz",
+					CheckAttempts: 2,
+				}).Return(&linodego.NodeBalancerConfig{}, nil)
+			},
+		},
+		{
+			name: "Error - UpdateNodeBalancerConfig fails",
+			network: infrav1alpha2.NetworkSpec{
+				NodeBalancerID:                ptr.To(1234),
+				ApiserverNodeBalancerConfigID: ptr.To(5678),
+				ApiserverNodeBalancerConfig: &infrav1alpha2.LinodeNBConfigSettings{
+					ProxyProtocol: linodego.ProxyProtocolV1,
+				},
+			},
+			expects: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetNodeBalancerConfig(gomock.Any(), 1234, 5678).Return(&inSyncConfig, nil)
+				mockLinodeClient.EXPECT().UpdateNodeBalancerConfig(gomock.Any(), 1234, 5678, gomock.Any()).Return(nil, errors.New("update failed"))
+			},
+			expectedError: errors.New("update failed"),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			MockLinodeClient := mock.NewMockLinodeClient(ctrl)
+			testcase.expects(MockLinodeClient)
+
+			clusterScope := &scope.ClusterScope{
+				LinodeClient: MockLinodeClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: testcase.network,
+					},
+				},
+			}
+
+			err := EnsureNodeBalancerConfigSettings(t.Context(), clusterScope, logr.Discard())
+			if testcase.expectedError != nil {
+				assert.ErrorContains(t, err, testcase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAddNodeToNBConditions(t *testing.T) {
 	t.Parallel()
 
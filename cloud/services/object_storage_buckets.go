@@ -76,7 +76,7 @@ func DeleteBucket(ctx context.Context, bScope *scope.ObjectStorageBucketScope) e
 	if err != nil {
 		return fmt.Errorf("failed to create S3 client: %w", err)
 	}
-	if err := PurgeAllObjects(ctx, bScope.Bucket.Name, s3Client, true, true); err != nil {
+	if err := PurgeAllObjects(ctx, bScope.Bucket.Name, scope.S3ClientWithDryRun(s3Client, bScope.DryRunInterceptor), true, true); err != nil {
 		return fmt.Errorf("failed to purge all objects: %w", err)
 	}
 	bScope.Logger.Info("Purged all objects", "bucket", bScope.Bucket.Name)
@@ -21,6 +21,10 @@ import (
 
 const objectStoreAttemptTimeout = 30 * time.Second
 
+// objectOwnerMetadataKey is the user metadata of the bootstrap data objects holding the ID of the management cluster
+// which created them, as objects cannot carry owner tags.
+const objectOwnerMetadataKey = "capl-owner"
+
 func validateObjectScopeParams(mscope *scope.MachineScope) error {
 	if mscope == nil {
 		return errors.New("nil machine scope")
@@ -113,11 +117,15 @@ func createObjectWithCredentials(
 	}
 
 	bucket := string(credentials.Data["bucket"])
-	if _, err := s3Client.PutObject(attemptCtx, &s3.PutObjectInput{
+	input := &s3.PutObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		Body:   s3manager.ReadSeekCloser(bytes.NewReader(data)),
-	}); err != nil {
+	}
+	if mscope.ManagementClusterID != "" {
+		input.Metadata = map[string]string{objectOwnerMetadataKey: mscope.ManagementClusterID}
+	}
+	if _, err := s3Client.PutObject(attemptCtx, input); err != nil {
 		return "", fmt.Errorf("put object: %w", err)
 	}
 
@@ -158,6 +166,71 @@ func DeleteObject(ctx context.Context, mscope *scope.MachineScope) error {
 	return errors.Join(attemptErrs...)
 }
 
+// ListObjects returns the keys of the objects in the bucket of the cluster object store, using its primary credentials.
+// The LinodeMachine of the scope is not used.
+func ListObjects(ctx context.Context, mscope *scope.MachineScope) ([]string, error) {
+	s3Client, bucket, err := primaryObjectStore(ctx, mscope)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	})
+	var keys []string
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+	}
+
+	return keys, nil
+}
+
+// GetObjectOwner returns the ID of the management cluster which created the object with the key in the bucket of the
+// cluster object store, using its primary credentials. It is empty for the objects created without one.
+func GetObjectOwner(ctx context.Context, mscope *scope.MachineScope, key string) (string, error) {
+	s3Client, bucket, err := primaryObjectStore(ctx, mscope)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("head object: %w", err)
+	}
+
+	return output.Metadata[objectOwnerMetadataKey], nil
+}
+
+// primaryObjectStore returns the client and bucket of the primary credentials of the cluster object store.
+func primaryObjectStore(ctx context.Context, mscope *scope.MachineScope) (clients.S3Client, string, error) {
+	if mscope == nil || mscope.Client == nil || mscope.S3Clients == nil || mscope.LinodeCluster == nil || mscope.LinodeCluster.Spec.ObjectStore == nil {
+		return nil, "", errors.New("nil cluster object store")
+	}
+
+	credentials, err := mscope.GetObjectStoreCredentials(ctx, objectStoreRefs(mscope)[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("load credentials: %w", err)
+	}
+	s3Client, _, err := mscope.S3Clients(ctx, credentials)
+	if err != nil {
+		return nil, "", fmt.Errorf("create clients: %w", err)
+	}
+	if s3Client == nil {
+		return nil, "", errors.New("create clients: S3 client builder returned nil client")
+	}
+
+	return s3Client, string(credentials.Data["bucket"]), nil
+}
+
 func deleteObjectWithCredentials(
 	ctx context.Context,
 	mscope *scope.MachineScope,
@@ -1046,3 +1046,96 @@ func TestDeleteAllObjectVersionsAndDeleteMarkers(t *testing.T) {
 		),
 	)
 }
+
+func TestListObjects(t *testing.T) {
+	t.Parallel()
+
+	_, err := ListObjects(t.Context(), &scope.MachineScope{LinodeCluster: &infrav1alpha2.LinodeCluster{}})
+	require.ErrorContains(t, err, "nil cluster object store")
+
+	ctrl := gomock.NewController(t)
+	k8s := mock.NewMockK8sClient(ctrl)
+	s3Client := mock.NewMockS3Client(ctrl)
+	stubSecretLookups(k8s, objectStoreSecret("primary", "cluster-ns", firstBucket))
+	s3Client.EXPECT().ListObjectsV2(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+			assert.Equal(t, firstBucket, aws.ToString(input.Bucket))
+			return &s3.ListObjectsV2Output{Contents: []types.Object{{Key: aws.String("a")}, {Key: aws.String("b")}}}, nil
+		})
+
+	mscope := fallbackMachineScope(
+		recordingS3Factory(nil, map[string]stubClients{firstBucket: {s3: s3Client}}),
+		k8s,
+		&infrav1alpha2.ObjectStore{CredentialsRef: corev1.SecretReference{Name: "primary"}},
+	)
+	keys, err := ListObjects(t.Context(), mscope)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
+
+func TestCreateObjectOwnerMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	k8s := mock.NewMockK8sClient(ctrl)
+	s3Client := mock.NewMockS3Client(ctrl)
+	presign := mock.NewMockS3PresignClient(ctrl)
+	stubSecretLookups(k8s, objectStoreSecret("primary", "cluster-ns", firstBucket))
+	s3Client.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+			assert.Equal(t, map[string]string{"capl-owner": "mgmt"}, input.Metadata)
+			return &s3.PutObjectOutput{}, nil
+		})
+	presign.EXPECT().PresignGetObject(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&awssigner.PresignedHTTPRequest{URL: "https://first.example.com"}, nil)
+
+	mscope := fallbackMachineScope(
+		recordingS3Factory(nil, map[string]stubClients{firstBucket: {s3: s3Client, presign: presign}}),
+		k8s,
+		&infrav1alpha2.ObjectStore{CredentialsRef: corev1.SecretReference{Name: "primary"}},
+	)
+	mscope.ManagementClusterID = "mgmt"
+	url, err := CreateObject(t.Context(), mscope, []byte("data"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://first.example.com", url)
+}
+
+func TestGetObjectOwner(t *testing.T) {
+	t.Parallel()
+
+	_, err := GetObjectOwner(t.Context(), &scope.MachineScope{LinodeCluster: &infrav1alpha2.LinodeCluster{}}, "a")
+	require.ErrorContains(t, err, "nil cluster object store")
+
+	ctrl := gomock.NewController(t)
+	k8s := mock.NewMockK8sClient(ctrl)
+	s3Client := mock.NewMockS3Client(ctrl)
+	stubSecretLookups(k8s, objectStoreSecret("primary", "cluster-ns", firstBucket))
+	s3Client.EXPECT().HeadObject(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *s3.HeadObjectInput, _ ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+			assert.Equal(t, firstBucket, aws.ToString(input.Bucket))
+			switch aws.ToString(input.Key) {
+			case "owned":
+				return &s3.HeadObjectOutput{Metadata: map[string]string{"capl-owner": "mgmt"}}, nil
+			case "unowned":
+				return &s3.HeadObjectOutput{}, nil
+			default:
+				return nil, errors.New("fail")
+			}
+		}).Times(3)
+
+	mscope := fallbackMachineScope(
+		recordingS3Factory(nil, map[string]stubClients{firstBucket: {s3: s3Client}}),
+		k8s,
+		&infrav1alpha2.ObjectStore{CredentialsRef: corev1.SecretReference{Name: "primary"}},
+	)
+	owner, err := GetObjectOwner(t.Context(), mscope, "owned")
+	require.NoError(t, err)
+	assert.Equal(t, "mgmt", owner)
+
+	owner, err = GetObjectOwner(t.Context(), mscope, "unowned")
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+
+	_, err = GetObjectOwner(t.Context(), mscope, "failing")
+	require.ErrorContains(t, err, "head object: fail")
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/util"
+)
+
+// EnsureSharedIP returns the reserved IPv4 address used as the control plane endpoint of the cluster,
+// reserving a new address in the region of the cluster if one does not exist yet. The address is reserved up front
+// rather than allocated on the first control plane instance because the control plane provider only creates
+// control plane Machines once the endpoint is set; EnsureSharedIPAssignments moves it onto that instance later.
+func EnsureSharedIP(ctx context.Context, clusterScope *scope.ClusterScope, logger logr.Logger) (*linodego.InstanceIP, error) {
+	if address := clusterScope.LinodeCluster.Spec.Network.SharedIPv4; address != "" {
+		ip, err := clusterScope.LinodeClient.GetIPAddress(ctx, address)
+		if err != nil {
+			logger.Info("Failed to get shared IP address", "error", err.Error())
+			return nil, err
+		}
+		return ip, nil
+	}
+
+	// Handle the edge case where the IP was reserved but the LinodeCluster was not updated
+	filter, err := util.Filter{Tags: []string{string(clusterScope.LinodeCluster.UID)}}.String()
+	if err != nil {
+		return nil, err
+	}
+	ips, err := clusterScope.LinodeClient.ListReservedIPAddresses(ctx, linodego.NewListOptions(1, filter))
+	if err != nil {
+		logger.Error(err, "Failed to list reserved IP addresses")
+		return nil, err
+	}
+	if len(ips) != 0 {
+		setSharedIPReserved(clusterScope, ips[0].Address)
+		return &ips[0], nil
+	}
+
+	logger.Info(fmt.Sprintf("Reserving shared IP address for %s", clusterScope.LinodeCluster.Name))
+
+	ip, err := clusterScope.LinodeClient.ReserveIPAddress(ctx, linodego.ReserveIPOptions{
+		Region: clusterScope.LinodeCluster.Spec.Region,
+		Tags:   []string{string(clusterScope.LinodeCluster.UID)},
+	})
+	if err != nil {
+		logger.Error(err, "Failed to reserve shared IP address")
+		return nil, err
+	}
+	if ip == nil {
+		return nil, fmt.Errorf("reserved IP address was nil")
+	}
+	setSharedIPReserved(clusterScope, ip.Address)
+
+	return ip, nil
+}
+
+// setSharedIPReserved records that the address was reserved by CAPL, and is released with the LinodeCluster.
+func setSharedIPReserved(clusterScope *scope.ClusterScope, address string) {
+	metav1.SetMetaDataAnnotation(&clusterScope.LinodeCluster.ObjectMeta, infrav1alpha2.SharedIPReservedAnnotation, address)
+}
+
+// EnsureSharedIPAssignments assigns the shared IP address to the first control plane instance and shares it
+// with every other control plane instance of the cluster. If the instance the address is assigned to is no
+// longer part of the control plane, the address is moved to another control plane instance. Instances of
+// control plane machines being deleted stop sharing the address.
+func EnsureSharedIPAssignments(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
+	address := clusterScope.LinodeCluster.Spec.Network.SharedIPv4
+	if address == "" {
+		logger.Info("Shared IP address not yet reserved, nothing to assign")
+		return nil
+	}
+
+	instanceIDs := make([]int, 0, len(clusterScope.LinodeMachines.Items))
+	staleInstanceIDs := []int{}
+	for _, eachMachine := range clusterScope.LinodeMachines.Items {
+		instanceID, err := util.GetInstanceID(eachMachine.Spec.ProviderID)
+		if err != nil {
+			// Skip machines without a ProviderID, they don't have an instance to share the address with yet
+			continue
+		}
+		if !eachMachine.DeletionTimestamp.IsZero() {
+			staleInstanceIDs = append(staleInstanceIDs, instanceID)
+			continue
+		}
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+	if len(instanceIDs) == 0 {
+		logger.Info("No control plane instances to assign the shared IP address to")
+		return nil
+	}
+	slices.Sort(instanceIDs)
+
+	ip, err := clusterScope.LinodeClient.GetIPAddress(ctx, address)
+	if err != nil {
+		logger.Error(err, "Failed to get shared IP address")
+		return err
+	}
+
+	primaryID := ip.LinodeID
+	if !slices.Contains(instanceIDs, primaryID) {
+		primaryID = instanceIDs[0]
+		logger.Info("Assigning shared IP address", "address", address, "linodeID", primaryID)
+		if err := clusterScope.LinodeClient.InstancesAssignIPs(ctx, linodego.LinodesAssignIPsOptions{
+			Region:      clusterScope.LinodeCluster.Spec.Region,
+			Assignments: []linodego.LinodeIPAssignment{{Address: address, LinodeID: primaryID}},
+		}); err != nil {
+			logger.Error(err, "Failed to assign shared IP address")
+			return err
+		}
+	}
+
+	for _, instanceID := range instanceIDs {
+		if instanceID == primaryID {
+			continue
+		}
+		if err := ensureIPShared(ctx, logger, clusterScope, instanceID, address, true); err != nil {
+			return err
+		}
+	}
+	for _, instanceID := range staleInstanceIDs {
+		if err := ensureIPShared(ctx, logger, clusterScope, instanceID, address, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureIPShared adds the address to, or removes it from, the addresses shared with an instance.
+func ensureIPShared(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope, instanceID int, address string, shared bool) error {
+	addresses, err := clusterScope.LinodeClient.GetInstanceIPAddresses(ctx, instanceID)
+	if util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+		logger.Error(err, "Failed to get instance IP addresses", "linodeID", instanceID)
+		return err
+	}
+	if err != nil {
+		// The instance is already gone, and so is its share list
+		return nil
+	}
+
+	// The share list of an instance is replaced as a whole, so keep any other addresses that are shared with it
+	sharedIPs := []string{}
+	if addresses.IPv4 != nil {
+		for _, sharedIP := range addresses.IPv4.Shared {
+			if sharedIP.Address != address {
+				sharedIPs = append(sharedIPs, sharedIP.Address)
+			}
+		}
+	}
+	alreadyShared := addresses.IPv4 != nil && len(sharedIPs) != len(addresses.IPv4.Shared)
+	if alreadyShared == shared {
+		return nil
+	}
+
+	if shared {
+		logger.Info("Sharing shared IP address", "address", address, "linodeID", instanceID)
+		sharedIPs = append(sharedIPs, address)
+	} else {
+		logger.Info("Unsharing shared IP address", "address", address, "linodeID", instanceID)
+	}
+	if err := clusterScope.LinodeClient.ShareIPAddresses(ctx, linodego.IPAddressesShareOptions{
+		LinodeID: instanceID,
+		IPs:      sharedIPs,
+	}); err != nil {
+		logger.Error(err, "Failed to share IP addresses", "linodeID", instanceID)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSharedIP releases the reserved IPv4 address used as the control plane endpoint of the cluster, unless it
+// was reserved by the user rather than by CAPL.
+func DeleteSharedIP(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
+	address := clusterScope.LinodeCluster.Spec.Network.SharedIPv4
+	if address == "" {
+		logger.Info("Shared IP address already deleted, nothing to do")
+		return nil
+	}
+	if clusterScope.LinodeCluster.Annotations[infrav1alpha2.SharedIPReservedAnnotation] != address {
+		logger.Info("Shared IP address was not reserved by CAPL, keeping it", "address", address)
+		clusterScope.LinodeCluster.Spec.Network.SharedIPv4 = ""
+		return nil
+	}
+
+	if err := clusterScope.LinodeClient.DeleteReservedIPAddress(ctx, address); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+		logger.Error(err, "Failed to delete shared IP address")
+		return err
+	}
+	clusterScope.LinodeCluster.Spec.Network.SharedIPv4 = ""
+	delete(clusterScope.LinodeCluster.Annotations, infrav1alpha2.SharedIPReservedAnnotation)
+
+	return nil
+}
@@ -0,0 +1,563 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func TestEnsureSharedIP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		clusterScope  *scope.ClusterScope
+		expects       func(*mock.MockLinodeClient)
+		expectedIP    *linodego.InstanceIP
+		expectedError error
+		// expectedReserved is the address expected to be recorded as reserved by CAPL
+		expectedReserved string
+	}{
+		{
+			name: "Success - Get existing shared IP",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.InstanceIP{
+					Address: "172.0.0.10",
+				}, nil)
+			},
+			expectedIP: &linodego.InstanceIP{
+				Address: "172.0.0.10",
+			},
+		},
+		{
+			name: "Success - List reserved IPs returns an already reserved IP",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListReservedIPAddresses(gomock.Any(), gomock.Any()).Return([]linodego.InstanceIP{
+					{Address: "172.0.0.10", Reserved: true},
+				}, nil)
+			},
+			expectedIP: &linodego.InstanceIP{
+				Address:  "172.0.0.10",
+				Reserved: true,
+			},
+			expectedReserved: "172.0.0.10",
+		},
+		{
+			name: "Success - Reserve a new IP",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListReservedIPAddresses(gomock.Any(), gomock.Any()).Return([]linodego.InstanceIP{}, nil)
+				mockClient.EXPECT().ReserveIPAddress(gomock.Any(), linodego.ReserveIPOptions{
+					Region: "us-ord",
+					Tags:   []string{"test-uid"},
+				}).Return(&linodego.InstanceIP{
+					Address:  "172.0.0.10",
+					Reserved: true,
+				}, nil)
+			},
+			expectedIP: &linodego.InstanceIP{
+				Address:  "172.0.0.10",
+				Reserved: true,
+			},
+			expectedReserved: "172.0.0.10",
+		},
+		{
+			name: "Error - Get shared IP fails",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(nil, errors.New("unable to get IP address"))
+			},
+			expectedError: errors.New("unable to get IP address"),
+		},
+		{
+			name: "Error - Reserve IP fails",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListReservedIPAddresses(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockClient.EXPECT().ReserveIPAddress(gomock.Any(), gomock.Any()).Return(nil, errors.New("reserved IP limit reached"))
+			},
+			expectedError: errors.New("reserved IP limit reached"),
+		},
+		{
+			name: "Error - Reserve IP returns nil",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-cluster",
+						UID:  "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListReservedIPAddresses(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockClient.EXPECT().ReserveIPAddress(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedError: errors.New("reserved IP address was nil"),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			MockLinodeClient := mock.NewMockLinodeClient(ctrl)
+			testcase.clusterScope.LinodeClient = MockLinodeClient
+
+			testcase.expects(MockLinodeClient)
+
+			got, err := EnsureSharedIP(t.Context(), testcase.clusterScope, logr.Discard())
+			if testcase.expectedError != nil {
+				assert.ErrorContains(t, err, testcase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testcase.expectedIP, got)
+				assert.Equal(t, testcase.expectedReserved, testcase.clusterScope.LinodeCluster.Annotations[infrav1alpha2.SharedIPReservedAnnotation])
+			}
+		})
+	}
+}
+
+func TestEnsureSharedIPAssignments(t *testing.T) {
+	t.Parallel()
+
+	controlPlaneMachines := func(providerIDs ...string) infrav1alpha2.LinodeMachineList {
+		machines := infrav1alpha2.LinodeMachineList{}
+		for _, providerID := range providerIDs {
+			machines.Items = append(machines.Items, infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					ProviderID: ptr.To(providerID),
+				},
+			})
+		}
+		return machines
+	}
+
+	tests := []struct {
+		name          string
+		clusterScope  *scope.ClusterScope
+		expects       func(*mock.MockLinodeClient)
+		expectedError error
+	}{
+		{
+			name: "Success - Shared IP not yet reserved",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+						},
+					},
+				},
+				LinodeMachines: controlPlaneMachines("linode://123"),
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {},
+		},
+		{
+			name: "Success - No control plane instances yet",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+				LinodeMachines: infrav1alpha2.LinodeMachineList{
+					Items: []infrav1alpha2.LinodeMachine{{}},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {},
+		},
+		{
+			name: "Success - Assign the shared IP to the first instance and share it with the others",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+				LinodeMachines: controlPlaneMachines("linode://456", "linode://123"),
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.InstanceIP{
+					Address: "172.0.0.10",
+				}, nil)
+				mockClient.EXPECT().InstancesAssignIPs(gomock.Any(), linodego.LinodesAssignIPsOptions{
+					Region:      "us-ord",
+					Assignments: []linodego.LinodeIPAssignment{{Address: "172.0.0.10", LinodeID: 123}},
+				}).Return(nil)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 456).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Shared: []linodego.InstanceIP{{Address: "172.0.0.20"}},
+					},
+				}, nil)
+				mockClient.EXPECT().ShareIPAddresses(gomock.Any(), linodego.IPAddressesShareOptions{
+					LinodeID: 456,
+					IPs:      []string{"172.0.0.20", "172.0.0.10"},
+				}).Return(nil)
+			},
+		},
+		{
+			name: "Success - Shared IP already assigned and shared",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+				LinodeMachines: controlPlaneMachines("linode://123", "linode://456"),
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.InstanceIP{
+					Address:  "172.0.0.10",
+					LinodeID: 456,
+				}, nil)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Shared: []linodego.InstanceIP{{Address: "172.0.0.10"}},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - Stop sharing the shared IP with a deleted control plane instance",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+				LinodeMachines: infrav1alpha2.LinodeMachineList{
+					Items: []infrav1alpha2.LinodeMachine{
+						{
+							Spec: infrav1alpha2.LinodeMachineSpec{ProviderID: ptr.To("linode://123")},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: ptr.To(metav1.Now())},
+							Spec:       infrav1alpha2.LinodeMachineSpec{ProviderID: ptr.To("linode://456")},
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.InstanceIP{
+					Address:  "172.0.0.10",
+					LinodeID: 123,
+				}, nil)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 456).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Shared: []linodego.InstanceIP{{Address: "172.0.0.20"}, {Address: "172.0.0.10"}},
+					},
+				}, nil)
+				mockClient.EXPECT().ShareIPAddresses(gomock.Any(), linodego.IPAddressesShareOptions{
+					LinodeID: 456,
+					IPs:      []string{"172.0.0.20"},
+				}).Return(nil)
+			},
+		},
+		{
+			name: "Error - Assign shared IP fails",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+				LinodeMachines: controlPlaneMachines("linode://123"),
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.InstanceIP{
+					Address:  "172.0.0.10",
+					LinodeID: 999,
+				}, nil)
+				mockClient.EXPECT().InstancesAssignIPs(gomock.Any(), gomock.Any()).Return(errors.New("unable to assign IP"))
+			},
+			expectedError: errors.New("unable to assign IP"),
+		},
+		{
+			name: "Error - Share shared IP fails",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Region: "us-ord",
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+				LinodeMachines: controlPlaneMachines("linode://123", "linode://456"),
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.InstanceIP{
+					Address:  "172.0.0.10",
+					LinodeID: 123,
+				}, nil)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 456).Return(&linodego.InstanceIPAddressResponse{}, nil)
+				mockClient.EXPECT().ShareIPAddresses(gomock.Any(), gomock.Any()).Return(errors.New("unable to share IP"))
+			},
+			expectedError: errors.New("unable to share IP"),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			MockLinodeClient := mock.NewMockLinodeClient(ctrl)
+			testcase.clusterScope.LinodeClient = MockLinodeClient
+
+			testcase.expects(MockLinodeClient)
+
+			err := EnsureSharedIPAssignments(t.Context(), logr.Discard(), testcase.clusterScope)
+			if testcase.expectedError != nil {
+				assert.ErrorContains(t, err, testcase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteSharedIP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		clusterScope  *scope.ClusterScope
+		expects       func(*mock.MockLinodeClient)
+		expectedError error
+	}{
+		{
+			name: "Success - Delete shared IP",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{infrav1alpha2.SharedIPReservedAnnotation: "172.0.0.10"},
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().DeleteReservedIPAddress(gomock.Any(), "172.0.0.10").Return(nil)
+			},
+		},
+		{
+			name: "Success - Shared IP already deleted",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{infrav1alpha2.SharedIPReservedAnnotation: "172.0.0.10"},
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().DeleteReservedIPAddress(gomock.Any(), "172.0.0.10").Return(&linodego.Error{Code: http.StatusNotFound})
+			},
+		},
+		{
+			name: "Success - Keep the shared IP set by the user",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {},
+		},
+		{
+			name: "Success - Keep the shared IP set by the user in place of the reserved one",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{infrav1alpha2.SharedIPReservedAnnotation: "172.0.0.20"},
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {},
+		},
+		{
+			name: "Success - No shared IP",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {},
+		},
+		{
+			name: "Error - Delete shared IP fails",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{infrav1alpha2.SharedIPReservedAnnotation: "172.0.0.10"},
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: "sharedIP",
+							SharedIPv4:       "172.0.0.10",
+						},
+					},
+				},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().DeleteReservedIPAddress(gomock.Any(), "172.0.0.10").Return(errors.New("unable to delete IP"))
+			},
+			expectedError: errors.New("unable to delete IP"),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			MockLinodeClient := mock.NewMockLinodeClient(ctrl)
+			testcase.clusterScope.LinodeClient = MockLinodeClient
+
+			testcase.expects(MockLinodeClient)
+
+			err := DeleteSharedIP(t.Context(), logr.Discard(), testcase.clusterScope)
+			if testcase.expectedError != nil {
+				assert.ErrorContains(t, err, testcase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Empty(t, testcase.clusterScope.LinodeCluster.Spec.Network.SharedIPv4)
+			}
+		})
+	}
+}
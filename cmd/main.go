@@ -33,9 +33,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	kcpv1beta2 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	capi "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -44,10 +46,15 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	infrastructurev1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/feature"
 	"github.com/linode/cluster-api-provider-linode/internal/controller"
 	webhookinfrastructurev1alpha2 "github.com/linode/cluster-api-provider-linode/internal/webhook/v1alpha2"
 	"github.com/linode/cluster-api-provider-linode/observability/tracing"
+	"github.com/linode/cluster-api-provider-linode/observability/wrappers/linodeclient"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
 	"github.com/linode/cluster-api-provider-linode/version"
 
 	// +kubebuilder:scaffold:imports
@@ -90,6 +97,27 @@ type flagVars struct {
 	linodePlacementGroupConcurrency      int
 	linodeFirewallConcurrency            int
 	linodeMachineTemplateConcurrency     int
+	linodeVolumeConcurrency              int
+	linodeImageConcurrency               int
+	linodeMachineRemediationConcurrency  int
+	linodeInstanceSnapshotConcurrency    int
+	linodeMachinePoolConcurrency         int
+	enableEventPoller                    bool
+	eventPollInterval                    time.Duration
+	bootstrapDataEndpointAddr            string
+	bootstrapDataEndpointURL             string
+	bootstrapDataEndpointCertDir         string
+	bootstrapDataTokenTTL                time.Duration
+	gcMode                               string
+	gcInterval                           time.Duration
+	gcGracePeriod                        time.Duration
+	dryRun                               bool
+	enableCostEstimator                  bool
+	costEstimatorInterval                time.Duration
+	objectStoragePricePerGB              float64
+	backendHealthInterval                time.Duration
+	managementClusterID                  string
+	instanceLimit                        int
 }
 
 func init() {
@@ -156,6 +184,39 @@ func parseFlags() (flags flagVars, opts zap.Options) {
 	flag.IntVar(&flags.linodePlacementGroupConcurrency, "linodeplacementgroup-concurrency", concurrencyDefault, "Number of Linode Placement Groups to process simultaneously")
 	flag.IntVar(&flags.linodeFirewallConcurrency, "linodefirewall-concurrency", concurrencyDefault, "Number of Linode Firewall to process simultaneously")
 	flag.IntVar(&flags.linodeMachineTemplateConcurrency, "linodemachinetemplate-concurrency", concurrencyDefault, "Number of LinodeMachineTemplates to process simultaneously")
+	flag.IntVar(&flags.linodeVolumeConcurrency, "linodevolume-concurrency", concurrencyDefault, "Number of LinodeVolumes to process simultaneously")
+	flag.IntVar(&flags.linodeImageConcurrency, "linodeimage-concurrency", concurrencyDefault, "Number of LinodeImages to process simultaneously")
+	flag.IntVar(&flags.linodeMachineRemediationConcurrency, "linodemachineremediation-concurrency", concurrencyDefault, "Number of LinodeMachineRemediations to process simultaneously")
+	flag.IntVar(&flags.linodeInstanceSnapshotConcurrency, "linodeinstancesnapshot-concurrency", concurrencyDefault, "Number of LinodeInstanceSnapshots and LinodeInstanceSnapshotSchedules to process simultaneously")
+	flag.IntVar(&flags.linodeMachinePoolConcurrency, "linodemachinepool-concurrency", concurrencyDefault, "Number of LinodeMachinePools to process simultaneously")
+	flag.BoolVar(&flags.enableEventPoller, "enable-event-poller", false, "Drive reconciles of LinodeMachines, LinodeClusters and LinodeFirewalls from the Linode Events API")
+	flag.DurationVar(&flags.eventPollInterval, "event-poll-interval", reconciler.DefaultEventPollerInterval, "The interval between two polls of the Linode Events API")
+	flag.StringVar(&flags.bootstrapDataEndpointAddr, "bootstrap-data-endpoint-bind-address", "", "The address the bootstrap data endpoint binds to. "+
+		"Leave empty to disable the bootstrap data endpoint.")
+	flag.StringVar(&flags.bootstrapDataEndpointURL, "bootstrap-data-endpoint-url", "", "The HTTPS base URL instances reach the bootstrap data endpoint at, "+
+		"through the VPC or the public addresses of the manager.")
+	flag.StringVar(&flags.bootstrapDataEndpointCertDir, "bootstrap-data-endpoint-cert-dir", "/tmp/k8s-bootstrap-data-endpoint/serving-certs",
+		"The directory containing the tls.crt and tls.key serving certificate of the bootstrap data endpoint.")
+	flag.DurationVar(&flags.bootstrapDataTokenTTL, "bootstrap-data-token-ttl", reconciler.DefaultBootstrapDataTokenTTL, "The duration after which an unused token of the bootstrap data endpoint expires")
+	flag.StringVar(&flags.gcMode, "gc-mode", "", "The policy for orphaned cloud resources: report them, or delete them once they stayed "+
+		"orphaned for the grace period. Disabled when empty.")
+	flag.DurationVar(&flags.gcInterval, "gc-interval", reconciler.DefaultGarbageCollectorInterval, "The interval between two collections of orphaned cloud resources")
+	flag.DurationVar(&flags.gcGracePeriod, "gc-grace-period", 0, "The duration a cloud resource must stay orphaned before it is deleted, required by --gc-mode=delete")
+	flag.BoolVar(&flags.dryRun, "dry-run", false, "Intercept the Linode, Object Storage and DNS API calls mutating resources, which are only logged and recorded as Events, "+
+		"and only validate the writes of the objects on behalf of which resources would be created or deleted without persisting them.")
+	flag.BoolVar(&flags.enableCostEstimator, "enable-cost-estimator", false, "Estimate the cost of LinodeClusters and compare it with their monthly budget")
+	flag.DurationVar(&flags.costEstimatorInterval, "cost-estimator-interval", reconciler.DefaultCostEstimatorInterval, "The interval between two estimates of the cost of a LinodeCluster")
+	flag.Float64Var(&flags.objectStoragePricePerGB, "object-storage-price-per-gb", reconciler.DefaultObjectStoragePricePerGB,
+		"The monthly price in US dollars of a GB stored in Object Storage, used to estimate the cost of LinodeClusters")
+	flag.DurationVar(&flags.backendHealthInterval, "nodebalancer-backend-health-interval", reconciler.DefaultNodeBalancerBackendHealthInterval,
+		"The interval between two polls of the health of the NodeBalancer backends of a LinodeCluster")
+	flag.StringVar(&flags.managementClusterID, "management-cluster-id", "",
+		"The ID of the management cluster written in the DNS ownership records of LinodeClusters and in the owner tags of the cloud resources "+
+			"the garbage collector may delete, defaults to the UID of the kube-system Namespace. Required by --gc-mode=delete.")
+	flag.IntVar(&flags.instanceLimit, "instance-limit", 0,
+		"The maximum number of Linodes of the account, checked before creating the instance of a LinodeMachine. 0 disables the check")
+	flag.Func("feature-gates", "A set of key=value pairs that describe feature gates for alpha/experimental features. Options are:\n"+
+		strings.Join(feature.MutableGates.KnownFeatures(), "\n"), feature.MutableGates.Set)
 	opts = zap.Options{Development: true}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
@@ -224,12 +285,27 @@ func setupManager(flags flagVars, linodeConfig, dnsConfig scope.ClientConfig) ma
 		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
 	}
 
+	var dryRunObjects *linodeclient.DryRunObjects
+	var newClient client.NewClientFunc
+	if flags.dryRun {
+		// Only the objects whose state relies on synthetic results of the intercepted calls are not persisted.
+		dryRunObjects = linodeclient.NewDryRunObjects()
+		newClient = func(config *rest.Config, options client.Options) (client.Client, error) {
+			c, err := client.NewWithWatch(config, options)
+			if err != nil {
+				return nil, err
+			}
+			return dryRunObjects.Client(c), nil
+		}
+	}
+
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		HealthProbeBindAddress: flags.probeAddr,
 		LeaderElection:         flags.enableLeaderElection,
 		LeaderElectionID:       "3cfd31c3.cluster.x-k8s.io",
+		NewClient:              newClient,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to create manager")
@@ -242,6 +318,14 @@ func setupManager(flags flagVars, linodeConfig, dnsConfig scope.ClientConfig) ma
 		os.Exit(1)
 	}
 
+	if flags.dryRun {
+		setupLog.Info("Running in dry-run mode, Linode resources will not be modified")
+		linodeConfig.DryRun, dnsConfig.DryRun = true, true
+		linodeConfig.DryRunRecorder = mgr.GetEventRecorder("LinodeDryRun")
+		dnsConfig.DryRunRecorder = linodeConfig.DryRunRecorder
+		linodeConfig.DryRunObjects, dnsConfig.DryRunObjects = dryRunObjects, dryRunObjects
+	}
+
 	setupControllers(mgr, flags, linodeConfig, dnsConfig)
 
 	// Setup webhooks if enabled (defaults to true)
@@ -264,6 +348,19 @@ func setupManager(flags flagVars, linodeConfig, dnsConfig scope.ClientConfig) ma
 	return mgr
 }
 
+// getManagementClusterID returns the ID of the management cluster set by --management-cluster-id, defaulting to the UID
+// of the kube-system Namespace once a feature needs it.
+func getManagementClusterID(mgr manager.Manager, flags flagVars) *controller.ManagementClusterID {
+	if len(flags.managementClusterID) > util.MaxManagementClusterIDLength {
+		setupLog.Error(fmt.Errorf("--management-cluster-id must be at most %d characters long", util.MaxManagementClusterIDLength),
+			"unable to get the ID of the management cluster")
+		os.Exit(1)
+	}
+
+	// The cache of the manager is not started yet when the garbage collector needs the ID
+	return controller.NewManagementClusterID(mgr.GetAPIReader(), flags.managementClusterID)
+}
+
 // setupHealthChecks adds health and readiness checks to the manager.
 // It registers a health check at the "healthz" endpoint and a readiness check at the "readyz" endpoint.
 func setupHealthChecks(mgr manager.Manager) {
@@ -280,13 +377,94 @@ func setupHealthChecks(mgr manager.Manager) {
 // setupControllers initializes and registers various controllers with the manager.
 // It sets up controllers for Linode resources, configuring each with the appropriate client and options.
 func setupControllers(mgr manager.Manager, flags flagVars, linodeClientConfig, dnsConfig scope.ClientConfig) {
+	// Linode Events poller
+	var eventPoller *controller.EventPoller
+	if flags.enableEventPoller {
+		eventPoller = controller.NewEventPoller(mgr.GetClient(), mgr.GetEventRecorder("LinodeEventPoller"), flags.eventPollInterval)
+		eventPoller.MachineWatchFilterValue = flags.machineWatchFilter
+		eventPoller.ClusterWatchFilterValue = flags.clusterWatchFilter
+		if err := mgr.Add(eventPoller); err != nil {
+			setupLog.Error(err, "unable to create event poller")
+			os.Exit(1)
+		}
+	}
+
+	managementClusterID := getManagementClusterID(mgr, flags)
+	// The owner tags of the cloud resources are only needed by the garbage collector
+	ownerTagsID := flags.managementClusterID
+
+	// Garbage collector of orphaned cloud resources
+	var garbageCollector *controller.GarbageCollector
+	if flags.gcMode != "" {
+		mode := controller.GCMode(flags.gcMode)
+		switch {
+		case mode != controller.GCModeReport && mode != controller.GCModeDelete:
+			setupLog.Error(fmt.Errorf("unsupported --gc-mode %q", flags.gcMode), "unable to create garbage collector")
+			os.Exit(1)
+		case mode == controller.GCModeDelete && flags.gcGracePeriod <= 0:
+			setupLog.Error(errors.New("--gc-grace-period is required by --gc-mode=delete"), "unable to create garbage collector")
+			os.Exit(1)
+		case mode == controller.GCModeDelete && flags.managementClusterID == "":
+			// The default ID is the UID of the kube-system Namespace, which changes with the management cluster
+			setupLog.Error(errors.New("--management-cluster-id is required by --gc-mode=delete"), "unable to create garbage collector")
+			os.Exit(1)
+		}
+		id, err := managementClusterID.Get(context.Background())
+		if err != nil {
+			setupLog.Error(err, "unable to get the ID of the management cluster, set --management-cluster-id")
+			os.Exit(1)
+		}
+		ownerTagsID = id
+		garbageCollector = controller.NewGarbageCollector(mgr.GetClient(), mgr.GetEventRecorder("LinodeGarbageCollector"),
+			flags.gcInterval, mode, flags.gcGracePeriod, ownerTagsID)
+		// Orphaned resources are deleted through the Linode clients of the reconcilers, which intercept the deletions
+		// in dry-run mode just like the S3 clients of the collector.
+		garbageCollector.S3Clients = garbageCollector.S3Clients.WithDryRun(linodeClientConfig.DryRunInterceptor(nil))
+		if err := mgr.Add(garbageCollector); err != nil {
+			setupLog.Error(err, "unable to create garbage collector")
+			os.Exit(1)
+		}
+	}
+
+	// Bootstrap data endpoint
+	var bootstrapDataEndpoint clients.BootstrapDataEndpoint
+	if flags.bootstrapDataEndpointAddr != "" {
+		if flags.bootstrapDataEndpointURL == "" {
+			setupLog.Error(errors.New("--bootstrap-data-endpoint-url is required"), "unable to create bootstrap data endpoint")
+			os.Exit(1)
+		}
+		if flags.bootstrapDataTokenTTL <= 0 {
+			setupLog.Error(errors.New("--bootstrap-data-token-ttl must be positive"), "unable to create bootstrap data endpoint")
+			os.Exit(1)
+		}
+		endpoint := controller.NewBootstrapDataEndpoint(mgr.GetClient(), flags.bootstrapDataEndpointAddr, flags.bootstrapDataEndpointURL,
+			flags.bootstrapDataEndpointCertDir, flags.bootstrapDataTokenTTL)
+		if err := mgr.Add(endpoint); err != nil {
+			setupLog.Error(err, "unable to create bootstrap data endpoint")
+			os.Exit(1)
+		}
+		bootstrapDataEndpoint = endpoint
+	}
+
+	// Cost estimator of clusters
+	var costEstimator *controller.CostEstimator
+	if flags.enableCostEstimator {
+		costEstimator = controller.NewCostEstimator(flags.costEstimatorInterval, flags.objectStoragePricePerGB)
+	}
+
 	// LinodeCluster Controller
 	if err := (&controller.LinodeClusterReconciler{
-		Client:             mgr.GetClient(),
-		Recorder:           mgr.GetEventRecorder("LinodeClusterReconciler"),
-		WatchFilterValue:   flags.clusterWatchFilter,
-		LinodeClientConfig: linodeClientConfig,
-		DnsClientConfig:    dnsConfig,
+		Client:                mgr.GetClient(),
+		Recorder:              mgr.GetEventRecorder("LinodeClusterReconciler"),
+		WatchFilterValue:      flags.clusterWatchFilter,
+		LinodeClientConfig:    linodeClientConfig,
+		DnsClientConfig:       dnsConfig,
+		EventPoller:           eventPoller,
+		GarbageCollector:      garbageCollector,
+		CostEstimator:         costEstimator,
+		BackendHealthInterval: flags.backendHealthInterval,
+		ManagementClusterID:   ownerTagsID,
+		DNSOwnerID:            managementClusterID,
 	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeClusterConcurrency}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LinodeCluster")
 		os.Exit(1)
@@ -303,6 +481,11 @@ func setupControllers(mgr manager.Manager, flags flagVars, linodeClientConfig, d
 		Recorder:               mgr.GetEventRecorder("LinodeMachineReconciler"),
 		WatchFilterValue:       flags.machineWatchFilter,
 		LinodeClientConfig:     linodeClientConfig,
+		EventPoller:            eventPoller,
+		GarbageCollector:       garbageCollector,
+		BootstrapDataEndpoint:  bootstrapDataEndpoint,
+		ManagementClusterID:    ownerTagsID,
+		InstanceLimit:          flags.instanceLimit,
 		GzipCompressionEnabled: useGzip,
 	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeMachineConcurrency}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LinodeMachine")
@@ -311,10 +494,12 @@ func setupControllers(mgr manager.Manager, flags flagVars, linodeClientConfig, d
 
 	// LinodeVPC Controller
 	if err := (&controller.LinodeVPCReconciler{
-		Client:             mgr.GetClient(),
-		Recorder:           mgr.GetEventRecorder("LinodeVPCReconciler"),
-		LinodeClientConfig: linodeClientConfig,
-		WatchFilterValue:   flags.clusterWatchFilter,
+		Client:              mgr.GetClient(),
+		Recorder:            mgr.GetEventRecorder("LinodeVPCReconciler"),
+		LinodeClientConfig:  linodeClientConfig,
+		WatchFilterValue:    flags.clusterWatchFilter,
+		GarbageCollector:    garbageCollector,
+		ManagementClusterID: ownerTagsID,
 	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeVPCConcurrency}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LinodeVPC")
 		os.Exit(1)
@@ -357,10 +542,13 @@ func setupControllers(mgr manager.Manager, flags flagVars, linodeClientConfig, d
 
 	// LinodeFirewall Controller
 	if err := (&controller.LinodeFirewallReconciler{
-		Client:             mgr.GetClient(),
-		Recorder:           mgr.GetEventRecorder("LinodeFirewallReconciler"),
-		WatchFilterValue:   flags.clusterWatchFilter,
-		LinodeClientConfig: linodeClientConfig,
+		Client:              mgr.GetClient(),
+		Recorder:            mgr.GetEventRecorder("LinodeFirewallReconciler"),
+		WatchFilterValue:    flags.clusterWatchFilter,
+		LinodeClientConfig:  linodeClientConfig,
+		EventPoller:         eventPoller,
+		GarbageCollector:    garbageCollector,
+		ManagementClusterID: ownerTagsID,
 	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeFirewallConcurrency}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "LinodeFirewall")
 		os.Exit(1)
@@ -374,6 +562,74 @@ func setupControllers(mgr manager.Manager, flags flagVars, linodeClientConfig, d
 		setupLog.Error(err, "unable to create controller", "controller", "LinodeMachineTemplate")
 		os.Exit(1)
 	}
+
+	// LinodeVolume Controller
+	if err := (&controller.LinodeVolumeReconciler{
+		Client:             mgr.GetClient(),
+		Recorder:           mgr.GetEventRecorder("LinodeVolumeReconciler"),
+		WatchFilterValue:   flags.clusterWatchFilter,
+		LinodeClientConfig: linodeClientConfig,
+	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeVolumeConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LinodeVolume")
+		os.Exit(1)
+	}
+
+	// LinodeImage Controller
+	if err := (&controller.LinodeImageReconciler{
+		Client:             mgr.GetClient(),
+		Recorder:           mgr.GetEventRecorder("LinodeImageReconciler"),
+		WatchFilterValue:   flags.clusterWatchFilter,
+		LinodeClientConfig: linodeClientConfig,
+	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeImageConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LinodeImage")
+		os.Exit(1)
+	}
+
+	// LinodeMachineRemediation Controller
+	if err := (&controller.LinodeMachineRemediationReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorder("LinodeMachineRemediationReconciler"),
+		WatchFilterValue: flags.clusterWatchFilter,
+	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeMachineRemediationConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LinodeMachineRemediation")
+		os.Exit(1)
+	}
+
+	// LinodeInstanceSnapshot Controller
+	if err := (&controller.LinodeInstanceSnapshotReconciler{
+		Client:             mgr.GetClient(),
+		Recorder:           mgr.GetEventRecorder("LinodeInstanceSnapshotReconciler"),
+		WatchFilterValue:   flags.clusterWatchFilter,
+		LinodeClientConfig: linodeClientConfig,
+	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeInstanceSnapshotConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LinodeInstanceSnapshot")
+		os.Exit(1)
+	}
+
+	// LinodeInstanceSnapshotSchedule Controller
+	if err := (&controller.LinodeInstanceSnapshotScheduleReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorder("LinodeInstanceSnapshotScheduleReconciler"),
+		WatchFilterValue: flags.clusterWatchFilter,
+	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeInstanceSnapshotConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LinodeInstanceSnapshotSchedule")
+		os.Exit(1)
+	}
+
+	// LinodeMachinePool Controller
+	if err := (&controller.LinodeMachinePoolReconciler{
+		Client:                 mgr.GetClient(),
+		APIReader:              mgr.GetAPIReader(),
+		Recorder:               mgr.GetEventRecorder("LinodeMachinePoolReconciler"),
+		WatchFilterValue:       flags.machineWatchFilter,
+		LinodeClientConfig:     linodeClientConfig,
+		BootstrapDataEndpoint:  bootstrapDataEndpoint,
+		ManagementClusterID:    ownerTagsID,
+		GzipCompressionEnabled: useGzip,
+	}).SetupWithManager(mgr, crcontroller.Options{MaxConcurrentReconciles: flags.linodeMachinePoolConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LinodeMachinePool")
+		os.Exit(1)
+	}
 }
 
 // setupWebhooks initializes webhooks for the specified resources in the manager.
@@ -416,6 +672,14 @@ func setupWebhooks(mgr manager.Manager) {
 		setupLog.Error(err, "unable to create webhook", "webhook", "LinodeFirewall")
 		os.Exit(1)
 	}
+	if err = webhookinfrastructurev1alpha2.SetupLinodeVolumeWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "LinodeVolume")
+		os.Exit(1)
+	}
+	if err = webhookinfrastructurev1alpha2.SetupLinodeImageWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "LinodeImage")
+		os.Exit(1)
+	}
 }
 
 // setup configures observability features and returns a cleanup function.
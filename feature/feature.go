@@ -0,0 +1,44 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// LegacyInterfaceUpdates enables changing the legacy configuration interfaces of running LinodeMachines.
+	LegacyInterfaceUpdates featuregate.Feature = "LegacyInterfaceUpdates"
+)
+
+var (
+	// MutableGates is a mutable version of Gates. Only the manager should set it, from the --feature-gates flag.
+	MutableGates featuregate.MutableFeatureGate = featuregate.NewFeatureGate()
+
+	// Gates reports whether the features of CAPL are enabled.
+	Gates featuregate.FeatureGate = MutableGates
+)
+
+// defaultFeatureGates are the features of CAPL, with their default state and maturity.
+var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	LegacyInterfaceUpdates: {Default: false, PreRelease: featuregate.Alpha},
+}
+
+func init() {
+	runtime.Must(MutableGates.Add(defaultFeatureGates))
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+const (
+	// bootstrapDataEndpointPath is the path the bootstrap data is served at, followed by the namespace and name of the
+	// machine and its token.
+	bootstrapDataEndpointPath = "/bootstrap-data/"
+	// bootstrapDataTokenBytes is the number of random bytes of a token.
+	bootstrapDataTokenBytes = 32
+	// bootstrapDataEndpointReadHeaderTimeout is the time allowed to read the headers of a request.
+	bootstrapDataEndpointReadHeaderTimeout = 10 * time.Second
+	// bootstrapDataEndpointShutdownTimeout is the time given to in-flight requests when the manager stops.
+	bootstrapDataEndpointShutdownTimeout = 5 * time.Second
+
+	// bootstrapDataSecretSuffix is appended to the name of a machine to name the Secret of its bootstrap data.
+	bootstrapDataSecretSuffix = "-bootstrap-data"
+	// bootstrapDataSecretValueKey is the key of the bootstrap data in its Secret.
+	bootstrapDataSecretValueKey = "value"
+	// bootstrapDataSecretTokenHashKey is the key of the hash of the token in the Secret of the bootstrap data.
+	bootstrapDataSecretTokenHashKey = "tokenHash"
+	// bootstrapDataExpiresAnnotation records on the Secret of the bootstrap data when its token expires.
+	bootstrapDataExpiresAnnotation = "infrastructure.cluster.x-k8s.io/bootstrap-data-expires"
+	// bootstrapDataSecretLabel marks the Secrets of bootstrap data stored by CAPL, with the name of the machine as value.
+	bootstrapDataSecretLabel = "infrastructure.cluster.x-k8s.io/bootstrap-data"
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// BootstrapDataEndpoint serves the bootstrap data of machines over HTTPS, as an alternative to the Cluster Object
+// Store. The bootstrap data is kept in a Secret next to the machine and served to the holder of a random per-machine
+// token, so that it survives restarts of the manager and can be served by any of its replicas. The bootstrap data is
+// served once: the token is revoked after its first fetch, when the bootstrap data is revoked, or TokenTTL after the
+// bootstrap data was stored.
+type BootstrapDataEndpoint struct {
+	// BindAddress is the address the endpoint listens on.
+	BindAddress string
+	// URL is the base URL instances reach the endpoint at, through the VPC or the public addresses of the manager.
+	URL string
+	// CertDir contains the tls.crt and tls.key serving certificate of the endpoint.
+	CertDir string
+	// TokenTTL is the duration after which bootstrap data that was never fetched can't be fetched anymore.
+	TokenTTL time.Duration
+	// Client stores the bootstrap data in Secrets.
+	Client clients.K8sClient
+
+	now func() time.Time
+}
+
+// NewBootstrapDataEndpoint returns a BootstrapDataEndpoint which must be added to the manager to serve bootstrap data.
+func NewBootstrapDataEndpoint(k8sClient clients.K8sClient, bindAddress, url, certDir string, tokenTTL time.Duration) *BootstrapDataEndpoint {
+	return &BootstrapDataEndpoint{
+		BindAddress: bindAddress,
+		URL:         strings.TrimSuffix(url, "/"),
+		CertDir:     certDir,
+		TokenTTL:    tokenTTL,
+		Client:      k8sClient,
+		now:         time.Now,
+	}
+}
+
+// bootstrapDataSecretKey returns the key of the Secret of the bootstrap data of a machine.
+func bootstrapDataSecretKey(namespace, name string) client.ObjectKey {
+	return client.ObjectKey{Namespace: namespace, Name: name + bootstrapDataSecretSuffix}
+}
+
+// Store makes the bootstrap data of a machine available until it is fetched or revoked, replacing any bootstrap data
+// previously stored for it, and returns the URL to fetch it from. The Secret of the bootstrap data is owned by the
+// machine, a Secret with the same name which wasn't stored for the machine is never overwritten.
+func (e *BootstrapDataEndpoint) Store(ctx context.Context, machine client.Object, data []byte) (string, error) {
+	raw := make([]byte, bootstrapDataTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate bootstrap data token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	key := bootstrapDataSecretKey(machine.GetNamespace(), machine.GetName())
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, e.Client, secret, func() error {
+		if secret.ResourceVersion != "" && (secret.Labels[bootstrapDataSecretLabel] != machine.GetName() || !metav1.IsControlledBy(secret, machine)) {
+			return errors.New("secret exists and was not stored for the machine")
+		}
+		if secret.Labels == nil {
+			secret.Labels = make(map[string]string)
+		}
+		secret.Labels[bootstrapDataSecretLabel] = machine.GetName()
+		if err := controllerutil.SetControllerReference(machine, secret, e.Client.Scheme()); err != nil {
+			return err
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[bootstrapDataExpiresAnnotation] = e.now().Add(e.TokenTTL).UTC().Format(time.RFC3339)
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			bootstrapDataSecretValueKey:     data,
+			bootstrapDataSecretTokenHashKey: []byte(scope.GetHash(token)),
+		}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("store bootstrap data secret %s: %w", key, err)
+	}
+
+	return e.URL + bootstrapDataEndpointPath + key.Namespace + "/" + machine.GetName() + "/" + token, nil
+}
+
+// Revoke makes the bootstrap data of a machine unavailable. A Secret with the same name which wasn't stored for the
+// machine is left alone.
+func (e *BootstrapDataEndpoint) Revoke(ctx context.Context, machine client.Object) error {
+	key := bootstrapDataSecretKey(machine.GetNamespace(), machine.GetName())
+	secret := &corev1.Secret{}
+	if err := e.Client.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("get bootstrap data secret %s: %w", key, err)
+	}
+	if secret.Labels[bootstrapDataSecretLabel] != machine.GetName() || !metav1.IsControlledBy(secret, machine) {
+		return nil
+	}
+	if err := e.Client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete bootstrap data secret %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// fetch returns the bootstrap data of a machine and revokes its token. It returns false if the token is unknown,
+// expired or was already used.
+func (e *BootstrapDataEndpoint) fetch(ctx context.Context, key client.ObjectKey, token string) ([]byte, bool, error) {
+	secret := &corev1.Secret{}
+	if err := e.Client.Get(ctx, bootstrapDataSecretKey(key.Namespace, key.Name), secret); err != nil {
+		return nil, false, client.IgnoreNotFound(err)
+	}
+	if secret.Labels[bootstrapDataSecretLabel] != key.Name ||
+		subtle.ConstantTimeCompare(secret.Data[bootstrapDataSecretTokenHashKey], []byte(scope.GetHash(token))) != 1 {
+		return nil, false, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[bootstrapDataExpiresAnnotation])
+	if err != nil || !e.now().Before(expiresAt) {
+		return nil, false, nil
+	}
+
+	// Only the replica deleting this version of the Secret serves the bootstrap data, so that it is served once even
+	// when concurrent requests reach different replicas.
+	if err := e.Client.Delete(ctx, secret, client.Preconditions{UID: &secret.UID, ResourceVersion: &secret.ResourceVersion}); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("revoke bootstrap data token: %w", err)
+	}
+
+	return secret.Data[bootstrapDataSecretValueKey], true, nil
+}
+
+// ServeHTTP serves the bootstrap data of the machine and token in the request path.
+func (e *BootstrapDataEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logf.FromContext(r.Context()).WithName("BootstrapDataEndpoint")
+
+	var (
+		key  client.ObjectKey
+		data []byte
+		ok   bool
+		err  error
+	)
+	if parts := strings.Split(strings.TrimPrefix(r.URL.Path, bootstrapDataEndpointPath), "/"); len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		key = client.ObjectKey{Namespace: parts[0], Name: parts[1]}
+		data, ok, err = e.fetch(r.Context(), key, parts[2])
+	}
+	if err != nil {
+		logger.Error(err, "Failed to fetch bootstrap data", "machine", key, "remoteAddr", r.RemoteAddr)
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	if !ok {
+		logger.Info("Refused bootstrap data request with an unknown, used or expired token", "remoteAddr", r.RemoteAddr)
+		http.NotFound(w, r)
+		return
+	}
+
+	logger.Info("Serving bootstrap data", "machine", key, "remoteAddr", r.RemoteAddr)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err := w.Write(data); err != nil {
+		logger.Error(err, "Failed to serve bootstrap data", "machine", key)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. The bootstrap data is stored in Secrets, so the
+// endpoint is served by every replica of the manager and the Service in front of it can route to any of them.
+func (e *BootstrapDataEndpoint) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the bootstrap data until the context is done.
+func (e *BootstrapDataEndpoint) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("BootstrapDataEndpoint")
+
+	watcher, err := certwatcher.New(filepath.Join(e.CertDir, "tls.crt"), filepath.Join(e.CertDir, "tls.key"))
+	if err != nil {
+		return fmt.Errorf("load bootstrap data endpoint certificate: %w", err)
+	}
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			logger.Error(err, "Failed to watch the bootstrap data endpoint certificate")
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle(http.MethodGet+" "+bootstrapDataEndpointPath, e)
+	server := &http.Server{
+		Addr:              e.BindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: bootstrapDataEndpointReadHeaderTimeout,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: watcher.GetCertificate,
+		},
+		BaseContext: func(_ net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), bootstrapDataEndpointShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err, "Failed to shut down the bootstrap data endpoint")
+		}
+	}()
+
+	logger.Info("Serving bootstrap data", "address", e.BindAddress, "url", e.URL)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serve bootstrap data: %w", err)
+	}
+
+	return nil
+}
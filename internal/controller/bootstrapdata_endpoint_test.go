@@ -0,0 +1,342 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func fetchBootstrapData(endpoint *BootstrapDataEndpoint, url string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	endpoint.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+	return recorder
+}
+
+// expectBootstrapDataSecret expects the Secret of the bootstrap data of the test machine to be read.
+func expectBootstrapDataSecret(k8sClient *mock.MockK8sClient, secret *corev1.Secret) {
+	k8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "default", Name: "machine-bootstrap-data"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+			if secret == nil {
+				return apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "machine-bootstrap-data")
+			}
+			secret.DeepCopyInto(obj)
+			return nil
+		})
+}
+
+// bootstrapDataTestMachine returns the test machine owning the Secret of the bootstrap data.
+func bootstrapDataTestMachine() *infrav1alpha2.LinodeMachine {
+	return &infrav1alpha2.LinodeMachine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "machine", UID: "machine-uid"},
+	}
+}
+
+// bootstrapDataTestOwnerReferences returns the owner references of the Secret of the bootstrap data of the test machine.
+func bootstrapDataTestOwnerReferences() []metav1.OwnerReference {
+	return []metav1.OwnerReference{{
+		APIVersion:         infrav1alpha2.GroupVersion.String(),
+		Kind:               "LinodeMachine",
+		Name:               "machine",
+		UID:                "machine-uid",
+		Controller:         ptr.To(true),
+		BlockOwnerDeletion: ptr.To(true),
+	}}
+}
+
+func TestBootstrapDataEndpointStore(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, infrav1alpha2.AddToScheme(scheme))
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		existing      *corev1.Secret
+		expectedError string
+	}{
+		{
+			name: "Success - bootstrap data secret is created",
+		},
+		{
+			name: "Success - bootstrap data previously stored for the machine is replaced",
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       "default",
+					Name:            "machine-bootstrap-data",
+					ResourceVersion: "1",
+					Labels:          map[string]string{bootstrapDataSecretLabel: "machine"},
+					Annotations:     map[string]string{bootstrapDataExpiresAnnotation: "2024-12-31T23:30:00Z"},
+					OwnerReferences: bootstrapDataTestOwnerReferences(),
+				},
+				Data: map[string][]byte{bootstrapDataSecretValueKey: []byte("previous")},
+			},
+		},
+		{
+			name: "Error - secret with the same name was not stored for the machine",
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       "default",
+					Name:            "machine-bootstrap-data",
+					ResourceVersion: "1",
+				},
+				Data: map[string][]byte{"password": []byte("secret")},
+			},
+			expectedError: "secret exists and was not stored for the machine",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var stored *corev1.Secret
+			k8sClient := mock.NewMockK8sClient(ctrl)
+			k8sClient.EXPECT().Scheme().Return(scheme).AnyTimes()
+			expectBootstrapDataSecret(k8sClient, testcase.existing)
+			switch {
+			case testcase.expectedError != "":
+			case testcase.existing == nil:
+				k8sClient.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj *corev1.Secret, _ ...client.CreateOption) error {
+					stored = obj
+					return nil
+				})
+			default:
+				k8sClient.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj *corev1.Secret, _ ...client.UpdateOption) error {
+					stored = obj
+					return nil
+				})
+			}
+
+			endpoint := NewBootstrapDataEndpoint(k8sClient, ":9444", "https://10.0.0.1:9444/", "", time.Minute)
+			endpoint.now = func() time.Time { return now }
+			url, err := endpoint.Store(t.Context(), bootstrapDataTestMachine(), []byte("bootstrap-data"))
+			if testcase.expectedError != "" {
+				require.ErrorContains(t, err, testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			prefix := "https://10.0.0.1:9444" + bootstrapDataEndpointPath + "default/machine/"
+			require.True(t, strings.HasPrefix(url, prefix))
+			require.NotNil(t, stored)
+			assert.Equal(t, []byte("bootstrap-data"), stored.Data[bootstrapDataSecretValueKey])
+			assert.Equal(t, []byte(scope.GetHash(strings.TrimPrefix(url, prefix))), stored.Data[bootstrapDataSecretTokenHashKey])
+			assert.Equal(t, "2025-01-01T00:01:00Z", stored.Annotations[bootstrapDataExpiresAnnotation])
+			assert.Equal(t, "machine", stored.Labels[bootstrapDataSecretLabel])
+			assert.Equal(t, bootstrapDataTestOwnerReferences(), stored.OwnerReferences)
+		})
+	}
+}
+
+func TestBootstrapDataEndpointRevoke(t *testing.T) {
+	t.Parallel()
+
+	stored := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            "machine-bootstrap-data",
+			Labels:          map[string]string{bootstrapDataSecretLabel: "machine"},
+			OwnerReferences: bootstrapDataTestOwnerReferences(),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		existing      *corev1.Secret
+		deleteErr     error
+		expectDelete  bool
+		expectedError string
+	}{
+		{
+			name:         "Success - bootstrap data secret is deleted",
+			existing:     stored,
+			expectDelete: true,
+		},
+		{
+			name: "Success - bootstrap data secret is already gone",
+		},
+		{
+			name:         "Success - bootstrap data secret is gone before it is deleted",
+			existing:     stored,
+			deleteErr:    apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "machine-bootstrap-data"),
+			expectDelete: true,
+		},
+		{
+			name: "Success - secret with the same name is left alone",
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "machine-bootstrap-data"},
+			},
+		},
+		{
+			name:          "Error - bootstrap data secret can't be deleted",
+			existing:      stored,
+			deleteErr:     errors.New("fail"),
+			expectDelete:  true,
+			expectedError: "delete bootstrap data secret default/machine-bootstrap-data: fail",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			k8sClient := mock.NewMockK8sClient(ctrl)
+			expectBootstrapDataSecret(k8sClient, testcase.existing)
+			if testcase.expectDelete {
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(testcase.deleteErr)
+			}
+
+			endpoint := NewBootstrapDataEndpoint(k8sClient, ":9444", "https://10.0.0.1:9444", "", time.Minute)
+			err := endpoint.Revoke(t.Context(), bootstrapDataTestMachine())
+			if testcase.expectedError != "" {
+				require.ErrorContains(t, err, testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBootstrapDataEndpointServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	url := "https://10.0.0.1:9444" + bootstrapDataEndpointPath + "default/machine/token"
+	secret := func(expires string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "default",
+				Name:            "machine-bootstrap-data",
+				UID:             "secret-uid",
+				ResourceVersion: "1",
+				Labels:          map[string]string{bootstrapDataSecretLabel: "machine"},
+				Annotations:     map[string]string{bootstrapDataExpiresAnnotation: expires},
+			},
+			Data: map[string][]byte{
+				bootstrapDataSecretValueKey:     []byte("bootstrap-data"),
+				bootstrapDataSecretTokenHashKey: []byte(scope.GetHash("token")),
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		url      string
+		expects  func(k8sClient *mock.MockK8sClient)
+		wantCode int
+		wantBody string
+	}{
+		{
+			name: "Success - token is revoked by the first fetch",
+			url:  url,
+			expects: func(k8sClient *mock.MockK8sClient) {
+				expectBootstrapDataSecret(k8sClient, secret("2025-01-01T00:01:00Z"))
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj *corev1.Secret, opts ...client.DeleteOption) error {
+					assert.Equal(t, "machine-bootstrap-data", obj.Name)
+					deleteOpts := &client.DeleteOptions{}
+					deleteOpts.ApplyOptions(opts)
+					assert.Equal(t, "1", *deleteOpts.Preconditions.ResourceVersion)
+					return nil
+				})
+			},
+			wantCode: http.StatusOK,
+			wantBody: "bootstrap-data",
+		},
+		{
+			name: "Refused - fetched concurrently by another request",
+			url:  url,
+			expects: func(k8sClient *mock.MockK8sClient) {
+				expectBootstrapDataSecret(k8sClient, secret("2025-01-01T00:01:00Z"))
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, "machine-bootstrap-data", errors.New("precondition failed")))
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Refused - token expired before its first fetch",
+			url:  url,
+			expects: func(k8sClient *mock.MockK8sClient) {
+				expectBootstrapDataSecret(k8sClient, secret("2024-12-31T23:59:00Z"))
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Refused - unknown token",
+			url:  "https://10.0.0.1:9444" + bootstrapDataEndpointPath + "default/machine/unknown",
+			expects: func(k8sClient *mock.MockK8sClient) {
+				expectBootstrapDataSecret(k8sClient, secret("2025-01-01T00:01:00Z"))
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Refused - fetched or revoked bootstrap data",
+			url:  url,
+			expects: func(k8sClient *mock.MockK8sClient) {
+				expectBootstrapDataSecret(k8sClient, nil)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "Refused - malformed path",
+			url:      "https://10.0.0.1:9444" + bootstrapDataEndpointPath + "token",
+			expects:  func(k8sClient *mock.MockK8sClient) {},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "Error - token can't be revoked",
+			url:  url,
+			expects: func(k8sClient *mock.MockK8sClient) {
+				expectBootstrapDataSecret(k8sClient, secret("2025-01-01T00:01:00Z"))
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("fail"))
+			},
+			wantCode: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			k8sClient := mock.NewMockK8sClient(ctrl)
+			testcase.expects(k8sClient)
+
+			endpoint := NewBootstrapDataEndpoint(k8sClient, ":9444", "https://10.0.0.1:9444", "", time.Minute)
+			endpoint.now = func() time.Time { return now }
+
+			res := fetchBootstrapData(endpoint, testcase.url)
+			assert.Equal(t, testcase.wantCode, res.Code)
+			if testcase.wantBody != "" {
+				assert.Equal(t, testcase.wantBody, res.Body.String())
+			}
+		})
+	}
+}
@@ -20,15 +20,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	kutil "sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/paused"
@@ -41,9 +48,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/cloud/services"
 	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
 	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
 	"github.com/linode/cluster-api-provider-linode/util"
@@ -54,8 +63,37 @@ const (
 	lbTypeDNS                               string = "dns"
 	lbTypeExternal                          string = "external"
 	lbTypeNB                                string = "NodeBalancer"
+	lbTypeSharedIP                          string = "sharedIP"
 	ConditionPreflightLinodeVPCReady        string = "PreflightLinodeVPCReady"
 	ConditionPreflightLinodeNBFirewallReady string = "PreflightLinodeNBFirewallReady"
+	ConditionFailureDomainsReady            string = "FailureDomainsReady"
+	ConditionNodeBalancerFirewallAttached   string = "NodeBalancerFirewallAttached"
+
+	NodeBalancerFirewallAttachedReason = "FirewallAttached"
+	NodeBalancerFirewallDetachedReason = "FirewallDetached"
+	NodeBalancerFirewallFailedReason   = "FirewallAttachmentFailed"
+	NoNodeBalancerFirewallReason       = "NoFirewall"
+
+	// ConditionAPIServerBackendHealthy reports on control plane LinodeMachines and their Machines whether their
+	// NodeBalancer backends pass the health checks of the NodeBalancer.
+	ConditionAPIServerBackendHealthy = "APIServerBackendHealthy"
+
+	// reasons for the APIServerBackendHealthy condition
+	APIServerBackendUpReason      = "BackendsUp"
+	APIServerBackendDownReason    = "BackendsDown"
+	APIServerBackendUnknownReason = "BackendsUnknown"
+
+	// statuses of the NodeBalancer backends reported by the Linode API
+	nodeBalancerBackendUp   = "UP"
+	nodeBalancerBackendDown = "DOWN"
+
+	// ConditionDNSOwnerConflict reports whether the records of the subdomain of a LinodeCluster with the dns
+	// LoadBalancerType are owned by another entity, in which case they are left untouched.
+	ConditionDNSOwnerConflict = "DNSOwnerConflict"
+
+	// reasons for the DNSOwnerConflict condition
+	DNSRecordsOwnedElsewhereReason = "DNSRecordsOwnedElsewhere"
+	DNSRecordsOwnedReason          = "DNSRecordsOwned"
 )
 
 // LinodeClusterReconciler reconciles a LinodeCluster object
@@ -66,6 +104,17 @@ type LinodeClusterReconciler struct {
 	DnsClientConfig    scope.ClientConfig
 	WatchFilterValue   string
 	ReconcileTimeout   time.Duration
+	EventPoller        *EventPoller
+	GarbageCollector   *GarbageCollector
+	CostEstimator      *CostEstimator
+	// BackendHealthInterval is the interval between two polls of the health of the NodeBalancer backends.
+	BackendHealthInterval time.Duration
+	// ManagementClusterID identifies the management cluster in the owner tags of the NodeBalancers, they are not
+	// tagged when empty.
+	ManagementClusterID string
+	// DNSOwnerID identifies the management cluster in the DNS ownership records of the LinodeClusters, it is only
+	// resolved for the clusters with the dns load balancer type.
+	DNSOwnerID *ManagementClusterID
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeclusters,verbs=get;list;watch;create;update;patch;delete
@@ -74,6 +123,9 @@ type LinodeClusterReconciler struct {
 
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanes,verbs=get;list;watch
 
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines/status,verbs=get;update;patch
+
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 
@@ -100,16 +152,26 @@ func (r *LinodeClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, nil
 	}
 
+	managementClusterID := r.ManagementClusterID
+	if linodeCluster.Spec.Network.LoadBalancerType == lbTypeDNS && r.DNSOwnerID != nil {
+		managementClusterID, err = r.DNSOwnerID.Get(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to get the ID of the management cluster")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Create the cluster scope.
 	clusterScope, err := scope.NewClusterScope(
 		ctx,
 		r.LinodeClientConfig,
 		r.DnsClientConfig,
 		scope.ClusterScopeParams{
-			Client:            r.TracedClient(),
-			Cluster:           cluster,
-			LinodeCluster:     linodeCluster,
-			LinodeMachineList: infrav1alpha2.LinodeMachineList{},
+			Client:              r.TracedClient(),
+			Cluster:             cluster,
+			LinodeCluster:       linodeCluster,
+			LinodeMachineList:   infrav1alpha2.LinodeMachineList{},
+			ManagementClusterID: managementClusterID,
 		},
 	)
 
@@ -164,6 +226,8 @@ func (r *LinodeClusterReconciler) reconcile(
 		logger.Error(err, "failed to update linode client token from Credential Ref")
 		return res, err
 	}
+	r.EventPoller.Track(clusterScope.TokenHash, clusterScope.LinodeClient)
+	r.GarbageCollector.Track(clusterScope.TokenHash, clusterScope.LinodeClient)
 
 	// Handle deleted clusters
 	if !clusterScope.LinodeCluster.DeletionTimestamp.IsZero() {
@@ -175,6 +239,7 @@ func (r *LinodeClusterReconciler) reconcile(
 			}
 			return res, err
 		}
+		r.CostEstimator.Forget(clusterScope.LinodeCluster)
 		return res, nil
 	}
 
@@ -188,6 +253,16 @@ func (r *LinodeClusterReconciler) reconcile(
 		return res, err
 	}
 
+	// Failure domains whose placement group is not ready yet are published later, without holding back the cluster
+	res, err := r.reconcileFailureDomains(ctx, logger, clusterScope)
+	if err != nil {
+		return res, err
+	}
+
+	if res, err := r.reconcileAPIServerFirewall(ctx, logger, clusterScope); err != nil || !res.IsZero() {
+		return res, err
+	}
+
 	// Create
 	if clusterScope.LinodeCluster.Spec.ControlPlaneEndpoint.Host == "" {
 		if err := r.reconcileCreate(ctx, logger, clusterScope); err != nil {
@@ -198,6 +273,19 @@ func (r *LinodeClusterReconciler) reconcile(
 			}
 			return res, err
 		}
+	} else if clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeNB {
+		if err := services.EnsureNodeBalancerOwnerTags(ctx, clusterScope, logger); err != nil {
+			logger.Error(err, "Failed to update NodeBalancer owner tags")
+			return retryIfTransient(err, logger)
+		}
+		// Apply changes to the settings of the NodeBalancer configs in place
+		if err := services.EnsureNodeBalancerConfigSettings(ctx, clusterScope, logger); err != nil {
+			logger.Error(err, "Failed to update NodeBalancer configs")
+			return retryIfTransient(err, logger)
+		}
+		if res, err := r.reconcileNodeBalancerFirewall(ctx, logger, clusterScope); err != nil || !res.IsZero() {
+			return res, err
+		}
 	}
 
 	clusterScope.LinodeCluster.Status.Ready = true
@@ -207,6 +295,12 @@ func (r *LinodeClusterReconciler) reconcile(
 		Reason: "LoadBalancerReady", // We have to set the reason to not fail object patching
 	})
 
+	if r.CostEstimator.Enabled() && clusterScope.Cluster != nil {
+		if interval := r.reconcileCostEstimate(ctx, logger, clusterScope); res.RequeueAfter == 0 || interval < res.RequeueAfter {
+			res.RequeueAfter = interval
+		}
+	}
+
 	for _, eachMachine := range clusterScope.LinodeMachines.Items {
 		if len(eachMachine.Status.Addresses) == 0 {
 			return res, nil
@@ -222,9 +316,141 @@ func (r *LinodeClusterReconciler) reconcile(
 		return retryIfTransient(err, logger)
 	}
 
+	if clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeNB {
+		if err := r.reconcileNodeBalancerBackendHealth(ctx, logger, clusterScope); err != nil {
+			return retryIfTransient(err, logger)
+		}
+		interval := reconciler.WithJitter(reconciler.DefaultTimeout(r.BackendHealthInterval, reconciler.DefaultNodeBalancerBackendHealthInterval))
+		if res.RequeueAfter == 0 || interval < res.RequeueAfter {
+			res.RequeueAfter = interval
+		}
+	}
+
 	return res, nil
 }
 
+// reconcileNodeBalancerBackendHealth polls the health of the backends of every NodeBalancer config and publishes it in
+// the status of the LinodeCluster. The health of the backends of each control plane LinodeMachine is reported by its
+// APIServerBackendHealthy condition, which is mirrored on its Machine, and Events are emitted when it changes.
+func (r *LinodeClusterReconciler) reconcileNodeBalancerBackendHealth(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
+	network := clusterScope.LinodeCluster.Spec.Network
+	if network.NodeBalancerID == nil || network.ApiserverNodeBalancerConfigID == nil {
+		return nil
+	}
+
+	configPorts := []infrav1alpha2.LinodeNBPortConfig{{
+		Port:                 services.DetermineAPIServerLBPort(clusterScope),
+		NodeBalancerConfigID: network.ApiserverNodeBalancerConfigID,
+	}}
+	configPorts = append(configPorts, network.AdditionalPorts...)
+
+	machineNames := make(map[string]string, len(clusterScope.LinodeMachines.Items))
+	for _, linodeMachine := range clusterScope.LinodeMachines.Items {
+		if ip := nodeBalancerBackendIP(clusterScope, linodeMachine); ip != "" {
+			machineNames[ip] = linodeMachine.Name
+		}
+	}
+
+	var backends []infrav1alpha2.NodeBalancerBackendStatus
+	for _, configPort := range configPorts {
+		if configPort.NodeBalancerConfigID == nil {
+			continue
+		}
+		nodes, err := clusterScope.LinodeClient.ListNodeBalancerNodes(ctx, *network.NodeBalancerID, *configPort.NodeBalancerConfigID, &linodego.ListOptions{})
+		if err != nil {
+			logger.Error(err, "Failed to list NB nodes", "configID", *configPort.NodeBalancerConfigID)
+			return err
+		}
+		for _, node := range nodes {
+			host, _, _ := net.SplitHostPort(node.Address)
+			backends = append(backends, infrav1alpha2.NodeBalancerBackendStatus{
+				ConfigID: node.ConfigID,
+				Port:     configPort.Port,
+				Address:  node.Address,
+				Status:   node.Status,
+				Machine:  machineNames[host],
+			})
+		}
+	}
+	clusterScope.LinodeCluster.Status.NodeBalancerBackends = backends
+
+	for i := range clusterScope.LinodeMachines.Items {
+		linodeMachine := &clusterScope.LinodeMachines.Items[i]
+		condition, ok := nodeBalancerBackendHealthCondition(linodeMachine.Name, backends)
+		if !ok {
+			continue
+		}
+		previous := linodeMachine.GetCondition(ConditionAPIServerBackendHealthy)
+		if previous == nil || previous.Status != condition.Status || previous.Reason != condition.Reason || previous.Message != condition.Message {
+			if previous != nil && previous.Status == condition.Status {
+				condition.LastTransitionTime = previous.LastTransitionTime
+			}
+			transitioned := previous != nil && previous.Status != condition.Status
+
+			original := linodeMachine.DeepCopy()
+			linodeMachine.SetCondition(condition)
+			if err := r.TracedClient().Status().Patch(ctx, linodeMachine, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+				logger.Error(err, "Failed to report the NodeBalancer backend health", "machine", linodeMachine.Name)
+				return err
+			}
+
+			switch {
+			case condition.Status == metav1.ConditionFalse && (previous == nil || transitioned):
+				r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeWarning, APIServerBackendDownReason, "ReconcileNodeBalancerBackendHealth", "%s", condition.Message)
+			case condition.Status == metav1.ConditionTrue && transitioned:
+				r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, APIServerBackendUpReason, "ReconcileNodeBalancerBackendHealth",
+					"NodeBalancer backends are UP")
+			}
+		}
+
+		if err := r.reportMachineBackendHealth(ctx, linodeMachine, condition); err != nil {
+			logger.Error(err, "Failed to report the NodeBalancer backend health on the Machine", "machine", linodeMachine.Name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reportMachineBackendHealth mirrors the APIServerBackendHealthy condition of the LinodeMachine on the Machine owning
+// it. Cluster API only mirrors the Ready condition of infrastructure machines, so the condition has to be set on the
+// Machine for MachineHealthChecks to remediate control plane nodes whose backends are down with
+// unhealthyMachineConditions.
+func (r *LinodeClusterReconciler) reportMachineBackendHealth(ctx context.Context, linodeMachine *infrav1alpha2.LinodeMachine, condition metav1.Condition) error {
+	machine, err := kutil.GetOwnerMachine(ctx, r.TracedClient(), linodeMachine.ObjectMeta)
+	if err != nil || machine == nil {
+		return err
+	}
+
+	previous := meta.FindStatusCondition(machine.Status.Conditions, ConditionAPIServerBackendHealthy)
+	if previous != nil && previous.Status == condition.Status && previous.Reason == condition.Reason && previous.Message == condition.Message {
+		return nil
+	}
+
+	original := machine.DeepCopy()
+	condition.ObservedGeneration = machine.Generation
+	meta.SetStatusCondition(&machine.Status.Conditions, condition)
+	// The conditions are replaced as a whole by the merge patch, so it must fail on conflict rather than revert the
+	// conditions written since the Machine was read, such as those of MachineHealthChecks.
+	return r.TracedClient().Status().Patch(ctx, machine, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+}
+
+// reconcileCostEstimate refreshes the estimated cost of the cluster once it is stale and compares it with the monthly
+// budget of the cluster. It returns the delay after which the estimate is refreshed.
+func (r *LinodeClusterReconciler) reconcileCostEstimate(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) time.Duration {
+	if r.CostEstimator.Stale(clusterScope.LinodeCluster) {
+		estimate, err := r.CostEstimator.Estimate(ctx, r.TracedClient(), clusterScope.LinodeClient, clusterScope.LinodeCluster, clusterScope.Cluster.Name)
+		if err != nil {
+			logger.Error(err, "Failed to estimate the cost of the cluster")
+		} else {
+			clusterScope.LinodeCluster.Status.EstimatedCost = estimate
+		}
+	}
+	publishClusterCost(r.Recorder, clusterScope.LinodeCluster)
+
+	return reconciler.WithJitter(r.CostEstimator.Interval)
+}
+
 func (r *LinodeClusterReconciler) performPreflightChecks(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
 	// Check VPC configuration - either direct ID or reference
 	if clusterScope.LinodeCluster.Spec.VPCID != nil || clusterScope.LinodeCluster.Spec.VPCRef != nil {
@@ -416,6 +642,286 @@ func (r *LinodeClusterReconciler) reconcilePreflightLinodeVPCCheck(ctx context.C
 	return ctrl.Result{}, nil
 }
 
+// reconcileFailureDomains publishes the failure domains of the LinodeCluster whose LinodePlacementGroups are ready.
+// The failure domains that are not ready yet are left out, so that machines keep being created in the ready ones, and
+// the reconciliation is requeued until all of them are published.
+func (r *LinodeClusterReconciler) reconcileFailureDomains(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+	if len(clusterScope.LinodeCluster.Spec.FailureDomains) == 0 {
+		clusterScope.LinodeCluster.Status.FailureDomains = nil
+		return ctrl.Result{}, nil
+	}
+
+	failureDomains := make([]clusterv1.FailureDomain, 0, len(clusterScope.LinodeCluster.Spec.FailureDomains))
+	var pending, failed []string
+	for _, failureDomain := range clusterScope.LinodeCluster.Spec.FailureDomains {
+		name := failureDomain.PlacementGroupRef.Name
+		namespace := failureDomain.PlacementGroupRef.Namespace
+		if namespace == "" {
+			namespace = clusterScope.LinodeCluster.Namespace
+		}
+
+		linodePlacementGroup := infrav1alpha2.LinodePlacementGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+		if err := clusterScope.Client.Get(ctx, client.ObjectKeyFromObject(&linodePlacementGroup), &linodePlacementGroup); err != nil {
+			logger.Error(err, "Failed to fetch LinodePlacementGroup", "failureDomain", failureDomain.Name)
+			if reconciler.HasStaleCondition(clusterScope.LinodeCluster.GetCondition(ConditionFailureDomainsReady),
+				reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultClusterControllerReconcileTimeout)) {
+				clusterScope.LinodeCluster.Status.FailureDomains = failureDomains
+				clusterScope.LinodeCluster.SetCondition(metav1.Condition{
+					Type:    ConditionFailureDomainsReady,
+					Status:  metav1.ConditionFalse,
+					Reason:  util.CreateError,
+					Message: err.Error(),
+				})
+				return ctrl.Result{}, err
+			}
+			pending = append(pending, failureDomain.Name)
+			continue
+		}
+		if linodePlacementGroup.Spec.Region != clusterScope.LinodeCluster.Spec.Region {
+			err := fmt.Errorf("placement group %s/%s of failure domain %s is in region %s, not %s",
+				namespace, name, failureDomain.Name, linodePlacementGroup.Spec.Region, clusterScope.LinodeCluster.Spec.Region)
+			logger.Error(err, "Failed preflight check: placement group is in another region")
+			failed = append(failed, err.Error())
+			continue
+		}
+		if !linodePlacementGroup.Status.Ready || linodePlacementGroup.Spec.PGID == nil {
+			logger.Info("LinodePlacementGroup is not yet available", "failureDomain", failureDomain.Name)
+			pending = append(pending, failureDomain.Name)
+			continue
+		}
+
+		failureDomains = append(failureDomains, failureDomainFromPlacementGroup(failureDomain, &linodePlacementGroup))
+	}
+
+	clusterScope.LinodeCluster.Status.FailureDomains = failureDomains
+	switch {
+	case len(failed) > 0:
+		clusterScope.LinodeCluster.SetCondition(metav1.Condition{
+			Type:    ConditionFailureDomainsReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  util.CreateError,
+			Message: strings.Join(failed, "; "),
+		})
+	case len(pending) > 0:
+		clusterScope.LinodeCluster.SetCondition(metav1.Condition{
+			Type:    ConditionFailureDomainsReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  "LinodePlacementGroupNotYetAvailable", // We have to set the reason to not fail object patching
+			Message: "waiting for the placement groups of failure domains " + strings.Join(pending, ", "),
+		})
+	default:
+		clusterScope.LinodeCluster.SetCondition(metav1.Condition{
+			Type:   ConditionFailureDomainsReady,
+			Status: metav1.ConditionTrue,
+			Reason: "LinodePlacementGroupsReady", // We have to set the reason to not fail object patching
+		})
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultClusterControllerReconcileDelay)}, nil
+}
+
+// reconcileAPIServerFirewall keeps the LinodeFirewall managed for the apiServerAllowedCIDRs in sync with the allowlist
+// and the addresses of the machines of the cluster, and attaches it to the NodeBalancer. The managed LinodeFirewall is
+// deleted once the allowlist is removed.
+func (r *LinodeClusterReconciler) reconcileAPIServerFirewall(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+	linodeCluster := clusterScope.LinodeCluster
+	logger = logger.WithValues("firewallName", services.APIServerFirewallName(linodeCluster))
+
+	linodeFirewall := &infrav1alpha2.LinodeFirewall{}
+	firewallKey := client.ObjectKey{Namespace: linodeCluster.Namespace, Name: services.APIServerFirewallName(linodeCluster)}
+	err := r.TracedClient().Get(ctx, firewallKey, linodeFirewall)
+	if client.IgnoreNotFound(err) != nil {
+		logger.Error(err, "Failed to fetch API server LinodeFirewall")
+		return ctrl.Result{}, err
+	}
+	found := err == nil
+	managed := found && linodeFirewall.Labels[infrav1alpha2.APIServerFirewallLabel] == linodeCluster.Name
+
+	if len(linodeCluster.Spec.Network.APIServerAllowedCIDRs) == 0 || linodeCluster.Spec.Network.LoadBalancerType != lbTypeNB {
+		if managed {
+			logger.Info("Deleting API server LinodeFirewall")
+			if err := r.TracedClient().Delete(ctx, linodeFirewall); client.IgnoreNotFound(err) != nil {
+				logger.Error(err, "Failed to delete API server LinodeFirewall")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+	if found && !managed {
+		return ctrl.Result{}, fmt.Errorf("LinodeFirewall %s already exists and is not managed by the LinodeCluster", firewallKey)
+	}
+
+	var linodeMachines infrav1alpha2.LinodeMachineList
+	var poolMachines infrav1alpha2.LinodeMachinePoolMachineList
+	if clusterScope.Cluster != nil {
+		if err := r.TracedClient().List(ctx, &linodeMachines, client.InNamespace(linodeCluster.Namespace),
+			client.MatchingLabels{clusterv1.ClusterNameLabel: clusterScope.Cluster.Name}); err != nil {
+			logger.Error(err, "Failed to list LinodeMachines")
+			return ctrl.Result{}, err
+		}
+		if err := r.TracedClient().List(ctx, &poolMachines, client.InNamespace(linodeCluster.Namespace),
+			client.MatchingLabels{clusterv1.ClusterNameLabel: clusterScope.Cluster.Name}); err != nil {
+			logger.Error(err, "Failed to list LinodeMachinePoolMachines")
+			return ctrl.Result{}, err
+		}
+	}
+	firewallSpec := apiServerFirewallSpec(clusterScope, linodeMachines.Items, poolMachines.Items)
+
+	if !found {
+		linodeFirewall = &infrav1alpha2.LinodeFirewall{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      firewallKey.Name,
+				Namespace: firewallKey.Namespace,
+				Labels: map[string]string{
+					infrav1alpha2.APIServerFirewallLabel: linodeCluster.Name,
+				},
+			},
+			Spec: firewallSpec,
+		}
+		if clusterScope.Cluster != nil {
+			linodeFirewall.Labels[clusterv1.ClusterNameLabel] = clusterScope.Cluster.Name
+		}
+		if err := controllerutil.SetControllerReference(linodeCluster, linodeFirewall, r.Scheme()); err != nil {
+			return ctrl.Result{}, err
+		}
+		logger.Info("Creating API server LinodeFirewall")
+		if err := r.TracedClient().Create(ctx, linodeFirewall); err != nil {
+			logger.Error(err, "Failed to create API server LinodeFirewall")
+			return ctrl.Result{}, err
+		}
+	} else {
+		firewallSpec.FirewallID = linodeFirewall.Spec.FirewallID
+		if !equality.Semantic.DeepEqual(linodeFirewall.Spec, firewallSpec) {
+			patch := client.MergeFrom(linodeFirewall.DeepCopy())
+			linodeFirewall.Spec = firewallSpec
+			logger.Info("Updating API server LinodeFirewall")
+			if err := r.TracedClient().Patch(ctx, linodeFirewall, patch); err != nil {
+				logger.Error(err, "Failed to update API server LinodeFirewall")
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	// Wait for the firewall to be created so the NodeBalancer is never created without it
+	if linodeFirewall.Spec.FirewallID == nil && linodeCluster.Spec.Network.NodeBalancerID == nil {
+		logger.Info("Waiting for the API server firewall to be created")
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultClusterControllerReconcileDelay)}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileNodeBalancerFirewall attaches the firewall given by the nodeBalancerFirewallID, the nodeBalancerFirewallRef
+// or the apiServerAllowedCIDRs to the NodeBalancer, and detaches the firewall it replaces. The attached firewall is
+// reported in the status, so that it can be detached once removed from the spec and re-attached when it is detached
+// outside of CAPL.
+//
+//nolint:cyclop,gocognit // each branch handles a transition of the attached firewall
+func (r *LinodeClusterReconciler) reconcileNodeBalancerFirewall(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+	linodeCluster := clusterScope.LinodeCluster
+	if linodeCluster.Spec.Network.NodeBalancerID == nil {
+		return ctrl.Result{}, nil
+	}
+	nodeBalancerID := *linodeCluster.Spec.Network.NodeBalancerID
+
+	desiredID := linodeCluster.Spec.Network.NodeBalancerFirewallID
+	if firewallKey := nodeBalancerFirewallKey(linodeCluster); desiredID == nil && firewallKey != nil {
+		linodeFirewall := &infrav1alpha2.LinodeFirewall{}
+		if err := r.TracedClient().Get(ctx, *firewallKey, linodeFirewall); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to fetch NodeBalancer LinodeFirewall", "firewall", firewallKey)
+			return ctrl.Result{}, err
+		}
+		if linodeFirewall.Spec.FirewallID == nil {
+			logger.Info("Waiting for the NodeBalancer firewall to be created", "firewall", firewallKey)
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultClusterControllerReconcileDelay)}, nil
+		}
+		desiredID = linodeFirewall.Spec.FirewallID
+	}
+	attachedID := linodeCluster.Status.NodeBalancerFirewallID
+
+	firewalls, err := clusterScope.LinodeClient.ListNodeBalancerFirewalls(ctx, nodeBalancerID, nil)
+	if err != nil {
+		logger.Error(err, "Failed to list NodeBalancer firewalls")
+		return retryIfTransient(err, logger)
+	}
+	attached := func(firewallID int) bool {
+		return slices.ContainsFunc(firewalls, func(firewall linodego.Firewall) bool { return firewall.ID == firewallID })
+	}
+
+	if attachedID != nil && !attached(*attachedID) && (desiredID == nil || *desiredID == *attachedID) {
+		r.Recorder.Eventf(linodeCluster, nil, corev1.EventTypeWarning, NodeBalancerFirewallDetachedReason, "ReconcileNodeBalancerFirewall",
+			"Firewall %d was detached from NodeBalancer %d outside of CAPL", *attachedID, nodeBalancerID)
+		linodeCluster.SetCondition(metav1.Condition{
+			Type:    ConditionNodeBalancerFirewallAttached,
+			Status:  metav1.ConditionFalse,
+			Reason:  NodeBalancerFirewallDetachedReason,
+			Message: fmt.Sprintf("firewall %d was detached outside of CAPL", *attachedID),
+		})
+		linodeCluster.Status.NodeBalancerFirewallID = nil
+		attachedID = nil
+	}
+
+	// A NodeBalancer can only have a single firewall, so the firewall being replaced is detached first
+	for _, firewall := range firewalls {
+		replaced := attachedID != nil && firewall.ID == *attachedID
+		if firewall.ID == ptr.Deref(desiredID, 0) || (desiredID == nil && !replaced) {
+			continue
+		}
+		logger.Info("Detaching firewall from NodeBalancer", "firewallID", firewall.ID)
+		if err := services.DetachNodeBalancerFirewall(ctx, clusterScope, firewall.ID); err != nil {
+			logger.Error(err, "Failed to detach firewall from NodeBalancer", "firewallID", firewall.ID)
+			return r.failNodeBalancerFirewall(logger, clusterScope, err)
+		}
+	}
+
+	if desiredID == nil {
+		linodeCluster.Status.NodeBalancerFirewallID = nil
+		if linodeCluster.GetCondition(ConditionNodeBalancerFirewallAttached) != nil {
+			linodeCluster.SetCondition(metav1.Condition{
+				Type:   ConditionNodeBalancerFirewallAttached,
+				Status: metav1.ConditionFalse,
+				Reason: NoNodeBalancerFirewallReason,
+			})
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !attached(*desiredID) {
+		logger.Info("Attaching firewall to NodeBalancer", "firewallID", *desiredID)
+		if err := services.AttachNodeBalancerFirewall(ctx, clusterScope, *desiredID); err != nil {
+			logger.Error(err, "Failed to attach firewall to NodeBalancer", "firewallID", *desiredID)
+			return r.failNodeBalancerFirewall(logger, clusterScope, err)
+		}
+	}
+
+	linodeCluster.Status.NodeBalancerFirewallID = ptr.To(*desiredID)
+	linodeCluster.SetCondition(metav1.Condition{
+		Type:    ConditionNodeBalancerFirewallAttached,
+		Status:  metav1.ConditionTrue,
+		Reason:  NodeBalancerFirewallAttachedReason,
+		Message: fmt.Sprintf("firewall %d is attached", *desiredID),
+	})
+
+	return ctrl.Result{}, nil
+}
+
+func (r *LinodeClusterReconciler) failNodeBalancerFirewall(logger logr.Logger, clusterScope *scope.ClusterScope, err error) (ctrl.Result, error) {
+	clusterScope.LinodeCluster.SetCondition(metav1.Condition{
+		Type:    ConditionNodeBalancerFirewallAttached,
+		Status:  metav1.ConditionFalse,
+		Reason:  NodeBalancerFirewallFailedReason,
+		Message: err.Error(),
+	})
+
+	return retryIfTransient(err, logger)
+}
+
 func (r *LinodeClusterReconciler) setFailureReason(clusterScope *scope.ClusterScope, failureReason, failureMessage string) {
 	clusterScope.LinodeCluster.Status.FailureReason = util.Pointer(failureReason)
 	clusterScope.LinodeCluster.Status.FailureMessage = util.Pointer(failureMessage)
@@ -436,9 +942,14 @@ func (r *LinodeClusterReconciler) reconcileCreate(ctx context.Context, logger lo
 	}
 
 	// handle creation for the loadbalancer for the control plane
-	if clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeDNS {
+	switch clusterScope.LinodeCluster.Spec.Network.LoadBalancerType {
+	case lbTypeDNS:
 		handleDNS(clusterScope)
-	} else {
+	case lbTypeSharedIP:
+		if err := handleSharedIPCreate(ctx, logger, clusterScope); err != nil {
+			return err
+		}
+	default:
 		if err := handleNBCreate(ctx, logger, clusterScope); err != nil {
 			return err
 		}
@@ -470,6 +981,30 @@ func (r *LinodeClusterReconciler) reconcileDelete(ctx context.Context, logger lo
 			Message: "Load balancing for Type DNS deleted",
 		})
 
+	case clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeSharedIP && len(clusterScope.LinodeMachines.Items) > 0:
+		logger.Info("Waiting for control plane machines to be deleted before releasing the shared IP address")
+
+	case clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeSharedIP:
+		if err := services.DeleteSharedIP(ctx, logger, clusterScope); err != nil {
+			r.setFailureReason(clusterScope, util.DeleteError, err.Error())
+			r.Recorder.Eventf(
+				clusterScope.LinodeCluster,
+				nil,
+				corev1.EventTypeWarning,
+				util.DeleteError,
+				"DeleteSharedIP",
+				err.Error(),
+			)
+			return err
+		}
+
+		clusterScope.LinodeCluster.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.DeletionCompletedReason,
+			Message: "Load balancing for Type sharedIP deleted",
+		})
+
 	case clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeNB && clusterScope.LinodeCluster.Spec.Network.NodeBalancerID == nil:
 		logger.Info("NodeBalancer ID is missing for Type NodeBalancer, nothing to do")
 
@@ -525,7 +1060,7 @@ func (r *LinodeClusterReconciler) reconcileDelete(ctx context.Context, logger lo
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *LinodeClusterReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
-	err := ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha2.LinodeCluster{}).
 		WithOptions(options).
 		// we care about reconciling on metadata updates for LinodeClusters because the OwnerRef for the Cluster is needed
@@ -556,7 +1091,17 @@ func (r *LinodeClusterReconciler) SetupWithManager(mgr ctrl.Manager, options crc
 		Watches(
 			&infrav1alpha2.LinodeMachine{},
 			handler.EnqueueRequestsFromMapFunc(linodeMachineToLinodeCluster(r.TracedClient(), mgr.GetLogger())),
-		).Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+		).
+		Watches(
+			&infrav1alpha2.LinodeMachinePoolMachine{},
+			handler.EnqueueRequestsFromMapFunc(linodeMachinePoolMachineToLinodeCluster(r.TracedClient(), mgr.GetLogger())),
+		).
+		// The API server LinodeFirewall is watched to attach it to the NodeBalancer once it is created
+		Owns(&infrav1alpha2.LinodeFirewall{})
+	if r.EventPoller.Enabled() {
+		b = b.WatchesRawSource(source.Channel(r.EventPoller.ClusterEvents(), &handler.EnqueueRequestForObject{}))
+	}
+	err := b.Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
 	if err != nil {
 		return fmt.Errorf("failed to build controller: %w", err)
 	}
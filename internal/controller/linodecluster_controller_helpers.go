@@ -2,12 +2,17 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/linode/linodego/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	kutil "sigs.k8s.io/cluster-api/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -28,38 +33,56 @@ func addMachineToLB(ctx context.Context, clusterScope *scope.ClusterScope) error
 		return nil
 	}
 	if clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeDNS {
-		if err := services.EnsureDNSEntries(ctx, clusterScope, "create"); err != nil {
+		err := services.EnsureDNSEntries(ctx, clusterScope, "create")
+		setDNSOwnerConflictCondition(clusterScope.LinodeCluster, err)
+		if err != nil {
 			logger.Error(err, "Failed to ensure DNS entries")
 			return err
 		}
 		return nil
 	}
+	if clusterScope.LinodeCluster.Spec.Network.LoadBalancerType == lbTypeSharedIP {
+		if err := services.EnsureSharedIPAssignments(ctx, logger, clusterScope); err != nil {
+			logger.Error(err, "Failed to ensure shared IP assignments")
+			return err
+		}
+		return nil
+	}
 	// Reconcile clusters with Spec.Network = {} and ControlPlaneEndpoint.Host externally managed
 	if clusterScope.LinodeCluster.Spec.Network.NodeBalancerID == nil || clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfigID == nil {
 		logger.Info("NodeBalancerID or ApiserverNodeBalancerConfigID not set for Type NodeBalancer, this cluster is managed externally")
 		clusterScope.LinodeCluster.Spec.Network.LoadBalancerType = lbTypeExternal
 		return nil
 	}
-	nodeBalancerNodes, err := clusterScope.LinodeClient.ListNodeBalancerNodes(
-		ctx,
-		*clusterScope.LinodeCluster.Spec.Network.NodeBalancerID,
-		*clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfigID,
-		&linodego.ListOptions{},
-	)
-	if err != nil {
-		logger.Error(err, "Failed to list NB nodes")
-		return err
+	// List the backends of every config, so that backends left behind by a change of target port are removed from the
+	// additional ports as well as from the API server port
+	var nodeBalancerNodes []linodego.NodeBalancerNode
+	for _, configPort := range nodeBalancerConfigPorts(clusterScope) {
+		if configPort.configID == 0 {
+			// The config of the port is not created yet
+			continue
+		}
+		configNodes, err := clusterScope.LinodeClient.ListNodeBalancerNodes(
+			ctx,
+			*clusterScope.LinodeCluster.Spec.Network.NodeBalancerID,
+			configPort.configID,
+			&linodego.ListOptions{},
+		)
+		if err != nil {
+			logger.Error(err, "Failed to list NB nodes", "configID", configPort.configID)
+			return err
+		}
+		nodeBalancerNodes = append(nodeBalancerNodes, configNodes...)
 	}
 	for _, eachMachine := range clusterScope.LinodeMachines.Items {
-		err = services.AddNodesToNB(ctx, logger, clusterScope, eachMachine, nodeBalancerNodes)
-		if err != nil {
+		if err := services.AddNodesToNB(ctx, logger, clusterScope, eachMachine, nodeBalancerNodes); err != nil {
 			logger.Error(err, "Failed to add nodes to NB")
 			return err
 		}
 	}
 	ipPortCombo := getIPPortCombo(clusterScope)
 	for _, node := range nodeBalancerNodes {
-		if !slices.Contains(ipPortCombo, node.Address) {
+		if !slices.Contains(ipPortCombo[node.ConfigID], node.Address) {
 			if err := clusterScope.LinodeClient.DeleteNodeBalancerNode(ctx, node.NodeBalancerID, node.ConfigID, node.ID); err != nil {
 				logger.Error(err, "Failed to delete NB node")
 				return err
@@ -71,13 +94,39 @@ func addMachineToLB(ctx context.Context, clusterScope *scope.ClusterScope) error
 }
 
 func removeMachineFromDNS(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
-	if err := services.EnsureDNSEntries(ctx, clusterScope, "delete"); err != nil {
+	err := services.EnsureDNSEntries(ctx, clusterScope, "delete")
+	if errors.Is(err, services.ErrDNSOwnerConflict) {
+		// The records belong to another entity, there is nothing to delete on its behalf
+		logger.Info("Leaving DNS records owned by another entity", "reason", err.Error())
+		return nil
+	}
+	if err != nil {
 		logger.Error(err, "Failed to remove IP from DNS")
 		return err
 	}
 	return nil
 }
 
+// setDNSOwnerConflictCondition reports whether the records of the subdomain of the LinodeCluster are owned by another
+// entity, given the result of ensuring them.
+func setDNSOwnerConflictCondition(linodeCluster *infrav1alpha2.LinodeCluster, err error) {
+	switch {
+	case errors.Is(err, services.ErrDNSOwnerConflict):
+		linodeCluster.SetCondition(metav1.Condition{
+			Type:    ConditionDNSOwnerConflict,
+			Status:  metav1.ConditionTrue,
+			Reason:  DNSRecordsOwnedElsewhereReason,
+			Message: err.Error(),
+		})
+	case err == nil && linodeCluster.GetCondition(ConditionDNSOwnerConflict) != nil:
+		linodeCluster.SetCondition(metav1.Condition{
+			Type:   ConditionDNSOwnerConflict,
+			Status: metav1.ConditionFalse,
+			Reason: DNSRecordsOwnedReason,
+		})
+	}
+}
+
 func removeMachineFromNB(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
 	if err := services.DeleteNodesFromNB(ctx, logger, clusterScope); err != nil {
 		logger.Error(err, "Failed to remove node from Node Balancer backend")
@@ -86,31 +135,222 @@ func removeMachineFromNB(ctx context.Context, logger logr.Logger, clusterScope *
 	return nil
 }
 
-func getIPPortCombo(cscope *scope.ClusterScope) (ipPortComboList []string) {
-	apiServerLBPort := services.DetermineAPIServerLBPort(cscope)
-	useVPCIPs := services.ShouldUseVPC(cscope)
+// nodeBalancerFirewallKey returns the key of the LinodeFirewall to attach to the NodeBalancer, which is either given by
+// the nodeBalancerFirewallRef or managed for the apiServerAllowedCIDRs. It returns nil if there is none.
+func nodeBalancerFirewallKey(linodeCluster *infrav1alpha2.LinodeCluster) *client.ObjectKey {
+	switch {
+	case linodeCluster.Spec.NodeBalancerFirewallRef != nil:
+		namespace := linodeCluster.Spec.NodeBalancerFirewallRef.Namespace
+		if namespace == "" {
+			namespace = linodeCluster.Namespace
+		}
+		return &client.ObjectKey{Namespace: namespace, Name: linodeCluster.Spec.NodeBalancerFirewallRef.Name}
+	case len(linodeCluster.Spec.Network.APIServerAllowedCIDRs) > 0:
+		return &client.ObjectKey{Namespace: linodeCluster.Namespace, Name: services.APIServerFirewallName(linodeCluster)}
+	default:
+		return nil
+	}
+}
 
-	for _, eachMachine := range cscope.LinodeMachines.Items {
-		var selectedIP string
+// apiServerFirewallSpec returns the spec of the LinodeFirewall admitting the apiServerAllowedCIDRs and the addresses
+// of the machines of the cluster, including the machines of its machine pools, to the ports of the NodeBalancer, and
+// dropping any other inbound traffic.
+func apiServerFirewallSpec(
+	clusterScope *scope.ClusterScope,
+	linodeMachines []infrav1alpha2.LinodeMachine,
+	poolMachines []infrav1alpha2.LinodeMachinePoolMachine,
+) infrav1alpha2.LinodeFirewallSpec {
+	network := clusterScope.LinodeCluster.Spec.Network
+	ports := []string{strconv.Itoa(services.DetermineAPIServerLBPort(clusterScope))}
+	for _, portConfig := range network.AdditionalPorts {
+		ports = append(ports, strconv.Itoa(portConfig.Port))
+	}
 
-		if useVPCIPs {
-			if ip, ok := findFirstVPCInternalIP(eachMachine.Status.Addresses); ok {
-				selectedIP = ip
+	addressesList := make([][]clusterv1.MachineAddress, 0, len(linodeMachines)+len(poolMachines))
+	for _, linodeMachine := range linodeMachines {
+		addressesList = append(addressesList, linodeMachine.Status.Addresses)
+	}
+	for _, poolMachine := range poolMachines {
+		addressesList = append(addressesList, poolMachine.Status.Addresses)
+	}
+	var machineAddresses []string
+	for _, addresses := range addressesList {
+		for _, addr := range addresses {
+			if addr.Type == clusterv1.MachineExternalIP || addr.Type == clusterv1.MachineInternalIP {
+				machineAddresses = append(machineAddresses, addr.Address)
 			}
 		}
+	}
+
+	inboundRules := make([]infrav1alpha2.FirewallRuleSpec, 0, 2)
+	for _, rule := range []struct {
+		label       string
+		description string
+		addresses   []string
+	}{
+		{"allowed-cidrs", "apiServerAllowedCIDRs of the LinodeCluster", network.APIServerAllowedCIDRs},
+		{"cluster-machines", "Addresses of the LinodeMachines and LinodeMachinePoolMachines of the cluster", machineAddresses},
+	} {
+		if addresses := firewallRuleAddresses(rule.addresses); addresses != nil {
+			inboundRules = append(inboundRules, infrav1alpha2.FirewallRuleSpec{
+				Action:      "ACCEPT",
+				Label:       rule.label,
+				Description: rule.description,
+				Ports:       strings.Join(ports, ","),
+				Protocol:    linodego.TCP,
+				Addresses:   addresses,
+			})
+		}
+	}
+
+	return infrav1alpha2.LinodeFirewallSpec{
+		Enabled:        true,
+		InboundPolicy:  "DROP",
+		InboundRules:   inboundRules,
+		OutboundPolicy: "ACCEPT",
+		CredentialsRef: clusterScope.LinodeCluster.Spec.CredentialsRef,
+	}
+}
+
+// firewallRuleAddresses sorts the addresses by family, and returns nil if there are none.
+func firewallRuleAddresses(addresses []string) *infrav1alpha2.NetworkAddresses {
+	var ipv4s, ipv6s []string
+	for _, address := range addresses {
+		if strings.Contains(address, ":") {
+			ipv6s = append(ipv6s, address)
+		} else {
+			ipv4s = append(ipv4s, address)
+		}
+	}
+	if len(ipv4s) == 0 && len(ipv6s) == 0 {
+		return nil
+	}
 
+	// Keep the order stable so that the LinodeFirewall is only updated when the addresses change
+	networkAddresses := &infrav1alpha2.NetworkAddresses{}
+	if len(ipv4s) > 0 {
+		slices.Sort(ipv4s)
+		networkAddresses.IPv4 = ptr.To(slices.Compact(ipv4s))
+	}
+	if len(ipv6s) > 0 {
+		slices.Sort(ipv6s)
+		networkAddresses.IPv6 = ptr.To(slices.Compact(ipv6s))
+	}
+	return networkAddresses
+}
+
+// failureDomainFromPlacementGroup returns the Cluster API failure domain backed by a ready LinodePlacementGroup.
+func failureDomainFromPlacementGroup(failureDomain infrav1alpha2.LinodeFailureDomain, linodePlacementGroup *infrav1alpha2.LinodePlacementGroup) clusterv1.FailureDomain {
+	return clusterv1.FailureDomain{
+		Name:         failureDomain.Name,
+		ControlPlane: ptr.To(ptr.Deref(failureDomain.ControlPlane, true)),
+		Attributes: map[string]string{
+			"region":           linodePlacementGroup.Spec.Region,
+			"placementGroupID": strconv.Itoa(ptr.Deref(linodePlacementGroup.Spec.PGID, 0)),
+		},
+	}
+}
+
+// nodeBalancerConfigPort is the NodeBalancer config of a port of the cluster and the port its backends listen on.
+type nodeBalancerConfigPort struct {
+	configID int
+	port     int
+}
+
+// nodeBalancerConfigPorts returns the NodeBalancer configs of the API server port and of the additional ports of the
+// cluster with the target ports of their backends.
+func nodeBalancerConfigPorts(cscope *scope.ClusterScope) []nodeBalancerConfigPort {
+	network := cscope.LinodeCluster.Spec.Network
+	configPorts := make([]nodeBalancerConfigPort, 0, 1+len(network.AdditionalPorts))
+	configPorts = append(configPorts, nodeBalancerConfigPort{
+		configID: ptr.Deref(network.ApiserverNodeBalancerConfigID, 0),
+		port:     services.DetermineAPIServerTargetPort(cscope),
+	})
+	for _, portConfig := range network.AdditionalPorts {
+		configPorts = append(configPorts, nodeBalancerConfigPort{
+			configID: ptr.Deref(portConfig.NodeBalancerConfigID, 0),
+			port:     services.NodeBalancerTargetPort(portConfig.Port, &portConfig.LinodeNBConfigSettings),
+		})
+	}
+	return configPorts
+}
+
+// getIPPortCombo returns the ip:port addresses of the backends the machines of the cluster should have, keyed by the
+// ID of their NodeBalancer config.
+func getIPPortCombo(cscope *scope.ClusterScope) map[int][]string {
+	configPorts := nodeBalancerConfigPorts(cscope)
+	ipPortCombos := make(map[int][]string, len(configPorts))
+	for _, eachMachine := range cscope.LinodeMachines.Items {
+		selectedIP := nodeBalancerBackendIP(cscope, eachMachine)
 		if selectedIP == "" {
-			if ip, ok := findFirstPrivateInternalIP(eachMachine.Status.Addresses); ok {
-				selectedIP = ip
-			}
+			continue
+		}
+		for _, configPort := range configPorts {
+			ipPortCombos[configPort.configID] = append(ipPortCombos[configPort.configID], fmt.Sprintf("%s:%d", selectedIP, configPort.port))
+		}
+	}
+
+	return ipPortCombos
+}
+
+// nodeBalancerBackendIP returns the IP the NodeBalancer backends of the machine use, or an empty string if the machine
+// has no suitable address yet.
+func nodeBalancerBackendIP(cscope *scope.ClusterScope, linodeMachine infrav1alpha2.LinodeMachine) string {
+	if services.ShouldUseVPC(cscope) {
+		if ip, ok := findFirstVPCInternalIP(linodeMachine.Status.Addresses); ok {
+			return ip
 		}
+	}
+	if ip, ok := findFirstPrivateInternalIP(linodeMachine.Status.Addresses); ok {
+		return ip
+	}
+
+	return ""
+}
 
-		if selectedIP != "" {
-			ipPortComboList = append(ipPortComboList, buildPortCombosForIP(selectedIP, apiServerLBPort, cscope.LinodeCluster.Spec.Network.AdditionalPorts)...)
+// nodeBalancerBackendHealthCondition returns the APIServerBackendHealthy condition of the machine from the health of
+// its backends. It returns false if the machine has no backend.
+func nodeBalancerBackendHealthCondition(machineName string, backends []infrav1alpha2.NodeBalancerBackendStatus) (metav1.Condition, bool) {
+	var down, unknown []string
+	found := false
+	for _, backend := range backends {
+		if backend.Machine != machineName {
+			continue
+		}
+		found = true
+		switch backend.Status {
+		case nodeBalancerBackendUp:
+		case nodeBalancerBackendDown:
+			down = append(down, backend.Address)
+		default:
+			unknown = append(unknown, backend.Address)
 		}
 	}
 
-	return ipPortComboList
+	switch {
+	case !found:
+		return metav1.Condition{}, false
+	case len(down) > 0:
+		return metav1.Condition{
+			Type:    ConditionAPIServerBackendHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  APIServerBackendDownReason,
+			Message: "NodeBalancer backends are DOWN: " + strings.Join(down, ", "),
+		}, true
+	case len(unknown) > 0:
+		return metav1.Condition{
+			Type:    ConditionAPIServerBackendHealthy,
+			Status:  metav1.ConditionUnknown,
+			Reason:  APIServerBackendUnknownReason,
+			Message: "NodeBalancer backends have an unknown health: " + strings.Join(unknown, ", "),
+		}, true
+	default:
+		return metav1.Condition{
+			Type:   ConditionAPIServerBackendHealthy,
+			Status: metav1.ConditionTrue,
+			Reason: APIServerBackendUpReason,
+		}, true
+	}
 }
 
 // findFirstVPCInternalIP returns the first internal IP that is not in Linode's private 192.168.* range.
@@ -133,16 +373,6 @@ func findFirstPrivateInternalIP(addresses []clusterv1.MachineAddress) (string, b
 	return "", false
 }
 
-// buildPortCombosForIP composes ip:port pairs for the API server port and any additional ports.
-func buildPortCombosForIP(ip string, apiServerLBPort int, additionalPorts []infrav1alpha2.LinodeNBPortConfig) []string {
-	results := make([]string, 0, 1+len(additionalPorts))
-	results = append(results, fmt.Sprintf("%s:%d", ip, apiServerLBPort))
-	for _, portConfig := range additionalPorts {
-		results = append(results, fmt.Sprintf("%s:%d", ip, portConfig.Port))
-	}
-	return results
-}
-
 func machineToLinodeCluster(tracedClient client.Client, logger logr.Logger) handler.MapFunc {
 	logger = logger.WithName("LinodeClusterReconciler").WithName("MachineToLinodeCluster")
 
@@ -168,6 +398,12 @@ func machineToLinodeCluster(tracedClient client.Client, logger logr.Logger) hand
 			return nil
 		}
 
+		// We only need control plane machines to trigger reconciliation, unless the addresses of every machine
+		// are admitted by the API server firewall
+		if !kutil.IsControlPlaneMachine(machine) && len(linodeCluster.Spec.Network.APIServerAllowedCIDRs) == 0 {
+			return nil
+		}
+
 		result := make([]ctrl.Request, 0, 1)
 		result = append(result, ctrl.Request{
 			NamespacedName: client.ObjectKey{
@@ -193,9 +429,8 @@ func linodeMachineToLinodeCluster(tracedClient client.Client, logger logr.Logger
 			return nil
 		}
 
-		// We only need control plane machines to trigger reconciliation
 		machine, err := kutil.GetOwnerMachine(ctx, tracedClient, linodeMachine.ObjectMeta)
-		if err != nil || machine == nil || !kutil.IsControlPlaneMachine(machine) {
+		if err != nil || machine == nil {
 			return nil
 		}
 
@@ -211,6 +446,12 @@ func linodeMachineToLinodeCluster(tracedClient client.Client, logger logr.Logger
 			return nil
 		}
 
+		// We only need control plane machines to trigger reconciliation, unless the addresses of every machine
+		// are admitted by the API server firewall
+		if !kutil.IsControlPlaneMachine(machine) && len(linodeCluster.Spec.Network.APIServerAllowedCIDRs) == 0 {
+			return nil
+		}
+
 		result := make([]ctrl.Request, 0, 1)
 		result = append(result, ctrl.Request{
 			NamespacedName: client.ObjectKey{
@@ -223,6 +464,33 @@ func linodeMachineToLinodeCluster(tracedClient client.Client, logger logr.Logger
 	}
 }
 
+// linodeMachinePoolMachineToLinodeCluster maps a LinodeMachinePoolMachine to its LinodeCluster, whose API server
+// firewall admits the addresses of the machines of its machine pools.
+func linodeMachinePoolMachineToLinodeCluster(tracedClient client.Client, logger logr.Logger) handler.MapFunc {
+	logger = logger.WithName("LinodeClusterReconciler").WithName("linodeMachinePoolMachineToLinodeCluster")
+
+	return func(ctx context.Context, o client.Object) []ctrl.Request {
+		ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultMappingTimeout)
+		defer cancel()
+
+		clusterName, ok := o.GetLabels()[clusterv1.ClusterNameLabel]
+		if !ok {
+			return nil
+		}
+
+		linodeCluster := infrav1alpha2.LinodeCluster{}
+		if err := tracedClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: o.GetNamespace()}, &linodeCluster); err != nil {
+			logger.Info("Failed to get LinodeCluster")
+			return nil
+		}
+		if len(linodeCluster.Spec.Network.APIServerAllowedCIDRs) == 0 {
+			return nil
+		}
+
+		return []ctrl.Request{{NamespacedName: client.ObjectKeyFromObject(&linodeCluster)}}
+	}
+}
+
 func handleDNS(clusterScope *scope.ClusterScope) {
 	clusterSpec := clusterScope.LinodeCluster.Spec
 	clusterMetadata := clusterScope.LinodeCluster.ObjectMeta
@@ -243,6 +511,23 @@ func handleDNS(clusterScope *scope.ClusterScope) {
 	}
 }
 
+func handleSharedIPCreate(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
+	sharedIP, err := services.EnsureSharedIP(ctx, clusterScope, logger)
+	if err != nil {
+		logger.Error(err, "failed to ensure shared IP address")
+		return err
+	}
+	clusterScope.LinodeCluster.Spec.Network.SharedIPv4 = sharedIP.Address
+
+	apiLBPort := services.DetermineAPIServerLBPort(clusterScope)
+	clusterScope.LinodeCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{
+		Host: sharedIP.Address,
+		Port: int32(apiLBPort), // #nosec G115: Integer overflow conversion is safe for port numbers
+	}
+
+	return nil
+}
+
 func handleNBCreate(ctx context.Context, logger logr.Logger, clusterScope *scope.ClusterScope) error {
 	linodeNB, err := services.EnsureNodeBalancer(ctx, clusterScope, logger)
 	if err != nil {
@@ -263,15 +548,10 @@ func handleNBCreate(ctx context.Context, logger logr.Logger, clusterScope *scope
 	}
 
 	clusterScope.LinodeCluster.Spec.Network.ApiserverNodeBalancerConfigID = util.Pointer(configs[0].ID)
-	additionalPorts := make([]infrav1alpha2.LinodeNBPortConfig, 0)
-	for _, config := range configs[1:] {
-		portConfig := infrav1alpha2.LinodeNBPortConfig{
-			Port:                 config.Port,
-			NodeBalancerConfigID: &config.ID,
-		}
-		additionalPorts = append(additionalPorts, portConfig)
+	// configs are returned in the order of the additional ports, keep their settings
+	for i, config := range configs[1:] {
+		clusterScope.LinodeCluster.Spec.Network.AdditionalPorts[i].NodeBalancerConfigID = &config.ID
 	}
-	clusterScope.LinodeCluster.Spec.Network.AdditionalPorts = additionalPorts
 
 	clusterScope.LinodeCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{
 		Host: *linodeNB.IPv4,
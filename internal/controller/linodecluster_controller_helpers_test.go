@@ -2,7 +2,11 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
 	"testing"
 	"time"
 
@@ -14,16 +18,25 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/cloud/services"
 	"github.com/linode/cluster-api-provider-linode/mock"
+	"github.com/linode/cluster-api-provider-linode/observability/wrappers/linodeclient"
 	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
 )
 
 func TestGetIPPortCombo(t *testing.T) {
@@ -190,6 +203,43 @@ func TestGetIPPortCombo(t *testing.T) {
 			},
 			expectedCombo: []string{"192.168.128.100:6443", "192.168.128.100:8080", "192.168.128.100:9090"},
 		},
+		{
+			name: "With target ports",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							ApiserverNodeBalancerConfig: &infrav1alpha2.LinodeNBConfigSettings{
+								TargetPort: 7443,
+							},
+							AdditionalPorts: []infrav1alpha2.LinodeNBPortConfig{
+								{
+									Port: 8080,
+									LinodeNBConfigSettings: infrav1alpha2.LinodeNBConfigSettings{
+										TargetPort: 8081,
+									},
+								},
+							},
+						},
+					},
+				},
+				LinodeMachines: infrav1alpha2.LinodeMachineList{
+					Items: []infrav1alpha2.LinodeMachine{
+						{
+							Status: infrav1alpha2.LinodeMachineStatus{
+								Addresses: []clusterv1.MachineAddress{
+									{
+										Type:    clusterv1.MachineInternalIP,
+										Address: "192.168.128.100",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedCombo: []string{"192.168.128.100:7443", "192.168.128.100:8081"},
+		},
 		{
 			name: "With VPC IPs and additional ports",
 			clusterScope: &scope.ClusterScope{
@@ -318,7 +368,7 @@ func TestGetIPPortCombo(t *testing.T) {
 			result := getIPPortCombo(testcase.clusterScope)
 
 			// Verify the results
-			assert.ElementsMatch(t, testcase.expectedCombo, result)
+			assert.ElementsMatch(t, testcase.expectedCombo, slices.Concat(slices.Collect(maps.Values(result))...))
 		})
 	}
 }
@@ -397,6 +447,59 @@ func TestAddMachineToLB(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "Stale backends should be removed from every config",
+			clusterScope: &scope.ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType:              lbTypeNB,
+							NodeBalancerID:                util.Pointer(12345),
+							ApiserverNodeBalancerConfigID: util.Pointer(67890),
+							AdditionalPorts: []infrav1alpha2.LinodeNBPortConfig{
+								{
+									Port:                 8132,
+									NodeBalancerConfigID: util.Pointer(67891),
+									// The target port was changed from the default, which is the port itself
+									LinodeNBConfigSettings: infrav1alpha2.LinodeNBConfigSettings{TargetPort: 8133},
+								},
+							},
+						},
+					},
+				},
+				LinodeMachines: infrav1alpha2.LinodeMachineList{
+					Items: []infrav1alpha2.LinodeMachine{
+						{
+							Status: infrav1alpha2.LinodeMachineStatus{
+								Addresses: []clusterv1.MachineAddress{
+									{Type: clusterv1.MachineInternalIP, Address: "192.168.128.10"},
+								},
+							},
+						},
+					},
+				},
+			},
+			setupMocks: func(mockLinodeClient *mock.MockLinodeClient, mockDNSClient *mock.MockAkamClient, mockK8sClient *mock.MockK8sClient) {
+				mockLinodeClient.EXPECT().ListNodeBalancerNodes(gomock.Any(), 12345, 67890, gomock.Any()).
+					Return([]linodego.NodeBalancerNode{
+						{ID: 1, NodeBalancerID: 12345, ConfigID: 67890, Address: "192.168.128.10:6443"},
+					}, nil)
+				mockLinodeClient.EXPECT().ListNodeBalancerNodes(gomock.Any(), 12345, 67891, gomock.Any()).
+					Return([]linodego.NodeBalancerNode{
+						{ID: 2, NodeBalancerID: 12345, ConfigID: 67891, Address: "192.168.128.10:8132"},
+					}, nil)
+				mockLinodeClient.EXPECT().CreateNodeBalancerNode(gomock.Any(), 12345, 67891, linodego.NodeBalancerNodeCreateOptions{
+					Label:   "test-cluster",
+					Address: "192.168.128.10:8133",
+					Mode:    linodego.ModeAccept,
+				}).Return(&linodego.NodeBalancerNode{}, nil)
+				mockLinodeClient.EXPECT().DeleteNodeBalancerNode(gomock.Any(), 12345, 67891, 2).Return(nil)
+			},
+			expectedError: false,
+		},
 		{
 			name: "Error listing NodeBalancer nodes",
 			clusterScope: &scope.ClusterScope{
@@ -466,6 +569,36 @@ func TestAddMachineToLB(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "DNS records owned by another entity",
+			clusterScope: &scope.ClusterScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-cluster",
+						Namespace: defaultNamespace,
+						UID:       "test-uid",
+					},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType: lbTypeDNS,
+							DNSRootDomain:    "akafn.com",
+							DNSProvider:      "akamai",
+						},
+					},
+				},
+			},
+			setupMocks: func(mockLinodeClient *mock.MockLinodeClient, mockDNSClient *mock.MockAkamClient, mockK8sClient *mock.MockK8sClient) {
+				mockDNSClient.EXPECT().GetRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, params dns.GetRecordRequest) (*dns.GetRecordResponse, error) {
+						if params.RecordType == string(linodego.RecordTypeTXT) {
+							return &dns.GetRecordResponse{Target: []string{`"heritage=external-dns,external-dns/owner=default"`}}, nil
+						}
+						return &dns.GetRecordResponse{Target: []string{"10.10.10.10"}}, nil
+					}).AnyTimes()
+			},
+			expectedError:       true,
+			expectedErrorString: "owned by another entity",
+		},
 		{
 			name: "machine not ready yet for DNS load balancer type",
 			clusterScope: &scope.ClusterScope{
@@ -600,6 +733,12 @@ func TestAddMachineToLB(t *testing.T) {
 				if testcase.expectedErrorString != "" {
 					assert.Contains(t, err.Error(), testcase.expectedErrorString)
 				}
+				if errors.Is(err, services.ErrDNSOwnerConflict) {
+					condition := testcase.clusterScope.LinodeCluster.GetCondition(ConditionDNSOwnerConflict)
+					require.NotNil(t, condition)
+					assert.Equal(t, metav1.ConditionTrue, condition.Status)
+					assert.Equal(t, DNSRecordsOwnedElsewhereReason, condition.Reason)
+				}
 			} else {
 				require.NoError(t, err)
 				// For the NodeBalancer without IDs case, verify that LoadBalancerType was changed to lbTypeExternal
@@ -660,6 +799,11 @@ func TestLinodeMachineToLinodeCluster(t *testing.T) {
 						},
 					}).
 					Return(nil)
+
+				// The LinodeCluster has no API server allowlist
+				mockClient.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeCluster{})).
+					Return(nil)
 			},
 			inputObject: &infrav1alpha2.LinodeMachine{
 				ObjectMeta: metav1.ObjectMeta{
@@ -778,6 +922,60 @@ func TestLinodeMachineToLinodeCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Worker LinodeMachine with API server allowlist",
+			setupMockClient: func(mockClient *mock.MockK8sClient) {
+				mockClient.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&clusterv1.Machine{})).
+					SetArg(2, clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "worker-machine",
+							Namespace: "default",
+							Labels: map[string]string{
+								clusterv1.ClusterNameLabel: "test-cluster",
+							},
+						},
+					}).
+					Return(nil)
+
+				mockClient.EXPECT().
+					Get(gomock.Any(), types.NamespacedName{
+						Name:      "test-cluster",
+						Namespace: "default",
+					}, gomock.AssignableToTypeOf(&infrav1alpha2.LinodeCluster{})).
+					DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj *infrav1alpha2.LinodeCluster, _ ...client.GetOption) error {
+						obj.Name = "test-cluster"
+						obj.Namespace = "default"
+						obj.Spec.Network.APIServerAllowedCIDRs = []string{"203.0.113.0/24"}
+						return nil
+					})
+			},
+			inputObject: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "worker-machine",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: clusterv1.GroupVersion.String(),
+							Kind:       "Machine",
+							Name:       "worker-machine",
+							UID:        "abc-123",
+						},
+					},
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel: "test-cluster",
+					},
+				},
+			},
+			expectedRequests: []ctrl.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Namespace: "default",
+						Name:      "test-cluster",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -811,3 +1009,581 @@ func TestLinodeMachineToLinodeCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileAPIServerFirewall(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, infrav1alpha2.AddToScheme(scheme))
+
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "linodefirewalls"}, "test-cluster-apiserver")
+	managedFirewall := func(firewall *infrav1alpha2.LinodeFirewall) {
+		firewall.Name = "test-cluster-apiserver"
+		firewall.Namespace = "default"
+		firewall.Labels = map[string]string{infrav1alpha2.APIServerFirewallLabel: "test-cluster"}
+		firewall.Spec.FirewallID = ptr.To(5678)
+	}
+	controlPlaneMachines := func(list *infrav1alpha2.LinodeMachineList) {
+		list.Items = []infrav1alpha2.LinodeMachine{{
+			Status: infrav1alpha2.LinodeMachineStatus{
+				Addresses: []clusterv1.MachineAddress{
+					{Type: clusterv1.MachineExternalIP, Address: "172.235.1.2"},
+					{Type: clusterv1.MachineExternalIP, Address: "2600:3c06::1"},
+					{Type: clusterv1.MachineInternalIP, Address: "10.0.0.2"},
+					{Type: clusterv1.MachineInternalDNS, Address: "test-cluster-control-plane"},
+				},
+			},
+		}}
+	}
+
+	poolMachines := func(list *infrav1alpha2.LinodeMachinePoolMachineList) {
+		list.Items = []infrav1alpha2.LinodeMachinePoolMachine{{
+			Status: infrav1alpha2.LinodeMachinePoolMachineStatus{
+				Addresses: []clusterv1.MachineAddress{
+					{Type: clusterv1.MachineExternalIP, Address: "172.235.1.3"},
+					{Type: clusterv1.MachineInternalIP, Address: "10.0.0.3"},
+				},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name           string
+		allowedCIDRs   []string
+		nodeBalancerID *int
+		expects        func(*mock.MockK8sClient, *mock.MockLinodeClient)
+		expectedResult ctrl.Result
+		expectedError  string
+	}{
+		{
+			name: "no allowlist",
+			expects: func(k8sClient *mock.MockK8sClient, _ *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).Return(notFound)
+			},
+		},
+		{
+			name: "allowlist removed",
+			expects: func(k8sClient *mock.MockK8sClient, _ *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).
+					DoAndReturn(func(_ context.Context, _ types.NamespacedName, firewall *infrav1alpha2.LinodeFirewall, _ ...client.GetOption) error {
+						managedFirewall(firewall)
+						return nil
+					})
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).Return(nil)
+			},
+		},
+		{
+			name:         "firewall created before the NodeBalancer",
+			allowedCIDRs: []string{"203.0.113.0/24", "2001:db8::/32"},
+			expects: func(k8sClient *mock.MockK8sClient, _ *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).Return(notFound)
+				k8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachineList{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, list *infrav1alpha2.LinodeMachineList, _ ...client.ListOption) error {
+						controlPlaneMachines(list)
+						return nil
+					})
+				k8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachinePoolMachineList{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, list *infrav1alpha2.LinodeMachinePoolMachineList, _ ...client.ListOption) error {
+						poolMachines(list)
+						return nil
+					})
+				k8sClient.EXPECT().Scheme().Return(scheme)
+				k8sClient.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).
+					DoAndReturn(func(_ context.Context, firewall *infrav1alpha2.LinodeFirewall, _ ...client.CreateOption) error {
+						assert.Equal(t, "test-cluster", firewall.Labels[infrav1alpha2.APIServerFirewallLabel])
+						assert.Equal(t, "test-cluster", firewall.Labels[clusterv1.ClusterNameLabel])
+						assert.Len(t, firewall.OwnerReferences, 1)
+						assert.Equal(t, infrav1alpha2.LinodeFirewallSpec{
+							Enabled:       true,
+							InboundPolicy: "DROP",
+							InboundRules: []infrav1alpha2.FirewallRuleSpec{
+								{
+									Action:      "ACCEPT",
+									Label:       "allowed-cidrs",
+									Description: "apiServerAllowedCIDRs of the LinodeCluster",
+									Ports:       "6443,8132",
+									Protocol:    linodego.TCP,
+									Addresses: &infrav1alpha2.NetworkAddresses{
+										IPv4: ptr.To([]string{"203.0.113.0/24"}),
+										IPv6: ptr.To([]string{"2001:db8::/32"}),
+									},
+								},
+								{
+									Action:      "ACCEPT",
+									Label:       "cluster-machines",
+									Description: "Addresses of the LinodeMachines and LinodeMachinePoolMachines of the cluster",
+									Ports:       "6443,8132",
+									Protocol:    linodego.TCP,
+									Addresses: &infrav1alpha2.NetworkAddresses{
+										IPv4: ptr.To([]string{"10.0.0.2", "10.0.0.3", "172.235.1.2", "172.235.1.3"}),
+										IPv6: ptr.To([]string{"2600:3c06::1"}),
+									},
+								},
+							},
+							OutboundPolicy: "ACCEPT",
+						}, firewall.Spec)
+						return nil
+					})
+			},
+			expectedResult: ctrl.Result{RequeueAfter: reconciler.DefaultClusterControllerReconcileDelay},
+		},
+		{
+			name:           "firewall updated for an existing NodeBalancer",
+			allowedCIDRs:   []string{"203.0.113.0/24"},
+			nodeBalancerID: ptr.To(1234),
+			expects: func(k8sClient *mock.MockK8sClient, _ *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).
+					DoAndReturn(func(_ context.Context, _ types.NamespacedName, firewall *infrav1alpha2.LinodeFirewall, _ ...client.GetOption) error {
+						managedFirewall(firewall)
+						return nil
+					})
+				k8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachineList{}), gomock.Any()).Return(nil)
+				k8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachinePoolMachineList{}), gomock.Any()).Return(nil)
+				k8sClient.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, firewall *infrav1alpha2.LinodeFirewall, _ client.Patch, _ ...client.PatchOption) error {
+						assert.Equal(t, ptr.To(5678), firewall.Spec.FirewallID)
+						assert.Len(t, firewall.Spec.InboundRules, 1)
+						return nil
+					})
+			},
+		},
+		{
+			name:         "firewall not managed by the cluster",
+			allowedCIDRs: []string{"203.0.113.0/24"},
+			expects: func(k8sClient *mock.MockK8sClient, _ *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).Return(nil)
+			},
+			expectedError: "already exists and is not managed by the LinodeCluster",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			mockK8sClient := mock.NewMockK8sClient(mockCtrl)
+			mockLinodeClient := mock.NewMockLinodeClient(mockCtrl)
+			testcase.expects(mockK8sClient, mockLinodeClient)
+
+			clusterScope := &scope.ClusterScope{
+				Client:       mockK8sClient,
+				LinodeClient: mockLinodeClient,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default", UID: "test-uid"},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType:      lbTypeNB,
+							NodeBalancerID:        testcase.nodeBalancerID,
+							APIServerAllowedCIDRs: testcase.allowedCIDRs,
+							AdditionalPorts:       []infrav1alpha2.LinodeNBPortConfig{{Port: 8132}},
+						},
+					},
+				},
+			}
+			r := &LinodeClusterReconciler{Client: mockK8sClient}
+
+			res, err := r.reconcileAPIServerFirewall(t.Context(), testr.New(t), clusterScope)
+			if testcase.expectedError != "" {
+				assert.ErrorContains(t, err, testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			if testcase.expectedResult.RequeueAfter != 0 {
+				assert.GreaterOrEqual(t, res.RequeueAfter, testcase.expectedResult.RequeueAfter)
+			} else {
+				assert.Zero(t, res)
+			}
+		})
+	}
+}
+
+func TestReconcileNodeBalancerFirewall(t *testing.T) {
+	t.Parallel()
+
+	nodeBalancerDevice := func(deviceID int) []linodego.FirewallDevice {
+		return []linodego.FirewallDevice{{
+			ID:     deviceID,
+			Entity: linodego.FirewallDeviceEntity{ID: 1234, Type: linodego.FirewallDeviceNodeBalancer},
+		}}
+	}
+	attachOptions := linodego.FirewallDeviceCreateOptions{ID: 1234, Type: linodego.FirewallDeviceNodeBalancer}
+
+	tests := []struct {
+		name           string
+		firewallID     *int
+		firewallRef    *corev1.ObjectReference
+		attachedID     *int
+		expects        func(*mock.MockK8sClient, *mock.MockLinodeClient)
+		expectedResult ctrl.Result
+		wantAttachedID *int
+		wantReason     string
+		wantEvent      bool
+	}{
+		{
+			name:       "firewall swapped",
+			firewallID: ptr.To(200),
+			attachedID: ptr.To(100),
+			expects: func(_ *mock.MockK8sClient, linodeClient *mock.MockLinodeClient) {
+				linodeClient.EXPECT().ListNodeBalancerFirewalls(gomock.Any(), 1234, gomock.Any()).Return([]linodego.Firewall{{ID: 100}}, nil)
+				linodeClient.EXPECT().ListFirewallDevices(gomock.Any(), 100, gomock.Any()).Return(nodeBalancerDevice(10), nil)
+				linodeClient.EXPECT().DeleteFirewallDevice(gomock.Any(), 100, 10).Return(nil)
+				linodeClient.EXPECT().CreateFirewallDevice(gomock.Any(), 200, attachOptions).Return(&linodego.FirewallDevice{}, nil)
+			},
+			wantAttachedID: ptr.To(200),
+			wantReason:     NodeBalancerFirewallAttachedReason,
+		},
+		{
+			name:       "firewall detached",
+			attachedID: ptr.To(100),
+			expects: func(_ *mock.MockK8sClient, linodeClient *mock.MockLinodeClient) {
+				linodeClient.EXPECT().ListNodeBalancerFirewalls(gomock.Any(), 1234, gomock.Any()).Return([]linodego.Firewall{{ID: 100}}, nil)
+				linodeClient.EXPECT().ListFirewallDevices(gomock.Any(), 100, gomock.Any()).Return(nodeBalancerDevice(10), nil)
+				linodeClient.EXPECT().DeleteFirewallDevice(gomock.Any(), 100, 10).Return(nil)
+			},
+		},
+		{
+			name:       "firewall detached outside of CAPL is re-attached",
+			firewallID: ptr.To(100),
+			attachedID: ptr.To(100),
+			expects: func(_ *mock.MockK8sClient, linodeClient *mock.MockLinodeClient) {
+				linodeClient.EXPECT().ListNodeBalancerFirewalls(gomock.Any(), 1234, gomock.Any()).Return([]linodego.Firewall{}, nil)
+				linodeClient.EXPECT().CreateFirewallDevice(gomock.Any(), 100, attachOptions).Return(&linodego.FirewallDevice{}, nil)
+			},
+			wantAttachedID: ptr.To(100),
+			wantReason:     NodeBalancerFirewallAttachedReason,
+			wantEvent:      true,
+		},
+		{
+			name: "firewall attached outside of CAPL is left alone",
+			expects: func(_ *mock.MockK8sClient, linodeClient *mock.MockLinodeClient) {
+				linodeClient.EXPECT().ListNodeBalancerFirewalls(gomock.Any(), 1234, gomock.Any()).Return([]linodego.Firewall{{ID: 300}}, nil)
+			},
+		},
+		{
+			name:        "referenced firewall already attached",
+			firewallRef: &corev1.ObjectReference{Name: "nb-firewall"},
+			expects: func(k8sClient *mock.MockK8sClient, linodeClient *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "default", Name: "nb-firewall"}, gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).
+					DoAndReturn(func(_ context.Context, _ types.NamespacedName, firewall *infrav1alpha2.LinodeFirewall, _ ...client.GetOption) error {
+						firewall.Spec.FirewallID = ptr.To(300)
+						return nil
+					})
+				linodeClient.EXPECT().ListNodeBalancerFirewalls(gomock.Any(), 1234, gomock.Any()).Return([]linodego.Firewall{{ID: 300}}, nil)
+			},
+			wantAttachedID: ptr.To(300),
+			wantReason:     NodeBalancerFirewallAttachedReason,
+		},
+		{
+			name:        "referenced firewall not created yet",
+			firewallRef: &corev1.ObjectReference{Name: "nb-firewall"},
+			expects: func(k8sClient *mock.MockK8sClient, _ *mock.MockLinodeClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewall{})).Return(nil)
+			},
+			expectedResult: ctrl.Result{RequeueAfter: reconciler.DefaultClusterControllerReconcileDelay},
+		},
+		{
+			name:       "attaching the firewall fails",
+			firewallID: ptr.To(200),
+			expects: func(_ *mock.MockK8sClient, linodeClient *mock.MockLinodeClient) {
+				linodeClient.EXPECT().ListNodeBalancerFirewalls(gomock.Any(), 1234, gomock.Any()).Return([]linodego.Firewall{}, nil)
+				linodeClient.EXPECT().CreateFirewallDevice(gomock.Any(), 200, attachOptions).Return(nil, &linodego.Error{Code: http.StatusInternalServerError})
+			},
+			expectedResult: ctrl.Result{RequeueAfter: reconciler.DefaultClusterControllerReconcileDelay},
+			wantReason:     NodeBalancerFirewallFailedReason,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			mockK8sClient := mock.NewMockK8sClient(mockCtrl)
+			mockLinodeClient := mock.NewMockLinodeClient(mockCtrl)
+			testcase.expects(mockK8sClient, mockLinodeClient)
+
+			clusterScope := &scope.ClusterScope{
+				Client:       mockK8sClient,
+				LinodeClient: mockLinodeClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						NodeBalancerFirewallRef: testcase.firewallRef,
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType:       lbTypeNB,
+							NodeBalancerID:         ptr.To(1234),
+							NodeBalancerFirewallID: testcase.firewallID,
+						},
+					},
+					Status: infrav1alpha2.LinodeClusterStatus{NodeBalancerFirewallID: testcase.attachedID},
+				},
+			}
+			recorder := events.NewFakeRecorder(10)
+			r := &LinodeClusterReconciler{Client: mockK8sClient, Recorder: recorder}
+
+			res, err := r.reconcileNodeBalancerFirewall(t.Context(), testr.New(t), clusterScope)
+			require.NoError(t, err)
+			if testcase.expectedResult.RequeueAfter != 0 {
+				assert.GreaterOrEqual(t, res.RequeueAfter, testcase.expectedResult.RequeueAfter)
+			} else {
+				assert.Zero(t, res)
+			}
+			assert.Equal(t, testcase.wantAttachedID, clusterScope.LinodeCluster.Status.NodeBalancerFirewallID)
+			if testcase.wantReason != "" {
+				condition := clusterScope.LinodeCluster.GetCondition(ConditionNodeBalancerFirewallAttached)
+				require.NotNil(t, condition)
+				assert.Equal(t, testcase.wantReason, condition.Reason)
+			}
+			if testcase.wantEvent {
+				assert.Contains(t, <-recorder.Events, NodeBalancerFirewallDetachedReason)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+// patchRecorder records the status patches of the objects it is given.
+type patchRecorder struct {
+	client.SubResourceWriter
+	patched []client.Object
+	patches [][]byte
+}
+
+func (p *patchRecorder) Patch(_ context.Context, obj client.Object, patch client.Patch, _ ...client.SubResourcePatchOption) error {
+	data, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+	p.patched = append(p.patched, obj)
+	p.patches = append(p.patches, data)
+	return nil
+}
+
+func TestReconcileNodeBalancerBackendHealth(t *testing.T) {
+	t.Parallel()
+
+	machine := func(name, ip string, previous *metav1.ConditionStatus) infrav1alpha2.LinodeMachine {
+		linodeMachine := infrav1alpha2.LinodeMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: "1"},
+			Status: infrav1alpha2.LinodeMachineStatus{
+				Addresses: []clusterv1.MachineAddress{{Type: clusterv1.MachineInternalIP, Address: ip}},
+			},
+		}
+		if previous != nil {
+			linodeMachine.SetCondition(metav1.Condition{Type: ConditionAPIServerBackendHealthy, Status: *previous, Reason: APIServerBackendUpReason})
+		}
+		return linodeMachine
+	}
+	ownedMachine := func(name, ip string, previous *metav1.ConditionStatus) infrav1alpha2.LinodeMachine {
+		linodeMachine := machine(name, ip, previous)
+		linodeMachine.OwnerReferences = []metav1.OwnerReference{{APIVersion: clusterv1.GroupVersion.String(), Kind: "Machine", Name: name}}
+		return linodeMachine
+	}
+
+	tests := []struct {
+		name          string
+		machines      []infrav1alpha2.LinodeMachine
+		nodes         []linodego.NodeBalancerNode
+		wantBackends  []infrav1alpha2.NodeBalancerBackendStatus
+		wantPatched   int
+		wantCondition map[string]metav1.ConditionStatus
+		wantEvent     string
+		// owner is the Machine owning the first LinodeMachine, wantOwner the status of its APIServerBackendHealthy
+		// condition once reconciled
+		owner     *clusterv1.Machine
+		wantOwner metav1.ConditionStatus
+	}{
+		{
+			name:     "backend goes down",
+			machines: []infrav1alpha2.LinodeMachine{machine("cp-0", "192.168.128.1", ptr.To(metav1.ConditionTrue))},
+			nodes:    []linodego.NodeBalancerNode{{ConfigID: 2, Address: "192.168.128.1:6443", Status: "DOWN"}},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.1:6443", Status: "DOWN", Machine: "cp-0"},
+			},
+			wantPatched:   1,
+			wantCondition: map[string]metav1.ConditionStatus{"cp-0": metav1.ConditionFalse},
+			wantEvent:     APIServerBackendDownReason,
+		},
+		{
+			name:     "backend comes back up",
+			machines: []infrav1alpha2.LinodeMachine{machine("cp-0", "192.168.128.1", ptr.To(metav1.ConditionFalse))},
+			nodes:    []linodego.NodeBalancerNode{{ConfigID: 2, Address: "192.168.128.1:6443", Status: "UP"}},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.1:6443", Status: "UP", Machine: "cp-0"},
+			},
+			wantPatched:   1,
+			wantCondition: map[string]metav1.ConditionStatus{"cp-0": metav1.ConditionTrue},
+			wantEvent:     APIServerBackendUpReason,
+		},
+		{
+			name:     "healthy backend is reported once",
+			machines: []infrav1alpha2.LinodeMachine{machine("cp-0", "192.168.128.1", nil), machine("cp-1", "192.168.128.2", ptr.To(metav1.ConditionTrue))},
+			nodes: []linodego.NodeBalancerNode{
+				{ConfigID: 2, Address: "192.168.128.1:6443", Status: "UP"},
+				{ConfigID: 2, Address: "192.168.128.2:6443", Status: "UP"},
+			},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.1:6443", Status: "UP", Machine: "cp-0"},
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.2:6443", Status: "UP", Machine: "cp-1"},
+			},
+			wantPatched:   1,
+			wantCondition: map[string]metav1.ConditionStatus{"cp-0": metav1.ConditionTrue, "cp-1": metav1.ConditionTrue},
+		},
+		{
+			name:     "backend health is mirrored on the Machine",
+			machines: []infrav1alpha2.LinodeMachine{ownedMachine("cp-0", "192.168.128.1", ptr.To(metav1.ConditionTrue))},
+			nodes:    []linodego.NodeBalancerNode{{ConfigID: 2, Address: "192.168.128.1:6443", Status: "DOWN"}},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.1:6443", Status: "DOWN", Machine: "cp-0"},
+			},
+			wantPatched:   2,
+			wantCondition: map[string]metav1.ConditionStatus{"cp-0": metav1.ConditionFalse},
+			wantEvent:     APIServerBackendDownReason,
+			owner:         &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "cp-0", Namespace: "default", ResourceVersion: "1"}},
+			wantOwner:     metav1.ConditionFalse,
+		},
+		{
+			name:     "conditions of the Machine written by others are kept",
+			machines: []infrav1alpha2.LinodeMachine{ownedMachine("cp-0", "192.168.128.1", ptr.To(metav1.ConditionTrue))},
+			nodes:    []linodego.NodeBalancerNode{{ConfigID: 2, Address: "192.168.128.1:6443", Status: "DOWN"}},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.1:6443", Status: "DOWN", Machine: "cp-0"},
+			},
+			wantPatched:   2,
+			wantCondition: map[string]metav1.ConditionStatus{"cp-0": metav1.ConditionFalse},
+			wantEvent:     APIServerBackendDownReason,
+			owner: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp-0", Namespace: "default", ResourceVersion: "42"},
+				Status: clusterv1.MachineStatus{
+					Conditions: []metav1.Condition{{Type: clusterv1.MachineHealthCheckSucceededCondition, Status: metav1.ConditionTrue, Reason: "Succeeded"}},
+				},
+			},
+			wantOwner: metav1.ConditionFalse,
+		},
+		{
+			name:     "missing Machine condition is reported again",
+			machines: []infrav1alpha2.LinodeMachine{ownedMachine("cp-0", "192.168.128.1", ptr.To(metav1.ConditionTrue))},
+			nodes:    []linodego.NodeBalancerNode{{ConfigID: 2, Address: "192.168.128.1:6443", Status: "UP"}},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.1:6443", Status: "UP", Machine: "cp-0"},
+			},
+			wantPatched:   1,
+			wantCondition: map[string]metav1.ConditionStatus{"cp-0": metav1.ConditionTrue},
+			owner:         &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "cp-0", Namespace: "default", ResourceVersion: "1"}},
+			wantOwner:     metav1.ConditionTrue,
+		},
+		{
+			name:     "machine without backend",
+			machines: []infrav1alpha2.LinodeMachine{machine("cp-0", "192.168.128.1", nil)},
+			nodes:    []linodego.NodeBalancerNode{{ConfigID: 2, Address: "192.168.128.9:6443", Status: "unknown"}},
+			wantBackends: []infrav1alpha2.NodeBalancerBackendStatus{
+				{ConfigID: 2, Port: 6443, Address: "192.168.128.9:6443", Status: "unknown"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			mockK8sClient := mock.NewMockK8sClient(mockCtrl)
+			mockLinodeClient := mock.NewMockLinodeClient(mockCtrl)
+			statusWriter := &patchRecorder{}
+			mockK8sClient.EXPECT().Status().Return(statusWriter).AnyTimes()
+			mockLinodeClient.EXPECT().ListNodeBalancerNodes(gomock.Any(), 1, 2, gomock.Any()).Return(testcase.nodes, nil)
+			if testcase.owner != nil {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKeyFromObject(testcase.owner), gomock.AssignableToTypeOf(&clusterv1.Machine{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *clusterv1.Machine, _ ...client.GetOption) error {
+						testcase.owner.DeepCopyInto(obj)
+						return nil
+					})
+			}
+
+			clusterScope := &scope.ClusterScope{
+				Client:       mockK8sClient,
+				LinodeClient: mockLinodeClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							LoadBalancerType:              lbTypeNB,
+							NodeBalancerID:                ptr.To(1),
+							ApiserverNodeBalancerConfigID: ptr.To(2),
+						},
+					},
+				},
+				LinodeMachines: infrav1alpha2.LinodeMachineList{Items: testcase.machines},
+			}
+			recorder := events.NewFakeRecorder(10)
+			r := &LinodeClusterReconciler{Client: mockK8sClient, Recorder: recorder}
+
+			err := r.reconcileNodeBalancerBackendHealth(t.Context(), testr.New(t), clusterScope)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.wantBackends, clusterScope.LinodeCluster.Status.NodeBalancerBackends)
+			assert.Len(t, statusWriter.patched, testcase.wantPatched)
+			for _, linodeMachine := range clusterScope.LinodeMachines.Items {
+				condition := linodeMachine.GetCondition(ConditionAPIServerBackendHealthy)
+				want, ok := testcase.wantCondition[linodeMachine.Name]
+				if !ok {
+					assert.Nil(t, condition)
+					continue
+				}
+				require.NotNil(t, condition)
+				assert.Equal(t, want, condition.Status)
+			}
+			if testcase.owner != nil {
+				owner, ok := statusWriter.patched[len(statusWriter.patched)-1].(*clusterv1.Machine)
+				require.True(t, ok)
+				condition := meta.FindStatusCondition(owner.Status.Conditions, ConditionAPIServerBackendHealthy)
+				require.NotNil(t, condition)
+				assert.Equal(t, testcase.wantOwner, condition.Status)
+				// The patch replaces the conditions of the Machine, so it must only apply to the version it was computed from
+				patch := string(statusWriter.patches[len(statusWriter.patches)-1])
+				assert.Contains(t, patch, `"resourceVersion":"`+testcase.owner.ResourceVersion+`"`)
+				for _, foreign := range testcase.owner.Status.Conditions {
+					assert.NotNil(t, meta.FindStatusCondition(owner.Status.Conditions, foreign.Type))
+					assert.Contains(t, patch, foreign.Type)
+				}
+			}
+			if testcase.wantEvent != "" {
+				assert.Contains(t, <-recorder.Events, testcase.wantEvent)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestHandleSharedIPCreateInDryRun(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	mockLinodeClient := mock.NewMockLinodeClient(mockCtrl)
+	mockLinodeClient.EXPECT().ListReservedIPAddresses(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	linodeCluster := &infrav1alpha2.LinodeCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default", UID: "test-uid"},
+		Spec: infrav1alpha2.LinodeClusterSpec{
+			Region:  "us-ord",
+			Network: infrav1alpha2.NetworkSpec{LoadBalancerType: lbTypeSharedIP},
+		},
+	}
+	objects := linodeclient.NewDryRunObjects()
+	config := scope.ClientConfig{DryRun: true, DryRunObjects: objects}
+	clusterScope := &scope.ClusterScope{
+		LinodeClient:  linodeclient.NewLinodeClientWithDryRun(mockLinodeClient, config.DryRunInterceptor(linodeCluster)),
+		LinodeCluster: linodeCluster,
+	}
+
+	require.NoError(t, handleSharedIPCreate(t.Context(), testr.New(t), clusterScope))
+	// The reservation is intercepted, so the LinodeCluster holds an empty address which must not be persisted
+	assert.Empty(t, linodeCluster.Spec.Network.SharedIPv4)
+	assert.Empty(t, linodeCluster.Spec.ControlPlaneEndpoint.Host)
+	assert.True(t, objects.Contains(linodeCluster))
+}
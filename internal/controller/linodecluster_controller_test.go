@@ -1077,3 +1077,121 @@ var _ = Describe("cluster-with-direct-vpcid", Ordered, Label("cluster", "direct-
 		),
 	)
 })
+
+var _ = Describe("failure-domains", Label("cluster", "failure-domains"), func() {
+	linodeCluster := infrav1alpha2.LinodeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "failure-domains",
+			Namespace: defaultNamespace,
+		},
+		Spec: infrav1alpha2.LinodeClusterSpec{
+			Region: "us-ord",
+			FailureDomains: []infrav1alpha2.LinodeFailureDomain{
+				{
+					Name:              "pg-1",
+					PlacementGroupRef: &corev1.ObjectReference{Name: "pg-1"},
+				},
+				{
+					Name:              "pg-2",
+					PlacementGroupRef: &corev1.ObjectReference{Name: "pg-2", Namespace: "other"},
+					ControlPlane:      ptr.To(false),
+				},
+			},
+		},
+	}
+
+	ctlrSuite := NewControllerSuite(GinkgoT(), mock.MockK8sClient{})
+	reconciler := LinodeClusterReconciler{}
+	cScope := &scope.ClusterScope{}
+
+	ctlrSuite.BeforeEach(func(ctx context.Context, mck Mock) {
+		cScope.Client = mck.K8sClient
+		cScope.LinodeCluster = linodeCluster.DeepCopy()
+	})
+
+	placementGroup := func(region string, ready bool) func(context.Context, client.ObjectKey, *infrav1alpha2.LinodePlacementGroup, ...client.GetOption) error {
+		return func(_ context.Context, key client.ObjectKey, pg *infrav1alpha2.LinodePlacementGroup, _ ...client.GetOption) error {
+			pg.Spec.Region = region
+			pg.Spec.PGID = ptr.To(len(key.Name))
+			pg.Status.Ready = ready
+			return nil
+		}
+	}
+
+	ctlrSuite.Run(
+		OneOf(
+			Path(
+				Call("placement groups are ready", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: "pg-1"}, gomock.Any()).
+						DoAndReturn(placementGroup("us-ord", true))
+					mck.K8sClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: "other", Name: "pg-2"}, gomock.Any()).
+						DoAndReturn(placementGroup("us-ord", true))
+				}),
+				Result("failure domains are published", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileFailureDomains(ctx, mck.Logger(), cScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+					Expect(cScope.LinodeCluster.Status.FailureDomains).To(Equal([]clusterv1.FailureDomain{
+						{
+							Name:         "pg-1",
+							ControlPlane: ptr.To(true),
+							Attributes:   map[string]string{"region": "us-ord", "placementGroupID": "4"},
+						},
+						{
+							Name:         "pg-2",
+							ControlPlane: ptr.To(false),
+							Attributes:   map[string]string{"region": "us-ord", "placementGroupID": "4"},
+						},
+					}))
+					Expect(rec.ConditionTrue(cScope.LinodeCluster.GetCondition(ConditionFailureDomainsReady))).To(BeTrue())
+				}),
+			),
+			Path(
+				Call("placement group is not ready", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: "pg-1"}, gomock.Any()).
+						DoAndReturn(placementGroup("us-ord", true))
+					mck.K8sClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: "other", Name: "pg-2"}, gomock.Any()).
+						DoAndReturn(placementGroup("us-ord", false))
+				}),
+				Result("ready failure domains are published", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileFailureDomains(ctx, mck.Logger(), cScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultClusterControllerReconcileDelay))
+					Expect(cScope.LinodeCluster.Status.FailureDomains).To(Equal([]clusterv1.FailureDomain{
+						{
+							Name:         "pg-1",
+							ControlPlane: ptr.To(true),
+							Attributes:   map[string]string{"region": "us-ord", "placementGroupID": "4"},
+						},
+					}))
+					condition := cScope.LinodeCluster.GetCondition(ConditionFailureDomainsReady)
+					Expect(condition.Reason).To(Equal("LinodePlacementGroupNotYetAvailable"))
+					Expect(condition.Message).To(ContainSubstring("pg-2"))
+				}),
+			),
+			Path(
+				Call("placement group is in another region", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(placementGroup("us-sea", true)).Times(2)
+				}),
+				Result("failure domains are not published", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileFailureDomains(ctx, mck.Logger(), cScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultClusterControllerReconcileDelay))
+					Expect(cScope.LinodeCluster.Status.FailureDomains).To(BeEmpty())
+					Expect(cScope.LinodeCluster.GetCondition(ConditionFailureDomainsReady).Message).To(ContainSubstring("is in region us-sea, not us-ord"))
+				}),
+			),
+			Path(
+				Call("placement group is not found", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errors.New("not found")).Times(2)
+				}),
+				Result("failure domains are not published", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileFailureDomains(ctx, mck.Logger(), cScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultClusterControllerReconcileDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Failed to fetch LinodePlacementGroup"))
+				}),
+			),
+		),
+	)
+})
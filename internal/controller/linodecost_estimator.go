@@ -0,0 +1,308 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/util"
+)
+
+const (
+	ConditionBudgetExceeded = "BudgetExceeded"
+
+	BudgetExceededReason = "MonthlyBudgetExceeded"
+	WithinBudgetReason   = "WithinBudget"
+
+	// nodeBalancerTypeID is the NodeBalancer type the NodeBalancers created by CAPL are billed as.
+	nodeBalancerTypeID = "nodebalancer"
+	// hoursPerMonth is the number of hours after which resources are billed their monthly price.
+	hoursPerMonth = 730
+	bytesPerGB    = 1 << 30
+)
+
+var (
+	clusterHourlyCost = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capl_cluster_estimated_hourly_cost_dollars",
+		Help: "Estimated hourly cost of the Linode resources of a cluster, in US dollars.",
+	}, []string{"namespace", "cluster"})
+	clusterMonthlyCost = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capl_cluster_estimated_monthly_cost_dollars",
+		Help: "Estimated monthly cost of the Linode resources of a cluster, in US dollars.",
+	}, []string{"namespace", "cluster"})
+	clusterMonthlyBudget = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capl_cluster_monthly_budget_dollars",
+		Help: "Monthly budget of a cluster, in US dollars.",
+	}, []string{"namespace", "cluster"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(clusterHourlyCost, clusterMonthlyCost, clusterMonthlyBudget)
+}
+
+// CostEstimator estimates the cost of clusters from the list prices of their LinodeMachines, NodeBalancer and
+// Object Storage buckets. Estimates are published in the status of the LinodeClusters and as metrics.
+type CostEstimator struct {
+	// Interval is the interval between two estimates of the cost of a cluster.
+	Interval time.Duration
+	// ObjectStoragePricePerGB is the monthly price of a GB stored in Object Storage, which the Linode API does not list.
+	ObjectStoragePricePerGB float64
+
+	now func() time.Time
+}
+
+// NewCostEstimator returns a CostEstimator which must be set on the LinodeClusterReconciler to estimate costs.
+func NewCostEstimator(interval time.Duration, objectStoragePricePerGB float64) *CostEstimator {
+	return &CostEstimator{
+		Interval:                interval,
+		ObjectStoragePricePerGB: objectStoragePricePerGB,
+		now:                     time.Now,
+	}
+}
+
+// Enabled returns true if the CostEstimator is set.
+func (e *CostEstimator) Enabled() bool {
+	return e != nil
+}
+
+// Stale returns true if the estimated cost of the cluster is missing or older than Interval.
+func (e *CostEstimator) Stale(linodeCluster *infrav1alpha2.LinodeCluster) bool {
+	estimate := linodeCluster.Status.EstimatedCost
+	return estimate == nil || estimate.LastUpdated == nil || e.now().Sub(estimate.LastUpdated.Time) >= e.Interval
+}
+
+// Estimate returns the estimated cost of the Linode resources of a cluster.
+func (e *CostEstimator) Estimate(ctx context.Context, k8sClient client.Client, linodeClient clients.LinodeClient, linodeCluster *infrav1alpha2.LinodeCluster, clusterName string) (*infrav1alpha2.ClusterCostEstimate, error) {
+	machinesHourly, machinesMonthly, err := machinesCost(ctx, k8sClient, linodeClient, linodeCluster, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	nodeBalancerHourly, nodeBalancerMonthly, err := nodeBalancerCost(ctx, linodeClient, linodeCluster)
+	if err != nil {
+		return nil, err
+	}
+	bucketsHourly, bucketsMonthly, err := e.bucketsCost(ctx, k8sClient, linodeClient, linodeCluster, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	hourly := machinesHourly + nodeBalancerHourly + bucketsHourly
+	monthly := machinesMonthly + nodeBalancerMonthly + bucketsMonthly
+
+	return &infrav1alpha2.ClusterCostEstimate{
+		Hourly:      strconv.FormatFloat(hourly, 'f', 4, 64),
+		Monthly:     strconv.FormatFloat(monthly, 'f', 2, 64),
+		LastUpdated: ptr.To(metav1.NewTime(e.now())),
+	}, nil
+}
+
+// machinesCost returns the cost of the instances of the LinodeMachines and LinodeMachinePoolMachines of a cluster, and
+// of their backups. LinodeMachinePoolMachines don't carry the type of their instance, which is read from the instance.
+func machinesCost(ctx context.Context, k8sClient client.Client, linodeClient clients.LinodeClient, linodeCluster *infrav1alpha2.LinodeCluster, clusterName string) (hourly, monthly float64, err error) {
+	machines := infrav1alpha2.LinodeMachineList{}
+	if err := k8sClient.List(ctx, &machines, client.InNamespace(linodeCluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}); err != nil {
+		return 0, 0, fmt.Errorf("list LinodeMachines: %w", err)
+	}
+	poolMachines := infrav1alpha2.LinodeMachinePoolMachineList{}
+	if err := k8sClient.List(ctx, &poolMachines, client.InNamespace(linodeCluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}); err != nil {
+		return 0, 0, fmt.Errorf("list LinodeMachinePoolMachines: %w", err)
+	}
+
+	linodeTypes := make(map[string]*linodego.LinodeType)
+	addInstance := func(typeID, region string, backupsEnabled bool) error {
+		linodeType, ok := linodeTypes[typeID]
+		if !ok {
+			fetched, err := linodeClient.GetType(ctx, typeID)
+			if err != nil {
+				return fmt.Errorf("get type %s: %w", typeID, err)
+			}
+			linodeType, linodeTypes[typeID] = fetched, fetched
+		}
+		instanceHourly, instanceMonthly := linodePrice(linodeType.Price, linodeType.RegionPrices, region)
+		hourly += instanceHourly
+		monthly += instanceMonthly
+		if backupsEnabled && linodeType.Addons != nil && linodeType.Addons.Backups != nil {
+			backupsHourly, backupsMonthly := linodePrice(linodeType.Addons.Backups.Price, linodeType.Addons.Backups.RegionPrices, region)
+			hourly += backupsHourly
+			monthly += backupsMonthly
+		}
+
+		return nil
+	}
+
+	for _, machine := range machines.Items {
+		if err := addInstance(machine.Spec.Type, machine.Spec.Region, machine.Spec.BackupsEnabled); err != nil {
+			return 0, 0, err
+		}
+	}
+	for _, poolMachine := range poolMachines.Items {
+		instanceID := ptr.Deref(poolMachine.Spec.InstanceID, 0)
+		if instanceID == 0 {
+			if instanceID, err = util.GetInstanceID(poolMachine.Spec.ProviderID); err != nil || instanceID == 0 {
+				// The instance is not created yet
+				continue
+			}
+		}
+		instance, err := linodeClient.GetInstance(ctx, instanceID)
+		if err != nil {
+			if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+				continue
+			}
+			return 0, 0, fmt.Errorf("get instance %d: %w", instanceID, err)
+		}
+		if err := addInstance(instance.Type, instance.Region, instance.Backups != nil && instance.Backups.Enabled); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return hourly, monthly, nil
+}
+
+// nodeBalancerCost returns the cost of the NodeBalancer of a cluster.
+func nodeBalancerCost(ctx context.Context, linodeClient clients.LinodeClient, linodeCluster *infrav1alpha2.LinodeCluster) (hourly, monthly float64, err error) {
+	loadBalancerType := linodeCluster.Spec.Network.LoadBalancerType
+	if linodeCluster.Spec.Network.NodeBalancerID == nil || (loadBalancerType != "" && loadBalancerType != lbTypeNB) {
+		return 0, 0, nil
+	}
+	nodeBalancerTypes, err := linodeClient.ListNodeBalancerTypes(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("list NodeBalancer types: %w", err)
+	}
+	for _, nodeBalancerType := range nodeBalancerTypes {
+		if nodeBalancerType.ID != nodeBalancerTypeID {
+			continue
+		}
+		hourly, monthly = nodeBalancerType.Price.Hourly, nodeBalancerType.Price.Monthly
+		for _, regionPrice := range nodeBalancerType.RegionPrices {
+			if regionPrice.ID == linodeCluster.Spec.Region {
+				hourly, monthly = regionPrice.Hourly, regionPrice.Monthly
+			}
+		}
+	}
+
+	return hourly, monthly, nil
+}
+
+// bucketsCost returns the cost of the storage used by the LinodeObjectStorageBuckets of a cluster.
+func (e *CostEstimator) bucketsCost(ctx context.Context, k8sClient client.Client, linodeClient clients.LinodeClient, linodeCluster *infrav1alpha2.LinodeCluster, clusterName string) (hourly, monthly float64, err error) {
+	buckets := infrav1alpha2.LinodeObjectStorageBucketList{}
+	if err := k8sClient.List(ctx, &buckets, client.InNamespace(linodeCluster.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}); err != nil {
+		return 0, 0, fmt.Errorf("list LinodeObjectStorageBuckets: %w", err)
+	}
+	for _, bucket := range buckets.Items {
+		objectStorageBucket, err := linodeClient.GetObjectStorageBucket(ctx, bucket.Spec.Region, bucket.Name)
+		if err != nil {
+			// Buckets provisioned with other credentials are not visible to the cluster.
+			if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+				continue
+			}
+			return 0, 0, fmt.Errorf("get bucket %s: %w", bucket.Name, err)
+		}
+		bucketMonthly := float64(objectStorageBucket.Size) / bytesPerGB * e.ObjectStoragePricePerGB
+		hourly += bucketMonthly / hoursPerMonth
+		monthly += bucketMonthly
+	}
+
+	return hourly, monthly, nil
+}
+
+// Forget removes the metrics of a deleted cluster.
+func (e *CostEstimator) Forget(linodeCluster *infrav1alpha2.LinodeCluster) {
+	if e == nil {
+		return
+	}
+	for _, gauge := range []*prometheus.GaugeVec{clusterHourlyCost, clusterMonthlyCost, clusterMonthlyBudget} {
+		gauge.DeleteLabelValues(linodeCluster.Namespace, linodeCluster.Name)
+	}
+}
+
+// linodePrice returns the hourly and monthly price of a Linode type or add-on in a region.
+func linodePrice(price *linodego.LinodePrice, regionPrices []linodego.LinodeRegionPrice, region string) (hourly, monthly float64) {
+	for _, regionPrice := range regionPrices {
+		if regionPrice.ID == region {
+			return float64(regionPrice.Hourly), float64(regionPrice.Monthly)
+		}
+	}
+	if price == nil {
+		return 0, 0
+	}
+
+	return float64(price.Hourly), float64(price.Monthly)
+}
+
+// publishClusterCost sets the cost metrics of a cluster and compares its estimated cost with its monthly budget. A
+// Warning Event is recorded when the budget gets exceeded.
+func publishClusterCost(recorder events.EventRecorder, linodeCluster *infrav1alpha2.LinodeCluster) {
+	estimate := linodeCluster.Status.EstimatedCost
+	if estimate == nil {
+		return
+	}
+	monthly, err := strconv.ParseFloat(estimate.Monthly, 64)
+	if err != nil {
+		return
+	}
+	hourly, err := strconv.ParseFloat(estimate.Hourly, 64)
+	if err != nil {
+		return
+	}
+	clusterHourlyCost.WithLabelValues(linodeCluster.Namespace, linodeCluster.Name).Set(hourly)
+	clusterMonthlyCost.WithLabelValues(linodeCluster.Namespace, linodeCluster.Name).Set(monthly)
+
+	budget, err := strconv.ParseFloat(linodeCluster.Spec.MonthlyBudget, 64)
+	if linodeCluster.Spec.MonthlyBudget == "" || err != nil {
+		clusterMonthlyBudget.DeleteLabelValues(linodeCluster.Namespace, linodeCluster.Name)
+		meta.RemoveStatusCondition(&linodeCluster.Status.Conditions, ConditionBudgetExceeded)
+		return
+	}
+	clusterMonthlyBudget.WithLabelValues(linodeCluster.Namespace, linodeCluster.Name).Set(budget)
+
+	if monthly <= budget {
+		linodeCluster.SetCondition(metav1.Condition{
+			Type:    ConditionBudgetExceeded,
+			Status:  metav1.ConditionFalse,
+			Reason:  WithinBudgetReason,
+			Message: fmt.Sprintf("Estimated monthly cost of $%s is within the budget of $%s", estimate.Monthly, linodeCluster.Spec.MonthlyBudget),
+		})
+		return
+	}
+	if condition := linodeCluster.GetCondition(ConditionBudgetExceeded); condition == nil || condition.Status != metav1.ConditionTrue {
+		recorder.Eventf(linodeCluster, nil, corev1.EventTypeWarning, BudgetExceededReason, "EstimateCost",
+			"Estimated monthly cost of $%s exceeds the budget of $%s", estimate.Monthly, linodeCluster.Spec.MonthlyBudget)
+	}
+	linodeCluster.SetCondition(metav1.Condition{
+		Type:    ConditionBudgetExceeded,
+		Status:  metav1.ConditionTrue,
+		Reason:  BudgetExceededReason,
+		Message: fmt.Sprintf("Estimated monthly cost of $%s exceeds the budget of $%s", estimate.Monthly, linodeCluster.Spec.MonthlyBudget),
+	})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func TestCostEstimatorEstimate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	mockK8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachineList{}), gomock.Any()).DoAndReturn(
+		func(_ context.Context, list *infrav1alpha2.LinodeMachineList, _ ...client.ListOption) error {
+			list.Items = []infrav1alpha2.LinodeMachine{
+				{Spec: infrav1alpha2.LinodeMachineSpec{Type: "g6-standard-2", Region: "us-ord"}},
+				{Spec: infrav1alpha2.LinodeMachineSpec{Type: "g6-standard-2", Region: "us-ord", BackupsEnabled: true}},
+				{Spec: infrav1alpha2.LinodeMachineSpec{Type: "g6-standard-2", Region: "id-cgk"}},
+			}
+			return nil
+		})
+	mockK8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachinePoolMachineList{}), gomock.Any()).DoAndReturn(
+		func(_ context.Context, list *infrav1alpha2.LinodeMachinePoolMachineList, _ ...client.ListOption) error {
+			list.Items = []infrav1alpha2.LinodeMachinePoolMachine{
+				{Spec: infrav1alpha2.LinodeMachinePoolMachineSpec{InstanceID: ptr.To(10)}},
+				{Spec: infrav1alpha2.LinodeMachinePoolMachineSpec{ProviderID: ptr.To("linode://11")}},
+				// Not created yet
+				{},
+				// Deleted out of band
+				{Spec: infrav1alpha2.LinodeMachinePoolMachineSpec{InstanceID: ptr.To(12)}},
+			}
+			return nil
+		})
+	mockK8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeObjectStorageBucketList{}), gomock.Any()).DoAndReturn(
+		func(_ context.Context, list *infrav1alpha2.LinodeObjectStorageBucketList, _ ...client.ListOption) error {
+			list.Items = []infrav1alpha2.LinodeObjectStorageBucket{
+				{ObjectMeta: metav1.ObjectMeta{Name: "etcd-backup"}, Spec: infrav1alpha2.LinodeObjectStorageBucketSpec{Region: "us-ord"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-account"}, Spec: infrav1alpha2.LinodeObjectStorageBucketSpec{Region: "us-ord"}},
+			}
+			return nil
+		})
+
+	mockLinodeClient := mock.NewMockLinodeClient(ctrl)
+	// The type is only fetched once for all the machines and pool machines using it.
+	mockLinodeClient.EXPECT().GetType(gomock.Any(), "g6-standard-2").Return(&linodego.LinodeType{
+		ID:    "g6-standard-2",
+		Price: &linodego.LinodePrice{Hourly: 0.036, Monthly: 24},
+		RegionPrices: []linodego.LinodeRegionPrice{
+			{ID: "id-cgk", Hourly: 0.043, Monthly: 28.8},
+		},
+		Addons: &linodego.LinodeAddons{Backups: &linodego.LinodeBackupsAddon{
+			Price: &linodego.LinodePrice{Hourly: 0.008, Monthly: 5},
+		}},
+	}, nil)
+	mockLinodeClient.EXPECT().GetInstance(gomock.Any(), 10).Return(&linodego.Instance{
+		ID: 10, Type: "g6-standard-2", Region: "us-ord", Backups: &linodego.InstanceBackup{Enabled: true},
+	}, nil)
+	mockLinodeClient.EXPECT().GetInstance(gomock.Any(), 11).Return(&linodego.Instance{ID: 11, Type: "g6-standard-2", Region: "id-cgk"}, nil)
+	mockLinodeClient.EXPECT().GetInstance(gomock.Any(), 12).Return(nil, &linodego.Error{Code: 404})
+	nodeBalancerType := linodego.NodeBalancerType{}
+	nodeBalancerType.ID = nodeBalancerTypeID
+	nodeBalancerType.Price.Hourly = 0.015
+	nodeBalancerType.Price.Monthly = 10
+	mockLinodeClient.EXPECT().ListNodeBalancerTypes(gomock.Any(), gomock.Any()).Return([]linodego.NodeBalancerType{nodeBalancerType}, nil)
+	mockLinodeClient.EXPECT().GetObjectStorageBucket(gomock.Any(), "us-ord", "etcd-backup").Return(&linodego.ObjectStorageBucket{Size: 50 * bytesPerGB}, nil)
+	mockLinodeClient.EXPECT().GetObjectStorageBucket(gomock.Any(), "us-ord", "other-account").Return(nil, &linodego.Error{Code: 404})
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	estimator := NewCostEstimator(time.Hour, 0.02)
+	estimator.now = func() time.Time { return now }
+	linodeCluster := &infrav1alpha2.LinodeCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		Spec: infrav1alpha2.LinodeClusterSpec{
+			Region:  "us-ord",
+			Network: infrav1alpha2.NetworkSpec{NodeBalancerID: ptr.To(1)},
+		},
+	}
+
+	estimate, err := estimator.Estimate(t.Context(), mockK8sClient, mockLinodeClient, linodeCluster, "test-cluster")
+	require.NoError(t, err)
+	// 3 * 0.036 + 2 * 0.008 + 2 * 0.043 + 0.015 + 50 * 0.02 / 730
+	assert.Equal(t, "0.2264", estimate.Hourly)
+	// 3 * 24 + 2 * 5 + 2 * 28.8 + 10 + 50 * 0.02
+	assert.Equal(t, "150.60", estimate.Monthly)
+	assert.Equal(t, now, estimate.LastUpdated.Time)
+
+	linodeCluster.Status.EstimatedCost = estimate
+	assert.False(t, estimator.Stale(linodeCluster))
+	estimator.now = func() time.Time { return now.Add(time.Hour) }
+	assert.True(t, estimator.Stale(linodeCluster))
+}
+
+func TestPublishClusterCost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		budget        string
+		previous      *metav1.Condition
+		wantCondition *metav1.Condition
+		wantEvent     bool
+	}{
+		{
+			name: "no budget",
+			previous: &metav1.Condition{
+				Type:   ConditionBudgetExceeded,
+				Status: metav1.ConditionTrue,
+				Reason: BudgetExceededReason,
+			},
+		},
+		{
+			name:          "within budget",
+			budget:        "100",
+			wantCondition: &metav1.Condition{Type: ConditionBudgetExceeded, Status: metav1.ConditionFalse, Reason: WithinBudgetReason},
+		},
+		{
+			name:          "budget exceeded",
+			budget:        "50.50",
+			wantCondition: &metav1.Condition{Type: ConditionBudgetExceeded, Status: metav1.ConditionTrue, Reason: BudgetExceededReason},
+			wantEvent:     true,
+		},
+		{
+			name:   "budget still exceeded",
+			budget: "50.50",
+			previous: &metav1.Condition{
+				Type:   ConditionBudgetExceeded,
+				Status: metav1.ConditionTrue,
+				Reason: BudgetExceededReason,
+			},
+			wantCondition: &metav1.Condition{Type: ConditionBudgetExceeded, Status: metav1.ConditionTrue, Reason: BudgetExceededReason},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := events.NewFakeRecorder(10)
+			linodeCluster := &infrav1alpha2.LinodeCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster-" + testcase.name, Namespace: "default"},
+				Spec:       infrav1alpha2.LinodeClusterSpec{MonthlyBudget: testcase.budget},
+				Status: infrav1alpha2.LinodeClusterStatus{
+					EstimatedCost: &infrav1alpha2.ClusterCostEstimate{Hourly: "0.1394", Monthly: "92.80"},
+				},
+			}
+			if testcase.previous != nil {
+				linodeCluster.SetCondition(*testcase.previous)
+			}
+
+			publishClusterCost(recorder, linodeCluster)
+
+			condition := linodeCluster.GetCondition(ConditionBudgetExceeded)
+			if testcase.wantCondition == nil {
+				assert.Nil(t, condition)
+			} else {
+				require.NotNil(t, condition)
+				assert.Equal(t, testcase.wantCondition.Status, condition.Status)
+				assert.Equal(t, testcase.wantCondition.Reason, condition.Reason)
+			}
+			if testcase.wantEvent {
+				assert.Contains(t, <-recorder.Events, BudgetExceededReason)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
@@ -0,0 +1,424 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+const (
+	// ConditionMaintenanceScheduled reports whether the Linode platform has scheduled maintenance,
+	// such as a host migration, for the instance of a LinodeMachine.
+	ConditionMaintenanceScheduled = "MaintenanceScheduled"
+
+	// eventPollerTimeFormat is the time format the Linode API expects in filters.
+	eventPollerTimeFormat = "2006-01-02T15:04:05"
+	// eventPollerQueueSize is the number of reconcile triggers buffered for each kind of object.
+	eventPollerQueueSize = 1024
+)
+
+// maintenanceActions are the event actions the Linode platform uses for maintenance of an instance.
+var maintenanceActions = map[linodego.EventAction]struct{}{
+	linodego.ActionLinodeMigrate:           {},
+	linodego.ActionLinodeMigrateDatacenter: {},
+	linodego.ActionHostReboot:              {},
+}
+
+// disruptiveActions are the event actions that interrupt an instance without CAPL asking for it.
+var disruptiveActions = map[linodego.EventAction]struct{}{
+	linodego.ActionHostReboot:              {},
+	linodego.ActionLassieReboot:            {},
+	linodego.ActionLinodeMigrate:           {},
+	linodego.ActionLinodeMigrateDatacenter: {},
+}
+
+// EventPoller polls the Linode Events API once for every set of credentials in use and fans out the events to the
+// LinodeMachines, LinodeClusters and LinodeFirewalls they relate to. Kubernetes Events are recorded on the objects,
+// conditions are updated and the objects are reconciled through the channels returned by the *Events methods.
+type EventPoller struct {
+	Client   client.Client
+	Recorder events.EventRecorder
+	Interval time.Duration
+	// MachineWatchFilterValue and ClusterWatchFilterValue are the watch filters of the LinodeMachine controller, and of
+	// the LinodeCluster and LinodeFirewall controllers. Events are only dispatched to the objects they reconcile.
+	MachineWatchFilterValue string
+	ClusterWatchFilterValue string
+
+	mu          sync.Mutex
+	credentials map[string]*eventPollerCredential
+
+	machineEvents  chan event.GenericEvent
+	clusterEvents  chan event.GenericEvent
+	firewallEvents chan event.GenericEvent
+}
+
+// eventPollerCredential tracks the events seen for a single set of credentials.
+type eventPollerCredential struct {
+	linodeClient clients.LinodeEventClient
+	// since is the creation time of the newest event seen.
+	since time.Time
+	// seen holds the last known status of the events created since the last poll and of the events still in progress.
+	seen map[int]linodego.EventStatus
+}
+
+// NewEventPoller returns an EventPoller polling the Linode Events API every interval.
+func NewEventPoller(k8sClient client.Client, recorder events.EventRecorder, interval time.Duration) *EventPoller {
+	return &EventPoller{
+		Client:         k8sClient,
+		Recorder:       recorder,
+		Interval:       interval,
+		credentials:    make(map[string]*eventPollerCredential),
+		machineEvents:  make(chan event.GenericEvent, eventPollerQueueSize),
+		clusterEvents:  make(chan event.GenericEvent, eventPollerQueueSize),
+		firewallEvents: make(chan event.GenericEvent, eventPollerQueueSize),
+	}
+}
+
+// MachineEvents returns the channel LinodeMachines to reconcile are sent to.
+func (p *EventPoller) MachineEvents() <-chan event.GenericEvent {
+	return p.machineEvents
+}
+
+// ClusterEvents returns the channel LinodeClusters to reconcile are sent to.
+func (p *EventPoller) ClusterEvents() <-chan event.GenericEvent {
+	return p.clusterEvents
+}
+
+// FirewallEvents returns the channel LinodeFirewalls to reconcile are sent to.
+func (p *EventPoller) FirewallEvents() <-chan event.GenericEvent {
+	return p.firewallEvents
+}
+
+// Track makes sure the events of the account behind the credentials identified by tokenHash are polled.
+// Credentials are deduplicated by their hash, so this is cheap to call on every reconcile.
+// Calling Track on a nil EventPoller is a no-op.
+func (p *EventPoller) Track(tokenHash string, linodeClient clients.LinodeEventClient) {
+	if p == nil || tokenHash == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.credentials[tokenHash]; ok {
+		return
+	}
+	p.credentials[tokenHash] = &eventPollerCredential{
+		linodeClient: linodeClient,
+		since:        time.Now().UTC(),
+		seen:         make(map[int]linodego.EventStatus),
+	}
+}
+
+// Enabled reports whether reconciles are driven by the EventPoller.
+func (p *EventPoller) Enabled() bool {
+	return p != nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the leader polls.
+func (p *EventPoller) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable and polls the Linode Events API until the context is cancelled.
+func (p *EventPoller) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("LinodeEventPoller")
+
+	ticker := time.NewTicker(reconciler.DefaultTimeout(p.Interval, reconciler.DefaultEventPollerInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.poll(ctx, logger)
+		}
+	}
+}
+
+// poll fetches and dispatches the new events of every tracked set of credentials.
+func (p *EventPoller) poll(ctx context.Context, logger logr.Logger) {
+	p.mu.Lock()
+	credentials := make(map[string]*eventPollerCredential, len(p.credentials))
+	for tokenHash, credential := range p.credentials {
+		credentials[tokenHash] = credential
+	}
+	p.mu.Unlock()
+
+	for tokenHash, credential := range credentials {
+		err := p.pollCredential(ctx, logger, credential)
+		if err == nil {
+			continue
+		}
+		if util.IgnoreLinodeAPIError(err, http.StatusUnauthorized) == nil {
+			// The token was revoked or rotated, it is tracked again as soon as it is used by a reconcile.
+			logger.Info("Stopped polling events for revoked credentials")
+			p.mu.Lock()
+			delete(p.credentials, tokenHash)
+			p.mu.Unlock()
+
+			continue
+		}
+		logger.Error(err, "Failed to poll Linode events")
+	}
+}
+
+// pollCredential dispatches the events created since the last poll along with the status changes of the events
+// that are still in progress.
+func (p *EventPoller) pollCredential(ctx context.Context, logger logr.Logger, credential *eventPollerCredential) error {
+	filter, err := json.Marshal(map[string]any{
+		"+or": []map[string]any{
+			{"created": map[string]string{"+gte": credential.since.Format(eventPollerTimeFormat)}},
+			{"status": linodego.EventScheduled},
+			{"status": linodego.EventStarted},
+		},
+		"+order_by": "created",
+		"+order":    "asc",
+	})
+	if err != nil {
+		return err
+	}
+
+	linodeEvents, err := credential.linodeClient.ListEvents(ctx, linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[int]struct{}, len(linodeEvents))
+	for _, linodeEvent := range linodeEvents {
+		listed[linodeEvent.ID] = struct{}{}
+		p.observe(ctx, logger, credential, &linodeEvent)
+	}
+
+	// Events that are no longer listed have either reached a final state or are older than the last poll.
+	for eventID, status := range credential.seen {
+		if _, ok := listed[eventID]; ok {
+			continue
+		}
+		if isFinalEventStatus(status) {
+			delete(credential.seen, eventID)
+
+			continue
+		}
+		linodeEvent, err := credential.linodeClient.GetEvent(ctx, eventID)
+		if err != nil {
+			if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+				delete(credential.seen, eventID)
+
+				continue
+			}
+
+			return err
+		}
+		p.observe(ctx, logger, credential, linodeEvent)
+	}
+
+	return nil
+}
+
+// observe dispatches an event when it is new or its status changed since it was last seen.
+func (p *EventPoller) observe(ctx context.Context, logger logr.Logger, credential *eventPollerCredential, linodeEvent *linodego.Event) {
+	if linodeEvent.Created != nil && linodeEvent.Created.After(credential.since) {
+		credential.since = *linodeEvent.Created
+	}
+
+	if status, ok := credential.seen[linodeEvent.ID]; ok && status == linodeEvent.Status {
+		return
+	}
+	credential.seen[linodeEvent.ID] = linodeEvent.Status
+
+	if err := p.dispatch(ctx, linodeEvent); err != nil {
+		logger.Error(err, "Failed to dispatch Linode event", "eventID", linodeEvent.ID, "action", linodeEvent.Action)
+	}
+}
+
+// dispatch records the event on the objects backed by its entity and triggers a reconcile of those objects.
+func (p *EventPoller) dispatch(ctx context.Context, linodeEvent *linodego.Event) error {
+	if linodeEvent.Entity == nil {
+		return nil
+	}
+	entityID, ok := eventEntityID(linodeEvent.Entity)
+	if !ok {
+		return nil
+	}
+
+	switch linodeEvent.Entity.Type {
+	case linodego.EntityLinode:
+		var linodeMachines infrav1alpha2.LinodeMachineList
+		if err := p.Client.List(ctx, &linodeMachines, watchFilterOptions(p.MachineWatchFilterValue)...); err != nil {
+			return err
+		}
+		for i := range linodeMachines.Items {
+			linodeMachine := &linodeMachines.Items[i]
+			if instanceID, err := util.GetInstanceID(linodeMachine.Spec.ProviderID); err != nil || instanceID != entityID {
+				continue
+			}
+			p.record(linodeMachine, linodeEvent)
+			if _, ok := maintenanceActions[linodeEvent.Action]; ok {
+				if err := p.setMaintenanceCondition(ctx, linodeMachine, linodeEvent); err != nil {
+					return err
+				}
+			}
+			enqueue(ctx, p.machineEvents, linodeMachine)
+		}
+	case linodego.EntityNodebalancer:
+		var linodeClusters infrav1alpha2.LinodeClusterList
+		if err := p.Client.List(ctx, &linodeClusters, watchFilterOptions(p.ClusterWatchFilterValue)...); err != nil {
+			return err
+		}
+		for i := range linodeClusters.Items {
+			linodeCluster := &linodeClusters.Items[i]
+			if nodeBalancerID := linodeCluster.Spec.Network.NodeBalancerID; nodeBalancerID == nil || *nodeBalancerID != entityID {
+				continue
+			}
+			p.record(linodeCluster, linodeEvent)
+			enqueue(ctx, p.clusterEvents, linodeCluster)
+		}
+	case linodego.EntityFirewall:
+		var linodeFirewalls infrav1alpha2.LinodeFirewallList
+		if err := p.Client.List(ctx, &linodeFirewalls, watchFilterOptions(p.ClusterWatchFilterValue)...); err != nil {
+			return err
+		}
+		for i := range linodeFirewalls.Items {
+			linodeFirewall := &linodeFirewalls.Items[i]
+			if firewallID := linodeFirewall.Spec.FirewallID; firewallID == nil || *firewallID != entityID {
+				continue
+			}
+			p.record(linodeFirewall, linodeEvent)
+			enqueue(ctx, p.firewallEvents, linodeFirewall)
+		}
+	}
+
+	return nil
+}
+
+// watchFilterOptions selects the objects with the watch filter value, as predicates.ResourceHasFilterLabel does.
+// Every object is selected without a value.
+func watchFilterOptions(watchFilterValue string) []client.ListOption {
+	if watchFilterValue == "" {
+		return nil
+	}
+
+	return []client.ListOption{client.MatchingLabels{clusterv1.WatchLabel: watchFilterValue}}
+}
+
+// record emits a Kubernetes Event mirroring the Linode event on obj.
+func (p *EventPoller) record(obj client.Object, linodeEvent *linodego.Event) {
+	eventType := corev1.EventTypeNormal
+	if _, ok := disruptiveActions[linodeEvent.Action]; ok || linodeEvent.Status == linodego.EventFailed {
+		eventType = corev1.EventTypeWarning
+	}
+
+	note := fmt.Sprintf("Linode event %d %s is %s", linodeEvent.ID, linodeEvent.Action, linodeEvent.Status)
+	if linodeEvent.Message != "" {
+		note = fmt.Sprintf("%s: %s", note, linodeEvent.Message)
+	}
+
+	p.Recorder.Eventf(obj, nil, eventType, eventReason(string(linodeEvent.Action)), "LinodeEvent", note)
+}
+
+// setMaintenanceCondition reflects the state of a maintenance event in the MaintenanceScheduled condition.
+func (p *EventPoller) setMaintenanceCondition(ctx context.Context, linodeMachine *infrav1alpha2.LinodeMachine, linodeEvent *linodego.Event) error {
+	helper, err := patch.NewHelper(linodeMachine, p.Client)
+	if err != nil {
+		return fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	condition := metav1.Condition{
+		Type:    ConditionMaintenanceScheduled,
+		Status:  metav1.ConditionFalse,
+		Reason:  eventReason(string(linodeEvent.Status)),
+		Message: fmt.Sprintf("%s %s", linodeEvent.Action, linodeEvent.Status),
+	}
+	switch linodeEvent.Status {
+	case linodego.EventScheduled:
+		condition.Status = metav1.ConditionTrue
+		if linodeEvent.NotBefore != nil {
+			condition.Message = fmt.Sprintf("%s scheduled to start after %s", linodeEvent.Action, linodeEvent.NotBefore.UTC().Format(time.RFC3339))
+		}
+	case linodego.EventStarted:
+		condition.Status = metav1.ConditionTrue
+	}
+	linodeMachine.SetCondition(condition)
+
+	return helper.Patch(ctx, linodeMachine)
+}
+
+// enqueue sends obj to ch unless ctx is cancelled first.
+func enqueue(ctx context.Context, ch chan<- event.GenericEvent, obj client.Object) {
+	select {
+	case ch <- event.GenericEvent{Object: obj}:
+	case <-ctx.Done():
+	}
+}
+
+// eventEntityID returns the numeric ID of an event entity. The ID is decoded as a float64 from the JSON response.
+func eventEntityID(entity *linodego.EventEntity) (int, bool) {
+	switch id := entity.ID.(type) {
+	case float64:
+		return int(id), true
+	case int:
+		return id, true
+	default:
+		return 0, false
+	}
+}
+
+// eventReason converts an event action or status such as lassie_reboot to a Kubernetes Event reason such as LassieReboot.
+func eventReason(value string) string {
+	var reason strings.Builder
+	for word := range strings.SplitSeq(value, "_") {
+		if word == "" {
+			continue
+		}
+		reason.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return reason.String()
+}
+
+func isFinalEventStatus(status linodego.EventStatus) bool {
+	switch status {
+	case linodego.EventFinished, linodego.EventFailed, linodego.EventCanceled, linodego.EventNotification:
+		return true
+	default:
+		return false
+	}
+}
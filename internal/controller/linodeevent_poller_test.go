@@ -0,0 +1,199 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func TestEventReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "single word", value: "scheduled", want: "Scheduled"},
+		{name: "multiple words", value: string(linodego.ActionLassieReboot), want: "LassieReboot"},
+		{name: "repeated separators", value: "linode__migrate_", want: "LinodeMigrate"},
+		{name: "empty", value: "", want: ""},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testcase.want, eventReason(testcase.value))
+		})
+	}
+}
+
+func TestEventEntityID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		entity *linodego.EventEntity
+		want   int
+		wantOk bool
+	}{
+		{name: "decoded from JSON", entity: &linodego.EventEntity{ID: float64(123)}, want: 123, wantOk: true},
+		{name: "int", entity: &linodego.EventEntity{ID: 456}, want: 456, wantOk: true},
+		{name: "string", entity: &linodego.EventEntity{ID: "private/1"}, wantOk: false},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			id, ok := eventEntityID(testcase.entity)
+			assert.Equal(t, testcase.wantOk, ok)
+			assert.Equal(t, testcase.want, id)
+		})
+	}
+}
+
+func TestEventPollerTrack(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var nilPoller *EventPoller
+	nilPoller.Track("hash", mock.NewMockLinodeClient(ctrl))
+	assert.False(t, nilPoller.Enabled())
+
+	poller := NewEventPoller(mock.NewMockK8sClient(ctrl), events.NewFakeRecorder(10), time.Second)
+	poller.Track("hash", mock.NewMockLinodeClient(ctrl))
+	poller.Track("hash", mock.NewMockLinodeClient(ctrl))
+	poller.Track("", mock.NewMockLinodeClient(ctrl))
+	assert.True(t, poller.Enabled())
+	assert.Len(t, poller.credentials, 1)
+}
+
+func TestEventPollerPollCredential(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	mockLinodeClient := mock.NewMockLinodeClient(ctrl)
+	recorder := events.NewFakeRecorder(10)
+	poller := NewEventPoller(mockK8sClient, recorder, time.Second)
+	poller.Track("hash", mockLinodeClient)
+	credential := poller.credentials["hash"]
+
+	created := time.Now().UTC().Add(time.Minute)
+	scheduled := linodego.Event{
+		ID:      1,
+		Action:  linodego.ActionLinodeMigrate,
+		Status:  linodego.EventScheduled,
+		Created: ptr.To(created.Add(-time.Hour)),
+		Entity:  &linodego.EventEntity{ID: float64(100), Type: linodego.EntityLinode},
+	}
+	firewallUpdate := linodego.Event{
+		ID:      2,
+		Action:  linodego.ActionFirewallUpdate,
+		Status:  linodego.EventNotification,
+		Created: ptr.To(created),
+		Entity:  &linodego.EventEntity{ID: float64(200), Type: linodego.EntityFirewall},
+	}
+
+	// Machines are listed twice: once for the scheduled event and once when it finishes.
+	mockK8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachineList{})).Times(2).Return(nil)
+	mockK8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeFirewallList{})).DoAndReturn(
+		func(_ context.Context, list *infrav1alpha2.LinodeFirewallList, _ ...client.ListOption) error {
+			list.Items = []infrav1alpha2.LinodeFirewall{
+				{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: infrav1alpha2.LinodeFirewallSpec{FirewallID: ptr.To(201)}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "matching"}, Spec: infrav1alpha2.LinodeFirewallSpec{FirewallID: ptr.To(200)}},
+			}
+			return nil
+		})
+
+	// The first poll dispatches both events
+	mockLinodeClient.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Return([]linodego.Event{scheduled, firewallUpdate}, nil)
+	require.NoError(t, poller.pollCredential(t.Context(), testr.New(t), credential))
+	assert.Equal(t, created, credential.since)
+	assert.Equal(t, map[int]linodego.EventStatus{1: linodego.EventScheduled, 2: linodego.EventNotification}, credential.seen)
+
+	require.Len(t, poller.firewallEvents, 1)
+	assert.Equal(t, "matching", (<-poller.firewallEvents).Object.GetName())
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Normal FirewallUpdate Linode event 2 firewall_update is notification")
+
+	// The second poll does not dispatch events again, but follows up on the scheduled event
+	finished := scheduled
+	finished.Status = linodego.EventFinished
+	mockLinodeClient.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Return([]linodego.Event{firewallUpdate}, nil)
+	mockLinodeClient.EXPECT().GetEvent(gomock.Any(), 1).Return(&finished, nil)
+	require.NoError(t, poller.pollCredential(t.Context(), testr.New(t), credential))
+	assert.Equal(t, map[int]linodego.EventStatus{1: linodego.EventFinished, 2: linodego.EventNotification}, credential.seen)
+
+	// The third poll forgets about events that are done
+	mockLinodeClient.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Return(nil, nil)
+	require.NoError(t, poller.pollCredential(t.Context(), testr.New(t), credential))
+	assert.Empty(t, credential.seen)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestEventPollerDispatchWatchFilter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	recorder := events.NewFakeRecorder(10)
+	poller := NewEventPoller(mockK8sClient, recorder, time.Second)
+	poller.MachineWatchFilterValue = "machines"
+	poller.ClusterWatchFilterValue = "clusters"
+
+	// Only the objects reconciled by the controllers running with the watch filters are listed
+	for list, value := range map[client.ObjectList]string{
+		&infrav1alpha2.LinodeMachineList{}:  "machines",
+		&infrav1alpha2.LinodeClusterList{}:  "clusters",
+		&infrav1alpha2.LinodeFirewallList{}: "clusters",
+	} {
+		mockK8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(list), client.MatchingLabels{clusterv1.WatchLabel: value}).Return(nil)
+	}
+
+	for _, entityType := range []linodego.EntityType{linodego.EntityLinode, linodego.EntityNodebalancer, linodego.EntityFirewall} {
+		require.NoError(t, poller.dispatch(t.Context(), &linodego.Event{
+			ID:     1,
+			Action: linodego.ActionHostReboot,
+			Status: linodego.EventStarted,
+			Entity: &linodego.EventEntity{ID: float64(100), Type: entityType},
+		}))
+	}
+	assert.Empty(t, recorder.Events)
+}
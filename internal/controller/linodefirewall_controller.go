@@ -41,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
@@ -57,6 +58,10 @@ type LinodeFirewallReconciler struct {
 	LinodeClientConfig scope.ClientConfig
 	WatchFilterValue   string
 	ReconcileTimeout   time.Duration
+	EventPoller        *EventPoller
+	GarbageCollector   *GarbageCollector
+	// ManagementClusterID identifies the management cluster in the owner tags of the firewalls.
+	ManagementClusterID string
 }
 
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodefirewalls,verbs=get;list;watch;create;update;patch;delete
@@ -103,9 +108,10 @@ func (r *LinodeFirewallReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		ctx,
 		r.LinodeClientConfig,
 		scope.FirewallScopeParams{
-			Client:         r.TracedClient(),
-			LinodeFirewall: linodeFirewall,
-			Cluster:        cluster,
+			Client:              r.TracedClient(),
+			LinodeFirewall:      linodeFirewall,
+			Cluster:             cluster,
+			ManagementClusterID: r.ManagementClusterID,
 		})
 	if err != nil {
 		log.Error(err, "failed to create firewall scope")
@@ -176,6 +182,8 @@ func (r *LinodeFirewallReconciler) reconcile(
 		logger.Error(err, "failed to update linode client token from Credential Ref")
 		return ctrl.Result{}, err
 	}
+	r.EventPoller.Track(fwScope.TokenHash, fwScope.LinodeClient)
+	r.GarbageCollector.Track(fwScope.TokenHash, fwScope.LinodeClient)
 
 	// Delete
 	if !fwScope.LinodeFirewall.DeletionTimestamp.IsZero() {
@@ -306,7 +314,7 @@ func (r *LinodeFirewallReconciler) SetupWithManager(mgr ctrl.Manager, options cr
 	if err != nil {
 		return fmt.Errorf("failed to create mapper for LinodeFirewalls: %w", err)
 	}
-	err = ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha2.LinodeFirewall{}).
 		WithOptions(options).
 		WithEventFilter(
@@ -340,8 +348,11 @@ func (r *LinodeFirewallReconciler) SetupWithManager(mgr ctrl.Manager, options cr
 			&infrav1alpha2.FirewallRule{},
 			handler.EnqueueRequestsFromMapFunc(findObjectsForObject(mgr.GetLogger(), r.TracedClient())),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
-		).
-		Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+		)
+	if r.EventPoller.Enabled() {
+		b = b.WatchesRawSource(source.Channel(r.EventPoller.FirewallEvents(), &handler.EnqueueRequestForObject{}))
+	}
+	err = b.Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
 	if err != nil {
 		return fmt.Errorf("failed to build controller: %w", err)
 	}
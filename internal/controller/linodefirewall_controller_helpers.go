@@ -146,6 +146,8 @@ func reconcileFirewall(
 		linodeFW.ID,
 		linodego.FirewallUpdateOptions{
 			Status: status,
+			// The owner tags of the management cluster the firewall was moved from are replaced
+			Tags: util.ClaimOwnerTags(linodeFW.Tags, fwScope.ManagementClusterID),
 		},
 	); err != nil {
 		logger.Info("Failed to update Linode Firewall status and tags", "error", err.Error())
@@ -160,6 +162,9 @@ func createFirewall(ctx context.Context, fwScope *scope.FirewallScope, fwConfig
 	logger.Info(fmt.Sprintf("Creating firewall %s", fwScope.LinodeFirewall.Name))
 	opts := linodego.FirewallCreateOptions{
 		Label: fwScope.LinodeFirewall.Name,
+		// The UID tag tells the garbage collector which LinodeFirewall the firewall belongs to, and the owner tag
+		// allows it to delete the firewall.
+		Tags: append([]string{string(fwScope.LinodeFirewall.UID)}, util.GetOwnerTags(fwScope.ManagementClusterID)...),
 		Rules: linodego.FirewallRulesCreateOptions{
 			Inbound:        fwConfig.Inbound,
 			InboundPolicy:  fwConfig.InboundPolicy,
@@ -0,0 +1,582 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/cloud/services"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+// GCMode is the policy of the GarbageCollector for orphaned resources.
+type GCMode string
+
+const (
+	// GCModeReport only reports orphaned resources.
+	GCModeReport GCMode = "report"
+	// GCModeDelete deletes the resources that stayed orphaned for the grace period.
+	GCModeDelete GCMode = "delete"
+
+	// reasons of the Events recorded by the GarbageCollector
+	OrphanedResourceReason        = "OrphanedResource"
+	OrphanedResourceDeletedReason = "OrphanedResourceDeleted"
+
+	// kinds of the resources collected by the GarbageCollector
+	orphanKindLinode          = "linode"
+	orphanKindNodeBalancer    = "nodebalancer"
+	orphanKindFirewall        = "firewall"
+	orphanKindVPC             = "vpc"
+	orphanKindBootstrapObject = "bootstrapobject"
+)
+
+var orphanKinds = []string{orphanKindLinode, orphanKindNodeBalancer, orphanKindFirewall, orphanKindVPC, orphanKindBootstrapObject}
+
+var (
+	orphanedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capl_orphaned_resources",
+		Help: "Number of cloud resources created by CAPL that no object references anymore.",
+	}, []string{"kind"})
+	orphanedResourcesDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "capl_orphaned_resources_deleted_total",
+		Help: "Number of orphaned cloud resources deleted by the garbage collector.",
+	}, []string{"kind"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(orphanedResources, orphanedResourcesDeleted)
+}
+
+// GarbageCollector periodically looks for the cloud resources left behind by CAPL, for instance when a cluster is
+// deleted while it is provisioned or when the manager crashes between creating a resource and recording its ID.
+// The resources of every set of credentials in use are matched against the objects of the management cluster
+// through the tags CAPL sets on them, and only the resources tagged as created on behalf of this management cluster
+// are considered. Orphans are reported through metrics, logs and Events on their LinodeCluster
+// when it still exists, and only deleted in GCModeDelete once they stayed orphaned for GracePeriod.
+type GarbageCollector struct {
+	Client      client.Client
+	Recorder    events.EventRecorder
+	Interval    time.Duration
+	Mode        GCMode
+	GracePeriod time.Duration
+	S3Clients   scope.S3ClientBuilder
+	// ManagementClusterID identifies the management cluster in the owner tags of the resources it collects.
+	ManagementClusterID string
+
+	mu          sync.Mutex
+	credentials map[string]clients.LinodeClient
+	// firstSeen is when each orphan was first found, by kind and ID. Resources that are no longer orphaned,
+	// or could not be listed, are forgotten so their grace period starts over.
+	firstSeen map[string]time.Time
+	now       func() time.Time
+}
+
+// orphan is a cloud resource that no object references.
+type orphan struct {
+	kind  string
+	id    string
+	label string
+	// cluster is the existing LinodeCluster the orphan belongs to, if any.
+	cluster *infrav1alpha2.LinodeCluster
+	delete  func(ctx context.Context) error
+}
+
+// gcInventory holds what the objects of the management cluster reference.
+type gcInventory struct {
+	// ownerTag is the tag set on the resources created on behalf of the management cluster, only they are collected.
+	ownerTag string
+	clusters []infrav1alpha2.LinodeCluster
+	// clusterTags are the tags set by util.GetAutoGenTags for the existing LinodeClusters, they tell which cluster
+	// the Events about an orphaned Linode go to.
+	clusterTags     map[string]*infrav1alpha2.LinodeCluster
+	clusterUIDs     map[string]struct{}
+	nodeBalancerIDs map[int]struct{}
+	instanceIDs     map[int]struct{}
+	// instanceLabels are the labels of the instances of machines, which are known before their ID.
+	instanceLabels map[string]struct{}
+	machineUIDs    map[string]struct{}
+	firewallUIDs   map[string]struct{}
+	firewallIDs    map[int]struct{}
+	vpcUIDs        map[string]struct{}
+	vpcIDs         map[int]struct{}
+}
+
+// NewGarbageCollector returns a GarbageCollector looking for orphaned resources every interval.
+func NewGarbageCollector(k8sClient client.Client, recorder events.EventRecorder, interval time.Duration, mode GCMode, gracePeriod time.Duration, managementClusterID string) *GarbageCollector {
+	return &GarbageCollector{
+		ManagementClusterID: managementClusterID,
+		Client:              k8sClient,
+		Recorder:            recorder,
+		Interval:            interval,
+		Mode:                mode,
+		GracePeriod:         gracePeriod,
+		S3Clients:           scope.CreateS3Clients,
+		credentials:         make(map[string]clients.LinodeClient),
+		firstSeen:           make(map[string]time.Time),
+		now:                 time.Now,
+	}
+}
+
+// Track makes sure the resources of the account behind the credentials identified by tokenHash are collected.
+// Credentials are deduplicated by their hash, so this is cheap to call on every reconcile.
+// Calling Track on a nil GarbageCollector is a no-op.
+func (gc *GarbageCollector) Track(tokenHash string, linodeClient clients.LinodeClient) {
+	if gc == nil || tokenHash == "" {
+		return
+	}
+
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	if _, ok := gc.credentials[tokenHash]; !ok {
+		gc.credentials[tokenHash] = linodeClient
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the leader collects.
+func (gc *GarbageCollector) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable and looks for orphaned resources until the context is cancelled.
+func (gc *GarbageCollector) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("LinodeGarbageCollector")
+
+	ticker := time.NewTicker(reconciler.DefaultTimeout(gc.Interval, reconciler.DefaultGarbageCollectorInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			gc.collect(ctx, logger)
+		}
+	}
+}
+
+// collect finds the orphaned resources of every tracked set of credentials and handles them.
+func (gc *GarbageCollector) collect(ctx context.Context, logger logr.Logger) {
+	inventory, err := gc.inventory(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to list the objects referencing cloud resources")
+		return
+	}
+
+	gc.mu.Lock()
+	credentials := make(map[string]clients.LinodeClient, len(gc.credentials))
+	for tokenHash, linodeClient := range gc.credentials {
+		credentials[tokenHash] = linodeClient
+	}
+	gc.mu.Unlock()
+
+	var orphans []orphan
+	for tokenHash, linodeClient := range credentials {
+		found, err := findOrphans(ctx, linodeClient, inventory)
+		if err != nil {
+			if util.IgnoreLinodeAPIError(err, http.StatusUnauthorized) == nil {
+				// The token was revoked or rotated, it is tracked again as soon as it is used by a reconcile.
+				logger.Info("Stopped collecting resources for revoked credentials")
+				gc.mu.Lock()
+				delete(gc.credentials, tokenHash)
+				gc.mu.Unlock()
+
+				continue
+			}
+			logger.Error(err, "Failed to list cloud resources")
+
+			continue
+		}
+		orphans = append(orphans, found...)
+	}
+	orphans = append(orphans, gc.findOrphanedBootstrapObjects(ctx, logger, inventory)...)
+
+	gc.handle(ctx, logger, orphans)
+}
+
+// inventory lists the cloud resources referenced by the objects of the management cluster.
+func (gc *GarbageCollector) inventory(ctx context.Context) (*gcInventory, error) {
+	inventory := &gcInventory{
+		clusterTags:     make(map[string]*infrav1alpha2.LinodeCluster),
+		clusterUIDs:     make(map[string]struct{}),
+		nodeBalancerIDs: make(map[int]struct{}),
+		instanceIDs:     make(map[int]struct{}),
+		instanceLabels:  make(map[string]struct{}),
+		machineUIDs:     make(map[string]struct{}),
+		firewallUIDs:    make(map[string]struct{}),
+		firewallIDs:     make(map[int]struct{}),
+		vpcUIDs:         make(map[string]struct{}),
+		vpcIDs:          make(map[int]struct{}),
+	}
+	if ownerTags := util.GetOwnerTags(gc.ManagementClusterID); len(ownerTags) > 0 {
+		inventory.ownerTag = ownerTags[0]
+	}
+
+	var linodeClusters infrav1alpha2.LinodeClusterList
+	if err := gc.Client.List(ctx, &linodeClusters); err != nil {
+		return nil, err
+	}
+	inventory.clusters = linodeClusters.Items
+	for i := range inventory.clusters {
+		linodeCluster := &inventory.clusters[i]
+		inventory.clusterUIDs[string(linodeCluster.UID)] = struct{}{}
+		for _, tag := range util.GetAutoGenTags(linodeCluster) {
+			inventory.clusterTags[tag] = linodeCluster
+		}
+		if nodeBalancerID := linodeCluster.Spec.Network.NodeBalancerID; nodeBalancerID != nil {
+			inventory.nodeBalancerIDs[*nodeBalancerID] = struct{}{}
+		}
+	}
+
+	var linodeMachines infrav1alpha2.LinodeMachineList
+	if err := gc.Client.List(ctx, &linodeMachines); err != nil {
+		return nil, err
+	}
+	for i := range linodeMachines.Items {
+		linodeMachine := &linodeMachines.Items[i]
+		inventory.addMachine(linodeMachine.Name, linodeMachine.UID, linodeMachine.Spec.ProviderID)
+		// Instances selected for adoption belong to their LinodeMachine, even when the adoption is refused.
+		if selector := getAdoptionSelector(linodeMachine); selector != nil {
+			if selector.ID != nil {
+				inventory.instanceIDs[*selector.ID] = struct{}{}
+			}
+			if selector.Label != "" {
+				inventory.instanceLabels[selector.Label] = struct{}{}
+			}
+		}
+	}
+
+	var poolMachines infrav1alpha2.LinodeMachinePoolMachineList
+	if err := gc.Client.List(ctx, &poolMachines); err != nil {
+		return nil, err
+	}
+	for i := range poolMachines.Items {
+		poolMachine := &poolMachines.Items[i]
+		inventory.addMachine(poolMachine.Name, poolMachine.UID, poolMachine.Spec.ProviderID)
+	}
+
+	var linodeFirewalls infrav1alpha2.LinodeFirewallList
+	if err := gc.Client.List(ctx, &linodeFirewalls); err != nil {
+		return nil, err
+	}
+	for i := range linodeFirewalls.Items {
+		linodeFirewall := &linodeFirewalls.Items[i]
+		inventory.firewallUIDs[string(linodeFirewall.UID)] = struct{}{}
+		if firewallID := linodeFirewall.Spec.FirewallID; firewallID != nil {
+			inventory.firewallIDs[*firewallID] = struct{}{}
+		}
+	}
+
+	var linodeVPCs infrav1alpha2.LinodeVPCList
+	if err := gc.Client.List(ctx, &linodeVPCs); err != nil {
+		return nil, err
+	}
+	for i := range linodeVPCs.Items {
+		linodeVPC := &linodeVPCs.Items[i]
+		inventory.vpcUIDs[string(linodeVPC.UID)] = struct{}{}
+		if vpcID := linodeVPC.Spec.VPCID; vpcID != nil {
+			inventory.vpcIDs[*vpcID] = struct{}{}
+		}
+	}
+
+	return inventory, nil
+}
+
+func (inventory *gcInventory) addMachine(name string, uid types.UID, providerID *string) {
+	inventory.instanceLabels[name] = struct{}{}
+	inventory.machineUIDs[string(uid)] = struct{}{}
+	if instanceID, err := util.GetInstanceID(providerID); err == nil && instanceID != 0 {
+		inventory.instanceIDs[instanceID] = struct{}{}
+	}
+}
+
+// findOrphans lists the resources of an account and returns the ones created on behalf of the management cluster,
+// as told by their owner tag, that no object references:
+//   - NodeBalancers tagged with the UID of a LinodeCluster that doesn't exist anymore.
+//   - Linodes which no machine references by ID or label.
+//   - Firewalls tagged with the UID of a LinodeFirewall that doesn't exist anymore.
+//   - VPCs, which cannot be tagged, holding the UID of a LinodeVPC that doesn't exist anymore in their description.
+//
+// Resources without the owner tag of the management cluster are never collected, whatever their other tags.
+func findOrphans(ctx context.Context, linodeClient clients.LinodeClient, inventory *gcInventory) ([]orphan, error) {
+	if inventory.ownerTag == "" {
+		return nil, nil
+	}
+
+	var orphans []orphan
+
+	nodeBalancers, err := linodeClient.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list nodebalancers: %w", err)
+	}
+	for _, nodeBalancer := range nodeBalancers {
+		if !slices.Contains(nodeBalancer.Tags, inventory.ownerTag) {
+			continue
+		}
+		if _, ok := inventory.clusterUIDs[uidTag(nodeBalancer.Tags)]; ok {
+			continue
+		}
+		if _, ok := inventory.nodeBalancerIDs[nodeBalancer.ID]; ok {
+			continue
+		}
+		label := ""
+		if nodeBalancer.Label != nil {
+			label = *nodeBalancer.Label
+		}
+		nodeBalancerID := nodeBalancer.ID
+		orphans = append(orphans, orphan{
+			kind:  orphanKindNodeBalancer,
+			id:    strconv.Itoa(nodeBalancerID),
+			label: label,
+			delete: func(ctx context.Context) error {
+				return linodeClient.DeleteNodeBalancer(ctx, nodeBalancerID)
+			},
+		})
+	}
+
+	instances, err := linodeClient.ListInstances(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+	for _, instance := range instances {
+		if !slices.Contains(instance.Tags, inventory.ownerTag) {
+			continue
+		}
+		if _, ok := inventory.instanceIDs[instance.ID]; ok {
+			continue
+		}
+		if _, ok := inventory.instanceLabels[instance.Label]; ok {
+			continue
+		}
+		var cluster *infrav1alpha2.LinodeCluster
+		for _, tag := range instance.Tags {
+			if linodeCluster, ok := inventory.clusterTags[tag]; ok {
+				cluster = linodeCluster
+				break
+			}
+		}
+		instanceID := instance.ID
+		orphans = append(orphans, orphan{
+			kind:    orphanKindLinode,
+			id:      strconv.Itoa(instanceID),
+			label:   instance.Label,
+			cluster: cluster,
+			delete: func(ctx context.Context) error {
+				return linodeClient.DeleteInstance(ctx, instanceID)
+			},
+		})
+	}
+
+	firewalls, err := linodeClient.ListFirewalls(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list firewalls: %w", err)
+	}
+	for _, firewall := range firewalls {
+		if !slices.Contains(firewall.Tags, inventory.ownerTag) {
+			continue
+		}
+		if _, ok := inventory.firewallUIDs[uidTag(firewall.Tags)]; ok {
+			continue
+		}
+		if _, ok := inventory.firewallIDs[firewall.ID]; ok {
+			continue
+		}
+		firewallID := firewall.ID
+		orphans = append(orphans, orphan{
+			kind:  orphanKindFirewall,
+			id:    strconv.Itoa(firewallID),
+			label: firewall.Label,
+			delete: func(ctx context.Context) error {
+				return linodeClient.DeleteFirewall(ctx, firewallID)
+			},
+		})
+	}
+
+	vpcs, err := linodeClient.ListVPCs(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list vpcs: %w", err)
+	}
+	for _, vpc := range vpcs {
+		// vpcDescription appends the UID of the LinodeVPC and the owner tag to the description.
+		fields := strings.Fields(vpc.Description)
+		if len(fields) < 2 || fields[len(fields)-1] != inventory.ownerTag {
+			continue
+		}
+		if _, ok := inventory.vpcUIDs[fields[len(fields)-2]]; ok {
+			continue
+		}
+		if _, ok := inventory.vpcIDs[vpc.ID]; ok {
+			continue
+		}
+		vpcID := vpc.ID
+		orphans = append(orphans, orphan{
+			kind:  orphanKindVPC,
+			id:    strconv.Itoa(vpcID),
+			label: vpc.Label,
+			delete: func(ctx context.Context) error {
+				return linodeClient.DeleteVPC(ctx, vpcID)
+			},
+		})
+	}
+
+	return orphans, nil
+}
+
+// findOrphanedBootstrapObjects returns the bootstrap data objects of the cluster object stores, keyed by the UID of
+// their machine, whose machine doesn't exist anymore. Buckets may be shared, so only the objects whose metadata tells
+// they were created by the management cluster are returned.
+func (gc *GarbageCollector) findOrphanedBootstrapObjects(ctx context.Context, logger logr.Logger, inventory *gcInventory) []orphan {
+	if gc.ManagementClusterID == "" {
+		return nil
+	}
+
+	var orphans []orphan
+	for i := range inventory.clusters {
+		linodeCluster := &inventory.clusters[i]
+		if linodeCluster.Spec.ObjectStore == nil {
+			continue
+		}
+
+		storeScope := &scope.MachineScope{
+			Client:        gc.Client,
+			S3Clients:     gc.S3Clients,
+			LinodeCluster: linodeCluster,
+		}
+		keys, err := services.ListObjects(ctx, storeScope)
+		if err != nil {
+			logger.Error(err, "Failed to list bootstrap data objects", "cluster", linodeCluster.Name)
+			continue
+		}
+		for _, key := range keys {
+			if _, err := uuid.Parse(key); err != nil {
+				continue
+			}
+			if _, ok := inventory.machineUIDs[key]; ok {
+				continue
+			}
+			owner, err := services.GetObjectOwner(ctx, storeScope, key)
+			if err != nil {
+				logger.Error(err, "Failed to get the owner of the bootstrap data object", "cluster", linodeCluster.Name, "key", key)
+				continue
+			}
+			if owner != gc.ManagementClusterID {
+				continue
+			}
+			machineScope := &scope.MachineScope{
+				Client:        gc.Client,
+				S3Clients:     gc.S3Clients,
+				LinodeCluster: linodeCluster,
+				LinodeMachine: &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{UID: types.UID(key)}},
+			}
+			orphans = append(orphans, orphan{
+				kind:    orphanKindBootstrapObject,
+				id:      key,
+				cluster: linodeCluster,
+				delete: func(ctx context.Context) error {
+					return services.DeleteObject(ctx, machineScope)
+				},
+			})
+		}
+	}
+
+	return orphans
+}
+
+// handle reports the orphans and deletes the ones that stayed orphaned for the grace period in GCModeDelete.
+func (gc *GarbageCollector) handle(ctx context.Context, logger logr.Logger, orphans []orphan) {
+	now := gc.now()
+	seen := make(map[string]time.Time, len(orphans))
+	handled := make(map[string]struct{}, len(orphans))
+	counts := make(map[string]int, len(orphanKinds))
+
+	for _, found := range orphans {
+		key := found.kind + "/" + found.id
+		if _, ok := handled[key]; ok {
+			// The same account is used by several credentials.
+			continue
+		}
+		handled[key] = struct{}{}
+		counts[found.kind]++
+
+		firstSeen, ok := gc.firstSeen[key]
+		if !ok {
+			firstSeen = now
+			logger.Info("Found orphaned resource", "kind", found.kind, "id", found.id, "label", found.label)
+			gc.record(found, corev1.EventTypeWarning, OrphanedResourceReason, "Found orphaned %s %s", found.kind, found.id)
+		}
+		seen[key] = firstSeen
+
+		if gc.Mode != GCModeDelete || gc.GracePeriod <= 0 || now.Sub(firstSeen) < gc.GracePeriod {
+			continue
+		}
+		if err := found.delete(ctx); err != nil && util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+			logger.Error(err, "Failed to delete orphaned resource", "kind", found.kind, "id", found.id)
+			continue
+		}
+		logger.Info("Deleted orphaned resource", "kind", found.kind, "id", found.id, "label", found.label)
+		gc.record(found, corev1.EventTypeNormal, OrphanedResourceDeletedReason, "Deleted orphaned %s %s", found.kind, found.id)
+		orphanedResourcesDeleted.WithLabelValues(found.kind).Inc()
+		counts[found.kind]--
+		delete(seen, key)
+	}
+
+	gc.firstSeen = seen
+	for _, kind := range orphanKinds {
+		orphanedResources.WithLabelValues(kind).Set(float64(counts[kind]))
+	}
+}
+
+// record emits a Kubernetes Event on the LinodeCluster of the orphan, if it still exists.
+func (gc *GarbageCollector) record(found orphan, eventType, reason, note string, args ...any) {
+	if found.cluster == nil {
+		return
+	}
+	gc.Recorder.Eventf(found.cluster, nil, eventType, reason, "CollectGarbage", note, args...)
+}
+
+// uidTag returns the first tag that is a UID, as set by CAPL on the resources of a LinodeCluster or LinodeFirewall.
+func uidTag(tags []string) string {
+	for _, tag := range tags {
+		if _, err := uuid.Parse(tag); err == nil {
+			return tag
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+	"github.com/linode/cluster-api-provider-linode/util"
+)
+
+const (
+	liveClusterUID     = "3f1a5b6e-8f5e-4f7c-9d2a-1b2c3d4e5f60"
+	deletedClusterUID  = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	liveFirewallUID    = "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	deletedFirewallUID = "7e6d5c4b-3a29-4180-9f8e-7d6c5b4a3928"
+	liveVPCUID         = "5b4a3c2d-1e0f-4a9b-8c7d-6e5f4a3b2c1d"
+	deletedVPCUID      = "2d3c4b5a-6f7e-4d8c-9b0a-1f2e3d4c5b6a"
+)
+
+func orphanIDs(orphans []orphan) map[string]string {
+	ids := make(map[string]string, len(orphans))
+	for _, found := range orphans {
+		ids[found.kind+"/"+found.id] = found.label
+	}
+	return ids
+}
+
+func TestFindOrphans(t *testing.T) {
+	t.Parallel()
+
+	const ownerTag = "capl-owner:test-management-cluster"
+	liveCluster := infrav1alpha2.LinodeCluster{ObjectMeta: metav1.ObjectMeta{Name: "live", UID: liveClusterUID}}
+	inventory := &gcInventory{
+		ownerTag:        ownerTag,
+		clusterTags:     map[string]*infrav1alpha2.LinodeCluster{"live": &liveCluster},
+		clusterUIDs:     map[string]struct{}{liveClusterUID: {}},
+		nodeBalancerIDs: map[int]struct{}{},
+		instanceIDs:     map[int]struct{}{10: {}},
+		instanceLabels:  map[string]struct{}{"live-md-0-creating": {}},
+		machineUIDs:     map[string]struct{}{},
+		firewallUIDs:    map[string]struct{}{liveFirewallUID: {}},
+		firewallIDs:     map[int]struct{}{},
+		vpcUIDs:         map[string]struct{}{liveVPCUID: {}},
+		vpcIDs:          map[int]struct{}{33: {}},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockClient := mock.NewMockLinodeClient(ctrl)
+	mockClient.EXPECT().ListNodeBalancers(gomock.Any(), gomock.Any()).Return([]linodego.NodeBalancer{
+		{ID: 1, Label: ptr.To("live"), Tags: []string{liveClusterUID, ownerTag}},
+		{ID: 2, Label: ptr.To("deleted"), Tags: []string{deletedClusterUID, ownerTag}},
+		{ID: 3, Label: ptr.To("unmanaged"), Tags: []string{"prod"}},
+		{ID: 4, Label: ptr.To("other-management-cluster"), Tags: []string{deletedClusterUID, "capl-owner:other"}},
+		{ID: 5, Label: ptr.To("uid-tagged"), Tags: []string{deletedClusterUID}},
+	}, nil)
+	mockClient.EXPECT().ListInstances(gomock.Any(), gomock.Any()).Return([]linodego.Instance{
+		{ID: 10, Label: "live-control-plane-abc", Tags: []string{"live", ownerTag}},
+		{ID: 11, Label: "live-md-0-creating", Tags: []string{"live", ownerTag}},
+		{ID: 12, Label: "live-md-0-leaked", Tags: []string{"live", ownerTag}},
+		{ID: 13, Label: "deleted-md-0-abc", Tags: []string{"deleted", ownerTag}},
+		{ID: 14, Label: "unmanaged", Tags: []string{"prod"}},
+		{ID: 15, Label: "live-tagged", Tags: []string{"live"}},
+		{ID: 16, Label: "other-management-cluster", Tags: []string{"live", "capl-owner:other"}},
+	}, nil)
+	mockClient.EXPECT().ListFirewalls(gomock.Any(), gomock.Any()).Return([]linodego.Firewall{
+		{ID: 20, Label: "live-fw", Tags: []string{liveFirewallUID, ownerTag}},
+		{ID: 21, Label: "deleted-fw", Tags: []string{deletedFirewallUID, ownerTag}},
+		{ID: 22, Label: "unmanaged"},
+		{ID: 23, Label: "uid-tagged", Tags: []string{deletedFirewallUID}},
+	}, nil)
+	mockClient.EXPECT().ListVPCs(gomock.Any(), gomock.Any()).Return([]linodego.VPC{
+		{ID: 30, Label: "live-vpc", Description: "live " + liveVPCUID + " " + ownerTag},
+		{ID: 31, Label: "deleted-vpc", Description: deletedVPCUID + " " + ownerTag},
+		{ID: 32, Label: "unmanaged", Description: deletedVPCUID},
+		{ID: 33, Label: "adopted-vpc", Description: deletedVPCUID + " " + ownerTag},
+		{ID: 34, Label: "other-management-cluster", Description: deletedVPCUID + " capl-owner:other"},
+		{ID: 35, Label: "owner-in-text", Description: ownerTag + " " + deletedVPCUID},
+	}, nil)
+
+	orphans, err := findOrphans(t.Context(), mockClient, inventory)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"nodebalancer/2": "deleted",
+		"linode/12":      "live-md-0-leaked",
+		"linode/13":      "deleted-md-0-abc",
+		"firewall/21":    "deleted-fw",
+		"vpc/31":         "deleted-vpc",
+	}, orphanIDs(orphans))
+
+	for _, found := range orphans {
+		if found.kind == orphanKindLinode && found.id == "12" {
+			assert.Equal(t, &liveCluster, found.cluster)
+		} else {
+			assert.Nil(t, found.cluster)
+		}
+	}
+}
+
+func TestFindOrphansAfterMove(t *testing.T) {
+	t.Parallel()
+
+	// The cluster was moved by clusterctl, so the previous management cluster doesn't know about it anymore while the
+	// new one claimed its resources.
+	inventory := &gcInventory{
+		ownerTag:        "capl-owner:previous-management-cluster",
+		clusterTags:     map[string]*infrav1alpha2.LinodeCluster{},
+		clusterUIDs:     map[string]struct{}{},
+		nodeBalancerIDs: map[int]struct{}{},
+		instanceIDs:     map[int]struct{}{},
+		instanceLabels:  map[string]struct{}{},
+		machineUIDs:     map[string]struct{}{},
+		firewallUIDs:    map[string]struct{}{},
+		firewallIDs:     map[int]struct{}{},
+		vpcUIDs:         map[string]struct{}{},
+		vpcIDs:          map[int]struct{}{},
+	}
+	claim := func(tags ...string) []string {
+		return util.ClaimOwnerTags(append(tags, inventory.ownerTag), "new-management-cluster")
+	}
+
+	ctrl := gomock.NewController(t)
+	mockClient := mock.NewMockLinodeClient(ctrl)
+	mockClient.EXPECT().ListNodeBalancers(gomock.Any(), gomock.Any()).Return([]linodego.NodeBalancer{
+		{ID: 1, Label: ptr.To("moved"), Tags: claim(liveClusterUID)},
+	}, nil)
+	mockClient.EXPECT().ListInstances(gomock.Any(), gomock.Any()).Return([]linodego.Instance{
+		{ID: 10, Label: "moved-control-plane-abc", Tags: claim("moved")},
+	}, nil)
+	mockClient.EXPECT().ListFirewalls(gomock.Any(), gomock.Any()).Return([]linodego.Firewall{
+		{ID: 20, Label: "moved-fw", Tags: claim(liveFirewallUID)},
+	}, nil)
+	mockClient.EXPECT().ListVPCs(gomock.Any(), gomock.Any()).Return([]linodego.VPC{
+		{ID: 30, Label: "moved-vpc", Description: liveVPCUID + " capl-owner:new-management-cluster"},
+	}, nil)
+
+	orphans, err := findOrphans(t.Context(), mockClient, inventory)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func TestFindOrphansWithoutOwnerTag(t *testing.T) {
+	t.Parallel()
+
+	// Without the ID of the management cluster nothing is collectable, so the account is not even listed.
+	ctrl := gomock.NewController(t)
+	orphans, err := findOrphans(t.Context(), mock.NewMockLinodeClient(ctrl), &gcInventory{})
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func TestGarbageCollectorHandle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		mode        GCMode
+		gracePeriod time.Duration
+		firstSeen   time.Duration
+		wantDeleted bool
+	}{
+		{name: "report mode never deletes", mode: GCModeReport, gracePeriod: time.Hour, firstSeen: 2 * time.Hour},
+		{name: "delete mode waits for the grace period", mode: GCModeDelete, gracePeriod: time.Hour, firstSeen: 30 * time.Minute},
+		{name: "delete mode without a grace period never deletes", mode: GCModeDelete, firstSeen: 2 * time.Hour},
+		{name: "delete mode deletes after the grace period", mode: GCModeDelete, gracePeriod: time.Hour, firstSeen: 2 * time.Hour, wantDeleted: true},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			recorder := events.NewFakeRecorder(10)
+			gc := NewGarbageCollector(nil, recorder, time.Minute, testcase.mode, testcase.gracePeriod, "test-management-cluster")
+			gc.now = func() time.Time { return now }
+			gc.firstSeen["linode/12"] = now.Add(-testcase.firstSeen)
+			gc.firstSeen["linode/99"] = now.Add(-testcase.firstSeen)
+
+			deleted := 0
+			found := orphan{
+				kind:    orphanKindLinode,
+				id:      "12",
+				cluster: &infrav1alpha2.LinodeCluster{},
+				delete: func(context.Context) error {
+					deleted++
+					return nil
+				},
+			}
+			// The same orphan listed with two sets of credentials of the same account is only handled once.
+			gc.handle(t.Context(), testr.New(t), []orphan{found, found})
+
+			// Resources that are no longer orphaned are forgotten.
+			assert.NotContains(t, gc.firstSeen, "linode/99")
+			if testcase.wantDeleted {
+				assert.Equal(t, 1, deleted)
+				assert.NotContains(t, gc.firstSeen, "linode/12")
+				assert.Contains(t, <-recorder.Events, OrphanedResourceDeletedReason)
+				return
+			}
+			assert.Zero(t, deleted)
+			assert.Equal(t, now.Add(-testcase.firstSeen), gc.firstSeen["linode/12"])
+			assert.Empty(t, recorder.Events)
+		})
+	}
+}
+
+func TestGarbageCollectorHandleNewOrphan(t *testing.T) {
+	t.Parallel()
+
+	recorder := events.NewFakeRecorder(10)
+	gc := NewGarbageCollector(nil, recorder, time.Minute, GCModeDelete, time.Hour, "test-management-cluster")
+
+	gc.handle(t.Context(), testr.New(t), []orphan{
+		{kind: orphanKindLinode, id: "12", cluster: &infrav1alpha2.LinodeCluster{}},
+		{kind: orphanKindNodeBalancer, id: "2"},
+	})
+
+	assert.Contains(t, gc.firstSeen, "linode/12")
+	assert.Contains(t, gc.firstSeen, "nodebalancer/2")
+	// Only orphans of an existing LinodeCluster have an object to record Events on.
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, OrphanedResourceReason)
+}
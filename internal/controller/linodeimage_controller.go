@@ -0,0 +1,620 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	kutil "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/paused"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/cloud/services"
+	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
+	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+const (
+	// ConditionImageUploaded is set once the raw disk image has been uploaded to the Image.
+	ConditionImageUploaded = "ImageUploaded"
+)
+
+// LinodeImageReconciler reconciles a LinodeImage object
+type LinodeImageReconciler struct {
+	client.Client
+	Recorder           events.EventRecorder
+	LinodeClientConfig scope.ClientConfig
+	WatchFilterValue   string
+	ReconcileTimeout   time.Duration
+
+	uploads imageUploads
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeimages,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeimages/finalizers,verbs=update
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeobjectstoragebuckets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the Image closer to the desired state.
+//
+
+func (r *LinodeImageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx).WithName("LinodeImageReconciler").WithValues("name", req.String())
+
+	linodeImage := &infrav1alpha2.LinodeImage{}
+	if err := r.TracedClient().Get(ctx, req.NamespacedName, linodeImage); err != nil {
+		if err = client.IgnoreNotFound(err); err != nil {
+			log.Error(err, "Failed to fetch LinodeImage")
+		}
+
+		return ctrl.Result{}, err
+	}
+	var cluster *clusterv1.Cluster
+	var err error
+	if _, ok := linodeImage.Labels[clusterv1.ClusterNameLabel]; ok {
+		cluster, err = kutil.GetClusterFromMetadata(ctx, r.TracedClient(), linodeImage.ObjectMeta)
+		if err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				log.Error(err, "failed to fetch cluster from metadata")
+				return ctrl.Result{}, err
+			}
+			log.Info("Cluster not found but LinodeImage is being deleted, continuing with deletion")
+		}
+
+		// Set ownerRef to LinodeCluster
+		// It will handle the case where the cluster is not found
+		if err := util.SetOwnerReferenceToLinodeCluster(ctx, r.TracedClient(), cluster, linodeImage, r.Scheme()); err != nil {
+			log.Error(err, "Failed to set owner reference to LinodeCluster")
+			return ctrl.Result{}, err
+		}
+	}
+
+	imageScope, err := scope.NewImageScope(
+		ctx,
+		r.LinodeClientConfig,
+		scope.ImageScopeParams{
+			Client:      r.TracedClient(),
+			LinodeImage: linodeImage,
+			Cluster:     cluster,
+		},
+	)
+	if err != nil {
+		log.Error(err, "Failed to create Image scope")
+
+		return ctrl.Result{}, fmt.Errorf("failed to create Image scope: %w", err)
+	}
+
+	// Only check pause if not deleting or if cluster still exists.
+	if linodeImage.DeletionTimestamp.IsZero() || cluster != nil {
+		isPaused, _, err := paused.EnsurePausedCondition(ctx, imageScope.Client, imageScope.Cluster, imageScope.LinodeImage)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if isPaused {
+			log.Info("linodeimage or linked cluster is paused, skipping reconciliation")
+			return ctrl.Result{}, nil
+		}
+	}
+
+	return r.reconcile(ctx, log, imageScope)
+}
+
+func (r *LinodeImageReconciler) reconcile(
+	ctx context.Context,
+	logger logr.Logger,
+	imageScope *scope.ImageScope,
+) (res ctrl.Result, err error) {
+	res = ctrl.Result{}
+
+	imageScope.LinodeImage.Status.Ready = false
+	imageScope.LinodeImage.Status.FailureReason = nil
+	imageScope.LinodeImage.Status.FailureMessage = util.Pointer("")
+
+	failureReason := infrav1alpha2.LinodeImageStatusError("UnknownError")
+	//nolint:dupl // Code duplication is simplicity in this case.
+	defer func() {
+		if err != nil {
+			imageScope.LinodeImage.Status.FailureReason = util.Pointer(failureReason)
+			imageScope.LinodeImage.Status.FailureMessage = util.Pointer(err.Error())
+
+			imageScope.LinodeImage.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(failureReason),
+				Message: err.Error(),
+			})
+
+			r.Recorder.Eventf(
+				imageScope.LinodeImage,
+				nil,
+				corev1.EventTypeWarning,
+				string(failureReason),
+				"Reconcile",
+				err.Error(),
+			)
+		}
+
+		// Always close the scope when exiting this function so we can persist any LinodeImage changes.
+		// This ignores any resource not found errors when reconciling deletions.
+		if patchErr := imageScope.Close(ctx); patchErr != nil && utilerrors.FilterOut(util.UnwrapError(patchErr), apierrors.IsNotFound) != nil {
+			logger.Error(patchErr, "failed to patch LinodeImage")
+
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	// Override the controller credentials with ones from the Image's Secret reference (if supplied).
+	if err := imageScope.SetCredentialRefTokenForLinodeClients(ctx); err != nil {
+		logger.Error(err, "failed to update linode client token from Credential Ref")
+		return res, err
+	}
+
+	// Delete
+	if !imageScope.LinodeImage.DeletionTimestamp.IsZero() {
+		failureReason = infrav1alpha2.DeleteImageError
+
+		res, err = r.reconcileDelete(ctx, logger, imageScope)
+
+		return
+	}
+
+	// Add the finalizer if not already there
+	err = imageScope.AddFinalizer(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to add finalizer")
+
+		return
+	}
+
+	// Create
+	if imageScope.LinodeImage.Status.ImageID == nil {
+		failureReason = infrav1alpha2.CreateImageError
+
+		err = r.reconcileCreate(ctx, logger, imageScope)
+		if err != nil {
+			if !reconciler.HasStaleCondition(imageScope.LinodeImage.GetCondition(string(clusterv1.ReadyCondition)),
+				reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultImageControllerReconcileTimeout)) {
+				logger.Info("re-queuing Image creation")
+
+				res = ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}
+				err = nil
+			}
+
+			return
+		}
+	}
+
+	// Update
+	failureReason = infrav1alpha2.UpdateImageError
+
+	logger = logger.WithValues("imageID", *imageScope.LinodeImage.Status.ImageID)
+
+	res, err = r.reconcileUpdate(ctx, logger, imageScope)
+	if err != nil && !reconciler.HasStaleCondition(imageScope.LinodeImage.GetCondition(string(clusterv1.ReadyCondition)),
+		reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultImageControllerReconcileTimeout)) {
+		logger.Info("re-queuing Image update")
+
+		res = ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}
+		err = nil
+	}
+
+	return
+}
+
+func (r *LinodeImageReconciler) reconcileCreate(ctx context.Context, logger logr.Logger, imageScope *scope.ImageScope) error {
+	logger.Info("creating image")
+
+	if err := imageScope.AddCredentialsRefFinalizer(ctx); err != nil {
+		logger.Error(err, "Failed to update credentials secret")
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.CreateImageError),
+			Message: err.Error(),
+		})
+
+		return err
+	}
+
+	if err := r.reconcileImageUpload(ctx, logger, imageScope); err != nil {
+		logger.Error(err, "Failed to create Image")
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.CreateImageError),
+			Message: err.Error(),
+		})
+		r.Recorder.Eventf(
+			imageScope.LinodeImage,
+			nil,
+			corev1.EventTypeWarning,
+			string(infrav1alpha2.CreateImageError),
+			"CreateImage",
+			err.Error(),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+//nolint:cyclop // The Image goes through several states that all need handling here.
+func (r *LinodeImageReconciler) reconcileUpdate(ctx context.Context, logger logr.Logger, imageScope *scope.ImageScope) (ctrl.Result, error) {
+	logger.Info("updating image")
+
+	imageID := *imageScope.LinodeImage.Status.ImageID
+	image, err := imageScope.LinodeClient.GetImage(ctx, imageID)
+	if err != nil {
+		if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+			// The Image was deleted out of band, upload it again.
+			logger.Info("Image not found via API, re-creating it")
+			r.uploads.forget(imageID)
+			resetImageUpload(imageScope)
+
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+		}
+		logger.Error(err, "Failed to fetch Image")
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.UpdateImageError),
+			Message: err.Error(),
+		})
+
+		return ctrl.Result{}, err
+	}
+
+	imageScope.LinodeImage.Status.Size = image.Size
+	imageScope.LinodeImage.Status.Regions = linodeImageRegionStatuses(image.Regions)
+
+	switch image.Status {
+	case linodego.ImageStatusPendingUpload:
+		if cond := imageScope.LinodeImage.GetCondition(ConditionImageUploaded); cond == nil || cond.Status != metav1.ConditionTrue {
+			if err := r.reconcileImageUploadProgress(ctx, logger, imageScope); err != nil {
+				imageScope.LinodeImage.SetCondition(metav1.Condition{
+					Type:    clusterv1.ReadyCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  string(infrav1alpha2.UpdateImageError),
+					Message: err.Error(),
+				})
+
+				return ctrl.Result{}, err
+			}
+		}
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: string(image.Status), // We have to set the reason to not fail object patching
+		})
+
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+	case linodego.ImageStatusAvailable:
+	default:
+		logger.Info("Image is not yet available", "status", image.Status)
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: string(image.Status), // We have to set the reason to not fail object patching
+		})
+
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+	}
+
+	if linodeImageChanged(imageScope.LinodeImage, image) {
+		if _, err := imageScope.LinodeClient.UpdateImage(ctx, imageID, linodego.ImageUpdateOptions{
+			Description: util.Pointer(imageScope.LinodeImage.Spec.Description),
+			Tags:        imageScope.LinodeImage.Spec.Tags,
+		}); err != nil {
+			logger.Error(err, "Failed to update Image")
+			imageScope.LinodeImage.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(infrav1alpha2.UpdateImageError),
+				Message: err.Error(),
+			})
+
+			return ctrl.Result{}, err
+		}
+	}
+
+	desiredRegions := linodeImageDesiredRegions(imageScope.LinodeImage)
+	if !slices.Equal(desiredRegions, linodeImageCurrentRegions(image.Regions)) {
+		logger.Info("replicating image", "regions", desiredRegions)
+		if _, err := imageScope.LinodeClient.ReplicateImage(ctx, imageID, linodego.ImageReplicateOptions{Regions: desiredRegions}); err != nil {
+			logger.Error(err, "Failed to replicate Image")
+			imageScope.LinodeImage.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(infrav1alpha2.UpdateImageError),
+				Message: err.Error(),
+			})
+			r.Recorder.Eventf(
+				imageScope.LinodeImage,
+				nil,
+				corev1.EventTypeWarning,
+				string(infrav1alpha2.UpdateImageError),
+				"ReplicateImage",
+				err.Error(),
+			)
+
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(
+			imageScope.LinodeImage,
+			nil,
+			corev1.EventTypeNormal,
+			"Replicating",
+			"ReplicateImage",
+			"Replicating Image %s to regions %v",
+			imageID,
+			desiredRegions,
+		)
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: "Replicating", // We have to set the reason to not fail object patching
+		})
+
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+	}
+
+	for _, region := range image.Regions {
+		if region.Status != linodego.ImageRegionStatusAvailable {
+			logger.Info("Image is not yet available in every region", "region", region.Region, "status", region.Status)
+			imageScope.LinodeImage.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  "Replicating", // We have to set the reason to not fail object patching
+				Message: fmt.Sprintf("Image is %s in region %s", region.Status, region.Region),
+			})
+
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+		}
+	}
+
+	imageScope.LinodeImage.Status.Ready = true
+	imageScope.LinodeImage.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: "LinodeImageReady", // We have to set the reason to not fail object patching
+	})
+
+	return ctrl.Result{}, nil
+}
+
+func (r *LinodeImageReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, imageScope *scope.ImageScope) (ctrl.Result, error) {
+	logger.Info("deleting Image")
+
+	switch {
+	case imageScope.LinodeImage.Spec.Retain:
+		logger.Info("Image has retain flag, skipping Image deletion")
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.NotDeletingReason,
+			Message: "Image retained as requested, associated cloud resource was not deleted.",
+		})
+	case imageScope.LinodeImage.Status.ImageID != nil:
+		imageID := *imageScope.LinodeImage.Status.ImageID
+		logger = logger.WithValues("imageID", imageID)
+
+		r.uploads.forget(imageID)
+		if err := imageScope.LinodeClient.DeleteImage(ctx, imageID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+			logger.Error(err, "Failed to delete Image via API")
+			if imageScope.LinodeImage.ObjectMeta.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultImageControllerReconcileTimeout)).After(time.Now()) {
+				logger.Info("re-queuing Image deletion due to API delete error")
+				return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to delete image %s after timeout: %w", imageID, err)
+		}
+
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.DeletionCompletedReason,
+			Message: "Image deleted",
+		})
+		imageScope.LinodeImage.Status.ImageID = nil
+	default:
+		logger.Info("Image ID is missing, nothing to do")
+	}
+
+	if err := imageScope.RemoveCredentialsRefFinalizer(ctx); err != nil {
+		logger.Error(err, "Failed to remove credentials secret finalizer")
+		if imageScope.LinodeImage.ObjectMeta.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultImageControllerReconcileTimeout)).After(time.Now()) {
+			logger.Info("re-queuing Image deletion due to credential finalizer removal error")
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultImageControllerReconcileDelay)}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to remove credential finalizer after timeout: %w", err)
+	}
+
+	controllerutil.RemoveFinalizer(imageScope.LinodeImage, infrav1alpha2.ImageFinalizer)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+//
+//nolint:dupl // this is same as Volume, worth making generic later.
+func (r *LinodeImageReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
+	linodeImageMapper, err := kutil.ClusterToTypedObjectsMapper(
+		r.TracedClient(),
+		&infrav1alpha2.LinodeImageList{},
+		mgr.GetScheme(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create mapper for LinodeImages: %w", err)
+	}
+
+	if err := mgr.Add(&r.uploads); err != nil {
+		return fmt.Errorf("failed to add image uploads: %w", err)
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.LinodeImage{}).
+		WithOptions(options).
+		WithEventFilter(predicate.And(
+			predicates.ResourceHasFilterLabel(mgr.GetScheme(), mgr.GetLogger(), r.WatchFilterValue),
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			),
+		)).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(linodeImageMapper),
+			builder.WithPredicates(predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), mgr.GetLogger())),
+		).Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+	if err != nil {
+		return fmt.Errorf("failed to build controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LinodeImageReconciler) TracedClient() client.Client {
+	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
+}
+
+// reconcileImageUpload creates the Image and starts uploading the raw disk image from its source in the background.
+func (r *LinodeImageReconciler) reconcileImageUpload(ctx context.Context, logger logr.Logger, imageScope *scope.ImageScope) error {
+	sourceURL, err := services.ImageSourceURL(ctx, imageScope)
+	if err != nil {
+		logger.Error(err, "Failed to resolve Image source")
+		return err
+	}
+
+	image, uploadURL, err := imageScope.LinodeClient.CreateImageUpload(ctx, linodeImageSpecToImageCreateUploadConfig(imageScope.LinodeImage))
+	if err != nil {
+		logger.Error(err, "Failed to create Image")
+		return err
+	}
+	if image == nil {
+		return errors.New("created image was nil")
+	}
+
+	// Persist the Image ID before the potentially long upload so an interrupted upload can be cleaned up.
+	imageScope.LinodeImage.Status.ImageID = util.Pointer(image.ID)
+	imageScope.LinodeImage.SetCondition(metav1.Condition{
+		Type:   ConditionImageUploaded,
+		Status: metav1.ConditionFalse,
+		Reason: "Uploading", // We have to set the reason to not fail object patching
+	})
+	if err := imageScope.PatchObject(ctx); err != nil {
+		logger.Error(err, "Failed to persist Image ID")
+		return err
+	}
+
+	logger.Info("uploading image", "imageID", image.ID)
+
+	// Uploads can take much longer than a regular reconcile loop, their progress is checked by the next ones.
+	linodeClient := imageScope.LinodeClient
+	r.uploads.start(image.ID, reconciler.DefaultImageControllerUploadTimeout, func(ctx context.Context, transferred *atomic.Int64) error {
+		return services.UploadImage(ctx, linodeClient, sourceURL, uploadURL, transferred)
+	})
+
+	return nil
+}
+
+// reconcileImageUploadProgress reports the progress of the upload of an Image waiting for it. Images whose upload
+// failed, or was interrupted by a restart of the manager, are deleted to be created and uploaded again.
+func (r *LinodeImageReconciler) reconcileImageUploadProgress(ctx context.Context, logger logr.Logger, imageScope *scope.ImageScope) error {
+	imageID := *imageScope.LinodeImage.Status.ImageID
+	upload, ok := r.uploads.get(imageID)
+	if !ok {
+		// The upload URL is only handed out once, an interrupted upload can't be resumed.
+		logger.Info("Image upload was interrupted, re-creating it")
+		if err := imageScope.LinodeClient.DeleteImage(ctx, imageID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+			logger.Error(err, "Failed to delete incomplete Image")
+			return err
+		}
+		resetImageUpload(imageScope)
+
+		return nil
+	}
+
+	finished, err := upload.finished()
+	switch {
+	case !finished:
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:    ConditionImageUploaded,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Uploading", // We have to set the reason to not fail object patching
+			Message: fmt.Sprintf("Uploaded %d bytes", upload.transferred.Load()),
+		})
+	case err != nil:
+		logger.Error(err, "Failed to upload Image")
+		r.uploads.forget(imageID)
+		if deleteErr := imageScope.LinodeClient.DeleteImage(ctx, imageID); util.IgnoreLinodeAPIError(deleteErr, http.StatusNotFound) != nil {
+			logger.Error(deleteErr, "Failed to delete incomplete Image")
+			return errors.Join(err, deleteErr)
+		}
+		resetImageUpload(imageScope)
+
+		return err
+	default:
+		r.uploads.forget(imageID)
+		imageScope.LinodeImage.SetCondition(metav1.Condition{
+			Type:   ConditionImageUploaded,
+			Status: metav1.ConditionTrue,
+			Reason: "Uploaded", // We have to set the reason to not fail object patching
+		})
+		r.Recorder.Eventf(
+			imageScope.LinodeImage,
+			nil,
+			corev1.EventTypeNormal,
+			"Uploaded",
+			"UploadImage",
+			"Uploaded Image %s",
+			imageID,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+
+	"github.com/linode/linodego/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+func linodeImageSpecToImageCreateUploadConfig(linodeImage *infrav1alpha2.LinodeImage) linodego.ImageCreateUploadOptions {
+	label := linodeImage.Spec.Label
+	if label == "" {
+		label = linodeImage.Name
+	}
+
+	return linodego.ImageCreateUploadOptions{
+		Region:      linodeImage.Spec.Region,
+		Label:       label,
+		Description: linodeImage.Spec.Description,
+		CloudInit:   linodeImage.Spec.CloudInit,
+		Tags:        linodeImage.Spec.Tags,
+	}
+}
+
+// resetImageUpload forgets the current Image so that it is created and uploaded again.
+func resetImageUpload(imageScope *scope.ImageScope) {
+	imageScope.LinodeImage.Status.ImageID = nil
+	imageScope.LinodeImage.Status.Size = 0
+	imageScope.LinodeImage.Status.Regions = nil
+	meta.RemoveStatusCondition(&imageScope.LinodeImage.Status.Conditions, ConditionImageUploaded)
+}
+
+// linodeImageChanged reports whether the description or tags of the Image differ from the LinodeImage spec. Tags
+// are compared ignoring order, like the tags of Volumes.
+func linodeImageChanged(linodeImage *infrav1alpha2.LinodeImage, image *linodego.Image) bool {
+	return image.Description != linodeImage.Spec.Description || volumeTagsChanged(linodeImage.Spec.Tags, image.Tags)
+}
+
+// linodeImageDesiredRegions returns the sorted regions the Image should be available in.
+func linodeImageDesiredRegions(linodeImage *infrav1alpha2.LinodeImage) []string {
+	regions := append([]string{linodeImage.Spec.Region}, linodeImage.Spec.ReplicaRegions...)
+	slices.Sort(regions)
+
+	return slices.Compact(regions)
+}
+
+// linodeImageCurrentRegions returns the sorted regions the Image is available or being replicated in.
+func linodeImageCurrentRegions(imageRegions []linodego.ImageRegion) []string {
+	regions := make([]string, 0, len(imageRegions))
+	for _, region := range imageRegions {
+		if region.Status == linodego.ImageRegionStatusPendingDeletion {
+			continue
+		}
+		regions = append(regions, region.Region)
+	}
+	slices.Sort(regions)
+
+	return regions
+}
+
+func linodeImageRegionStatuses(imageRegions []linodego.ImageRegion) []infrav1alpha2.LinodeImageRegionStatus {
+	if len(imageRegions) == 0 {
+		return nil
+	}
+
+	statuses := make([]infrav1alpha2.LinodeImageRegionStatus, 0, len(imageRegions))
+	for _, region := range imageRegions {
+		statuses = append(statuses, infrav1alpha2.LinodeImageRegionStatus{
+			Region: region.Region,
+			Status: string(region.Status),
+		})
+	}
+
+	return statuses
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+	rec "github.com/linode/cluster-api-provider-linode/util/reconciler"
+
+	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lifecycle", Ordered, Label("image", "lifecycle"), func() {
+	suite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{})
+
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("image"))
+	}))
+
+	linodeImage := infrav1alpha2.LinodeImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "lifecycle",
+			Namespace: "default",
+		},
+		Spec: infrav1alpha2.LinodeImageSpec{
+			Region:         "us-ord",
+			ReplicaRegions: []string{"us-sea"},
+			Source: infrav1alpha2.LinodeImageSource{
+				URL: source.URL + "/image.img.gz",
+			},
+		},
+	}
+
+	objectKey := client.ObjectKeyFromObject(&linodeImage)
+
+	var reconciler LinodeImageReconciler
+	var imageScope scope.ImageScope
+
+	BeforeAll(func(ctx SpecContext) {
+		imageScope.Client = k8sClient
+		Expect(k8sClient.Create(ctx, &linodeImage)).To(Succeed())
+	})
+
+	AfterAll(func() {
+		source.Close()
+	})
+
+	suite.BeforeEach(func(ctx context.Context, mck Mock) {
+		imageScope.LinodeClient = mck.LinodeClient
+
+		Expect(k8sClient.Get(ctx, objectKey, &linodeImage)).To(Succeed())
+		imageScope.LinodeImage = &linodeImage
+
+		// Create patch helper with latest state of resource.
+		// This is only needed when relying on envtest's k8sClient.
+		patchHelper, err := patch.NewHelper(&linodeImage, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		imageScope.PatchHelper = patchHelper
+
+		// Reset reconciler for each test
+		reconciler = LinodeImageReconciler{
+			Recorder: mck.Recorder(),
+		}
+	})
+
+	suite.Run(
+		OneOf(
+			Path(
+				Call("unable to create", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().CreateImageUpload(ctx, gomock.Any()).Return(nil, "", errors.New("server error"))
+				}),
+				OneOf(
+					Path(Result("create requeues", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultImageControllerReconcileDelay))
+						Expect(mck.Logs()).To(ContainSubstring("re-queuing Image creation"))
+					})),
+					Path(Result("timeout error", func(ctx context.Context, mck Mock) {
+						reconciler.ReconcileTimeout = time.Nanosecond
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+						Expect(err).To(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(mck.Events()).To(ContainSubstring("server error"))
+					})),
+				),
+			),
+			Path(
+				Call("able to create", func(ctx context.Context, mck Mock) {
+					createImage := mck.LinodeClient.EXPECT().CreateImageUpload(ctx, gomock.Any()).Return(&linodego.Image{
+						ID:     "private/1",
+						Status: linodego.ImageStatusPendingUpload,
+					}, "https://upload.example.com", nil)
+					mck.LinodeClient.EXPECT().UploadImageToURL(gomock.Any(), "https://upload.example.com", gomock.Any()).After(createImage).Return(nil)
+					mck.LinodeClient.EXPECT().GetImage(ctx, "private/1").After(createImage).Return(&linodego.Image{
+						ID:     "private/1",
+						Status: linodego.ImageStatusPendingUpload,
+					}, nil).Times(2)
+				}),
+				Result("uploads the image in the background", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultImageControllerReconcileDelay))
+					Expect(mck.Logs()).To(ContainSubstring("uploading image"))
+
+					Eventually(func() bool {
+						upload, ok := reconciler.uploads.get("private/1")
+						if !ok {
+							return true
+						}
+						finished, _ := upload.finished()
+						return finished
+					}).Should(BeTrue())
+
+					res, err = reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultImageControllerReconcileDelay))
+					Expect(mck.Events()).To(ContainSubstring("Uploaded Image private/1"))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeImage)).To(Succeed())
+					Expect(*linodeImage.Status.ImageID).To(Equal("private/1"))
+					Expect(linodeImage.Status.Ready).To(BeFalse())
+				}),
+			),
+		),
+		OneOf(
+			Path(
+				Call("image is not replicated", func(ctx context.Context, mck Mock) {
+					getImage := mck.LinodeClient.EXPECT().GetImage(ctx, "private/1").Return(&linodego.Image{
+						ID:      "private/1",
+						Status:  linodego.ImageStatusAvailable,
+						Regions: []linodego.ImageRegion{{Region: "us-ord", Status: linodego.ImageRegionStatusAvailable}},
+					}, nil)
+					mck.LinodeClient.EXPECT().ReplicateImage(ctx, "private/1", linodego.ImageReplicateOptions{
+						Regions: []string{"us-ord", "us-sea"},
+					}).After(getImage).Return(&linodego.Image{}, nil)
+				}),
+				Result("replicate requeues", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultImageControllerReconcileDelay))
+					Expect(mck.Events()).To(ContainSubstring("Replicating Image private/1 to regions [us-ord us-sea]"))
+				}),
+			),
+			Path(
+				Call("image is replicating", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetImage(ctx, "private/1").Return(&linodego.Image{
+						ID:     "private/1",
+						Status: linodego.ImageStatusAvailable,
+						Regions: []linodego.ImageRegion{
+							{Region: "us-ord", Status: linodego.ImageRegionStatusAvailable},
+							{Region: "us-sea", Status: linodego.ImageRegionStatusReplicating},
+						},
+					}, nil)
+				}),
+				Result("waits for replication", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultImageControllerReconcileDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Image is not yet available in every region"))
+				}),
+			),
+			Path(
+				Call("image is available", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetImage(ctx, "private/1").Return(&linodego.Image{
+						ID:     "private/1",
+						Status: linodego.ImageStatusAvailable,
+						Size:   2048,
+						Regions: []linodego.ImageRegion{
+							{Region: "us-ord", Status: linodego.ImageRegionStatusAvailable},
+							{Region: "us-sea", Status: linodego.ImageRegionStatusAvailable},
+						},
+					}, nil)
+				}),
+				Result("ready", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeImage)).To(Succeed())
+					Expect(linodeImage.Status.Ready).To(BeTrue())
+					Expect(linodeImage.Status.Size).To(Equal(2048))
+					Expect(linodeImage.Status.Regions).To(HaveLen(2))
+				}),
+			),
+		),
+		Once("delete", func(ctx context.Context, _ Mock) {
+			Expect(k8sClient.Delete(ctx, &linodeImage)).To(Succeed())
+			Expect(k8sClient.Get(ctx, objectKey, &linodeImage)).To(Succeed())
+		}),
+		OneOf(
+			Path(
+				Call("unable to delete", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().DeleteImage(ctx, "private/1").Return(errors.New("server error"))
+				}),
+				OneOf(
+					Path(Result("deletes are requeued", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultImageControllerReconcileDelay))
+						Expect(mck.Logs()).To(ContainSubstring("Failed to delete Image via API"))
+					})),
+					Path(Result("timeout error", func(ctx context.Context, mck Mock) {
+						reconciler.ReconcileTimeout = time.Nanosecond
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+						Expect(err).To(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(mck.Events()).To(ContainSubstring("server error"))
+					})),
+				),
+			),
+			Path(
+				Call("able to delete", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().DeleteImage(ctx, "private/1").Return(nil)
+				}),
+				Result("delete success", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &imageScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+					Expect(apierrors.IsNotFound(k8sClient.Get(ctx, objectKey, &linodeImage))).To(BeTrue())
+				}),
+			),
+		),
+	)
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// imageUploads runs the uploads of raw disk images in the background, as they can take much longer than a reconcile.
+// Uploads are tracked by Image ID until their result is collected, and are canceled when the manager stops. The zero
+// value is ready to use, uploads started before Start is called run until their timeout.
+type imageUploads struct {
+	mu sync.Mutex
+	// ctx is done when the manager stops.
+	ctx     context.Context
+	uploads map[string]*imageUpload
+}
+
+// imageUpload is the status of the upload of an Image.
+type imageUpload struct {
+	cancel context.CancelFunc
+	// transferred is the number of bytes uploaded so far.
+	transferred atomic.Int64
+	// done is closed once the upload finished, err is only set afterwards.
+	done chan struct{}
+	err  error
+}
+
+// finished returns whether the upload finished, and its error.
+func (u *imageUpload) finished() (bool, error) {
+	select {
+	case <-u.done:
+		return true, u.err
+	default:
+		return false, nil
+	}
+}
+
+// start runs the upload of an Image in the background, unless it is already tracked.
+func (t *imageUploads) start(imageID string, timeout time.Duration, upload func(ctx context.Context, transferred *atomic.Int64) error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.uploads[imageID]; ok {
+		return
+	}
+	if t.uploads == nil {
+		t.uploads = make(map[string]*imageUpload)
+	}
+	baseCtx := t.ctx
+	if baseCtx == nil {
+		baseCtx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(baseCtx, timeout)
+	tracked := &imageUpload{cancel: cancel, done: make(chan struct{})}
+	t.uploads[imageID] = tracked
+
+	go func() {
+		defer cancel()
+		tracked.err = upload(ctx, &tracked.transferred)
+		close(tracked.done)
+	}()
+}
+
+// get returns the upload of an Image, if it is tracked.
+func (t *imageUploads) get(imageID string) (*imageUpload, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	upload, ok := t.uploads[imageID]
+
+	return upload, ok
+}
+
+// forget cancels the upload of an Image if it is still running, and stops tracking it.
+func (t *imageUploads) forget(imageID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if upload, ok := t.uploads[imageID]; ok {
+		upload.cancel()
+		delete(t.uploads, imageID)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, only the leader reconciles LinodeImages.
+func (t *imageUploads) NeedLeaderElection() bool {
+	return true
+}
+
+// Start cancels the running uploads once the context is done.
+func (t *imageUploads) Start(ctx context.Context) error {
+	t.mu.Lock()
+	t.ctx = ctx
+	t.mu.Unlock()
+
+	<-ctx.Done()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, upload := range t.uploads {
+		upload.cancel()
+	}
+
+	return nil
+}
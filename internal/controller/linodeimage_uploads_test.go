@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForImageUpload(t *testing.T, uploads *imageUploads, imageID string) error {
+	t.Helper()
+
+	upload, ok := uploads.get(imageID)
+	require.True(t, ok)
+	var err error
+	require.Eventually(t, func() bool {
+		var finished bool
+		finished, err = upload.finished()
+		return finished
+	}, time.Second, time.Millisecond)
+
+	return err
+}
+
+func TestImageUploads(t *testing.T) {
+	t.Parallel()
+
+	t.Run("upload succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		var uploads imageUploads
+		uploads.start("private/1", time.Minute, func(_ context.Context, transferred *atomic.Int64) error {
+			transferred.Add(5)
+			return nil
+		})
+		require.NoError(t, waitForImageUpload(t, &uploads, "private/1"))
+
+		upload, _ := uploads.get("private/1")
+		assert.Equal(t, int64(5), upload.transferred.Load())
+		uploads.forget("private/1")
+		_, ok := uploads.get("private/1")
+		assert.False(t, ok)
+	})
+
+	t.Run("upload fails", func(t *testing.T) {
+		t.Parallel()
+
+		var uploads imageUploads
+		uploads.start("private/1", time.Minute, func(context.Context, *atomic.Int64) error {
+			return errors.New("connection reset")
+		})
+		require.EqualError(t, waitForImageUpload(t, &uploads, "private/1"), "connection reset")
+	})
+
+	t.Run("upload is only started once", func(t *testing.T) {
+		t.Parallel()
+
+		var uploads imageUploads
+		started := make(chan struct{})
+		uploads.start("private/1", time.Minute, func(ctx context.Context, _ *atomic.Int64) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		<-started
+		uploads.start("private/1", time.Minute, func(context.Context, *atomic.Int64) error {
+			t.Error("upload started twice")
+			return nil
+		})
+		upload, _ := uploads.get("private/1")
+		finished, _ := upload.finished()
+		assert.False(t, finished)
+
+		uploads.forget("private/1")
+		require.Eventually(t, func() bool {
+			finished, err := upload.finished()
+			return finished && errors.Is(err, context.Canceled)
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("uploads are canceled when the manager stops", func(t *testing.T) {
+		t.Parallel()
+
+		var uploads imageUploads
+		ctx, cancel := context.WithCancel(t.Context())
+		stopped := make(chan error)
+		go func() { stopped <- uploads.Start(ctx) }()
+		require.Eventually(t, func() bool {
+			uploads.mu.Lock()
+			defer uploads.mu.Unlock()
+			return uploads.ctx != nil
+		}, time.Second, time.Millisecond)
+
+		uploads.start("private/1", time.Minute, func(ctx context.Context, _ *atomic.Int64) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		cancel()
+		require.NoError(t, <-stopped)
+		assert.ErrorIs(t, waitForImageUpload(t, &uploads, "private/1"), context.Canceled)
+	})
+}
@@ -0,0 +1,481 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	kutil "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/paused"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
+	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+const (
+	// InstanceSnapshotExpiredReason is set on the Ready condition once the snapshot can no longer be restored from.
+	// Linode only keeps the most recent manual snapshot of an instance.
+	InstanceSnapshotExpiredReason = "Expired"
+)
+
+// LinodeInstanceSnapshotReconciler reconciles a LinodeInstanceSnapshot object
+type LinodeInstanceSnapshotReconciler struct {
+	client.Client
+	Recorder           events.EventRecorder
+	LinodeClientConfig scope.ClientConfig
+	WatchFilterValue   string
+	ReconcileTimeout   time.Duration
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeinstancesnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeinstancesnapshots/status,verbs=get;update;patch
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the LinodeInstanceSnapshot closer to the desired state.
+func (r *LinodeInstanceSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx).WithName("LinodeInstanceSnapshotReconciler").WithValues("name", req.String())
+
+	linodeInstanceSnapshot := &infrav1alpha2.LinodeInstanceSnapshot{}
+	if err := r.TracedClient().Get(ctx, req.NamespacedName, linodeInstanceSnapshot); err != nil {
+		if err = client.IgnoreNotFound(err); err != nil {
+			log.Error(err, "Failed to fetch LinodeInstanceSnapshot")
+		}
+
+		return ctrl.Result{}, err
+	}
+	// Snapshots can't be deleted through the Linode API, there is nothing to clean up.
+	if !linodeInstanceSnapshot.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	var cluster *clusterv1.Cluster
+	var err error
+	if _, ok := linodeInstanceSnapshot.Labels[clusterv1.ClusterNameLabel]; ok {
+		cluster, err = kutil.GetClusterFromMetadata(ctx, r.TracedClient(), linodeInstanceSnapshot.ObjectMeta)
+		if err != nil {
+			log.Error(err, "failed to fetch cluster from metadata")
+			return ctrl.Result{}, err
+		}
+
+		// Set ownerRef to LinodeCluster
+		if err := util.SetOwnerReferenceToLinodeCluster(ctx, r.TracedClient(), cluster, linodeInstanceSnapshot, r.Scheme()); err != nil {
+			log.Error(err, "Failed to set owner reference to LinodeCluster")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The LinodeMachine may be gone once the snapshot was taken, its instance ID is kept in the status.
+	linodeMachine := &infrav1alpha2.LinodeMachine{}
+	machineRef := linodeInstanceSnapshot.Spec.LinodeMachineRef
+	machineKey := types.NamespacedName{Namespace: machineRef.Namespace, Name: machineRef.Name}
+	if machineKey.Namespace == "" {
+		machineKey.Namespace = linodeInstanceSnapshot.Namespace
+	}
+	if err := r.TracedClient().Get(ctx, machineKey, linodeMachine); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to fetch LinodeMachine")
+			return ctrl.Result{}, err
+		}
+		linodeMachine = nil
+	}
+
+	instanceSnapshotScope, err := scope.NewInstanceSnapshotScope(
+		ctx,
+		r.LinodeClientConfig,
+		scope.InstanceSnapshotScopeParams{
+			Client:                 r.TracedClient(),
+			LinodeInstanceSnapshot: linodeInstanceSnapshot,
+			LinodeMachine:          linodeMachine,
+			Cluster:                cluster,
+		},
+	)
+	if err != nil {
+		log.Error(err, "Failed to create InstanceSnapshot scope")
+
+		return ctrl.Result{}, fmt.Errorf("failed to create InstanceSnapshot scope: %w", err)
+	}
+
+	isPaused, _, err := paused.EnsurePausedCondition(ctx, instanceSnapshotScope.Client, instanceSnapshotScope.Cluster, instanceSnapshotScope.LinodeInstanceSnapshot)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if isPaused {
+		log.Info("linodeinstancesnapshot or linked cluster is paused, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, instanceSnapshotScope)
+}
+
+func (r *LinodeInstanceSnapshotReconciler) reconcile(
+	ctx context.Context,
+	logger logr.Logger,
+	instanceSnapshotScope *scope.InstanceSnapshotScope,
+) (res ctrl.Result, err error) {
+	res = ctrl.Result{}
+
+	// Failed snapshots are not retried, a new LinodeInstanceSnapshot has to be created instead.
+	if instanceSnapshotFailed(instanceSnapshotScope.LinodeInstanceSnapshot) {
+		return res, nil
+	}
+
+	instanceSnapshotScope.LinodeInstanceSnapshot.Status.Ready = false
+	instanceSnapshotScope.LinodeInstanceSnapshot.Status.FailureReason = nil
+	instanceSnapshotScope.LinodeInstanceSnapshot.Status.FailureMessage = util.Pointer("")
+
+	failureReason := infrav1alpha2.LinodeInstanceSnapshotStatusError("UnknownError")
+	//nolint:dupl // Code duplication is simplicity in this case.
+	defer func() {
+		if err != nil {
+			instanceSnapshotScope.LinodeInstanceSnapshot.Status.FailureReason = util.Pointer(failureReason)
+			instanceSnapshotScope.LinodeInstanceSnapshot.Status.FailureMessage = util.Pointer(err.Error())
+
+			instanceSnapshotScope.LinodeInstanceSnapshot.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(failureReason),
+				Message: err.Error(),
+			})
+
+			r.Recorder.Eventf(
+				instanceSnapshotScope.LinodeInstanceSnapshot,
+				nil,
+				corev1.EventTypeWarning,
+				string(failureReason),
+				"Reconcile",
+				err.Error(),
+			)
+		}
+
+		// Always close the scope when exiting this function so we can persist any LinodeInstanceSnapshot changes.
+		if patchErr := instanceSnapshotScope.Close(ctx); patchErr != nil && utilerrors.FilterOut(util.UnwrapError(patchErr), apierrors.IsNotFound) != nil {
+			logger.Error(patchErr, "failed to patch LinodeInstanceSnapshot")
+
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	// Override the controller credentials with ones from the snapshot's or LinodeMachine's Secret reference (if supplied).
+	if err := instanceSnapshotScope.SetCredentialRefTokenForLinodeClients(ctx); err != nil {
+		logger.Error(err, "failed to update linode client token from Credential Ref")
+		return res, err
+	}
+
+	// Create
+	if instanceSnapshotScope.LinodeInstanceSnapshot.Status.BackupID == nil {
+		failureReason = infrav1alpha2.CreateInstanceSnapshotError
+
+		err = r.reconcileCreate(ctx, logger, instanceSnapshotScope)
+		if err != nil && !reconciler.HasStaleCondition(instanceSnapshotScope.LinodeInstanceSnapshot.GetCondition(string(clusterv1.ReadyCondition)),
+			reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultInstanceSnapshotControllerReconcileTimeout)) {
+			logger.Info("re-queuing instance snapshot creation")
+
+			res = ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultInstanceSnapshotControllerReconcileDelay)}
+			err = nil
+		}
+
+		return
+	}
+
+	// Update
+	failureReason = infrav1alpha2.UpdateInstanceSnapshotError
+
+	logger = logger.WithValues("backupID", *instanceSnapshotScope.LinodeInstanceSnapshot.Status.BackupID)
+
+	res, err = r.reconcileUpdate(ctx, logger, instanceSnapshotScope)
+	if err != nil && !reconciler.HasStaleCondition(instanceSnapshotScope.LinodeInstanceSnapshot.GetCondition(string(clusterv1.ReadyCondition)),
+		reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultInstanceSnapshotControllerReconcileTimeout)) {
+		logger.Info("re-queuing instance snapshot update")
+
+		res = ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultInstanceSnapshotControllerReconcileDelay)}
+		err = nil
+	}
+
+	return
+}
+
+func (r *LinodeInstanceSnapshotReconciler) reconcileCreate(ctx context.Context, logger logr.Logger, instanceSnapshotScope *scope.InstanceSnapshotScope) error {
+	linodeInstanceSnapshot := instanceSnapshotScope.LinodeInstanceSnapshot
+	linodeMachine := instanceSnapshotScope.LinodeMachine
+
+	var err error
+	switch {
+	case linodeMachine == nil:
+		err = fmt.Errorf("linodemachine %s not found", linodeInstanceSnapshot.Spec.LinodeMachineRef.Name)
+	case linodeMachine.Spec.ProviderID == nil:
+		err = fmt.Errorf("linodemachine %s has no instance yet", linodeMachine.Name)
+	}
+	if err != nil {
+		logger.Info("Unable to snapshot LinodeMachine", "error", err.Error())
+		linodeInstanceSnapshot.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.CreateInstanceSnapshotError),
+			Message: err.Error(),
+		})
+
+		return err
+	}
+
+	instanceID, err := util.GetInstanceID(linodeMachine.Spec.ProviderID)
+	if err != nil {
+		logger.Error(err, "Failed to parse instance ID from provider ID")
+		return err
+	}
+
+	label := linodeInstanceSnapshot.Spec.Label
+	if label == "" {
+		label = linodeInstanceSnapshot.Name
+	}
+
+	logger.Info("creating instance snapshot", "instanceID", instanceID)
+
+	snapshot, err := instanceSnapshotScope.LinodeClient.CreateInstanceSnapshot(ctx, instanceID, linodego.InstanceSnapshotCreateOptions{Label: label})
+	if err != nil {
+		logger.Error(err, "Failed to create instance snapshot")
+		linodeInstanceSnapshot.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.CreateInstanceSnapshotError),
+			Message: err.Error(),
+		})
+		r.Recorder.Eventf(
+			linodeInstanceSnapshot,
+			nil,
+			corev1.EventTypeWarning,
+			string(infrav1alpha2.CreateInstanceSnapshotError),
+			"CreateInstanceSnapshot",
+			err.Error(),
+		)
+
+		return err
+	}
+	if snapshot == nil {
+		return errors.New("created instance snapshot was nil")
+	}
+
+	linodeInstanceSnapshot.Status.BackupID = util.Pointer(snapshot.ID)
+	linodeInstanceSnapshot.Status.InstanceID = util.Pointer(instanceID)
+	linodeInstanceSnapshot.Status.Region = linodeMachine.Spec.Region
+	linodeInstanceSnapshot.Status.Status = string(snapshot.Status)
+	linodeInstanceSnapshot.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionFalse,
+		Reason: string(snapshot.Status), // We have to set the reason to not fail object patching
+	})
+	r.Recorder.Eventf(
+		linodeInstanceSnapshot,
+		linodeMachine,
+		corev1.EventTypeNormal,
+		"Created",
+		"CreateInstanceSnapshot",
+		"Created snapshot %d of instance %d",
+		snapshot.ID,
+		instanceID,
+	)
+
+	return nil
+}
+
+func (r *LinodeInstanceSnapshotReconciler) reconcileUpdate(ctx context.Context, logger logr.Logger, instanceSnapshotScope *scope.InstanceSnapshotScope) (ctrl.Result, error) {
+	linodeInstanceSnapshot := instanceSnapshotScope.LinodeInstanceSnapshot
+	backupID := *linodeInstanceSnapshot.Status.BackupID
+	instanceID := *linodeInstanceSnapshot.Status.InstanceID
+
+	backups, err := instanceSnapshotScope.LinodeClient.GetInstanceBackups(ctx, instanceID)
+	if err != nil {
+		if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+			r.expireInstanceSnapshot(linodeInstanceSnapshot, fmt.Sprintf("instance %d no longer exists", instanceID))
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to fetch instance backups")
+		linodeInstanceSnapshot.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.UpdateInstanceSnapshotError),
+			Message: err.Error(),
+		})
+
+		return ctrl.Result{}, err
+	}
+
+	snapshot := findInstanceSnapshot(backups, backupID)
+	if snapshot == nil {
+		r.expireInstanceSnapshot(linodeInstanceSnapshot, fmt.Sprintf("snapshot was replaced by a newer snapshot of instance %d", instanceID))
+		return ctrl.Result{}, nil
+	}
+
+	linodeInstanceSnapshot.Status.Status = string(snapshot.Status)
+	linodeInstanceSnapshot.Status.Size = 0
+	for _, disk := range snapshot.Disks {
+		linodeInstanceSnapshot.Status.Size += disk.Size
+	}
+	if snapshot.Finished != nil {
+		linodeInstanceSnapshot.Status.Finished = &metav1.Time{Time: *snapshot.Finished}
+	}
+
+	switch snapshot.Status {
+	case linodego.SnapshotSuccessful:
+	case linodego.SnapshotFailed, linodego.SnapshotUserAborted:
+		// The snapshot can't recover from this, record it as a terminal failure without retrying.
+		message := fmt.Sprintf("snapshot %d of instance %d is %s", backupID, instanceID, snapshot.Status)
+		logger.Info("Instance snapshot did not complete", "status", snapshot.Status)
+		linodeInstanceSnapshot.Status.FailureReason = util.Pointer(infrav1alpha2.CreateInstanceSnapshotError)
+		linodeInstanceSnapshot.Status.FailureMessage = util.Pointer(message)
+		linodeInstanceSnapshot.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(snapshot.Status), // We have to set the reason to not fail object patching
+			Message: message,
+		})
+		r.Recorder.Eventf(linodeInstanceSnapshot, nil, corev1.EventTypeWarning, string(infrav1alpha2.CreateInstanceSnapshotError), "CreateInstanceSnapshot", message)
+
+		return ctrl.Result{}, nil
+	default:
+		logger.Info("Instance snapshot is not yet complete", "status", snapshot.Status)
+		linodeInstanceSnapshot.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: string(snapshot.Status), // We have to set the reason to not fail object patching
+		})
+
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultInstanceSnapshotControllerReconcileDelay)}, nil
+	}
+
+	if cond := linodeInstanceSnapshot.GetCondition(string(clusterv1.ReadyCondition)); cond == nil || cond.Status != metav1.ConditionTrue {
+		r.Recorder.Eventf(
+			linodeInstanceSnapshot,
+			nil,
+			corev1.EventTypeNormal,
+			"Completed",
+			"CreateInstanceSnapshot",
+			"Snapshot %d of instance %d completed",
+			backupID,
+			instanceID,
+		)
+	}
+	linodeInstanceSnapshot.Status.Ready = true
+	linodeInstanceSnapshot.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: "LinodeInstanceSnapshotReady", // We have to set the reason to not fail object patching
+	})
+
+	// Keep checking that the snapshot was not replaced by a newer one.
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultInstanceSnapshotControllerRefreshDelay)}, nil
+}
+
+// expireInstanceSnapshot marks a snapshot that can no longer be restored from.
+func (r *LinodeInstanceSnapshotReconciler) expireInstanceSnapshot(linodeInstanceSnapshot *infrav1alpha2.LinodeInstanceSnapshot, message string) {
+	if cond := linodeInstanceSnapshot.GetCondition(string(clusterv1.ReadyCondition)); cond == nil || cond.Reason != InstanceSnapshotExpiredReason {
+		r.Recorder.Eventf(linodeInstanceSnapshot, nil, corev1.EventTypeNormal, InstanceSnapshotExpiredReason, "ExpireInstanceSnapshot", message)
+	}
+	linodeInstanceSnapshot.SetCondition(metav1.Condition{
+		Type:    clusterv1.ReadyCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  InstanceSnapshotExpiredReason,
+		Message: message,
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LinodeInstanceSnapshotReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
+	linodeInstanceSnapshotMapper, err := kutil.ClusterToTypedObjectsMapper(
+		r.TracedClient(),
+		&infrav1alpha2.LinodeInstanceSnapshotList{},
+		mgr.GetScheme(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create mapper for LinodeInstanceSnapshots: %w", err)
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.LinodeInstanceSnapshot{}).
+		WithOptions(options).
+		WithEventFilter(predicate.And(
+			predicates.ResourceHasFilterLabel(mgr.GetScheme(), mgr.GetLogger(), r.WatchFilterValue),
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			),
+		)).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(linodeInstanceSnapshotMapper),
+			builder.WithPredicates(predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), mgr.GetLogger())),
+		).Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+	if err != nil {
+		return fmt.Errorf("failed to build controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LinodeInstanceSnapshotReconciler) TracedClient() client.Client {
+	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
+}
+
+// instanceSnapshotFailed returns true if the snapshot did not complete as reported by the Linode API.
+func instanceSnapshotFailed(linodeInstanceSnapshot *infrav1alpha2.LinodeInstanceSnapshot) bool {
+	status := linodego.InstanceSnapshotStatus(linodeInstanceSnapshot.Status.Status)
+
+	return status == linodego.SnapshotFailed || status == linodego.SnapshotUserAborted
+}
+
+// findInstanceSnapshot returns the manual snapshot with the given backup ID, or nil if it was replaced.
+func findInstanceSnapshot(backups *linodego.InstanceBackupsResponse, backupID int) *linodego.InstanceSnapshot {
+	if backups == nil || backups.Snapshot == nil {
+		return nil
+	}
+	for _, snapshot := range []*linodego.InstanceSnapshot{backups.Snapshot.InProgress, backups.Snapshot.Current} {
+		if snapshot != nil && snapshot.ID == backupID {
+			return snapshot
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+	"github.com/linode/cluster-api-provider-linode/util"
+	rec "github.com/linode/cluster-api-provider-linode/util/reconciler"
+
+	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lifecycle", Ordered, Label("instancesnapshot", "lifecycle"), func() {
+	suite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{})
+
+	linodeMachine := infrav1alpha2.LinodeMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "snapshot-lifecycle",
+			Namespace: "default",
+		},
+		Spec: infrav1alpha2.LinodeMachineSpec{
+			ProviderID: util.Pointer("linode://123"),
+			Region:     "us-ord",
+			Type:       "g6-standard-2",
+		},
+	}
+
+	linodeInstanceSnapshot := infrav1alpha2.LinodeInstanceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "lifecycle",
+			Namespace: "default",
+		},
+		Spec: infrav1alpha2.LinodeInstanceSnapshotSpec{
+			LinodeMachineRef: corev1.ObjectReference{Name: "snapshot-lifecycle"},
+		},
+	}
+
+	objectKey := client.ObjectKeyFromObject(&linodeInstanceSnapshot)
+
+	var reconciler LinodeInstanceSnapshotReconciler
+	var instanceSnapshotScope scope.InstanceSnapshotScope
+
+	BeforeAll(func(ctx SpecContext) {
+		instanceSnapshotScope.Client = k8sClient
+		instanceSnapshotScope.LinodeMachine = &linodeMachine
+		Expect(k8sClient.Create(ctx, &linodeMachine)).To(Succeed())
+		Expect(k8sClient.Create(ctx, &linodeInstanceSnapshot)).To(Succeed())
+	})
+
+	suite.BeforeEach(func(ctx context.Context, mck Mock) {
+		instanceSnapshotScope.LinodeClient = mck.LinodeClient
+
+		Expect(k8sClient.Get(ctx, objectKey, &linodeInstanceSnapshot)).To(Succeed())
+		instanceSnapshotScope.LinodeInstanceSnapshot = &linodeInstanceSnapshot
+
+		// Create patch helper with latest state of resource.
+		// This is only needed when relying on envtest's k8sClient.
+		patchHelper, err := patch.NewHelper(&linodeInstanceSnapshot, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		instanceSnapshotScope.PatchHelper = patchHelper
+
+		// Reset reconciler for each test
+		reconciler = LinodeInstanceSnapshotReconciler{
+			Recorder: mck.Recorder(),
+		}
+	})
+
+	suite.Run(
+		OneOf(
+			Path(
+				Call("unable to create", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().CreateInstanceSnapshot(ctx, 123, gomock.Any()).Return(nil, errors.New("backups are not enabled"))
+				}),
+				OneOf(
+					Path(Result("create requeues", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &instanceSnapshotScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultInstanceSnapshotControllerReconcileDelay))
+						Expect(mck.Logs()).To(ContainSubstring("re-queuing instance snapshot creation"))
+					})),
+					Path(Result("timeout error", func(ctx context.Context, mck Mock) {
+						reconciler.ReconcileTimeout = time.Nanosecond
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &instanceSnapshotScope)
+						Expect(err).To(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(mck.Events()).To(ContainSubstring("backups are not enabled"))
+					})),
+				),
+			),
+			Path(
+				Call("able to create", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().CreateInstanceSnapshot(ctx, 123, linodego.InstanceSnapshotCreateOptions{Label: "lifecycle"}).Return(&linodego.InstanceSnapshot{
+						ID:     1,
+						Status: linodego.SnapshotPending,
+					}, nil)
+				}),
+				Result("snapshot is created", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &instanceSnapshotScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+					Expect(mck.Events()).To(ContainSubstring("Created snapshot 1 of instance 123"))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeInstanceSnapshot)).To(Succeed())
+					Expect(*linodeInstanceSnapshot.Status.BackupID).To(Equal(1))
+					Expect(*linodeInstanceSnapshot.Status.InstanceID).To(Equal(123))
+					Expect(linodeInstanceSnapshot.Status.Region).To(Equal("us-ord"))
+					Expect(linodeInstanceSnapshot.Status.Ready).To(BeFalse())
+				}),
+			),
+		),
+		OneOf(
+			Path(
+				Call("snapshot is in progress", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetInstanceBackups(ctx, 123).Return(&linodego.InstanceBackupsResponse{
+						Snapshot: &linodego.InstanceBackupSnapshotResponse{
+							InProgress: &linodego.InstanceSnapshot{ID: 1, Status: linodego.SnapshotRunning},
+						},
+					}, nil)
+				}),
+				Result("waits for the snapshot to complete", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &instanceSnapshotScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultInstanceSnapshotControllerReconcileDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Instance snapshot is not yet complete"))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeInstanceSnapshot)).To(Succeed())
+					Expect(linodeInstanceSnapshot.Status.Status).To(Equal("running"))
+					Expect(linodeInstanceSnapshot.Status.Ready).To(BeFalse())
+				}),
+			),
+			Path(
+				Call("snapshot is successful", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetInstanceBackups(ctx, 123).Return(&linodego.InstanceBackupsResponse{
+						Snapshot: &linodego.InstanceBackupSnapshotResponse{
+							Current: &linodego.InstanceSnapshot{
+								ID:       1,
+								Status:   linodego.SnapshotSuccessful,
+								Finished: util.Pointer(time.Now()),
+								Disks: []linodego.InstanceSnapshotDisk{
+									{Label: "root", Size: 79872},
+									{Label: "swap", Size: 512},
+								},
+							},
+						},
+					}, nil)
+				}),
+				Result("snapshot is ready", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &instanceSnapshotScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultInstanceSnapshotControllerRefreshDelay))
+					Expect(mck.Events()).To(ContainSubstring("Snapshot 1 of instance 123 completed"))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeInstanceSnapshot)).To(Succeed())
+					Expect(linodeInstanceSnapshot.Status.Ready).To(BeTrue())
+					Expect(linodeInstanceSnapshot.Status.Size).To(Equal(80384))
+					Expect(linodeInstanceSnapshot.Status.Finished).NotTo(BeNil())
+				}),
+			),
+		),
+		Path(
+			Call("snapshot is replaced", func(ctx context.Context, mck Mock) {
+				mck.LinodeClient.EXPECT().GetInstanceBackups(ctx, 123).Return(&linodego.InstanceBackupsResponse{
+					Snapshot: &linodego.InstanceBackupSnapshotResponse{
+						Current: &linodego.InstanceSnapshot{ID: 2, Status: linodego.SnapshotSuccessful},
+					},
+				}, nil)
+			}),
+			Result("snapshot is expired", func(ctx context.Context, mck Mock) {
+				res, err := reconciler.reconcile(ctx, mck.Logger(), &instanceSnapshotScope)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+				Expect(mck.Events()).To(ContainSubstring("snapshot was replaced by a newer snapshot of instance 123"))
+
+				Expect(k8sClient.Get(ctx, objectKey, &linodeInstanceSnapshot)).To(Succeed())
+				Expect(linodeInstanceSnapshot.Status.Ready).To(BeFalse())
+				Expect(linodeInstanceSnapshot.GetCondition(string(clusterv1.ReadyCondition)).Reason).To(Equal(InstanceSnapshotExpiredReason))
+			}),
+		),
+	)
+})
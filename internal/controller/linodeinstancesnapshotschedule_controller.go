@@ -0,0 +1,249 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
+	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+// LinodeInstanceSnapshotScheduleReconciler reconciles a LinodeInstanceSnapshotSchedule object
+type LinodeInstanceSnapshotScheduleReconciler struct {
+	client.Client
+	Recorder         events.EventRecorder
+	WatchFilterValue string
+	ReconcileTimeout time.Duration
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeinstancesnapshotschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeinstancesnapshotschedules/status,verbs=get;update;patch
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeinstancesnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the LinodeInstanceSnapshotSchedule closer to the desired state.
+func (r *LinodeInstanceSnapshotScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx).WithName("LinodeInstanceSnapshotScheduleReconciler").WithValues("name", req.String())
+
+	schedule := &infrav1alpha2.LinodeInstanceSnapshotSchedule{}
+	if err := r.TracedClient().Get(ctx, req.NamespacedName, schedule); err != nil {
+		if err = client.IgnoreNotFound(err); err != nil {
+			log.Error(err, "Failed to fetch LinodeInstanceSnapshotSchedule")
+		}
+
+		return ctrl.Result{}, err
+	}
+	// The LinodeInstanceSnapshots are garbage collected through their owner reference.
+	if !schedule.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(schedule, r.TracedClient())
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	return r.reconcile(ctx, log, schedule, patchHelper)
+}
+
+func (r *LinodeInstanceSnapshotScheduleReconciler) reconcile(
+	ctx context.Context,
+	logger logr.Logger,
+	schedule *infrav1alpha2.LinodeInstanceSnapshotSchedule,
+	patchHelper *patch.Helper,
+) (res ctrl.Result, err error) {
+	defer func() {
+		if patchErr := patchHelper.Patch(ctx, schedule); patchErr != nil && !apierrors.IsNotFound(patchErr) {
+			logger.Error(patchErr, "failed to patch LinodeInstanceSnapshotSchedule")
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	var snapshots infrav1alpha2.LinodeInstanceSnapshotList
+	if err := r.TracedClient().List(ctx, &snapshots,
+		client.InNamespace(schedule.Namespace),
+		client.MatchingLabels{infrav1alpha2.InstanceSnapshotScheduleLabel: schedule.Name},
+	); err != nil {
+		logger.Error(err, "Failed to list LinodeInstanceSnapshots")
+		return ctrl.Result{}, err
+	}
+	slices.SortFunc(snapshots.Items, func(a, b infrav1alpha2.LinodeInstanceSnapshot) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+
+	now := time.Now()
+	next := nextInstanceSnapshotTime(schedule, now)
+	switch {
+	case schedule.Spec.Suspend:
+		schedule.Status.NextSnapshotTime = nil
+		schedule.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: "Suspended", // We have to set the reason to not fail object patching
+		})
+	case now.Before(next):
+	case len(snapshots.Items) > 0 && instanceSnapshotInProgress(&snapshots.Items[len(snapshots.Items)-1]):
+		// Only one snapshot of an instance can be taken at a time.
+		logger.Info("Previous instance snapshot is still in progress", "snapshot", snapshots.Items[len(snapshots.Items)-1].Name)
+		next = now.Add(reconciler.DefaultInstanceSnapshotControllerReconcileDelay)
+	default:
+		snapshot, err := r.createInstanceSnapshot(ctx, schedule, now)
+		if err != nil {
+			logger.Error(err, "Failed to create LinodeInstanceSnapshot")
+			schedule.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  "CreateError", // We have to set the reason to not fail object patching
+				Message: err.Error(),
+			})
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(schedule, snapshot, corev1.EventTypeNormal, "Created", "CreateInstanceSnapshot",
+			"Created LinodeInstanceSnapshot %s", snapshot.Name)
+		snapshots.Items = append(snapshots.Items, *snapshot)
+		schedule.Status.LastSnapshotTime = &metav1.Time{Time: now}
+		schedule.Status.LastSnapshotName = snapshot.Name
+		next = now.Add(schedule.Spec.Interval.Duration)
+	}
+
+	for _, snapshot := range instanceSnapshotsToPrune(snapshots.Items, schedule.Spec.RetentionLimit) {
+		logger.Info("Deleting LinodeInstanceSnapshot past the retention limit", "snapshot", snapshot.Name)
+		if err := r.TracedClient().Delete(ctx, &snapshot); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to delete LinodeInstanceSnapshot")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if schedule.Spec.Suspend {
+		return ctrl.Result{}, nil
+	}
+
+	schedule.Status.NextSnapshotTime = &metav1.Time{Time: next}
+	schedule.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: "Scheduled", // We have to set the reason to not fail object patching
+	})
+
+	return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+}
+
+// createInstanceSnapshot creates a LinodeInstanceSnapshot owned by the schedule.
+func (r *LinodeInstanceSnapshotScheduleReconciler) createInstanceSnapshot(
+	ctx context.Context,
+	schedule *infrav1alpha2.LinodeInstanceSnapshotSchedule,
+	now time.Time,
+) (*infrav1alpha2.LinodeInstanceSnapshot, error) {
+	snapshot := &infrav1alpha2.LinodeInstanceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", schedule.Name, now.Unix()),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				infrav1alpha2.InstanceSnapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: infrav1alpha2.LinodeInstanceSnapshotSpec{
+			LinodeMachineRef: schedule.Spec.LinodeMachineRef,
+			CredentialsRef:   schedule.Spec.CredentialsRef,
+		},
+	}
+	if clusterName, ok := schedule.Labels[clusterv1.ClusterNameLabel]; ok {
+		snapshot.Labels[clusterv1.ClusterNameLabel] = clusterName
+	}
+	if err := controllerutil.SetControllerReference(schedule, snapshot, r.Scheme()); err != nil {
+		return nil, err
+	}
+	if err := r.TracedClient().Create(ctx, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// nextInstanceSnapshotTime returns when the next snapshot of the schedule is due.
+func nextInstanceSnapshotTime(schedule *infrav1alpha2.LinodeInstanceSnapshotSchedule, now time.Time) time.Time {
+	if schedule.Status.LastSnapshotTime == nil {
+		return now
+	}
+
+	return schedule.Status.LastSnapshotTime.Add(schedule.Spec.Interval.Duration)
+}
+
+// instanceSnapshotInProgress returns true if the snapshot was neither completed, failed, nor expired.
+func instanceSnapshotInProgress(snapshot *infrav1alpha2.LinodeInstanceSnapshot) bool {
+	if snapshot.Status.Ready || snapshot.Status.FailureReason != nil || instanceSnapshotFailed(snapshot) {
+		return false
+	}
+	cond := snapshot.GetCondition(string(clusterv1.ReadyCondition))
+
+	return cond == nil || cond.Reason != InstanceSnapshotExpiredReason
+}
+
+// instanceSnapshotsToPrune returns the oldest snapshots past the retention limit, snapshots must be sorted from
+// oldest to newest.
+func instanceSnapshotsToPrune(snapshots []infrav1alpha2.LinodeInstanceSnapshot, retentionLimit int32) []infrav1alpha2.LinodeInstanceSnapshot {
+	limit := max(int(retentionLimit), 1)
+	if len(snapshots) <= limit {
+		return nil
+	}
+
+	return snapshots[:len(snapshots)-limit]
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LinodeInstanceSnapshotScheduleReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.LinodeInstanceSnapshotSchedule{}).
+		Owns(&infrav1alpha2.LinodeInstanceSnapshot{}).
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), mgr.GetLogger(), r.WatchFilterValue)).
+		Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+	if err != nil {
+		return fmt.Errorf("failed to build controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LinodeInstanceSnapshotScheduleReconciler) TracedClient() client.Client {
+	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
+}
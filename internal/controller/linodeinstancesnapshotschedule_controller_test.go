@@ -0,0 +1,140 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/util"
+)
+
+func TestNextInstanceSnapshotTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name         string
+		lastSnapshot *metav1.Time
+		want         time.Time
+	}{
+		{
+			name: "no snapshot taken yet",
+			want: now,
+		},
+		{
+			name:         "snapshot taken",
+			lastSnapshot: &metav1.Time{Time: now.Add(-time.Hour)},
+			want:         now.Add(23 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule := &infrav1alpha2.LinodeInstanceSnapshotSchedule{
+				Spec:   infrav1alpha2.LinodeInstanceSnapshotScheduleSpec{Interval: metav1.Duration{Duration: 24 * time.Hour}},
+				Status: infrav1alpha2.LinodeInstanceSnapshotScheduleStatus{LastSnapshotTime: testcase.lastSnapshot},
+			}
+			assert.True(t, testcase.want.Equal(nextInstanceSnapshotTime(schedule, now)))
+		})
+	}
+}
+
+func TestInstanceSnapshotInProgress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		snapshot infrav1alpha2.LinodeInstanceSnapshot
+		want     bool
+	}{
+		{
+			name: "not reconciled yet",
+			want: true,
+		},
+		{
+			name: "running",
+			snapshot: infrav1alpha2.LinodeInstanceSnapshot{Status: infrav1alpha2.LinodeInstanceSnapshotStatus{
+				Conditions: []metav1.Condition{{Type: string(clusterv1.ReadyCondition), Status: metav1.ConditionFalse, Reason: "running"}},
+			}},
+			want: true,
+		},
+		{
+			name: "ready",
+			snapshot: infrav1alpha2.LinodeInstanceSnapshot{Status: infrav1alpha2.LinodeInstanceSnapshotStatus{
+				Ready: true,
+			}},
+		},
+		{
+			name: "failed",
+			snapshot: infrav1alpha2.LinodeInstanceSnapshot{Status: infrav1alpha2.LinodeInstanceSnapshotStatus{
+				FailureReason: util.Pointer(infrav1alpha2.CreateInstanceSnapshotError),
+			}},
+		},
+		{
+			name: "expired",
+			snapshot: infrav1alpha2.LinodeInstanceSnapshot{Status: infrav1alpha2.LinodeInstanceSnapshotStatus{
+				Conditions: []metav1.Condition{{Type: string(clusterv1.ReadyCondition), Status: metav1.ConditionFalse, Reason: InstanceSnapshotExpiredReason}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testcase.want, instanceSnapshotInProgress(&testcase.snapshot))
+		})
+	}
+}
+
+func TestInstanceSnapshotsToPrune(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []infrav1alpha2.LinodeInstanceSnapshot{
+		{ObjectMeta: metav1.ObjectMeta{Name: "oldest"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "older"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "newest"}},
+	}
+	tests := []struct {
+		name           string
+		retentionLimit int32
+		want           []string
+	}{
+		{name: "within limit", retentionLimit: 3},
+		{name: "above limit", retentionLimit: 2, want: []string{"oldest"}},
+		{name: "keeps at least one", retentionLimit: 0, want: []string{"oldest", "older"}},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, snapshot := range instanceSnapshotsToPrune(snapshots, testcase.retentionLimit) {
+				got = append(got, snapshot.Name)
+			}
+			assert.Equal(t, testcase.want, got)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	b64 "encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -26,6 +27,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"github.com/linode/linodego/v2"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -44,9 +46,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/feature"
 	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
 	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
 	"github.com/linode/cluster-api-provider-linode/util"
@@ -61,14 +66,90 @@ const (
 	ConditionPreflightBootstrapDataSecretReady  = "PreflightBootstrapDataSecretReady"
 	ConditionPreflightLinodeFirewallReady       = "PreflightLinodeFirewallReady"
 	ConditionPreflightMetadataSupportConfigured = "PreflightMetadataSupportConfigured"
+	ConditionPreflightCapacity                  = "PreflightCapacity"
 	ConditionPreflightCreated                   = "PreflightCreated"
 	ConditionPreflightAdditionalDisksCreated    = "PreflightAdditionalDisksCreated"
+	ConditionPreflightVolumesAttached           = "PreflightVolumesAttached"
 	ConditionPreflightConfigured                = "PreflightConfigured"
 	ConditionPreflightBootTriggered             = "PreflightBootTriggered"
 	ConditionPreflightReady                     = "PreflightReady"
 
+	// reasons for the PreflightCapacity condition
+	CapacityAvailableReason  = "CapacityAvailable"
+	PlanUnavailableReason    = "PlanUnavailable"
+	AccountUnavailableReason = "AccountUnavailable"
+	ImageUnavailableReason   = "ImageUnavailable"
+	// InstanceLimitReachedReason is used when the create call is refused because the account reached its limit of
+	// instances, which isn't reported by any availability endpoint.
+	InstanceLimitReachedReason = "InstanceLimitReached"
+
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+
+	// ConditionResized reports the progress of an in-place resize of the instance.
+	ConditionResized = "Resized"
+
+	// reasons for the Resized condition
+	ResizeShuttingDownReason = "ShuttingDown"
+	ResizingReason           = "Resizing"
+	ResizeBootingReason      = "Booting"
+	ResizeCompletedReason    = "ResizeCompleted"
+	ResizeFailedReason       = "ResizeFailed"
+
+	// ConditionRebuilt reports the progress of an in-place rebuild of the instance.
+	ConditionRebuilt = "Rebuilt"
+
+	// reasons for the Rebuilt condition
+	RebuildingReason         = "Rebuilding"
+	RebuildConfiguringReason = "Configuring"
+	RebuildBootingReason     = "Booting"
+	RebuildCompletedReason   = "RebuildCompleted"
+	RebuildFailedReason      = "RebuildFailed"
+
+	// ConditionAdopted reports the adoption of an existing instance.
+	ConditionAdopted = "Adopted"
+
+	// reasons for the Adopted condition
+	InstanceAdoptedReason = "InstanceAdopted"
+	AdoptionRefusedReason = "AdoptionRefused"
+
+	// ConditionInterfacesUpdated reports whether the network interfaces of the instance were updated to match the
+	// LinodeMachine, and the progress of their update.
+	ConditionInterfacesUpdated = "InterfacesUpdated"
+
+	// reasons for the InterfacesUpdated condition
+	InterfacesUpToDateReason     = "InterfacesUpToDate"
+	InterfacesShuttingDownReason = "ShuttingDown"
+	InterfacesBootingReason      = "Booting"
+	InterfacesUpdatedReason      = "InterfacesUpdated"
+	InterfacesUpdateFailedReason = "InterfacesUpdateFailed"
+
+	// ConditionInterfacesUpgraded reports the progress of the upgrade of the legacy configuration interfaces of the
+	// instance to Linode interfaces.
+	ConditionInterfacesUpgraded = "InterfacesUpgraded"
+
+	// reasons for the InterfacesUpgraded condition
+	InterfacesUpgradeShuttingDownReason = "ShuttingDown"
+	InterfacesUpgradeBootingReason      = "Booting"
+	InterfacesUpgradedReason            = "InterfacesUpgraded"
+	InterfacesUpgradeFailedReason       = "InterfacesUpgradeFailed"
+
+	// ConditionNodeBalancerDrained reports whether the NodeBalancer backends of the instance were drained before its
+	// deletion.
+	ConditionNodeBalancerDrained = "NodeBalancerDrained"
+
+	// reasons for the NodeBalancerDrained condition
+	NodeBalancerDrainingReason = "Draining"
+	NodeBalancerDrainedReason  = "NodeBalancerDrained"
+
+	// ConditionInstanceShutDown reports the progress of the shutdown of the instance before its deletion.
+	ConditionInstanceShutDown = "InstanceShutDown"
+
+	// reasons for the InstanceShutDown condition
+	InstanceShuttingDownReason     = "ShuttingDown"
+	InstanceShutDownReason         = "InstanceShutDown"
+	InstanceShutdownTimedOutReason = "ShutdownTimedOut"
+	InstanceShutdownFailedReason   = "ShutdownFailed"
 )
 
 // statuses to keep requeueing on while an instance is booting
@@ -91,6 +172,15 @@ type LinodeMachineReconciler struct {
 	LinodeClientConfig scope.ClientConfig
 	WatchFilterValue   string
 	ReconcileTimeout   time.Duration
+	EventPoller        *EventPoller
+	GarbageCollector   *GarbageCollector
+	// BootstrapDataEndpoint is nil unless the bootstrap data endpoint of the manager is enabled.
+	BootstrapDataEndpoint clients.BootstrapDataEndpoint
+	// ManagementClusterID identifies the management cluster in the owner tags of the instances.
+	ManagementClusterID string
+	// InstanceLimit is the maximum number of instances of the account, it is not checked before creating instances
+	// when zero.
+	InstanceLimit int
 	// Feature flags
 	GzipCompressionEnabled bool
 }
@@ -99,6 +189,7 @@ type LinodeMachineReconciler struct {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachines/finalizers,verbs=update
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodeclusters/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepoolmachines,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;watch;list
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;watch;list
@@ -157,6 +248,9 @@ func (r *LinodeMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			Machine:       machine,
 			LinodeCluster: linodeCluster,
 			LinodeMachine: linodeMachine,
+
+			BootstrapDataEndpoint: r.BootstrapDataEndpoint,
+			ManagementClusterID:   r.ManagementClusterID,
 		},
 	)
 	if err != nil {
@@ -229,6 +323,8 @@ func (r *LinodeMachineReconciler) reconcile(ctx context.Context, logger logr.Log
 			return ctrl.Result{}, err
 		}
 	}
+	r.EventPoller.Track(machineScope.TokenHash, machineScope.LinodeClient)
+	r.GarbageCollector.Track(machineScope.TokenHash, machineScope.LinodeClient)
 
 	// Delete
 	if !machineScope.LinodeMachine.DeletionTimestamp.IsZero() {
@@ -277,6 +373,11 @@ func (r *LinodeMachineReconciler) reconcileCreate(
 		return ctrl.Result{}, err
 	}
 
+	// Adopt the selected instance instead of creating one, never fall back to creating an instance.
+	if selector := getAdoptionSelector(machineScope.LinodeMachine); selector != nil && machineScope.LinodeMachine.Spec.ProviderID == nil {
+		return r.reconcileAdopt(ctx, logger, machineScope, selector)
+	}
+
 	if machineScope.LinodeMachine.Spec.FirewallRef != nil {
 		if !reconciler.ConditionTrue(machineScope.LinodeMachine.GetCondition(ConditionPreflightLinodeFirewallReady)) && machineScope.LinodeMachine.Spec.ProviderID == nil {
 			res, err := r.reconcilePreflightLinodeFirewallCheck(ctx, logger, machineScope)
@@ -308,6 +409,13 @@ func (r *LinodeMachineReconciler) reconcileCreate(
 		}
 	}
 
+	if !reconciler.ConditionTrue(machineScope.LinodeMachine.GetCondition(ConditionPreflightCapacity)) && machineScope.LinodeMachine.Spec.ProviderID == nil {
+		res, err := r.reconcilePreflightCapacity(ctx, logger, machineScope)
+		if err != nil || !res.IsZero() {
+			return res, err
+		}
+	}
+
 	if !reconciler.ConditionTrue(machineScope.LinodeMachine.GetCondition(ConditionPreflightCreated)) && machineScope.LinodeMachine.Spec.ProviderID == nil {
 		res, err := r.reconcilePreflightCreate(ctx, logger, machineScope)
 		if err != nil || !res.IsZero() {
@@ -346,6 +454,99 @@ func (r *LinodeMachineReconciler) reconcileCreate(
 	return ctrl.Result{}, nil
 }
 
+// reconcileAdopt adopts an existing instance instead of creating a new one. The adoption is refused through the
+// Adopted condition when the instance belongs to another machine or cluster or differs from the LinodeMachine, otherwise the LinodeMachine is backfilled
+// from the instance and its state is set so that the next reconciliations update it.
+func (r *LinodeMachineReconciler) reconcileAdopt(
+	ctx context.Context,
+	logger logr.Logger,
+	machineScope *scope.MachineScope,
+	selector *infrav1alpha2.InstanceAdoptionSelector,
+) (ctrl.Result, error) {
+	linodeInstance, err := findAdoptionInstance(ctx, machineScope, selector)
+	if err != nil {
+		if errors.Is(err, errAdoptionInstanceNotFound) {
+			return r.refuseAdoption(machineScope, err.Error())
+		}
+		logger.Error(err, "Failed to find the instance to adopt")
+		return retryIfTransient(err, logger)
+	}
+	logger = logger.WithValues("instanceID", linodeInstance.ID)
+
+	conflict, err := adoptionConflict(ctx, machineScope, linodeInstance)
+	if err != nil {
+		logger.Error(err, "Failed to check the owners of the instance to adopt")
+		return ctrl.Result{}, err
+	}
+	if conflict != "" {
+		return r.refuseAdoption(machineScope, conflict)
+	}
+
+	instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstance.ID)
+	if err != nil {
+		logger.Error(err, "Failed to get default instance configuration")
+		return retryIfTransient(err, logger)
+	}
+	diffs, err := adoptionDiffs(ctx, machineScope, linodeInstance, instanceConfig)
+	if err != nil {
+		logger.Error(err, "Failed to compare the instance to adopt")
+		return retryIfTransient(err, logger)
+	}
+	if len(diffs) > 0 {
+		return r.refuseAdoption(machineScope, fmt.Sprintf("instance %d differs from the LinodeMachine: %s", linodeInstance.ID, strings.Join(diffs, "; ")))
+	}
+
+	addrs, err := buildInstanceAddrs(ctx, machineScope, linodeInstance.ID)
+	if err != nil {
+		logger.Error(err, "Failed to get instance ip addresses")
+		return retryIfTransient(err, logger)
+	}
+
+	machineTags := getTags(machineScope, linodeInstance.Tags)
+	if !slices.Equal(machineTags, linodeInstance.Tags) {
+		if _, err := machineScope.LinodeClient.UpdateInstance(ctx, linodeInstance.ID, linodego.InstanceUpdateOptions{Tags: machineTags}); err != nil {
+			logger.Error(err, "Failed to update tags for Linode instance")
+			return retryIfTransient(err, logger)
+		}
+	}
+
+	if res, err := r.reconcileFirewallID(ctx, logger, machineScope, linodeInstance.ID); err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	for _, disk := range machineDisks(machineScope.LinodeMachine.Spec, instanceConfig.Devices) {
+		disk.disk.DiskID = disk.attached.DiskID
+	}
+	machineScope.LinodeMachine.Spec.ProviderID = util.Pointer(fmt.Sprintf("linode://%d", linodeInstance.ID))
+	machineScope.LinodeMachine.Status.Addresses = addrs
+	machineScope.LinodeMachine.Status.InstanceState = &linodeInstance.Status
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionAdopted,
+		Status:  metav1.ConditionTrue,
+		Reason:  InstanceAdoptedReason,
+		Message: fmt.Sprintf("adopted instance %d", linodeInstance.ID),
+	})
+	r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeNormal, InstanceAdoptedReason, "AdoptInstance",
+		"Adopted instance %d", linodeInstance.ID)
+
+	return ctrl.Result{}, nil
+}
+
+// refuseAdoption reports why the selected instance can't be adopted. It is not requeued, the adoption is retried
+// when the LinodeMachine changes.
+func (r *LinodeMachineReconciler) refuseAdoption(machineScope *scope.MachineScope, message string) (ctrl.Result, error) {
+	r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, AdoptionRefusedReason, "AdoptInstance",
+		"Refused to adopt instance: %s", message)
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionAdopted,
+		Status:  metav1.ConditionFalse,
+		Reason:  AdoptionRefusedReason,
+		Message: message,
+	})
+
+	return ctrl.Result{}, nil
+}
+
 // validateVPC checks if a VPC exists and has subnets
 // Returns error if VPC does not exist or has no subnets
 func (r *LinodeMachineReconciler) validateVPC(ctx context.Context, vpcID int, machineScope *scope.MachineScope, logger logr.Logger, source string) error {
@@ -544,10 +745,32 @@ func (r *LinodeMachineReconciler) reconcilePreflightMetadataSupportConfigure(ctx
 		logger.Error(err, fmt.Sprintf("Failed to fetch region %s", machineScope.LinodeMachine.Spec.Region))
 		return retryIfTransient(err, logger)
 	}
+	// Instances restored from a backup don't deploy an image.
+	if machineScope.LinodeMachine.Spec.BackupRef != nil {
+		if _, err := getBackupID(ctx, machineScope, logger); err != nil {
+			logger.Info("LinodeInstanceSnapshot is not yet available, re-queuing", "error", err.Error())
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+		}
+	}
+	if machineScope.LinodeMachine.Spec.BackupRef != nil || machineScope.LinodeMachine.Spec.BackupID != 0 {
+		machineScope.LinodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionPreflightMetadataSupportConfigured,
+			Status: metav1.ConditionTrue,
+			Reason: "LinodeMetadataSupportConfigured", // We have to set the reason to not fail object patching
+		})
+		return ctrl.Result{}, nil
+	}
 	imageName := reconciler.DefaultMachineControllerLinodeImage
 	if machineScope.LinodeMachine.Spec.Image != "" {
 		imageName = machineScope.LinodeMachine.Spec.Image
 	}
+	if machineScope.LinodeMachine.Spec.ImageRef != nil {
+		imageName, err = getImageID(ctx, machineScope, logger)
+		if err != nil {
+			logger.Info("LinodeImage is not yet available, re-queuing", "error", err.Error())
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+		}
+	}
 	_, err = machineScope.LinodeClient.GetImage(ctx, imageName)
 	if err != nil {
 		logger.Error(err, fmt.Sprintf("Failed to fetch image %s", imageName))
@@ -561,11 +784,81 @@ func (r *LinodeMachineReconciler) reconcilePreflightMetadataSupportConfigure(ctx
 	return ctrl.Result{}, nil
 }
 
+// reconcilePreflightCapacity checks that the instance can be created before calling the create endpoint, and backs
+// off for as long as the region, the account or the image don't allow it.
+func (r *LinodeMachineReconciler) reconcilePreflightCapacity(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope) (ctrl.Result, error) {
+	reason, message, err := checkCapacity(ctx, machineScope, r.InstanceLimit)
+	if err != nil {
+		logger.Error(err, "Failed to check capacity")
+		return retryIfTransient(err, logger)
+	}
+	if reason == "" {
+		// Without a configured instance limit, it is only known once the create call fails, so leave the condition as
+		// is and let the create call retry once the last refused call is old enough; it is set to True once the
+		// instance is created.
+		if prev := machineScope.LinodeMachine.GetCondition(ConditionPreflightCapacity); r.InstanceLimit == 0 && prev != nil && prev.Reason == InstanceLimitReachedReason {
+			if wait := time.Until(prev.LastTransitionTime.Add(reconciler.DefaultMachineControllerCapacityRetryDelay)); wait > 0 {
+				logger.Info("Instance limit was reached, re-queuing", "message", prev.Message)
+				return ctrl.Result{RequeueAfter: wait}, nil
+			}
+			return ctrl.Result{}, nil
+		}
+		machineScope.LinodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionPreflightCapacity,
+			Status: metav1.ConditionTrue,
+			Reason: CapacityAvailableReason, // We have to set the reason to not fail object patching
+		})
+		return ctrl.Result{}, nil
+	}
+
+	return r.setCapacityUnavailable(logger, machineScope, reason, message), nil
+}
+
+// setCapacityUnavailable sets the PreflightCapacity condition to False and returns the result backing off until
+// capacity is checked again.
+func (r *LinodeMachineReconciler) setCapacityUnavailable(logger logr.Logger, machineScope *scope.MachineScope, reason, message string) ctrl.Result {
+	cond := metav1.Condition{
+		Type:    ConditionPreflightCapacity,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	}
+	prev := machineScope.LinodeMachine.GetCondition(ConditionPreflightCapacity)
+	unchanged := prev != nil && prev.Status == metav1.ConditionFalse && prev.Reason == reason
+	// Keep the transition time while the reason is unchanged so that it can be used to back off. Without a configured
+	// instance limit, the create call is the only check of the limit, so the transition time records the last refused
+	// create call instead.
+	if unchanged && (reason != InstanceLimitReachedReason || r.InstanceLimit > 0) {
+		cond.LastTransitionTime = prev.LastTransitionTime
+	}
+	if !unchanged {
+		r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, reason, "PreflightCapacity", message)
+	}
+	machineScope.LinodeMachine.SetCondition(cond)
+	logger.Info("Instance can't be created yet, re-queuing", "reason", reason, "message", message)
+
+	return ctrl.Result{RequeueAfter: capacityRetryDelay(machineScope.LinodeMachine.GetCondition(ConditionPreflightCapacity))}
+}
+
 func (r *LinodeMachineReconciler) reconcilePreflightCreate(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope) (ctrl.Result, error) {
 	// get the bootstrap data for the Linode instance and set it for create config
 	createOpts, err := newCreateConfig(ctx, machineScope, r.GzipCompressionEnabled, logger)
 	if err != nil {
 		logger.Error(err, "Failed to create Linode machine InstanceCreateOptions")
+		if isTerminalBootstrapDataError(err) {
+			// The bootstrap data can't be delivered to the instance, retrying won't help.
+			machineScope.LinodeMachine.Status.FailureReason = util.Pointer(util.BootstrapDataError)
+			machineScope.LinodeMachine.Status.FailureMessage = util.Pointer(err.Error())
+			machineScope.LinodeMachine.SetCondition(metav1.Condition{
+				Type:    ConditionPreflightCreated,
+				Status:  metav1.ConditionFalse,
+				Reason:  util.BootstrapDataError,
+				Message: err.Error(),
+			})
+			r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, util.BootstrapDataError, "CreateInstance", err.Error())
+
+			return ctrl.Result{}, nil
+		}
 		return retryIfTransient(err, logger)
 	}
 
@@ -574,6 +867,15 @@ func (r *LinodeMachineReconciler) reconcilePreflightCreate(ctx context.Context,
 		return ctrl.Result{RequeueAfter: retryAfter}, nil
 	}
 
+	if isInstanceLimitError(err) {
+		// Reset the PreflightCapacity condition so that creating the instance backs off like the other capacity checks.
+		message := err.Error()
+		if r.InstanceLimit == 0 {
+			message += "; the limit of the account is only known once the instance is created, set --instance-limit to check it beforehand"
+		}
+		return r.setCapacityUnavailable(logger, machineScope, InstanceLimitReachedReason, message), nil
+	}
+
 	if err != nil {
 		logger.Error(err, "Failed to create Linode machine instance")
 		if reconciler.HasStaleCondition(machineScope.LinodeMachine.GetCondition(ConditionPreflightCreated),
@@ -595,6 +897,11 @@ func (r *LinodeMachineReconciler) reconcilePreflightCreate(ctx context.Context,
 		return retryIfTransient(err, logger)
 	}
 
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:   ConditionPreflightCapacity,
+		Status: metav1.ConditionTrue,
+		Reason: CapacityAvailableReason, // We have to set the reason to not fail object patching
+	})
 	machineScope.LinodeMachine.SetCondition(metav1.Condition{
 		Type:   ConditionPreflightCreated,
 		Status: metav1.ConditionTrue,
@@ -606,7 +913,11 @@ func (r *LinodeMachineReconciler) reconcilePreflightCreate(ctx context.Context,
 }
 
 func (r *LinodeMachineReconciler) reconcilePreflightConfigure(ctx context.Context, instanceID int, logger logr.Logger, machineScope *scope.MachineScope) (ctrl.Result, error) {
-	if err := configureDisks(ctx, logger, machineScope, instanceID); err != nil {
+	err := configureDisks(ctx, logger, machineScope, instanceID)
+	if err == nil {
+		err = attachVolumes(ctx, logger, machineScope, instanceID)
+	}
+	if err != nil {
 		if reconciler.HasStaleCondition(machineScope.LinodeMachine.GetCondition(ConditionPreflightConfigured),
 			reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultMachineControllerWaitForPreflightTimeout)) {
 			machineScope.LinodeMachine.SetCondition(metav1.Condition{
@@ -740,11 +1051,27 @@ func (r *LinodeMachineReconciler) reconcileUpdate(ctx context.Context, logger lo
 	}
 	// update the status
 	machineScope.LinodeMachine.Status.InstanceState = &linodeInstance.Status
+	// rebuild the instance in place if it was requested
+	if res, err := r.reconcileRebuild(ctx, logger, machineScope, linodeInstance); err != nil || !res.IsZero() {
+		return res, err
+	}
+	// upgrade the interfaces of the instance to Linode interfaces if it was requested
+	if res, err := r.reconcileInterfaceUpgrade(ctx, logger, machineScope, linodeInstance); err != nil || !res.IsZero() {
+		return res, err
+	}
+	// resize the instance in place if the type has changed
+	if res, err := r.reconcileResize(ctx, logger, machineScope, linodeInstance); err != nil || !res.IsZero() {
+		return res, err
+	}
+	// update the network interfaces of the instance if they have changed
+	if res, err := r.reconcileInterfaces(ctx, logger, machineScope, linodeInstance); err != nil || !res.IsZero() {
+		return res, err
+	}
 	// decide to requeue
 	if _, ok := requeueInstanceStatuses[linodeInstance.Status]; ok {
 		if linodeInstance.Updated.Add(reconciler.DefaultMachineControllerWaitForRunningTimeout).After(time.Now()) {
 			logger.Info("Instance not yet ready", "status", linodeInstance.Status)
-			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+			return ctrl.Result{RequeueAfter: r.waitForRunningDelay()}, nil
 		} else {
 			logger.Info("Instance not ready in time, skipping reconciliation", "status", linodeInstance.Status)
 			machineScope.LinodeMachine.SetCondition(metav1.Condition{
@@ -796,6 +1123,687 @@ func (r *LinodeMachineReconciler) reconcileUpdate(ctx context.Context, logger lo
 	return ctrl.Result{}, nil
 }
 
+// reconcileInterfaceUpgrade drives the upgrade of the legacy configuration interfaces of the instance to Linode
+// interfaces when the LinodeMachine has the upgrade annotation or its interface generation was changed to linode. The
+// upgrade is checked with a dry-run, then the instance is shut down, upgraded and booted again, with the progress
+// reflected in the InterfacesUpgraded condition. A zero result is returned when there is nothing left to do.
+func (r *LinodeMachineReconciler) reconcileInterfaceUpgrade(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	upgraded := linodeMachine.GetCondition(ConditionInterfacesUpgraded)
+	inProgress := upgraded != nil && upgraded.Status == metav1.ConditionFalse && upgraded.Reason != InterfacesUpgradeFailedReason
+
+	if !inProgress {
+		_, annotated := linodeMachine.Annotations[infrav1alpha2.UpgradeInterfacesAnnotation]
+		requested := machineInterfaceGeneration(linodeMachine.Spec) == linodego.GenerationLinode &&
+			(instanceInterfaceGeneration(linodeInstance) == linodego.GenerationLegacyConfig || linodeMachine.Spec.Interfaces != nil)
+		if !annotated && !requested {
+			return ctrl.Result{}, nil
+		}
+		// Don't retry a failed upgrade until the LinodeMachine is changed or annotated again
+		if !annotated && upgraded != nil && upgraded.Reason == InterfacesUpgradeFailedReason && upgraded.ObservedGeneration == linodeMachine.Generation {
+			return ctrl.Result{}, nil
+		}
+		return r.startInterfaceUpgrade(ctx, logger, machineScope, linodeInstance)
+	}
+
+	switch {
+	case upgraded.Reason == InterfacesUpgradeShuttingDownReason && linodeInstance.Status == linodego.InstanceOffline:
+		return r.upgradeInterfaces(ctx, logger, machineScope, linodeInstance)
+
+	case upgraded.Reason == InterfacesUpgradeBootingReason && linodeInstance.Status == linodego.InstanceRunning:
+		r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, InterfacesUpgradedReason, "UpgradeInterfaces",
+			"Upgraded interfaces of instance %d to Linode interfaces", linodeInstance.ID)
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionInterfacesUpgraded,
+			Status: metav1.ConditionTrue,
+			Reason: InterfacesUpgradedReason,
+		})
+		return ctrl.Result{}, nil
+
+	default:
+		logger.Info("Waiting for instance interfaces upgrade", "status", linodeInstance.Status)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// startInterfaceUpgrade checks that the interfaces of the running instance can be upgraded and shuts it down. When the
+// interfaces of the instance were already upgraded, only the LinodeMachine is updated.
+func (r *LinodeMachineReconciler) startInterfaceUpgrade(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+
+	if instanceInterfaceGeneration(linodeInstance) == linodego.GenerationLinode {
+		if machineInterfaceGeneration(linodeMachine.Spec) == linodego.GenerationLinode && linodeMachine.Spec.Interfaces == nil {
+			delete(linodeMachine.Annotations, infrav1alpha2.UpgradeInterfacesAnnotation)
+			return ctrl.Result{}, nil
+		}
+		if err := completeInterfaceUpgrade(ctx, machineScope, linodeInstance.ID); err != nil {
+			logger.Error(err, "Failed to get instance ip addresses after upgrading its interfaces")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionInterfacesUpgraded,
+			Status: metav1.ConditionTrue,
+			Reason: InterfacesUpgradedReason,
+		})
+		return ctrl.Result{}, nil
+	}
+	if linodeInstance.Status != linodego.InstanceRunning {
+		logger.Info("Waiting for instance to be running before upgrading its interfaces", "status", linodeInstance.Status)
+		return ctrl.Result{}, nil
+	}
+	if linodeMachine.Spec.PrivateIP != nil && *linodeMachine.Spec.PrivateIP {
+		return r.failInterfaceUpgrade(ctx, logger, machineScope, linodeInstance, "Linode interfaces do not support private IPs")
+	}
+	if machineScope.Machine != nil && kutil.IsControlPlaneMachine(machineScope.Machine) {
+		// Shutting down control plane nodes, possibly all of them at once, can cost the quorum of etcd, so they are
+		// replaced by their control plane instead.
+		return r.failInterfaceUpgrade(ctx, logger, machineScope, linodeInstance,
+			"upgrading the interfaces of control plane machines requires shutting them down, roll out the control plane instead")
+	}
+
+	instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstance.ID)
+	if err != nil {
+		logger.Error(err, "Failed to get default instance configuration")
+		return retryIfTransient(err, logger)
+	}
+	// Find out whether the upgrade fails before shutting the instance down
+	if _, err := machineScope.LinodeClient.UpgradeInterfaces(ctx, linodeInstance.ID, linodego.LinodeInterfacesUpgradeOptions{
+		ConfigID: util.Pointer(instanceConfig.ID),
+		DryRun:   util.Pointer(true),
+	}); err != nil {
+		if util.IsRetryableError(err) {
+			return retryIfTransient(err, logger)
+		}
+		logger.Error(err, "Failed to upgrade interfaces of instance in dry-run")
+		return r.failInterfaceUpgrade(ctx, logger, machineScope, linodeInstance, err.Error())
+	}
+
+	logger.Info("shutting down instance to upgrade its interfaces")
+	if err := machineScope.LinodeClient.ShutdownInstance(ctx, linodeInstance.ID); err != nil {
+		logger.Error(err, "Failed to shut down instance to upgrade its interfaces")
+		return retryIfTransient(err, logger)
+	}
+	linodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionInterfacesUpgraded,
+		Status:  metav1.ConditionFalse,
+		Reason:  InterfacesUpgradeShuttingDownReason,
+		Message: "shutting down instance to upgrade its interfaces",
+	})
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// upgradeInterfaces upgrades the interfaces of the powered off instance to Linode interfaces, moves the firewalls of the
+// instance to them and updates the LinodeMachine before booting the instance again.
+func (r *LinodeMachineReconciler) upgradeInterfaces(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	firewalls, err := machineScope.LinodeClient.ListInstanceFirewalls(ctx, linodeInstance.ID, nil)
+	if err != nil {
+		logger.Error(err, "Failed to list firewalls for Linode instance")
+		return retryIfTransient(err, logger)
+	}
+
+	var interfaces []linodego.LinodeInterface
+	if instanceInterfaceGeneration(linodeInstance) == linodego.GenerationLegacyConfig {
+		instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstance.ID)
+		if err != nil {
+			logger.Error(err, "Failed to get default instance configuration")
+			return retryIfTransient(err, logger)
+		}
+		logger.Info("upgrading interfaces of instance", "configID", instanceConfig.ID)
+		upgrade, err := machineScope.LinodeClient.UpgradeInterfaces(ctx, linodeInstance.ID, linodego.LinodeInterfacesUpgradeOptions{
+			ConfigID: util.Pointer(instanceConfig.ID),
+			DryRun:   util.Pointer(false),
+		})
+		if err != nil {
+			if util.IsRetryableError(err) {
+				return retryIfTransient(err, logger)
+			}
+			logger.Error(err, "Failed to upgrade interfaces of instance")
+			return r.failInterfaceUpgrade(ctx, logger, machineScope, linodeInstance, err.Error())
+		}
+		interfaces = upgrade.Interfaces
+	} else {
+		// The interfaces were upgraded by a previous reconciliation which failed afterwards
+		if interfaces, err = machineScope.LinodeClient.ListInterfaces(ctx, linodeInstance.ID, nil); err != nil {
+			logger.Error(err, "Failed to list interfaces for Linode instance")
+			return retryIfTransient(err, logger)
+		}
+	}
+
+	firewallIDs := make([]int, 0, len(firewalls))
+	for _, fw := range firewalls {
+		firewallIDs = append(firewallIDs, fw.ID)
+	}
+	if err := moveFirewallsToInterfaces(ctx, machineScope, linodeInstance.ID, firewallIDs, interfaces); err != nil {
+		logger.Error(err, "Failed to move firewalls of instance to its interfaces")
+		return retryIfTransient(err, logger)
+	}
+	if err := completeInterfaceUpgrade(ctx, machineScope, linodeInstance.ID); err != nil {
+		logger.Error(err, "Failed to get instance ip addresses after upgrading its interfaces")
+		return retryIfTransient(err, logger)
+	}
+
+	if err := machineScope.LinodeClient.BootInstance(ctx, linodeInstance.ID, linodego.InstanceBootOptions{}); err != nil && !strings.HasSuffix(err.Error(), "already booted.") {
+		logger.Error(err, "Failed to boot instance after upgrading its interfaces")
+		return retryIfTransient(err, logger)
+	}
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionInterfacesUpgraded,
+		Status:  metav1.ConditionFalse,
+		Reason:  InterfacesUpgradeBootingReason,
+		Message: "booting instance after upgrading its interfaces",
+	})
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// completeInterfaceUpgrade updates the interfaces and the addresses of the LinodeMachine together once the interfaces
+// of its instance were upgraded, so that they are patched at once.
+func completeInterfaceUpgrade(ctx context.Context, machineScope *scope.MachineScope, instanceID int) error {
+	linodeMachine := machineScope.LinodeMachine
+
+	addrs, err := buildInstanceAddrs(ctx, machineScope, instanceID)
+	if err != nil {
+		return err
+	}
+	linodeMachine.Status.Addresses = addrs
+	if upgradeMachineInterfaces(&linodeMachine.Spec) {
+		// The upgraded interfaces match the translated ones, so they are up to date with the next generation.
+		linodeMachine.DeleteCondition(ConditionInterfacesUpdated)
+	}
+	delete(linodeMachine.Annotations, infrav1alpha2.UpgradeInterfacesAnnotation)
+
+	return nil
+}
+
+// failInterfaceUpgrade marks the upgrade of the interfaces of the instance as failed and boots the instance back up. The
+// upgrade is not retried until the LinodeMachine is changed or annotated again.
+func (r *LinodeMachineReconciler) failInterfaceUpgrade(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance, message string) (ctrl.Result, error) {
+	r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, InterfacesUpgradeFailedReason, "UpgradeInterfaces",
+		"Failed to upgrade interfaces of instance %d: %s", linodeInstance.ID, message)
+	delete(machineScope.LinodeMachine.Annotations, infrav1alpha2.UpgradeInterfacesAnnotation)
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:               ConditionInterfacesUpgraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             InterfacesUpgradeFailedReason,
+		Message:            message,
+		ObservedGeneration: machineScope.LinodeMachine.Generation,
+	})
+	if linodeInstance.Status == linodego.InstanceRunning {
+		return ctrl.Result{}, nil
+	}
+
+	if err := machineScope.LinodeClient.BootInstance(ctx, linodeInstance.ID, linodego.InstanceBootOptions{}); err != nil && !strings.HasSuffix(err.Error(), "already booted.") {
+		logger.Error(err, "Failed to boot instance after failed interfaces upgrade")
+		return retryIfTransient(err, logger)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// reconcileResize drives an in-place resize of the instance when the type of the LinodeMachine differs from
+// the type of the instance. The instance is shut down, resized and booted again, with the progress reflected
+// in the Resized condition. A zero result is returned when there is nothing left to do.
+//
+//nolint:cyclop // each case is a step of the resize
+func (r *LinodeMachineReconciler) reconcileResize(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	resized := linodeMachine.GetCondition(ConditionResized)
+	inProgress := resized != nil && resized.Status == metav1.ConditionFalse && resized.Reason != ResizeFailedReason
+
+	if linodeInstance.Type == linodeMachine.Spec.Type && !inProgress {
+		return ctrl.Result{}, nil
+	}
+	if linodeInstance.Type != linodeMachine.Spec.Type {
+		if !linodeMachine.Spec.AllowInPlaceResize {
+			return ctrl.Result{}, nil
+		}
+		// Don't retry a failed resize until the LinodeMachine is changed
+		if resized != nil && resized.Reason == ResizeFailedReason && resized.ObservedGeneration == linodeMachine.Generation {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	switch {
+	case linodeInstance.Type != linodeMachine.Spec.Type && linodeInstance.Status == linodego.InstanceRunning:
+		logger.Info("shutting down instance for resize", "from", linodeInstance.Type, "to", linodeMachine.Spec.Type)
+		if err := machineScope.LinodeClient.ShutdownInstance(ctx, linodeInstance.ID); err != nil {
+			logger.Error(err, "Failed to shut down instance for resize")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionResized,
+			Status:  metav1.ConditionFalse,
+			Reason:  ResizeShuttingDownReason,
+			Message: fmt.Sprintf("shutting down instance to resize from %s to %s", linodeInstance.Type, linodeMachine.Spec.Type),
+		})
+
+	case linodeInstance.Type != linodeMachine.Spec.Type && linodeInstance.Status == linodego.InstanceOffline:
+		if resized != nil && resized.Reason == ResizingReason {
+			event, err := getLatestInstanceEvent(ctx, machineScope, linodeInstance.ID, linodego.ActionLinodeResize)
+			if err != nil {
+				logger.Error(err, "Failed to list resize events for instance")
+				return retryIfTransient(err, logger)
+			}
+			if event == nil || event.Status != linodego.EventFailed {
+				logger.Info("Instance resize not yet finished")
+				break
+			}
+			return r.failResize(ctx, logger, machineScope, linodeInstance, fmt.Sprintf("resize event %d failed", event.ID))
+		}
+
+		logger.Info("resizing instance", "from", linodeInstance.Type, "to", linodeMachine.Spec.Type)
+		if err := machineScope.LinodeClient.ResizeInstance(ctx, linodeInstance.ID, linodego.InstanceResizeOptions{
+			Type:                linodeMachine.Spec.Type,
+			MigrationType:       linodego.ColdMigration,
+			AllowAutoDiskResize: util.Pointer(linodeMachine.Spec.AutoResizeDisk),
+		}); err != nil {
+			if util.IsRetryableError(err) {
+				return retryIfTransient(err, logger)
+			}
+			logger.Error(err, "Failed to resize instance")
+			return r.failResize(ctx, logger, machineScope, linodeInstance, err.Error())
+		}
+		r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, ResizingReason, "ResizeInstance",
+			"Resizing instance %d from %s to %s", linodeInstance.ID, linodeInstance.Type, linodeMachine.Spec.Type)
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionResized,
+			Status:  metav1.ConditionFalse,
+			Reason:  ResizingReason,
+			Message: fmt.Sprintf("resizing instance from %s to %s", linodeInstance.Type, linodeMachine.Spec.Type),
+		})
+
+	case linodeInstance.Type == linodeMachine.Spec.Type && linodeInstance.Status == linodego.InstanceOffline:
+		logger.Info("booting instance after resize")
+		if err := machineScope.LinodeClient.BootInstance(ctx, linodeInstance.ID, linodego.InstanceBootOptions{}); err != nil && !strings.HasSuffix(err.Error(), "already booted.") {
+			logger.Error(err, "Failed to boot instance after resize")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionResized,
+			Status:  metav1.ConditionFalse,
+			Reason:  ResizeBootingReason,
+			Message: "booting instance after resize",
+		})
+
+	case linodeInstance.Type == linodeMachine.Spec.Type && linodeInstance.Status == linodego.InstanceRunning:
+		r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, ResizeCompletedReason, "ResizeInstance",
+			"Resized instance %d to %s", linodeInstance.ID, linodeInstance.Type)
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionResized,
+			Status: metav1.ConditionTrue,
+			Reason: ResizeCompletedReason,
+		})
+		return ctrl.Result{}, nil
+
+	default:
+		logger.Info("Waiting for instance resize", "status", linodeInstance.Status)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// failResize marks the in-place resize of the instance as failed and boots the instance back up with its previous type.
+func (r *LinodeMachineReconciler) failResize(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance, message string) (ctrl.Result, error) {
+	r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, ResizeFailedReason, "ResizeInstance",
+		"Failed to resize instance %d to %s: %s", linodeInstance.ID, machineScope.LinodeMachine.Spec.Type, message)
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:               ConditionResized,
+		Status:             metav1.ConditionFalse,
+		Reason:             ResizeFailedReason,
+		Message:            message,
+		ObservedGeneration: machineScope.LinodeMachine.Generation,
+	})
+
+	if err := machineScope.LinodeClient.BootInstance(ctx, linodeInstance.ID, linodego.InstanceBootOptions{}); err != nil && !strings.HasSuffix(err.Error(), "already booted.") {
+		logger.Error(err, "Failed to boot instance after failed resize")
+		return retryIfTransient(err, logger)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// reconcileInterfaces updates the network interfaces of the instance when the LinodeMachine has changed since they were
+// last updated. Linode interfaces are updated while the instance is running, but creating or deleting them and changing
+// the interfaces of a legacy configuration requires shutting the instance down and booting it again, with the progress
+// reflected in the InterfacesUpdated condition. Changes requiring a shutdown are refused for control plane machines. A
+// zero result is returned when there is nothing left to do.
+//
+//nolint:cyclop // each case is a step of the update
+func (r *LinodeMachineReconciler) reconcileInterfaces(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	updated := linodeMachine.GetCondition(ConditionInterfacesUpdated)
+	if updated == nil {
+		// The interfaces were created with the instance from the current LinodeMachine.
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:               ConditionInterfacesUpdated,
+			Status:             metav1.ConditionTrue,
+			Reason:             InterfacesUpToDateReason,
+			ObservedGeneration: linodeMachine.Generation,
+		})
+		return ctrl.Result{}, nil
+	}
+	inProgress := updated.Status == metav1.ConditionFalse && updated.Reason != InterfacesUpdateFailedReason
+	if !inProgress && updated.ObservedGeneration == linodeMachine.Generation {
+		return ctrl.Result{}, nil
+	}
+	if instanceInterfaceGeneration(linodeInstance) == linodego.GenerationLegacyConfig && !feature.Gates.Enabled(feature.LegacyInterfaceUpdates) {
+		return ctrl.Result{}, nil
+	}
+
+	switch {
+	case !inProgress && linodeInstance.Status == linodego.InstanceRunning:
+		changes, err := getInterfaceChanges(ctx, machineScope, linodeInstance, logger)
+		if err != nil {
+			logger.Error(err, "Failed to compute the changes to the interfaces of the instance")
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+		}
+		if changes.requireShutdown() && machineScope.Machine != nil && kutil.IsControlPlaneMachine(machineScope.Machine) {
+			// Shutting down control plane nodes one after the other can cost the quorum of etcd, so they are replaced
+			// by their control plane instead.
+			return r.failInterfaces(ctx, logger, machineScope, linodeInstance,
+				fmt.Sprintf("changing the interfaces of control plane machines requires shutting them down: %s, roll out the control plane instead", changes))
+		}
+		if changes.requireShutdown() {
+			logger.Info("shutting down instance to update its interfaces", "changes", changes.String())
+			if err := machineScope.LinodeClient.ShutdownInstance(ctx, linodeInstance.ID); err != nil {
+				logger.Error(err, "Failed to shut down instance to update its interfaces")
+				return retryIfTransient(err, logger)
+			}
+			linodeMachine.SetCondition(metav1.Condition{
+				Type:               ConditionInterfacesUpdated,
+				Status:             metav1.ConditionFalse,
+				Reason:             InterfacesShuttingDownReason,
+				Message:            "shutting down instance to update its interfaces",
+				ObservedGeneration: linodeMachine.Generation,
+			})
+			break
+		}
+		if !changes.empty() {
+			if err := changes.apply(ctx, machineScope, linodeInstance.ID); err != nil {
+				if util.IsRetryableError(err) {
+					return retryIfTransient(err, logger)
+				}
+				return r.failInterfaces(ctx, logger, machineScope, linodeInstance, err.Error())
+			}
+			r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, InterfacesUpdatedReason, "UpdateInterfaces",
+				"Updated interfaces of instance %d: %s", linodeInstance.ID, changes)
+		}
+		// The addresses of the instance change with its interfaces. They are refreshed even without changes, so that
+		// they are picked up when the refresh failed after the changes were applied.
+		addrs, err := buildInstanceAddrs(ctx, machineScope, linodeInstance.ID)
+		if err != nil {
+			logger.Error(err, "Failed to get instance ip addresses after updating its interfaces")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.Status.Addresses = addrs
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:               ConditionInterfacesUpdated,
+			Status:             metav1.ConditionTrue,
+			Reason:             InterfacesUpToDateReason,
+			ObservedGeneration: linodeMachine.Generation,
+		})
+		return ctrl.Result{}, nil
+
+	case !inProgress:
+		// Wait for the instance to be running before updating its interfaces.
+		return ctrl.Result{}, nil
+
+	case updated.Reason == InterfacesShuttingDownReason && linodeInstance.Status == linodego.InstanceOffline:
+		changes, err := getInterfaceChanges(ctx, machineScope, linodeInstance, logger)
+		if err != nil {
+			logger.Error(err, "Failed to compute the changes to the interfaces of the instance")
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+		}
+		logger.Info("updating interfaces of instance", "changes", changes.String())
+		if err := changes.apply(ctx, machineScope, linodeInstance.ID); err != nil {
+			if util.IsRetryableError(err) {
+				return retryIfTransient(err, logger)
+			}
+			logger.Error(err, "Failed to update interfaces of instance")
+			return r.failInterfaces(ctx, logger, machineScope, linodeInstance, err.Error())
+		}
+		if !changes.empty() {
+			r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, InterfacesUpdatedReason, "UpdateInterfaces",
+				"Updated interfaces of instance %d: %s", linodeInstance.ID, changes)
+		}
+		if err := machineScope.LinodeClient.BootInstance(ctx, linodeInstance.ID, linodego.InstanceBootOptions{}); err != nil && !strings.HasSuffix(err.Error(), "already booted.") {
+			logger.Error(err, "Failed to boot instance after updating its interfaces")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:               ConditionInterfacesUpdated,
+			Status:             metav1.ConditionFalse,
+			Reason:             InterfacesBootingReason,
+			Message:            "booting instance after updating its interfaces",
+			ObservedGeneration: updated.ObservedGeneration,
+		})
+
+	case updated.Reason == InterfacesBootingReason && linodeInstance.Status == linodego.InstanceRunning:
+		// The addresses of the instance change with its interfaces.
+		addrs, err := buildInstanceAddrs(ctx, machineScope, linodeInstance.ID)
+		if err != nil {
+			logger.Error(err, "Failed to get instance ip addresses after updating its interfaces")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.Status.Addresses = addrs
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:               ConditionInterfacesUpdated,
+			Status:             metav1.ConditionTrue,
+			Reason:             InterfacesUpdatedReason,
+			ObservedGeneration: updated.ObservedGeneration,
+		})
+		return ctrl.Result{}, nil
+
+	default:
+		logger.Info("Waiting for instance interfaces update", "status", linodeInstance.Status)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// failInterfaces marks the update of the interfaces of the instance as failed and boots the instance back up. The
+// update is not retried until the LinodeMachine is changed.
+func (r *LinodeMachineReconciler) failInterfaces(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance, message string) (ctrl.Result, error) {
+	r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, InterfacesUpdateFailedReason, "UpdateInterfaces",
+		"Failed to update interfaces of instance %d: %s", linodeInstance.ID, message)
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:               ConditionInterfacesUpdated,
+		Status:             metav1.ConditionFalse,
+		Reason:             InterfacesUpdateFailedReason,
+		Message:            message,
+		ObservedGeneration: machineScope.LinodeMachine.Generation,
+	})
+	if linodeInstance.Status == linodego.InstanceRunning {
+		return ctrl.Result{}, nil
+	}
+
+	if err := machineScope.LinodeClient.BootInstance(ctx, linodeInstance.ID, linodego.InstanceBootOptions{}); err != nil && !strings.HasSuffix(err.Error(), "already booted.") {
+		logger.Error(err, "Failed to boot instance after failed interfaces update")
+		return retryIfTransient(err, logger)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// reconcileRebuild drives an in-place rebuild of the instance when the LinodeMachine has the rebuild annotation.
+// The instance is rebuilt from its current image and freshly resolved bootstrap data, which keeps its IP addresses,
+// VPC addresses, firewalls and placement group, and then its data disks and configuration are re-created before it is
+// booted again. The progress is reflected in the Rebuilt condition. A zero result is returned when there is nothing
+// left to do.
+//
+//nolint:cyclop // each case is a step of the rebuild
+func (r *LinodeMachineReconciler) reconcileRebuild(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	rebuilt := linodeMachine.GetCondition(ConditionRebuilt)
+	inProgress := rebuilt != nil && rebuilt.Status == metav1.ConditionFalse && rebuilt.Reason != RebuildFailedReason
+
+	if _, ok := linodeMachine.Annotations[infrav1alpha2.RebuildAnnotation]; ok && !inProgress {
+		return r.startRebuild(ctx, logger, machineScope, linodeInstance)
+	}
+	if !inProgress {
+		return ctrl.Result{}, nil
+	}
+
+	switch {
+	case rebuilt.Reason == RebuildingReason && linodeInstance.Status == linodego.InstanceOffline:
+		event, err := getLatestInstanceEvent(ctx, machineScope, linodeInstance.ID, linodego.ActionLinodeRebuild)
+		if err != nil {
+			logger.Error(err, "Failed to list rebuild events for instance")
+			return retryIfTransient(err, logger)
+		}
+		if event != nil && event.Status == linodego.EventFailed {
+			return r.failRebuild(machineScope, linodeInstance, fmt.Sprintf("rebuild event %d failed", event.ID))
+		}
+		if event == nil || event.Status != linodego.EventFinished {
+			logger.Info("Instance rebuild not yet finished")
+			break
+		}
+
+		if err := resizeRootDisk(ctx, logger, machineScope, linodeInstance.ID); err != nil {
+			logger.Error(err, "Failed to resize root disk after rebuild")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionRebuilt,
+			Status:  metav1.ConditionFalse,
+			Reason:  RebuildConfiguringReason,
+			Message: "configuring instance after rebuild",
+		})
+
+	case rebuilt.Reason == RebuildConfiguringReason && linodeInstance.Status == linodego.InstanceOffline:
+		logger.Info("configuring instance after rebuild")
+		if res, err := r.reconcilePreflightConfigure(ctx, linodeInstance.ID, logger, machineScope); err != nil || !res.IsZero() {
+			return res, err
+		}
+		if err := restoreInstanceConfig(ctx, logger, machineScope, linodeInstance.ID); err != nil {
+			logger.Error(err, "Failed to restore instance configuration after rebuild")
+			return retryIfTransient(err, logger)
+		}
+		if res, err := r.reconcilePreflightBoot(ctx, linodeInstance.ID, logger, machineScope); err != nil || !res.IsZero() {
+			return res, err
+		}
+		linodeMachine.Status.RebuildInterfaces = nil
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionRebuilt,
+			Status:  metav1.ConditionFalse,
+			Reason:  RebuildBootingReason,
+			Message: "booting instance after rebuild",
+		})
+
+	case rebuilt.Reason == RebuildBootingReason && linodeInstance.Status == linodego.InstanceRunning:
+		r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, RebuildCompletedReason, "RebuildInstance",
+			"Rebuilt instance %d", linodeInstance.ID)
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionRebuilt,
+			Status: metav1.ConditionTrue,
+			Reason: RebuildCompletedReason,
+		})
+		return ctrl.Result{}, nil
+
+	default:
+		logger.Info("Waiting for instance rebuild", "status", linodeInstance.Status)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// startRebuild rebuilds the instance from its current image and bootstrap data, leaving it powered off so that its
+// data disks and configuration can be re-created before it is booted.
+func (r *LinodeMachineReconciler) startRebuild(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+
+	imageName := reconciler.DefaultMachineControllerLinodeImage
+	if linodeMachine.Spec.Image != "" {
+		imageName = linodeMachine.Spec.Image
+	}
+	if linodeMachine.Spec.ImageRef != nil {
+		var err error
+		if imageName, err = getImageID(ctx, machineScope, logger); err != nil {
+			logger.Info("LinodeImage is not yet available, re-queuing", "error", err.Error())
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+		}
+	}
+
+	bootstrapData, err := resolveBootstrapData(ctx, machineScope, r.GzipCompressionEnabled, logger)
+	if err != nil {
+		logger.Error(err, "Failed to resolve bootstrap data for rebuild")
+		if isTerminalBootstrapDataError(err) {
+			return r.failRebuild(machineScope, linodeInstance, err.Error())
+		}
+		return retryIfTransient(err, logger)
+	}
+
+	// Rebuilding deletes all configurations of the instance, so keep the interfaces of legacy configurations around
+	if linodeMachine.Spec.InterfaceGeneration != linodego.GenerationLinode {
+		instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstance.ID)
+		if err != nil {
+			logger.Error(err, "Failed to get default instance configuration")
+			return retryIfTransient(err, logger)
+		}
+		linodeMachine.Status.RebuildInterfaces = instanceConfigInterfacesToCreateOptions(instanceConfig.Interfaces)
+	}
+
+	rebuildOpts := linodego.InstanceRebuildOptions{
+		Image:           imageName,
+		RootPass:        linodeMachine.Spec.RootPass,
+		AuthorizedKeys:  linodeMachine.Spec.AuthorizedKeys,
+		AuthorizedUsers: linodeMachine.Spec.AuthorizedUsers,
+		Booted:          util.Pointer(false),
+		Metadata: &linodego.InstanceMetadataOptions{
+			UserData: b64.StdEncoding.EncodeToString(bootstrapData),
+		},
+		DiskEncryption: linodego.InstanceDiskEncryption(linodeMachine.Spec.DiskEncryption),
+	}
+	if rebuildOpts.RootPass == "" && len(rebuildOpts.AuthorizedKeys) == 0 {
+		rebuildOpts.RootPass = uuid.NewString()
+	}
+
+	logger.Info("rebuilding instance", "image", imageName)
+	if _, err := machineScope.LinodeClient.RebuildInstance(ctx, linodeInstance.ID, rebuildOpts); err != nil {
+		if util.IsRetryableError(err) {
+			return retryIfTransient(err, logger)
+		}
+		logger.Error(err, "Failed to rebuild instance")
+		return r.failRebuild(machineScope, linodeInstance, err.Error())
+	}
+
+	// The rebuild removed the data disks and the volumes from the configuration of the instance
+	clearDataDiskIDs(linodeMachine.Spec.DataDisks)
+	linodeMachine.DeleteCondition(ConditionPreflightAdditionalDisksCreated)
+	linodeMachine.DeleteCondition(ConditionPreflightVolumesAttached)
+	delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+
+	r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeNormal, RebuildingReason, "RebuildInstance",
+		"Rebuilding instance %d from image %s", linodeInstance.ID, imageName)
+	linodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionRebuilt,
+		Status:  metav1.ConditionFalse,
+		Reason:  RebuildingReason,
+		Message: fmt.Sprintf("rebuilding instance from image %s", imageName),
+	})
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// failRebuild marks the in-place rebuild of the instance as failed. The rebuild is not retried until the
+// LinodeMachine is annotated again.
+func (r *LinodeMachineReconciler) failRebuild(machineScope *scope.MachineScope, linodeInstance *linodego.Instance, message string) (ctrl.Result, error) {
+	r.Recorder.Eventf(machineScope.LinodeMachine, nil, corev1.EventTypeWarning, RebuildFailedReason, "RebuildInstance",
+		"Failed to rebuild instance %d: %s", linodeInstance.ID, message)
+	delete(machineScope.LinodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+	machineScope.LinodeMachine.Status.RebuildInterfaces = nil
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionRebuilt,
+		Status:  metav1.ConditionFalse,
+		Reason:  RebuildFailedReason,
+		Message: message,
+	})
+
+	return ctrl.Result{}, nil
+}
+
 func (r *LinodeMachineReconciler) reconcileFirewallID(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, instanceID int) (ctrl.Result, error) {
 	var (
 		firewalls []linodego.Firewall
@@ -886,6 +1894,20 @@ func (r *LinodeMachineReconciler) reconcileDelete(
 		return ctrl.Result{}, err
 	}
 
+	if res, err := r.reconcileGracefulShutdown(ctx, logger, machineScope, instanceID); err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	if err := detachVolumes(ctx, logger, machineScope, instanceID); err != nil {
+		if machineScope.LinodeMachine.ObjectMeta.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultMachineControllerRetryDelay)).After(time.Now()) {
+			logger.Info("re-queuing Volume detachment")
+
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
 	if err := machineScope.LinodeClient.DeleteInstance(ctx, instanceID); err != nil {
 		if util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
 			logger.Error(err, "Failed to delete Linode instance")
@@ -919,6 +1941,146 @@ func (r *LinodeMachineReconciler) reconcileDelete(
 	return ctrl.Result{}, nil
 }
 
+// reconcileGracefulShutdown shuts the instance down before its deletion when the LinodeMachine has a graceful shutdown
+// configured. The NodeBalancer backends of the instance are drained first if requested, then the instance is shut down
+// and its deletion waits for it to be offline, or for the shutdown to time out. The phases are reflected in the
+// NodeBalancerDrained and InstanceShutDown conditions. A zero result is returned when the instance can be deleted.
+//
+//nolint:cyclop // each case is a step of the shutdown
+func (r *LinodeMachineReconciler) reconcileGracefulShutdown(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, instanceID int) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	gracefulShutdown := linodeMachine.Spec.GracefulShutdown
+	if gracefulShutdown == nil {
+		return ctrl.Result{}, nil
+	}
+	shutDown := linodeMachine.GetCondition(ConditionInstanceShutDown)
+	if shutDown != nil && shutDown.Reason != InstanceShuttingDownReason {
+		return ctrl.Result{}, nil
+	}
+
+	if gracefulShutdown.DrainNodeBalancer && shutDown == nil {
+		if res, err := r.reconcileNodeBalancerDrain(ctx, logger, machineScope); err != nil || !res.IsZero() {
+			return res, err
+		}
+	}
+
+	linodeInstance, err := machineScope.LinodeClient.GetInstance(ctx, instanceID)
+	if err != nil {
+		if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get Linode instance before shutting it down")
+		return r.retryGracefulShutdown(err, logger, machineScope)
+	}
+
+	timeout := reconciler.DefaultMachineControllerGracefulShutdownTimeout
+	if gracefulShutdown.Timeout != nil {
+		timeout = gracefulShutdown.Timeout.Duration
+	}
+
+	switch {
+	case linodeInstance.Status == linodego.InstanceOffline:
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:   ConditionInstanceShutDown,
+			Status: metav1.ConditionTrue,
+			Reason: InstanceShutDownReason,
+		})
+		return ctrl.Result{}, nil
+
+	case shutDown == nil:
+		logger.Info("shutting down instance before deleting it", "timeout", timeout)
+		if err := machineScope.LinodeClient.ShutdownInstance(ctx, instanceID); err != nil {
+			logger.Error(err, "Failed to shut down instance before deleting it")
+			return r.retryGracefulShutdown(err, logger, machineScope)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionInstanceShutDown,
+			Status:  metav1.ConditionFalse,
+			Reason:  InstanceShuttingDownReason,
+			Message: "shutting down instance before deleting it",
+		})
+
+	case shutDown.LastTransitionTime.Add(timeout).Before(time.Now()):
+		r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeWarning, InstanceShutdownTimedOutReason, "ShutdownInstance",
+			"Instance %d not offline %s after being shut down, deleting it anyway", instanceID, timeout)
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionInstanceShutDown,
+			Status:  metav1.ConditionFalse,
+			Reason:  InstanceShutdownTimedOutReason,
+			Message: fmt.Sprintf("instance still %s %s after being shut down", linodeInstance.Status, timeout),
+		})
+		return ctrl.Result{}, nil
+
+	default:
+		logger.Info("Waiting for instance shutdown before deleting it", "status", linodeInstance.Status)
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+}
+
+// reconcileNodeBalancerDrain sets the NodeBalancer backends of the instance to drain mode, and waits for the drain
+// duration of the LinodeMachine before letting the instance shut down. A zero result is returned when it is drained.
+func (r *LinodeMachineReconciler) reconcileNodeBalancerDrain(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	drained := linodeMachine.GetCondition(ConditionNodeBalancerDrained)
+	if drained != nil && drained.Status == metav1.ConditionTrue {
+		return ctrl.Result{}, nil
+	}
+
+	if drained == nil {
+		backends, err := drainNodeBalancerNodes(ctx, machineScope)
+		if err != nil {
+			logger.Error(err, "Failed to drain NodeBalancer backends of instance")
+			return r.retryGracefulShutdown(err, logger, machineScope)
+		}
+		linodeMachine.SetCondition(metav1.Condition{
+			Type:    ConditionNodeBalancerDrained,
+			Status:  metav1.ConditionFalse,
+			Reason:  NodeBalancerDrainingReason,
+			Message: fmt.Sprintf("draining %d NodeBalancer backends", backends),
+		})
+		drained = linodeMachine.GetCondition(ConditionNodeBalancerDrained)
+	}
+
+	var drainDuration time.Duration
+	if linodeMachine.Spec.GracefulShutdown.DrainDuration != nil {
+		drainDuration = linodeMachine.Spec.GracefulShutdown.DrainDuration.Duration
+	}
+	if remaining := time.Until(drained.LastTransitionTime.Add(drainDuration)); remaining > 0 {
+		logger.Info("Waiting for NodeBalancer backends to drain", "remaining", remaining)
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+	linodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionNodeBalancerDrained,
+		Status:  metav1.ConditionTrue,
+		Reason:  NodeBalancerDrainedReason,
+		Message: drained.Message,
+	})
+
+	return ctrl.Result{}, nil
+}
+
+// retryGracefulShutdown retries the shutdown of the instance before its deletion until the reconcile timeout, after
+// which the shutdown fails and the instance is deleted anyway.
+func (r *LinodeMachineReconciler) retryGracefulShutdown(err error, logger logr.Logger, machineScope *scope.MachineScope) (ctrl.Result, error) {
+	linodeMachine := machineScope.LinodeMachine
+	if util.IsRetryableError(err) && linodeMachine.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultMachineControllerRetryDelay)).After(time.Now()) {
+		logger.Info("re-queuing Linode instance shutdown")
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+	}
+
+	r.Recorder.Eventf(linodeMachine, nil, corev1.EventTypeWarning, InstanceShutdownFailedReason, "ShutdownInstance",
+		"Failed to shut down instance gracefully before deleting it: %s", err.Error())
+	linodeMachine.SetCondition(metav1.Condition{
+		Type:    ConditionInstanceShutDown,
+		Status:  metav1.ConditionFalse,
+		Reason:  InstanceShutdownFailedReason,
+		Message: err.Error(),
+	})
+
+	return ctrl.Result{}, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *LinodeMachineReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
 	linodeMachineMapper, err := kutil.ClusterToTypedObjectsMapper(
@@ -930,7 +2092,7 @@ func (r *LinodeMachineReconciler) SetupWithManager(mgr ctrl.Manager, options crc
 		return fmt.Errorf("failed to create mapper for LinodeMachines: %w", err)
 	}
 
-	err = ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha2.LinodeMachine{}).
 		WithOptions(options).
 		Watches(
@@ -958,8 +2120,11 @@ func (r *LinodeMachineReconciler) SetupWithManager(mgr ctrl.Manager, options crc
 				}
 				return true
 			}},
-		)).
-		Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+		))
+	if r.EventPoller.Enabled() {
+		b = b.WatchesRawSource(source.Channel(r.EventPoller.MachineEvents(), &handler.EnqueueRequestForObject{}))
+	}
+	err = b.Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
 	if err != nil {
 		return fmt.Errorf("failed to build controller: %w", err)
 	}
@@ -967,6 +2132,16 @@ func (r *LinodeMachineReconciler) SetupWithManager(mgr ctrl.Manager, options crc
 	return nil
 }
 
+// waitForRunningDelay returns the delay before checking again on an instance that is not yet running. When the
+// EventPoller is enabled, Linode events trigger a reconcile as soon as the instance changes, so the delay is only a fallback.
+func (r *LinodeMachineReconciler) waitForRunningDelay() time.Duration {
+	if r.EventPoller.Enabled() {
+		return reconciler.WithJitter(reconciler.DefaultEventPollerFallbackDelay)
+	}
+
+	return reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)
+}
+
 func (r *LinodeMachineReconciler) TracedClient() client.Client {
 	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
 }
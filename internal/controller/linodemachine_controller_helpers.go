@@ -21,11 +21,15 @@ import (
 	"compress/gzip"
 	"context"
 	b64 "encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
 	"net/http"
 	"net/netip"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -55,8 +59,19 @@ import (
 
 const (
 	maxBootstrapDataBytesCloudInit = 16384
+	// ignitionPointerVersion is the Ignition spec version of the Ignition config replaced by the uploaded one.
+	ignitionPointerVersion = "3.3.0"
+
+	// Formats of the bootstrap data, as set by bootstrap providers in the format key of the bootstrap data secret.
+	bootstrapDataFormatCloudConfig = "cloud-config"
+	bootstrapDataFormatIgnition    = "ignition"
+	bootstrapDataFormatTalos       = "talos"
 	vlanIPFormat                   = "%s/11"
 	defaultNodeIPv6CIDRRange       = "/64" // Default IPv6 range for VPC interfaces
+
+	// instanceLimitErrorReason is the reason of the error returned by the Linode API when creating an instance would
+	// exceed the limit of instances of the account.
+	instanceLimitErrorReason = "Account Limit reached"
 )
 
 var (
@@ -67,6 +82,11 @@ var (
 	errNoPublicIPv6Addrs      = errors.New("no public IPv6 address set")
 	errNoPublicIPv6SLAACAddrs = errors.New("no public SLAAC address set")
 
+	errUnsupportedBootstrapDataFormat = errors.New("unsupported bootstrap data format")
+	errBootstrapDataTooLarge          = errors.New("bootstrap data is too large")
+
+	errAdoptionInstanceNotFound = errors.New("no instance matches the adoption selector")
+
 	// We have to account for the default swap in Linodes when calculating the root disk size.
 	// While we don't actually use swap in any of our flavors (we set swapoff), we can't
 	// explicitly set to swap to 0 for any created Linodes because it adds a 90 second hang on
@@ -120,6 +140,15 @@ func fillCreateConfig(ctx context.Context, createConfig *linodego.InstanceCreate
 		createConfig.Label = machineScope.LinodeMachine.Name
 	}
 
+	// The disks of the instance are restored from the backup, no image is deployed.
+	if createConfig.BackupID != 0 {
+		createConfig.Image = ""
+		createConfig.RootPass = ""
+		createConfig.AuthorizedKeys = nil
+		createConfig.AuthorizedUsers = nil
+		return nil
+	}
+
 	if createConfig.Image == "" {
 		createConfig.Image = reconciler.DefaultMachineControllerLinodeImage
 	}
@@ -151,6 +180,20 @@ func newCreateConfig(ctx context.Context, machineScope *scope.MachineScope, gzip
 		return nil, err
 	}
 
+	// Configure image from reference if needed
+	if machineScope.LinodeMachine.Spec.ImageRef != nil {
+		if err := configureImage(ctx, machineScope, createConfig, logger); err != nil {
+			return nil, err
+		}
+	}
+
+	// Configure backup from reference if needed
+	if machineScope.LinodeMachine.Spec.BackupRef != nil {
+		if err := configureBackup(ctx, machineScope, createConfig, logger); err != nil {
+			return nil, err
+		}
+	}
+
 	createConfig.Booted = util.Pointer(false)
 	if err := setUserData(ctx, machineScope, createConfig, gzipCompressionEnabled, logger); err != nil {
 		return nil, err
@@ -173,7 +216,7 @@ func newCreateConfig(ctx context.Context, machineScope *scope.MachineScope, gzip
 	}
 
 	// Configure placement group if needed
-	if machineScope.LinodeMachine.Spec.PlacementGroupRef != nil {
+	if machineScope.LinodeMachine.Spec.PlacementGroupRef != nil || machineFailureDomain(machineScope) != "" {
 		if err := configurePlacementGroup(ctx, machineScope, createConfig, logger); err != nil {
 			return nil, err
 		}
@@ -456,12 +499,49 @@ func requestsForCluster(ctx context.Context, tracedClient client.Client, namespa
 	return result, nil
 }
 
+// machineFailureDomain returns the failure domain that Cluster API assigned to the Machine.
+func machineFailureDomain(machineScope *scope.MachineScope) string {
+	if machineScope.Machine == nil {
+		return ""
+	}
+
+	return machineScope.Machine.Spec.FailureDomain
+}
+
+// getPlacementGroupRef returns the LinodePlacementGroup to place the machine in, which is either set on the
+// LinodeMachine or backs the LinodeCluster failure domain of the Machine.
+func getPlacementGroupRef(machineScope *scope.MachineScope) (corev1.ObjectReference, error) {
+	if machineScope.LinodeMachine.Spec.PlacementGroupRef != nil {
+		ref := *machineScope.LinodeMachine.Spec.PlacementGroupRef
+		if ref.Namespace == "" {
+			ref.Namespace = machineScope.LinodeMachine.Namespace
+		}
+		return ref, nil
+	}
+
+	failureDomain := machineFailureDomain(machineScope)
+	for _, fd := range machineScope.LinodeCluster.Spec.FailureDomains {
+		if fd.Name != failureDomain || fd.PlacementGroupRef == nil {
+			continue
+		}
+		ref := *fd.PlacementGroupRef
+		if ref.Namespace == "" {
+			ref.Namespace = machineScope.LinodeCluster.Namespace
+		}
+		return ref, nil
+	}
+
+	return corev1.ObjectReference{}, fmt.Errorf("failure domain %s is not defined on the LinodeCluster", failureDomain)
+}
+
 func getPlacementGroupID(ctx context.Context, machineScope *scope.MachineScope, logger logr.Logger) (int, error) {
-	name := machineScope.LinodeMachine.Spec.PlacementGroupRef.Name
-	namespace := machineScope.LinodeMachine.Spec.PlacementGroupRef.Namespace
-	if namespace == "" {
-		namespace = machineScope.LinodeMachine.Namespace
+	ref, err := getPlacementGroupRef(machineScope)
+	if err != nil {
+		logger.Error(err, "Failed to get LinodePlacementGroup reference")
+		return -1, err
 	}
+	name := ref.Name
+	namespace := ref.Namespace
 
 	logger = logger.WithValues("placementGroupName", name, "placementGroupNamespace", namespace)
 
@@ -472,7 +552,7 @@ func getPlacementGroupID(ctx context.Context, machineScope *scope.MachineScope,
 		},
 	}
 	objectKey := client.ObjectKeyFromObject(&linodePlacementGroup)
-	err := machineScope.Client.Get(ctx, objectKey, &linodePlacementGroup)
+	err = machineScope.Client.Get(ctx, objectKey, &linodePlacementGroup)
 	if err != nil {
 		logger.Error(err, "Failed to fetch LinodePlacementGroup")
 		return -1, err
@@ -484,6 +564,72 @@ func getPlacementGroupID(ctx context.Context, machineScope *scope.MachineScope,
 	return *linodePlacementGroup.Spec.PGID, nil
 }
 
+func getImageID(ctx context.Context, machineScope *scope.MachineScope, logger logr.Logger) (string, error) {
+	name := machineScope.LinodeMachine.Spec.ImageRef.Name
+	namespace := machineScope.LinodeMachine.Spec.ImageRef.Namespace
+	if namespace == "" {
+		namespace = machineScope.LinodeMachine.Namespace
+	}
+
+	logger = logger.WithValues("imageName", name, "imageNamespace", namespace)
+
+	linodeImage := infrav1alpha2.LinodeImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	objectKey := client.ObjectKeyFromObject(&linodeImage)
+	err := machineScope.Client.Get(ctx, objectKey, &linodeImage)
+	if err != nil {
+		logger.Error(err, "Failed to fetch LinodeImage")
+		return "", err
+	} else if !linodeImage.Status.Ready || linodeImage.Status.ImageID == nil {
+		logger.Info("LinodeImage is not ready")
+		return "", errors.New("image is not ready")
+	}
+
+	for _, region := range linodeImage.Status.Regions {
+		if region.Region == machineScope.LinodeMachine.Spec.Region && region.Status == string(linodego.ImageRegionStatusAvailable) {
+			return *linodeImage.Status.ImageID, nil
+		}
+	}
+
+	return "", fmt.Errorf("image is not available in region %s", machineScope.LinodeMachine.Spec.Region)
+}
+
+func getBackupID(ctx context.Context, machineScope *scope.MachineScope, logger logr.Logger) (int, error) {
+	name := machineScope.LinodeMachine.Spec.BackupRef.Name
+	namespace := machineScope.LinodeMachine.Spec.BackupRef.Namespace
+	if namespace == "" {
+		namespace = machineScope.LinodeMachine.Namespace
+	}
+
+	logger = logger.WithValues("snapshotName", name, "snapshotNamespace", namespace)
+
+	linodeInstanceSnapshot := infrav1alpha2.LinodeInstanceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	objectKey := client.ObjectKeyFromObject(&linodeInstanceSnapshot)
+	err := machineScope.Client.Get(ctx, objectKey, &linodeInstanceSnapshot)
+	if err != nil {
+		logger.Error(err, "Failed to fetch LinodeInstanceSnapshot")
+		return 0, err
+	} else if !linodeInstanceSnapshot.Status.Ready || linodeInstanceSnapshot.Status.BackupID == nil {
+		logger.Info("LinodeInstanceSnapshot is not ready")
+		return 0, errors.New("snapshot is not ready")
+	}
+
+	if linodeInstanceSnapshot.Status.Region != machineScope.LinodeMachine.Spec.Region {
+		return 0, fmt.Errorf("snapshot is in region %s, not %s", linodeInstanceSnapshot.Status.Region, machineScope.LinodeMachine.Spec.Region)
+	}
+
+	return *linodeInstanceSnapshot.Status.BackupID, nil
+}
+
 func getFirewallID(ctx context.Context, machineScope *scope.MachineScope, logger logr.Logger) (int, error) {
 	name := machineScope.LinodeMachine.Spec.FirewallRef.Name
 	namespace := machineScope.LinodeMachine.Spec.FirewallRef.Namespace
@@ -1143,6 +1289,8 @@ func linodeMachineSpecToInstanceCreateConfig(machineSpec infrav1alpha2.LinodeMac
 		FirewallID:          machineSpec.FirewallID,
 		InterfaceGeneration: machineSpec.InterfaceGeneration,
 		DiskEncryption:      linodego.InstanceDiskEncryption(machineSpec.DiskEncryption),
+		BackupID:            machineSpec.BackupID,
+		BackupsEnabled:      machineSpec.BackupsEnabled,
 	}
 
 	if machineSpec.PrivateIP != nil {
@@ -1154,28 +1302,7 @@ func linodeMachineSpecToInstanceCreateConfig(machineSpec infrav1alpha2.LinodeMac
 		// If LinodeInterfaces are specified, the InterfaceGeneration must be GenerationLinode
 		instCreateOpts.InterfaceGeneration = linodego.GenerationLinode
 	} else if len(machineSpec.Interfaces) > 0 {
-		interfaces := make([]linodego.InstanceConfigInterfaceCreateOptions, len(machineSpec.Interfaces))
-		for idx, iface := range machineSpec.Interfaces {
-			interfaces[idx] = linodego.InstanceConfigInterfaceCreateOptions{
-				IPAMAddress: iface.IPAMAddress,
-				Label:       iface.Label,
-				Purpose:     iface.Purpose,
-				Primary:     iface.Primary,
-				SubnetID:    iface.SubnetID,
-				IPRanges:    iface.IPRanges,
-			}
-			if iface.IPv4 != nil {
-				var NAT1to1 *string
-				if iface.IPv4.NAT1To1 != "" {
-					NAT1to1 = &iface.IPv4.NAT1To1
-				}
-				interfaces[idx].IPv4 = &linodego.VPCIPv4CreateOptions{
-					VPC:     iface.IPv4.VPC,
-					NAT1To1: NAT1to1,
-				}
-			}
-		}
-		instCreateOpts.Interfaces = interfaces
+		instCreateOpts.Interfaces = constructInstanceConfigInterfaceCreateOpts(machineSpec.Interfaces)
 		// If Interfaces are specified, the InterfaceGeneration must be GenerationLegacyConfig
 		instCreateOpts.InterfaceGeneration = linodego.GenerationLegacyConfig
 	}
@@ -1183,6 +1310,60 @@ func linodeMachineSpecToInstanceCreateConfig(machineSpec infrav1alpha2.LinodeMac
 	return instCreateOpts
 }
 
+func constructInstanceConfigInterfaceCreateOpts(createOpts []infrav1alpha2.InstanceConfigInterfaceCreateOptions) []linodego.InstanceConfigInterfaceCreateOptions {
+	interfaces := make([]linodego.InstanceConfigInterfaceCreateOptions, len(createOpts))
+	for idx, iface := range createOpts {
+		interfaces[idx] = linodego.InstanceConfigInterfaceCreateOptions{
+			IPAMAddress: iface.IPAMAddress,
+			Label:       iface.Label,
+			Purpose:     iface.Purpose,
+			Primary:     iface.Primary,
+			SubnetID:    iface.SubnetID,
+			IPRanges:    iface.IPRanges,
+		}
+		if iface.IPv4 != nil {
+			var NAT1to1 *string
+			if iface.IPv4.NAT1To1 != "" {
+				NAT1to1 = &iface.IPv4.NAT1To1
+			}
+			interfaces[idx].IPv4 = &linodego.VPCIPv4CreateOptions{
+				VPC:     iface.IPv4.VPC,
+				NAT1To1: NAT1to1,
+			}
+		}
+	}
+
+	return interfaces
+}
+
+// instanceConfigInterfacesToCreateOptions converts the interfaces of an instance configuration so that they can be
+// re-created with the same addresses.
+func instanceConfigInterfacesToCreateOptions(interfaces []linodego.InstanceConfigInterface) []infrav1alpha2.InstanceConfigInterfaceCreateOptions {
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	createOpts := make([]infrav1alpha2.InstanceConfigInterfaceCreateOptions, len(interfaces))
+	for idx, iface := range interfaces {
+		createOpts[idx] = infrav1alpha2.InstanceConfigInterfaceCreateOptions{
+			IPAMAddress: iface.IPAMAddress,
+			Label:       iface.Label,
+			Purpose:     iface.Purpose,
+			Primary:     iface.Primary,
+			SubnetID:    iface.SubnetID,
+			IPRanges:    iface.IPRanges,
+		}
+		if iface.IPv4 != nil {
+			createOpts[idx].IPv4 = &infrav1alpha2.VPCIPv4{
+				VPC:     iface.IPv4.VPC,
+				NAT1To1: ptr.Deref(iface.IPv4.NAT1To1, ""),
+			}
+		}
+	}
+
+	return createOpts
+}
+
 func compressUserData(bootstrapData []byte) ([]byte, error) {
 	var userDataBuff bytes.Buffer
 	var err error
@@ -1210,12 +1391,39 @@ func setUserData(ctx context.Context, machineScope *scope.MachineScope, createCo
 	return nil
 }
 
+// bootstrapDataDelivery describes how the bootstrap data of a format is delivered to an instance.
+type bootstrapDataDelivery struct {
+	// compressible is true if the bootstrap data can be delivered gzip compressed.
+	compressible bool
+	// pointer returns the bootstrap data fetching the one uploaded to the Cluster Object Store at the given URL.
+	// It is nil if bootstrap data of the format can't be fetched, in which case it must be delivered directly.
+	pointer func(name, url string) ([]byte, error)
+}
+
+// bootstrapDataDeliveries are the bootstrap data formats supported by the machine controller. Bootstrap data without
+// format is cloud-config. Talos machine configs can't include another config, and Talos only fetches its config from a
+// URL set with the talos.config kernel parameter, which is part of the image as instances have no kernel command line
+// of their own, so they are always delivered directly.
+var bootstrapDataDeliveries = map[string]bootstrapDataDelivery{
+	bootstrapDataFormatCloudConfig: {compressible: true, pointer: cloudConfigPointer},
+	bootstrapDataFormatIgnition:    {pointer: ignitionPointer},
+	bootstrapDataFormatTalos:       {},
+}
+
 func resolveBootstrapData(ctx context.Context, machineScope *scope.MachineScope, gzipCompressionEnabled bool, logger logr.Logger) ([]byte, error) {
-	bootstrapdata, err := machineScope.GetBootstrapData(ctx)
+	bootstrapdata, format, err := machineScope.GetBootstrapDataWithFormat(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if format == "" {
+		format = bootstrapDataFormatCloudConfig
+	}
+	delivery, ok := bootstrapDataDeliveries[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnsupportedBootstrapDataFormat, format)
+	}
+
 	var (
 		size       = len(bootstrapdata)
 		compressed []byte
@@ -1232,7 +1440,7 @@ func resolveBootstrapData(ctx context.Context, machineScope *scope.MachineScope,
 	case size < limit:
 		return bootstrapdata, nil
 	// Compromise case (Metadata): Use compression.
-	case gzipCompressionEnabled:
+	case gzipCompressionEnabled && delivery.compressible:
 		if compressed, err = compressUserData(bootstrapdata); err != nil {
 			// Break and use the Cluster Object Store workaround on compression failure.
 			logger.Info(fmt.Sprintf("Failed to compress bootstrap data: %v. Using Cluster Object Store instead.", err))
@@ -1245,23 +1453,64 @@ func resolveBootstrapData(ctx context.Context, machineScope *scope.MachineScope,
 		}
 	}
 
-	// Worst case: Upload to Cluster Object Store.
-	logger.Info("decoded bootstrap data exceeds size limit", "limit", limit, "size", size)
+	// Worst case: Upload to Cluster Object Store or serve from the bootstrap data endpoint.
+	logger.Info("decoded bootstrap data exceeds size limit", "limit", limit, "size", size, "format", format)
+
+	if delivery.pointer == nil {
+		return nil, fmt.Errorf("%w: %s bootstrap data of %d bytes exceeds the limit of %d bytes", errBootstrapDataTooLarge, format, size, limit)
+	}
+
+	// Make the original bootstrap data available.
+	url, err := storeBootstrapData(ctx, machineScope, bootstrapdata, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Format a "pointer" to the stored bootstrap data.
+	return delivery.pointer(string(machineScope.LinodeMachine.UID), url)
+}
+
+// storeBootstrapData makes the bootstrap data available with the delivery mechanism of the cluster and returns the
+// URL to fetch it from.
+func storeBootstrapData(ctx context.Context, machineScope *scope.MachineScope, bootstrapdata []byte, logger logr.Logger) (string, error) {
+	if machineScope.LinodeCluster.Spec.BootstrapDataDelivery == infrav1alpha2.BootstrapDataDeliveryEndpoint {
+		if machineScope.BootstrapDataEndpoint == nil {
+			return "", errors.New("must enable the bootstrap data endpoint of the manager to bootstrap linodemachine")
+		}
+
+		logger.Info("Serving bootstrap data from the bootstrap data endpoint")
+
+		url, err := machineScope.BootstrapDataEndpoint.Store(ctx, machineScope.LinodeMachine, bootstrapdata)
+		if err != nil {
+			return "", fmt.Errorf("store bootstrap data: %w", err)
+		}
+
+		return url, nil
+	}
 
 	if machineScope.LinodeCluster.Spec.ObjectStore == nil {
-		return nil, errors.New("must enable cluster object store feature to bootstrap linodemachine")
+		return "", errors.New("must enable cluster object store feature to bootstrap linodemachine")
 	}
 
 	logger.Info("Uploading bootstrap data the Cluster Object Store")
 
-	// Upload the original bootstrap data.
 	url, err := services.CreateObject(ctx, machineScope, bootstrapdata)
 	if err != nil {
-		return nil, fmt.Errorf("upload bootstrap data: %w", err)
+		return "", fmt.Errorf("upload bootstrap data: %w", err)
 	}
 
-	// Format a "pointer" cloud-config.
-	tmpl, err := template.New(string(machineScope.LinodeMachine.UID)).Parse(cloudConfigTemplate)
+	return url, nil
+}
+
+// isTerminalBootstrapDataError returns true if the bootstrap data can't be delivered to an instance until the
+// bootstrap provider changes it.
+func isTerminalBootstrapDataError(err error) bool {
+	return errors.Is(err, errUnsupportedBootstrapDataFormat) || errors.Is(err, errBootstrapDataTooLarge)
+}
+
+// cloudConfigPointer returns a cloud-config including the cloud-config at the given URL.
+func cloudConfigPointer(name, url string) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(cloudConfigTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("parse cloud-config template: %w", err)
 	}
@@ -1270,11 +1519,33 @@ func resolveBootstrapData(ctx context.Context, machineScope *scope.MachineScope,
 		return nil, fmt.Errorf("execute cloud-config template: %w", err)
 	}
 
-	return config.Bytes(), err
+	return config.Bytes(), nil
+}
+
+// ignitionPointer returns an Ignition config replaced by the Ignition config at the given URL.
+func ignitionPointer(_, url string) ([]byte, error) {
+	config, err := json.Marshal(map[string]any{
+		"ignition": map[string]any{
+			"version": ignitionPointerVersion,
+			"config": map[string]any{
+				"replace": map[string]string{"source": url},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal ignition config: %w", err)
+	}
+
+	return config, nil
 }
 
 // This *may* need to revisit w.r.t. rate-limits for shared(?) buckets 🤷‍♀️
 func deleteBootstrapData(ctx context.Context, machineScope *scope.MachineScope) error {
+	if machineScope.BootstrapDataEndpoint != nil {
+		if err := machineScope.BootstrapDataEndpoint.Revoke(ctx, machineScope.LinodeMachine); err != nil {
+			return err
+		}
+	}
 	if machineScope.LinodeCluster.Spec.ObjectStore != nil {
 		return services.DeleteObject(ctx, machineScope)
 	}
@@ -1446,6 +1717,103 @@ func calculateRootDisk(ctx context.Context, machineScope *scope.MachineScope) (*
 	return ptr.To(diskSize), nil
 }
 
+// resizeRootDisk shrinks the root disk of a rebuilt instance to make room for its data disks, since a rebuild
+// re-creates the root disk with all of the space of the plan.
+func resizeRootDisk(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstanceID int) error {
+	diskSize, err := calculateRootDisk(ctx, machineScope)
+	if err != nil || diskSize == nil {
+		return err
+	}
+
+	instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstanceID)
+	if err != nil {
+		return err
+	}
+	if instanceConfig.Devices == nil || instanceConfig.Devices.SDA == nil || instanceConfig.Devices.SDA.DiskID == 0 {
+		return errors.New("default instance configuration has no root disk")
+	}
+
+	logger.Info("resizing root disk", "diskID", instanceConfig.Devices.SDA.DiskID, "size", *diskSize)
+	return machineScope.LinodeClient.ResizeInstanceDisk(ctx, linodeInstanceID, instanceConfig.Devices.SDA.DiskID, linodego.InstanceDiskResizeOptions{
+		Size: *diskSize,
+	})
+}
+
+// restoreInstanceConfig restores the interfaces captured before a rebuild and the devices of the attached Volumes
+// in the new default configuration of the instance.
+func restoreInstanceConfig(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstanceID int) error {
+	instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstanceID)
+	if err != nil {
+		return err
+	}
+	if instanceConfig.Devices == nil {
+		instanceConfig.Devices = &linodego.InstanceConfigDeviceMap{}
+	}
+
+	devicesChanged := false
+	for _, volumeRef := range machineScope.LinodeMachine.Spec.VolumeRefs {
+		linodeVolume, err := getLinodeVolume(ctx, machineScope, volumeRef)
+		if err != nil {
+			return err
+		}
+		if linodeVolume.Spec.VolumeID == nil {
+			continue
+		}
+		if addVolumeDevice(instanceConfig.Devices, *linodeVolume.Spec.VolumeID) {
+			devicesChanged = true
+		}
+	}
+
+	configData := linodego.InstanceConfigUpdateOptions{}
+	if devicesChanged {
+		configData.Devices = instanceConfig.Devices
+	}
+	if len(machineScope.LinodeMachine.Status.RebuildInterfaces) > 0 {
+		configData.Interfaces = constructInstanceConfigInterfaceCreateOpts(machineScope.LinodeMachine.Status.RebuildInterfaces)
+	}
+	if configData.Devices == nil && configData.Interfaces == nil {
+		return nil
+	}
+
+	logger.Info("restoring instance configuration after rebuild", "configID", instanceConfig.ID)
+	_, err = machineScope.LinodeClient.UpdateInstanceConfig(ctx, linodeInstanceID, instanceConfig.ID, configData)
+	return err
+}
+
+// addVolumeDevice adds a Volume to the first free device of a configuration, and reports whether the devices changed.
+func addVolumeDevice(devices *linodego.InstanceConfigDeviceMap, volumeID int) bool {
+	slots := []**linodego.InstanceConfigDevice{
+		&devices.SDB, &devices.SDC, &devices.SDD, &devices.SDE, &devices.SDF, &devices.SDG, &devices.SDH,
+	}
+	for _, slot := range slots {
+		if *slot != nil && (*slot).VolumeID == volumeID {
+			return false
+		}
+	}
+	for _, slot := range slots {
+		if *slot == nil || (*slot).DiskID == 0 && (*slot).VolumeID == 0 {
+			*slot = &linodego.InstanceConfigDevice{VolumeID: volumeID}
+			return true
+		}
+	}
+
+	return false
+}
+
+// clearDataDiskIDs forgets the IDs of the data disks so that they are created again.
+func clearDataDiskIDs(instanceDisks *infrav1alpha2.InstanceDisks) {
+	if instanceDisks == nil {
+		return
+	}
+	for _, disk := range []*infrav1alpha2.InstanceDisk{
+		instanceDisks.SDB, instanceDisks.SDC, instanceDisks.SDD, instanceDisks.SDE, instanceDisks.SDF, instanceDisks.SDG, instanceDisks.SDH,
+	} {
+		if disk != nil {
+			disk.DiskID = 0
+		}
+	}
+}
+
 func updateInstanceConfigProfile(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstanceID int) error {
 	// get the default instance config
 	configs, err := machineScope.LinodeClient.ListInstanceConfigs(ctx, linodeInstanceID, &linodego.ListOptions{})
@@ -1474,91 +1842,1017 @@ func getDefaultInstanceConfig(ctx context.Context, machineScope *scope.MachineSc
 	return configs[0], nil
 }
 
-// createInstance provisions linode instance after checking if the request will be within the rate-limits
-// Note:
-//  1. this method represents the critical section. It takes a lock before checking for the rate limits and releases it after making request to linode API or when returning from function
-//  2. returned time duration here is not always used.
-//     a) In case of an error, we calculate for how long to requeue in method which checks if its a transient error or not.
-//     b) If POST limit is reached, only then the returned time duration is used to retry after that time has elapsed.
-func createInstance(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, createOpts *linodego.InstanceCreateOptions) (*linodego.Instance, time.Duration, error) {
-	ctr := util.GetPostReqCounter(machineScope.TokenHash)
-	ctr.Mu.Lock()
-	defer ctr.Mu.Unlock()
-
-	if ctr.IsPOSTLimitReached() {
-		logger.Info(fmt.Sprintf("Cannot make more POST requests as rate-limit is reached. Waiting and retrying after %v seconds", ctr.RetryAfter()))
-		return nil, ctr.RetryAfter(), util.ErrRateLimit
+// getAdoptionSelector returns the selector of the instance to adopt from the adoptInstance field of the LinodeMachine,
+// or else from its AdoptInstanceAnnotation. It returns nil when no adoption is requested.
+func getAdoptionSelector(linodeMachine *infrav1alpha2.LinodeMachine) *infrav1alpha2.InstanceAdoptionSelector {
+	if linodeMachine.Spec.AdoptInstance != nil {
+		return linodeMachine.Spec.AdoptInstance
+	}
+	value := linodeMachine.Annotations[infrav1alpha2.AdoptInstanceAnnotation]
+	if value == "" {
+		return nil
+	}
+	// Instance labels must start with a letter, so a number is always an ID.
+	if id, err := strconv.Atoi(value); err == nil {
+		return &infrav1alpha2.InstanceAdoptionSelector{ID: &id}
 	}
 
-	machineScope.LinodeClient.OnAfterResponse(ctr.ApiResponseRatelimitCounter)
-	inst, err := machineScope.LinodeClient.CreateInstance(ctx, *createOpts)
-
-	// if instance already exists, we get 400 response. get respective instance and return
-	if linodego.ErrHasStatus(err, http.StatusBadRequest) && strings.Contains(err.Error(), "Label must be unique") {
-		logger.Error(err, "Failed to create instance, received [400 BadRequest] response.")
+	return &infrav1alpha2.InstanceAdoptionSelector{Label: value}
+}
 
-		// check if instance already exists
-		listFilter := util.Filter{Label: createOpts.Label}
-		filter, errFilter := listFilter.String()
-		if errFilter != nil {
-			logger.Error(err, "Failed to create filter to list instance")
-			return nil, ctr.RetryAfter(), err
-		}
-		instances, listErr := machineScope.LinodeClient.ListInstances(ctx, linodego.NewListOptions(1, filter))
-		if listErr != nil {
-			return nil, ctr.RetryAfter(), listErr
-		}
-		if len(instances) > 0 {
-			return &instances[0], ctr.RetryAfter(), nil
-		}
+// findAdoptionInstance returns the instance selected for adoption.
+func findAdoptionInstance(ctx context.Context, machineScope *scope.MachineScope, selector *infrav1alpha2.InstanceAdoptionSelector) (*linodego.Instance, error) {
+	filter, err := util.Filter{ID: selector.ID, Label: selector.Label}.String()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := machineScope.LinodeClient.ListInstances(ctx, linodego.NewListOptions(1, filter))
+	if err != nil {
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+	if len(instances) == 0 {
+		return nil, errAdoptionInstanceNotFound
 	}
 
-	return inst, ctr.RetryAfter(), err
+	return &instances[0], nil
 }
 
-// getVPCRefFromScope returns the appropriate VPC reference based on priority:
-// 1. Machine-level VPC reference
-// 2. Cluster-level VPC reference
-func getVPCRefFromScope(machineScope *scope.MachineScope) *corev1.ObjectReference {
-	if machineScope.LinodeMachine.Spec.VPCRef != nil {
-		return machineScope.LinodeMachine.Spec.VPCRef
+// adoptionConflict returns why the instance selected for adoption belongs to another LinodeMachine,
+// LinodeMachinePoolMachine, cluster or management cluster, or an empty string if it doesn't.
+func adoptionConflict(ctx context.Context, machineScope *scope.MachineScope, linodeInstance *linodego.Instance) (string, error) {
+	// The owner tag would be replaced on adoption, taking the instance away from the garbage collector of its
+	// management cluster.
+	if tag := util.ForeignOwnerTag(linodeInstance.Tags, machineScope.ManagementClusterID); tag != "" {
+		return fmt.Sprintf("instance %d is owned by another management cluster: %s", linodeInstance.ID, tag), nil
 	}
-	return machineScope.LinodeCluster.Spec.VPCRef
-}
 
-// configureVlanInterface adds a VLAN interface to the configuration
-func configureVlanInterface(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
-	switch {
-	case createConfig.LinodeInterfaces != nil || (createConfig.LinodeInterfaces == nil && machineScope.LinodeMachine.Spec.InterfaceGeneration == linodego.GenerationLinode):
-		iface, err := getVlanLinodeInterfaceConfig(ctx, machineScope, createConfig.LinodeInterfaces, logger)
-		if err != nil {
-			logger.Error(err, "Failed to get VLAN interface config")
-			return err
-		}
+	providerID := fmt.Sprintf("linode://%d", linodeInstance.ID)
 
-		if iface != nil {
-			// add VLAN interface as first interface
-			createConfig.LinodeInterfaces = slices.Insert(createConfig.LinodeInterfaces, 0, *iface)
+	var linodeMachines infrav1alpha2.LinodeMachineList
+	if err := machineScope.Client.List(ctx, &linodeMachines); err != nil {
+		return "", fmt.Errorf("list LinodeMachines: %w", err)
+	}
+	for _, linodeMachine := range linodeMachines.Items {
+		if linodeMachine.UID != machineScope.LinodeMachine.UID && ptr.Deref(linodeMachine.Spec.ProviderID, "") == providerID {
+			return fmt.Sprintf("instance %d is already used by LinodeMachine %s/%s", linodeInstance.ID, linodeMachine.Namespace, linodeMachine.Name), nil
 		}
-	default:
-		iface, err := getVlanInterfaceConfig(ctx, machineScope, createConfig.Interfaces, logger)
-		if err != nil {
-			logger.Error(err, "Failed to get VLAN interface config")
-			return err
+	}
+
+	var poolMachines infrav1alpha2.LinodeMachinePoolMachineList
+	if err := machineScope.Client.List(ctx, &poolMachines); err != nil {
+		return "", fmt.Errorf("list LinodeMachinePoolMachines: %w", err)
+	}
+	for _, poolMachine := range poolMachines.Items {
+		if ptr.Deref(poolMachine.Spec.ProviderID, "") == providerID {
+			return fmt.Sprintf("instance %d is already used by LinodeMachinePoolMachine %s/%s", linodeInstance.ID, poolMachine.Namespace, poolMachine.Name), nil
 		}
+	}
 
-		if iface != nil {
-			// add VLAN interface as first interface
-			createConfig.Interfaces = slices.Insert(createConfig.Interfaces, 0, *iface)
+	// Instances are tagged with the name of their cluster.
+	var linodeClusters infrav1alpha2.LinodeClusterList
+	if err := machineScope.Client.List(ctx, &linodeClusters); err != nil {
+		return "", fmt.Errorf("list LinodeClusters: %w", err)
+	}
+	for _, linodeCluster := range linodeClusters.Items {
+		if linodeCluster.Name != machineScope.LinodeCluster.Name && slices.Contains(linodeInstance.Tags, linodeCluster.Name) {
+			return fmt.Sprintf("instance %d is tagged for LinodeCluster %s/%s", linodeInstance.ID, linodeCluster.Namespace, linodeCluster.Name), nil
 		}
 	}
 
-	return nil
+	return "", nil
 }
 
-// configurePlacementGroup adds placement group configuration
-func configurePlacementGroup(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
-	pgID, err := getPlacementGroupID(ctx, machineScope, logger)
+// adoptionDiffs returns how the instance selected for adoption differs from the LinodeMachine.
+func adoptionDiffs(ctx context.Context, machineScope *scope.MachineScope, linodeInstance *linodego.Instance, instanceConfig linodego.InstanceConfig) ([]string, error) {
+	spec := machineScope.LinodeMachine.Spec
+
+	var diffs []string
+	if linodeInstance.Region != spec.Region {
+		diffs = append(diffs, fmt.Sprintf("region is %s, not %s", linodeInstance.Region, spec.Region))
+	}
+	if linodeInstance.Type != spec.Type {
+		diffs = append(diffs, fmt.Sprintf("type is %s, not %s", linodeInstance.Type, spec.Type))
+	}
+
+	interfaceDiff, err := adoptionInterfaceDiff(ctx, machineScope, linodeInstance, instanceConfig)
+	if err != nil {
+		return nil, err
+	}
+	if interfaceDiff != "" {
+		diffs = append(diffs, interfaceDiff)
+	}
+
+	for _, disk := range machineDisks(spec, instanceConfig.Devices) {
+		switch {
+		case disk.attached == nil || disk.attached.DiskID == 0:
+			diffs = append(diffs, fmt.Sprintf("no disk is attached as %s", disk.device))
+		case disk.disk.DiskID != 0 && disk.disk.DiskID != disk.attached.DiskID:
+			diffs = append(diffs, fmt.Sprintf("disk %d is attached as %s, not %d", disk.attached.DiskID, disk.device, disk.disk.DiskID))
+		}
+	}
+
+	return diffs, nil
+}
+
+// adoptionInterfaceDiff describes how the interfaces of the instance selected for adoption differ from the ones of
+// the LinodeMachine. Only the interface generation and the kinds of the interfaces are compared, and only when the
+// LinodeMachine lists interfaces.
+func adoptionInterfaceDiff(ctx context.Context, machineScope *scope.MachineScope, linodeInstance *linodego.Instance, instanceConfig linodego.InstanceConfig) (string, error) {
+	spec := machineScope.LinodeMachine.Spec
+
+	generation := spec.InterfaceGeneration
+	if len(spec.LinodeInterfaces) > 0 {
+		generation = linodego.GenerationLinode
+	}
+	if generation == "" {
+		generation = linodego.GenerationLegacyConfig
+	}
+	instanceGeneration := linodeInstance.InterfaceGeneration
+	if instanceGeneration == "" {
+		instanceGeneration = linodego.GenerationLegacyConfig
+	}
+	if generation != instanceGeneration {
+		return fmt.Sprintf("interface generation is %s, not %s", instanceGeneration, generation), nil
+	}
+
+	var desired, actual []string
+	switch {
+	case generation == linodego.GenerationLinode && len(spec.LinodeInterfaces) > 0:
+		interfaces, err := machineScope.LinodeClient.ListInterfaces(ctx, linodeInstance.ID, nil)
+		if err != nil {
+			return "", fmt.Errorf("list interfaces: %w", err)
+		}
+		for _, iface := range spec.LinodeInterfaces {
+			desired = append(desired, linodeInterfaceKind(iface.Public != nil, iface.VPC != nil, iface.VLAN != nil))
+		}
+		for _, iface := range interfaces {
+			actual = append(actual, linodeInterfaceKind(iface.Public != nil, iface.VPC != nil, iface.VLAN != nil))
+		}
+	case generation == linodego.GenerationLegacyConfig && len(spec.Interfaces) > 0:
+		for _, iface := range spec.Interfaces {
+			desired = append(desired, string(iface.Purpose))
+		}
+		for _, iface := range instanceConfig.Interfaces {
+			actual = append(actual, string(iface.Purpose))
+		}
+	}
+	if !slices.Equal(desired, actual) {
+		return fmt.Sprintf("interfaces are [%s], not [%s]", strings.Join(actual, ", "), strings.Join(desired, ", ")), nil
+	}
+
+	return "", nil
+}
+
+func linodeInterfaceKind(public, vpc, vlan bool) string {
+	switch {
+	case public:
+		return "public"
+	case vpc:
+		return "vpc"
+	case vlan:
+		return "vlan"
+	default:
+		return "unknown"
+	}
+}
+
+// interfaceChanges are the changes to make to the network interfaces of an instance so that they match its LinodeMachine.
+type interfaceChanges struct {
+	// create, update and delete are the changes to the Linode interfaces of the instance.
+	create []linodego.LinodeInterfaceCreateOptions
+	update map[int]linodego.LinodeInterfaceUpdateOptions
+	delete []int
+
+	// config is the legacy configuration of the instance, whose interfaces are replaced by configInterfaces when set.
+	config           *linodego.InstanceConfig
+	configInterfaces []linodego.InstanceConfigInterfaceCreateOptions
+}
+
+func (c interfaceChanges) empty() bool {
+	return len(c.create) == 0 && len(c.update) == 0 && len(c.delete) == 0 && c.config == nil
+}
+
+// requireShutdown returns whether the instance must be shut down to make the changes. Linode interfaces can only be
+// created and deleted while the instance is offline, and the interfaces of a configuration take effect when it boots.
+func (c interfaceChanges) requireShutdown() bool {
+	return len(c.create) > 0 || len(c.delete) > 0 || c.config != nil
+}
+
+func (c interfaceChanges) String() string {
+	if c.config != nil {
+		return fmt.Sprintf("replaced the interfaces of configuration %d with %d interfaces", c.config.ID, len(c.configInterfaces))
+	}
+	return fmt.Sprintf("created %d, updated %d and deleted %d interfaces", len(c.create), len(c.update), len(c.delete))
+}
+
+// apply makes the changes to the interfaces of the instance. Interfaces are deleted before others are created, so that
+// an interface can be replaced by another one of the same kind.
+func (c interfaceChanges) apply(ctx context.Context, machineScope *scope.MachineScope, instanceID int) error {
+	for _, interfaceID := range c.delete {
+		if err := util.IgnoreLinodeAPIError(machineScope.LinodeClient.DeleteInterface(ctx, instanceID, interfaceID), http.StatusNotFound); err != nil {
+			return fmt.Errorf("delete interface %d: %w", interfaceID, err)
+		}
+	}
+	for _, interfaceID := range slices.Sorted(maps.Keys(c.update)) {
+		if _, err := machineScope.LinodeClient.UpdateInterface(ctx, instanceID, interfaceID, c.update[interfaceID]); err != nil {
+			return fmt.Errorf("update interface %d: %w", interfaceID, err)
+		}
+	}
+	for _, opts := range c.create {
+		if _, err := machineScope.LinodeClient.CreateInterface(ctx, instanceID, opts); err != nil {
+			return fmt.Errorf("create interface: %w", err)
+		}
+	}
+	if c.config != nil {
+		updateOpts := c.config.GetUpdateOptions()
+		updateOpts.Interfaces = c.configInterfaces
+		if _, err := machineScope.LinodeClient.UpdateInstanceConfig(ctx, instanceID, c.config.ID, updateOpts); err != nil {
+			return fmt.Errorf("update configuration %d: %w", c.config.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// getInterfaceChanges returns the changes to make to the network interfaces of the instance so that they match the
+// ones it would be created with. Nothing is changed when the LinodeMachine doesn't define any interface, in which case
+// the instance uses the default interfaces of the Linode API, or when its interfaces are of another generation.
+func getInterfaceChanges(ctx context.Context, machineScope *scope.MachineScope, linodeInstance *linodego.Instance, logger logr.Logger) (interfaceChanges, error) {
+	if machineInterfaceGeneration(machineScope.LinodeMachine.Spec) != instanceInterfaceGeneration(linodeInstance) {
+		return interfaceChanges{}, nil
+	}
+
+	if linodeInstance.InterfaceGeneration == linodego.GenerationLinode {
+		actual, err := machineScope.LinodeClient.ListInterfaces(ctx, linodeInstance.ID, &linodego.ListOptions{})
+		if err != nil {
+			return interfaceChanges{}, fmt.Errorf("list interfaces: %w", err)
+		}
+		hasVLAN := slices.ContainsFunc(actual, func(iface linodego.LinodeInterface) bool { return iface.VLAN != nil })
+		desired, err := desiredInterfaces(ctx, machineScope, hasVLAN, logger)
+		if err != nil || len(desired.LinodeInterfaces) == 0 {
+			return interfaceChanges{}, err
+		}
+		changes := diffLinodeInterfaces(desired.LinodeInterfaces, actual)
+		if err := setCreatedInterfacesFirewall(ctx, machineScope, changes.create, logger); err != nil {
+			return interfaceChanges{}, err
+		}
+		return changes, nil
+	}
+
+	instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstance.ID)
+	if err != nil {
+		return interfaceChanges{}, err
+	}
+	hasVLAN := slices.ContainsFunc(instanceConfig.Interfaces, func(iface linodego.InstanceConfigInterface) bool {
+		return iface.Purpose == linodego.InterfacePurposeVLAN
+	})
+	desired, err := desiredInterfaces(ctx, machineScope, hasVLAN, logger)
+	if err != nil || len(desired.Interfaces) == 0 {
+		return interfaceChanges{}, err
+	}
+	configInterfaces, changed := mergeInstanceConfigInterfaces(desired.Interfaces, instanceConfig.Interfaces)
+	if !changed {
+		return interfaceChanges{}, nil
+	}
+
+	return interfaceChanges{config: &instanceConfig, configInterfaces: configInterfaces}, nil
+}
+
+// desiredInterfaces returns the network interfaces the instance of the LinodeMachine would be created with, including
+// the VPC and VLAN interfaces. No VLAN address is allocated when the instance already has a VLAN interface, which
+// keeps its address.
+func desiredInterfaces(ctx context.Context, machineScope *scope.MachineScope, hasVLAN bool, logger logr.Logger) (*linodego.InstanceCreateOptions, error) {
+	createConfig := linodeMachineSpecToInstanceCreateConfig(machineScope.LinodeMachine.Spec, nil)
+	if err := configureVPCInterface(ctx, machineScope, createConfig, logger); err != nil {
+		return nil, err
+	}
+
+	if !machineScope.LinodeCluster.Spec.Network.UseVlan {
+		return createConfig, nil
+	}
+	if !hasVLAN {
+		if err := configureVlanInterface(ctx, machineScope, createConfig, logger); err != nil {
+			return nil, err
+		}
+		return createConfig, nil
+	}
+	if createConfig.LinodeInterfaces != nil || machineScope.LinodeMachine.Spec.InterfaceGeneration == linodego.GenerationLinode {
+		if !slices.ContainsFunc(createConfig.LinodeInterfaces, func(iface linodego.LinodeInterfaceCreateOptions) bool { return iface.VLAN != nil }) {
+			createConfig.LinodeInterfaces = slices.Insert(createConfig.LinodeInterfaces, 0, linodego.LinodeInterfaceCreateOptions{
+				VLAN: &linodego.VLANInterfaceCreateOptions{VLANLabel: machineScope.Cluster.Name},
+			})
+		}
+	} else if !slices.ContainsFunc(createConfig.Interfaces, func(iface linodego.InstanceConfigInterfaceCreateOptions) bool {
+		return iface.Purpose == linodego.InterfacePurposeVLAN
+	}) {
+		createConfig.Interfaces = slices.Insert(createConfig.Interfaces, 0, linodego.InstanceConfigInterfaceCreateOptions{
+			Purpose: linodego.InterfacePurposeVLAN,
+			Label:   machineScope.Cluster.Name,
+		})
+	}
+
+	return createConfig, nil
+}
+
+// setCreatedInterfacesFirewall sets the firewall of the LinodeMachine on the Linode interfaces to create, since they
+// don't inherit the firewall of the instance. VLAN interfaces don't support firewalls.
+func setCreatedInterfacesFirewall(ctx context.Context, machineScope *scope.MachineScope, create []linodego.LinodeInterfaceCreateOptions, logger logr.Logger) error {
+	spec := machineScope.LinodeMachine.Spec
+	if len(create) == 0 || (spec.FirewallID == 0 && spec.FirewallRef == nil) {
+		return nil
+	}
+
+	firewallID := spec.FirewallID
+	if firewallID == 0 {
+		var err error
+		if firewallID, err = getFirewallID(ctx, machineScope, logger); err != nil {
+			return err
+		}
+	}
+	for i := range create {
+		if create[i].VLAN == nil && create[i].FirewallID == nil {
+			create[i].FirewallID = ptr.To(firewallID)
+		}
+	}
+
+	return nil
+}
+
+// instanceInterfaceGeneration returns the generation of the network interfaces of an instance.
+func instanceInterfaceGeneration(linodeInstance *linodego.Instance) linodego.InterfaceGeneration {
+	if linodeInstance.InterfaceGeneration == "" {
+		return linodego.GenerationLegacyConfig
+	}
+	return linodeInstance.InterfaceGeneration
+}
+
+// machineInterfaceGeneration returns the generation of the network interfaces of a LinodeMachine.
+func machineInterfaceGeneration(spec infrav1alpha2.LinodeMachineSpec) linodego.InterfaceGeneration {
+	if len(spec.LinodeInterfaces) > 0 || spec.InterfaceGeneration == linodego.GenerationLinode {
+		return linodego.GenerationLinode
+	}
+	return linodego.GenerationLegacyConfig
+}
+
+// diffLinodeInterfaces returns the changes to make to the Linode interfaces of an instance so that they match the
+// desired ones. Public interfaces match each other, VPC interfaces match by subnet and VLAN interfaces by label.
+// Matching interfaces are only updated when their default route or the addresses set explicitly differ.
+func diffLinodeInterfaces(desired []linodego.LinodeInterfaceCreateOptions, actual []linodego.LinodeInterface) interfaceChanges {
+	changes := interfaceChanges{update: map[int]linodego.LinodeInterfaceUpdateOptions{}}
+	matched := make([]bool, len(actual))
+	for _, want := range desired {
+		found := false
+		for i, have := range actual {
+			if matched[i] || !linodeInterfaceMatches(want, have) {
+				continue
+			}
+			matched[i], found = true, true
+			if opts := linodeInterfaceUpdate(want, have); opts != nil {
+				changes.update[have.ID] = *opts
+			}
+			break
+		}
+		if !found {
+			changes.create = append(changes.create, want)
+		}
+	}
+	for i, have := range actual {
+		if !matched[i] {
+			changes.delete = append(changes.delete, have.ID)
+		}
+	}
+
+	return changes
+}
+
+func linodeInterfaceMatches(want linodego.LinodeInterfaceCreateOptions, have linodego.LinodeInterface) bool {
+	switch {
+	case want.Public != nil:
+		return have.Public != nil
+	case want.VPC != nil:
+		return have.VPC != nil && have.VPC.SubnetID == want.VPC.SubnetID
+	case want.VLAN != nil:
+		return have.VLAN != nil && have.VLAN.VLANLabel == want.VLAN.VLANLabel
+	default:
+		return false
+	}
+}
+
+// linodeInterfaceUpdate returns the options to update an interface to the desired one, or nil if it is up to date.
+func linodeInterfaceUpdate(want linodego.LinodeInterfaceCreateOptions, have linodego.LinodeInterface) *linodego.LinodeInterfaceUpdateOptions {
+	var (
+		opts    linodego.LinodeInterfaceUpdateOptions
+		changed bool
+	)
+	if route := want.DefaultRoute; route != nil {
+		var current linodego.InterfaceDefaultRoute
+		if have.DefaultRoute != nil {
+			current = *have.DefaultRoute
+		}
+		if (route.IPv4 != nil && *route.IPv4 != ptr.Deref(current.IPv4, false)) || (route.IPv6 != nil && *route.IPv6 != ptr.Deref(current.IPv6, false)) {
+			opts.DefaultRoute = &linodego.InterfaceDefaultRouteUpdateOptions{IPv4: route.IPv4, IPv6: route.IPv6}
+			changed = true
+		}
+	}
+	if want.Public != nil && want.Public.IPv4 != nil && have.Public != nil {
+		var current []string
+		if have.Public.IPv4 != nil {
+			for _, address := range have.Public.IPv4.Addresses {
+				current = append(current, address.Address)
+			}
+		}
+		for _, address := range want.Public.IPv4.Addresses {
+			if explicitAddress(address.Address) && !slices.Contains(current, *address.Address) {
+				opts.Public = want.Public
+				changed = true
+				break
+			}
+		}
+	}
+	if want.VPC != nil && want.VPC.IPv4 != nil && have.VPC != nil && vpcIPv4Changed(*want.VPC.IPv4, have.VPC.IPv4) {
+		opts.VPC = &linodego.VPCInterfaceUpdateOptions{IPv4: want.VPC.IPv4}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return &opts
+}
+
+// vpcIPv4Changed returns whether the addresses or ranges set explicitly for a VPC interface are missing from it.
+func vpcIPv4Changed(want linodego.VPCInterfaceIPv4CreateOptions, have linodego.VPCInterfaceIPv4) bool {
+	for _, address := range want.Addresses {
+		if explicitAddress(address.Address) && !slices.ContainsFunc(have.Addresses, func(current linodego.VPCInterfaceIPv4Address) bool {
+			return current.Address == *address.Address
+		}) {
+			return true
+		}
+	}
+	for _, ipRange := range want.Ranges {
+		// Ranges only given with a prefix length are allocated by the Linode API.
+		if !strings.HasPrefix(ipRange.Range, "/") && !slices.ContainsFunc(have.Ranges, func(current linodego.VPCInterfaceIPv4Range) bool {
+			return current.Range == ipRange.Range
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// explicitAddress returns whether an address is set explicitly, rather than allocated by the Linode API.
+func explicitAddress(address *string) bool {
+	return address != nil && *address != "" && *address != "auto"
+}
+
+// mergeInstanceConfigInterfaces returns the interfaces of a legacy configuration matching the desired ones, and whether
+// they differ from its current interfaces. Interfaces match by purpose, and VPC interfaces by subnet and VLAN
+// interfaces by label too. Matching interfaces are kept as they are, so that they keep their addresses.
+func mergeInstanceConfigInterfaces(desired []linodego.InstanceConfigInterfaceCreateOptions, actual []linodego.InstanceConfigInterface) ([]linodego.InstanceConfigInterfaceCreateOptions, bool) {
+	merged := make([]linodego.InstanceConfigInterfaceCreateOptions, len(desired))
+	matched := make([]bool, len(actual))
+	changed := len(desired) != len(actual)
+	for i, want := range desired {
+		merged[i] = want
+		found := false
+		for j, have := range actual {
+			if matched[j] || !instanceConfigInterfaceMatches(want, have) {
+				continue
+			}
+			matched[j], found = true, true
+			merged[i] = have.GetCreateOptions()
+			changed = changed || i != j
+			break
+		}
+		changed = changed || !found
+	}
+
+	return merged, changed
+}
+
+func instanceConfigInterfaceMatches(want linodego.InstanceConfigInterfaceCreateOptions, have linodego.InstanceConfigInterface) bool {
+	switch {
+	case want.Purpose != have.Purpose:
+		return false
+	case want.Purpose == linodego.InterfacePurposeVPC:
+		return ptr.Deref(want.SubnetID, 0) == ptr.Deref(have.SubnetID, 0)
+	case want.Purpose == linodego.InterfacePurposeVLAN:
+		return want.Label == have.Label
+	default:
+		return true
+	}
+}
+
+// upgradeMachineInterfaces updates the spec of a LinodeMachine once the interfaces of its instance were upgraded to
+// Linode interfaces. Its legacy configuration interfaces are translated to Linode interfaces, unless Linode interfaces
+// were set along with the interface generation, in which case false is returned.
+func upgradeMachineInterfaces(spec *infrav1alpha2.LinodeMachineSpec) bool {
+	translated := len(spec.LinodeInterfaces) == 0
+	if translated {
+		spec.LinodeInterfaces = instanceConfigInterfacesToLinodeInterfaces(spec.Interfaces)
+	}
+	spec.Interfaces = nil
+	spec.InterfaceGeneration = linodego.GenerationLinode
+
+	return translated
+}
+
+// instanceConfigInterfacesToLinodeInterfaces translates legacy configuration interfaces to Linode interfaces. The
+// default routes go through the primary interface, which is the first public or VPC interface unless one is marked as
+// primary, and VPC interfaces keep their address and 1:1 NAT.
+func instanceConfigInterfacesToLinodeInterfaces(interfaces []infrav1alpha2.InstanceConfigInterfaceCreateOptions) []infrav1alpha2.LinodeInterfaceCreateOptions {
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	primary := slices.IndexFunc(interfaces, func(iface infrav1alpha2.InstanceConfigInterfaceCreateOptions) bool {
+		return iface.Primary
+	})
+	if primary < 0 {
+		primary = slices.IndexFunc(interfaces, func(iface infrav1alpha2.InstanceConfigInterfaceCreateOptions) bool {
+			return iface.Purpose != linodego.InterfacePurposeVLAN
+		})
+	}
+
+	linodeInterfaces := make([]infrav1alpha2.LinodeInterfaceCreateOptions, 0, len(interfaces))
+	for idx, iface := range interfaces {
+		var linodeInterface infrav1alpha2.LinodeInterfaceCreateOptions
+		switch iface.Purpose {
+		case linodego.InterfacePurposePublic:
+			linodeInterface.Public = &infrav1alpha2.PublicInterfaceCreateOptions{}
+			if idx == primary {
+				linodeInterface.DefaultRoute = &infrav1alpha2.InterfaceDefaultRoute{IPv4: ptr.To(true), IPv6: ptr.To(true)}
+			}
+		case linodego.InterfacePurposeVPC:
+			linodeInterface.VPC = instanceConfigVPCInterfaceToLinodeInterface(iface)
+			if idx == primary {
+				linodeInterface.DefaultRoute = &infrav1alpha2.InterfaceDefaultRoute{IPv4: ptr.To(true)}
+			}
+		case linodego.InterfacePurposeVLAN:
+			linodeInterface.VLAN = &infrav1alpha2.VLANInterface{VLANLabel: iface.Label}
+			if iface.IPAMAddress != "" {
+				linodeInterface.VLAN.IPAMAddress = ptr.To(iface.IPAMAddress)
+			}
+		default:
+			continue
+		}
+		linodeInterfaces = append(linodeInterfaces, linodeInterface)
+	}
+
+	return linodeInterfaces
+}
+
+// instanceConfigVPCInterfaceToLinodeInterface translates a legacy configuration VPC interface to a Linode VPC interface.
+// Its IPv4 configuration is always set, since Linode VPC interfaces otherwise default to having a 1:1 NAT address.
+func instanceConfigVPCInterfaceToLinodeInterface(iface infrav1alpha2.InstanceConfigInterfaceCreateOptions) *infrav1alpha2.VPCInterfaceCreateOptions {
+	address := infrav1alpha2.VPCInterfaceIPv4AddressCreateOptions{
+		Address: "auto",
+		Primary: ptr.To(true),
+	}
+	if iface.IPv4 != nil {
+		if iface.IPv4.VPC != "" {
+			address.Address = iface.IPv4.VPC
+		}
+		switch iface.IPv4.NAT1To1 {
+		case "":
+		case "any":
+			address.NAT1To1Address = ptr.To("auto")
+		default:
+			address.NAT1To1Address = ptr.To(iface.IPv4.NAT1To1)
+		}
+	}
+
+	vpcInterface := &infrav1alpha2.VPCInterfaceCreateOptions{
+		SubnetID: iface.SubnetID,
+		IPv4: &infrav1alpha2.VPCInterfaceIPv4CreateOptions{
+			Addresses: []infrav1alpha2.VPCInterfaceIPv4AddressCreateOptions{address},
+		},
+	}
+	for _, ipRange := range iface.IPRanges {
+		vpcInterface.IPv4.Ranges = append(vpcInterface.IPv4.Ranges, infrav1alpha2.VPCInterfaceIPv4RangeCreateOptions{Range: ipRange})
+	}
+
+	return vpcInterface
+}
+
+// moveFirewallsToInterfaces attaches firewalls of an instance to its public and VPC Linode interfaces and detaches them
+// from the instance itself, since the firewalls of an instance with Linode interfaces are attached to its interfaces.
+func moveFirewallsToInterfaces(ctx context.Context, machineScope *scope.MachineScope, instanceID int, firewallIDs []int, interfaces []linodego.LinodeInterface) error {
+	for _, firewallID := range firewallIDs {
+		devices, err := machineScope.LinodeClient.ListFirewallDevices(ctx, firewallID, nil)
+		if err != nil {
+			return fmt.Errorf("listing devices of firewall %d: %w", firewallID, err)
+		}
+		attached := map[int]bool{}
+		instanceDeviceID := 0
+		for _, device := range devices {
+			switch {
+			case device.Entity.Type == linodego.FirewallDeviceLinodeInterface:
+				attached[device.Entity.ID] = true
+			case device.Entity.Type == linodego.FirewallDeviceLinode && device.Entity.ID == instanceID:
+				instanceDeviceID = device.ID
+			}
+		}
+
+		for _, iface := range interfaces {
+			if iface.VLAN != nil || attached[iface.ID] {
+				continue
+			}
+			if _, err := machineScope.LinodeClient.CreateFirewallDevice(ctx, firewallID, linodego.FirewallDeviceCreateOptions{
+				ID:   iface.ID,
+				Type: linodego.FirewallDeviceLinodeInterface,
+			}); err != nil {
+				return fmt.Errorf("attaching firewall %d to interface %d: %w", firewallID, iface.ID, err)
+			}
+		}
+		if instanceDeviceID != 0 {
+			if err := machineScope.LinodeClient.DeleteFirewallDevice(ctx, firewallID, instanceDeviceID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+				return fmt.Errorf("detaching firewall %d from instance: %w", firewallID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// drainNodeBalancerNodes sets the backends of the NodeBalancer of the cluster pointing to the addresses of the
+// LinodeMachine to drain mode, and returns how many backends point to them.
+func drainNodeBalancerNodes(ctx context.Context, machineScope *scope.MachineScope) (int, error) {
+	network := machineScope.LinodeCluster.Spec.Network
+	if network.NodeBalancerID == nil || network.ApiserverNodeBalancerConfigID == nil {
+		return 0, nil
+	}
+
+	addresses := make(map[string]bool, len(machineScope.LinodeMachine.Status.Addresses))
+	for _, addr := range machineScope.LinodeMachine.Status.Addresses {
+		addresses[addr.Address] = true
+	}
+	configIDs := []int{*network.ApiserverNodeBalancerConfigID}
+	for _, portConfig := range network.AdditionalPorts {
+		if portConfig.NodeBalancerConfigID != nil {
+			configIDs = append(configIDs, *portConfig.NodeBalancerConfigID)
+		}
+	}
+
+	backends := 0
+	for _, configID := range configIDs {
+		nodes, err := machineScope.LinodeClient.ListNodeBalancerNodes(ctx, *network.NodeBalancerID, configID, &linodego.ListOptions{})
+		if err != nil {
+			return backends, fmt.Errorf("listing nodes of NodeBalancer config %d: %w", configID, err)
+		}
+		for _, node := range nodes {
+			host, _, err := net.SplitHostPort(node.Address)
+			if err != nil || !addresses[host] {
+				continue
+			}
+			backends++
+			if node.Mode == linodego.ModeDrain {
+				continue
+			}
+			if _, err := machineScope.LinodeClient.UpdateNodeBalancerNode(ctx, *network.NodeBalancerID, configID, node.ID,
+				linodego.NodeBalancerNodeUpdateOptions{Mode: linodego.ModeDrain}); err != nil {
+				return backends, fmt.Errorf("draining node %d of NodeBalancer config %d: %w", node.ID, configID, err)
+			}
+		}
+	}
+
+	return backends, nil
+}
+
+// machineDisk is a disk of a LinodeMachine and the disk attached to the same device of an instance.
+type machineDisk struct {
+	device   string
+	disk     *infrav1alpha2.InstanceDisk
+	attached *linodego.InstanceConfigDevice
+}
+
+// machineDisks returns the disks of the LinodeMachine with the disks attached to the same devices.
+func machineDisks(spec infrav1alpha2.LinodeMachineSpec, devices *linodego.InstanceConfigDeviceMap) []machineDisk {
+	if devices == nil {
+		devices = &linodego.InstanceConfigDeviceMap{}
+	}
+	dataDisks := spec.DataDisks
+	if dataDisks == nil {
+		dataDisks = &infrav1alpha2.InstanceDisks{}
+	}
+
+	var disks []machineDisk
+	for _, disk := range []machineDisk{
+		{device: "sda", disk: spec.OSDisk, attached: devices.SDA},
+		{device: "sdb", disk: dataDisks.SDB, attached: devices.SDB},
+		{device: "sdc", disk: dataDisks.SDC, attached: devices.SDC},
+		{device: "sdd", disk: dataDisks.SDD, attached: devices.SDD},
+		{device: "sde", disk: dataDisks.SDE, attached: devices.SDE},
+		{device: "sdf", disk: dataDisks.SDF, attached: devices.SDF},
+		{device: "sdg", disk: dataDisks.SDG, attached: devices.SDG},
+		{device: "sdh", disk: dataDisks.SDH, attached: devices.SDH},
+	} {
+		if disk.disk != nil {
+			disks = append(disks, disk)
+		}
+	}
+
+	return disks
+}
+
+// getLatestInstanceEvent returns the most recent event for the given action on an instance, or nil if there is none.
+func getLatestInstanceEvent(ctx context.Context, machineScope *scope.MachineScope, linodeInstanceID int, action linodego.EventAction) (*linodego.Event, error) {
+	filter, err := json.Marshal(map[string]any{
+		"entity.id":   linodeInstanceID,
+		"entity.type": linodego.EntityLinode,
+		"action":      action,
+		"+order_by":   "created",
+		"+order":      "desc",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := machineScope.LinodeClient.ListEvents(ctx, linodego.NewListOptions(1, string(filter)))
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	return &events[0], nil
+}
+
+func getLinodeVolume(ctx context.Context, machineScope *scope.MachineScope, volumeRef corev1.ObjectReference) (*infrav1alpha2.LinodeVolume, error) {
+	namespace := volumeRef.Namespace
+	if namespace == "" {
+		namespace = machineScope.LinodeMachine.Namespace
+	}
+
+	linodeVolume := &infrav1alpha2.LinodeVolume{}
+	if err := machineScope.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: volumeRef.Name}, linodeVolume); err != nil {
+		return nil, err
+	}
+
+	return linodeVolume, nil
+}
+
+// attachVolumes attaches the Volumes referenced by the LinodeMachine to the default configuration profile of the instance.
+func attachVolumes(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstanceID int) error {
+	if len(machineScope.LinodeMachine.Spec.VolumeRefs) == 0 {
+		return nil
+	}
+	if reconciler.ConditionTrue(machineScope.LinodeMachine.GetCondition(ConditionPreflightVolumesAttached)) {
+		return nil
+	}
+
+	instanceConfig, err := getDefaultInstanceConfig(ctx, machineScope, linodeInstanceID)
+	if err != nil {
+		logger.Error(err, "Failed to get default instance configuration")
+		return err
+	}
+
+	for _, volumeRef := range machineScope.LinodeMachine.Spec.VolumeRefs {
+		linodeVolume, err := getLinodeVolume(ctx, machineScope, volumeRef)
+		if err != nil {
+			logger.Error(err, "Failed to fetch LinodeVolume", "volumeName", volumeRef.Name)
+			return err
+		}
+		if !linodeVolume.Status.Ready || linodeVolume.Spec.VolumeID == nil {
+			return fmt.Errorf("volume %s is not ready", linodeVolume.Name)
+		}
+		if linodeVolume.Spec.Region != machineScope.LinodeMachine.Spec.Region {
+			return fmt.Errorf("volume %s is in region %s, expected %s", linodeVolume.Name, linodeVolume.Spec.Region, machineScope.LinodeMachine.Spec.Region)
+		}
+
+		volumeID := *linodeVolume.Spec.VolumeID
+		volume, err := machineScope.LinodeClient.GetVolume(ctx, volumeID)
+		if err != nil {
+			logger.Error(err, "Failed to get Volume", "volumeID", volumeID)
+			return err
+		}
+		if volume.LinodeID != nil {
+			if *volume.LinodeID == linodeInstanceID {
+				continue
+			}
+			return fmt.Errorf("volume %d is attached to linode %d", volumeID, *volume.LinodeID)
+		}
+
+		if _, err := machineScope.LinodeClient.AttachVolume(ctx, volumeID, &linodego.VolumeAttachOptions{
+			LinodeID:           linodeInstanceID,
+			ConfigID:           instanceConfig.ID,
+			PersistAcrossBoots: ptr.To(true),
+		}); err != nil {
+			logger.Error(err, "Failed to attach Volume", "volumeID", volumeID)
+			return err
+		}
+	}
+
+	machineScope.LinodeMachine.SetCondition(metav1.Condition{
+		Type:   ConditionPreflightVolumesAttached,
+		Status: metav1.ConditionTrue,
+		Reason: "VolumesAttached",
+	})
+	return nil
+}
+
+// detachVolumes detaches the Volumes referenced by the LinodeMachine from the instance so they can be reused.
+func detachVolumes(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, linodeInstanceID int) error {
+	for _, volumeRef := range machineScope.LinodeMachine.Spec.VolumeRefs {
+		linodeVolume, err := getLinodeVolume(ctx, machineScope, volumeRef)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			logger.Error(err, "Failed to fetch LinodeVolume", "volumeName", volumeRef.Name)
+			return err
+		}
+		if linodeVolume.Spec.VolumeID == nil {
+			continue
+		}
+
+		volumeID := *linodeVolume.Spec.VolumeID
+		volume, err := machineScope.LinodeClient.GetVolume(ctx, volumeID)
+		if err != nil {
+			if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+				continue
+			}
+			logger.Error(err, "Failed to get Volume", "volumeID", volumeID)
+			return err
+		}
+		if volume.LinodeID == nil || *volume.LinodeID != linodeInstanceID {
+			continue
+		}
+
+		if err := machineScope.LinodeClient.DetachVolume(ctx, volumeID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+			logger.Error(err, "Failed to detach Volume", "volumeID", volumeID)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createInstance provisions linode instance after checking if the request will be within the rate-limits
+// Note:
+//  1. this method represents the critical section. It takes a lock before checking for the rate limits and releases it after making request to linode API or when returning from function
+//  2. returned time duration here is not always used.
+//     a) In case of an error, we calculate for how long to requeue in method which checks if its a transient error or not.
+//     b) If POST limit is reached, only then the returned time duration is used to retry after that time has elapsed.
+func createInstance(ctx context.Context, logger logr.Logger, machineScope *scope.MachineScope, createOpts *linodego.InstanceCreateOptions) (*linodego.Instance, time.Duration, error) {
+	ctr := util.GetPostReqCounter(machineScope.TokenHash)
+	ctr.Mu.Lock()
+	defer ctr.Mu.Unlock()
+
+	if ctr.IsPOSTLimitReached() {
+		logger.Info(fmt.Sprintf("Cannot make more POST requests as rate-limit is reached. Waiting and retrying after %v seconds", ctr.RetryAfter()))
+		return nil, ctr.RetryAfter(), util.ErrRateLimit
+	}
+
+	machineScope.LinodeClient.OnAfterResponse(ctr.ApiResponseRatelimitCounter)
+	inst, err := machineScope.LinodeClient.CreateInstance(ctx, *createOpts)
+
+	// if instance already exists, we get 400 response. get respective instance and return
+	if linodego.ErrHasStatus(err, http.StatusBadRequest) && strings.Contains(err.Error(), "Label must be unique") {
+		logger.Error(err, "Failed to create instance, received [400 BadRequest] response.")
+
+		// check if instance already exists
+		listFilter := util.Filter{Label: createOpts.Label}
+		filter, errFilter := listFilter.String()
+		if errFilter != nil {
+			logger.Error(err, "Failed to create filter to list instance")
+			return nil, ctr.RetryAfter(), err
+		}
+		instances, listErr := machineScope.LinodeClient.ListInstances(ctx, linodego.NewListOptions(1, filter))
+		if listErr != nil {
+			return nil, ctr.RetryAfter(), listErr
+		}
+		if len(instances) > 0 {
+			return &instances[0], ctr.RetryAfter(), nil
+		}
+	}
+
+	return inst, ctr.RetryAfter(), err
+}
+
+// checkCapacity returns the reason and message of the first capacity or quota check that prevents the instance from
+// being created, or an empty reason if it can be created. The number of instances of the account is only checked
+// against instanceLimit when it is positive, as the Linode API doesn't expose the limit of the account.
+func checkCapacity(ctx context.Context, machineScope *scope.MachineScope, instanceLimit int) (string, string, error) {
+	region := machineScope.LinodeMachine.Spec.Region
+	plan := machineScope.LinodeMachine.Spec.Type
+
+	availability, err := machineScope.LinodeClient.GetRegionAvailability(ctx, region)
+	if err != nil {
+		return "", "", fmt.Errorf("get region availability: %w", err)
+	}
+	for _, regionAvailability := range availability {
+		if regionAvailability.Plan == plan && !regionAvailability.Available {
+			return PlanUnavailableReason, fmt.Sprintf("plan %s is not available in region %s", plan, region), nil
+		}
+	}
+
+	accountAvailability, err := machineScope.LinodeClient.GetAccountAvailability(ctx, region)
+	if err != nil {
+		return "", "", fmt.Errorf("get account availability: %w", err)
+	}
+	if slices.Contains(accountAvailability.Unavailable, string(linodego.CapabilityLinodes)) {
+		return AccountUnavailableReason, fmt.Sprintf("the account can't create Linodes in region %s", region), nil
+	}
+
+	if instanceLimit > 0 {
+		// Only the first page is needed, the total number of instances is set in the list options.
+		listOpts := linodego.NewListOptions(1, "")
+		if _, err := machineScope.LinodeClient.ListInstances(ctx, listOpts); err != nil {
+			return "", "", fmt.Errorf("list instances: %w", err)
+		}
+		if listOpts.Results >= instanceLimit {
+			return InstanceLimitReachedReason, fmt.Sprintf("the account has %d Linodes out of its limit of %d", listOpts.Results, instanceLimit), nil
+		}
+	}
+
+	// Public images are available in all regions, and referenced LinodeImages were already checked to be
+	// available in the region of the machine.
+	image := machineScope.LinodeMachine.Spec.Image
+	if !strings.HasPrefix(image, "private/") || machineScope.LinodeMachine.Spec.ImageRef != nil ||
+		machineScope.LinodeMachine.Spec.BackupRef != nil || machineScope.LinodeMachine.Spec.BackupID != 0 {
+		return "", "", nil
+	}
+	linodeImage, err := machineScope.LinodeClient.GetImage(ctx, image)
+	if err != nil {
+		return "", "", fmt.Errorf("get image: %w", err)
+	}
+	if len(linodeImage.Regions) > 0 && !slices.ContainsFunc(linodeImage.Regions, func(imageRegion linodego.ImageRegion) bool {
+		return imageRegion.Region == region && imageRegion.Status == linodego.ImageRegionStatusAvailable
+	}) {
+		return ImageUnavailableReason, fmt.Sprintf("image %s is not available in region %s", image, region), nil
+	}
+
+	return "", "", nil
+}
+
+// isInstanceLimitError returns whether the Linode API refused to create an instance because the account reached its
+// limit of instances.
+func isInstanceLimitError(err error) bool {
+	var apiErr *linodego.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusBadRequest {
+		return false
+	}
+
+	return strings.Contains(apiErr.Message, instanceLimitErrorReason)
+}
+
+// capacityRetryDelay returns the delay before checking capacity again, which grows with the time capacity has been
+// unavailable so that the Linode API isn't polled needlessly.
+func capacityRetryDelay(cond *metav1.Condition) time.Duration {
+	delay := reconciler.DefaultMachineControllerCapacityRetryDelay
+	if cond != nil {
+		delay = max(delay, time.Since(cond.LastTransitionTime.Time))
+	}
+
+	return reconciler.WithJitter(min(delay, reconciler.DefaultMachineControllerCapacityMaxRetryDelay))
+}
+
+// getVPCRefFromScope returns the appropriate VPC reference based on priority:
+// 1. Machine-level VPC reference
+// 2. Cluster-level VPC reference
+func getVPCRefFromScope(machineScope *scope.MachineScope) *corev1.ObjectReference {
+	if machineScope.LinodeMachine.Spec.VPCRef != nil {
+		return machineScope.LinodeMachine.Spec.VPCRef
+	}
+	return machineScope.LinodeCluster.Spec.VPCRef
+}
+
+// configureVlanInterface adds a VLAN interface to the configuration
+func configureVlanInterface(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
+	switch {
+	case createConfig.LinodeInterfaces != nil || (createConfig.LinodeInterfaces == nil && machineScope.LinodeMachine.Spec.InterfaceGeneration == linodego.GenerationLinode):
+		iface, err := getVlanLinodeInterfaceConfig(ctx, machineScope, createConfig.LinodeInterfaces, logger)
+		if err != nil {
+			logger.Error(err, "Failed to get VLAN interface config")
+			return err
+		}
+
+		if iface != nil {
+			// add VLAN interface as first interface
+			createConfig.LinodeInterfaces = slices.Insert(createConfig.LinodeInterfaces, 0, *iface)
+		}
+	default:
+		iface, err := getVlanInterfaceConfig(ctx, machineScope, createConfig.Interfaces, logger)
+		if err != nil {
+			logger.Error(err, "Failed to get VLAN interface config")
+			return err
+		}
+
+		if iface != nil {
+			// add VLAN interface as first interface
+			createConfig.Interfaces = slices.Insert(createConfig.Interfaces, 0, *iface)
+		}
+	}
+
+	return nil
+}
+
+// configurePlacementGroup adds placement group configuration from the LinodeMachine or its failure domain
+func configurePlacementGroup(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
+	pgID, err := getPlacementGroupID(ctx, machineScope, logger)
 	if err != nil {
 		logger.Error(err, "Failed to get Placement Group config")
 		return err
@@ -1571,6 +2865,32 @@ func configurePlacementGroup(ctx context.Context, machineScope *scope.MachineSco
 	return nil
 }
 
+// configureImage sets the image from the referenced LinodeImage
+func configureImage(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
+	imageID, err := getImageID(ctx, machineScope, logger)
+	if err != nil {
+		logger.Error(err, "Failed to get Image config from reference")
+		return err
+	}
+
+	createConfig.Image = imageID
+
+	return nil
+}
+
+// configureBackup sets the backup to restore from the referenced LinodeInstanceSnapshot
+func configureBackup(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
+	backupID, err := getBackupID(ctx, machineScope, logger)
+	if err != nil {
+		logger.Error(err, "Failed to get Backup config from reference")
+		return err
+	}
+
+	createConfig.BackupID = backupID
+
+	return nil
+}
+
 // configureFirewall adds firewall configuration
 func configureFirewall(ctx context.Context, machineScope *scope.MachineScope, createConfig *linodego.InstanceCreateOptions, logger logr.Logger) error {
 	// First check if a direct FirewallID is specified
@@ -1624,5 +2944,6 @@ func getTags(machineScope *scope.MachineScope, instanceTags []string) []string {
 	}
 
 	machineScope.LinodeMachine.Status.Tags = slices.Clone(machineScope.LinodeMachine.Spec.Tags)
-	return outTags
+	// The owner tags of the management cluster the instance was moved from are replaced
+	return util.ClaimOwnerTags(outTags, machineScope.ManagementClusterID)
 }
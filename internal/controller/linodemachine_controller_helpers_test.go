@@ -5,8 +5,10 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"testing"
+	"time"
 
 	awssigner "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -20,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +32,7 @@ import (
 	"github.com/linode/cluster-api-provider-linode/cloud/scope"
 	"github.com/linode/cluster-api-provider-linode/mock"
 	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
 )
 
 func TestLinodeMachineSpecToCreateInstanceConfig(t *testing.T) {
@@ -235,6 +239,115 @@ func TestSetUserData(t *testing.T) {
 				s3PresignedMock.EXPECT().PresignGetObject(gomock.Any(), gomock.Any()).Return(&awssigner.PresignedHTTPRequest{URL: "https://object.bucket.example.com"}, nil)
 			},
 		},
+		{
+			name: "Success - SetUserData ignition pointer (large bootstrap data)",
+			machineScope: &scope.MachineScope{Machine: &v1beta2.Machine{
+				Spec: v1beta2.MachineSpec{
+					Bootstrap: v1beta2.Bootstrap{
+						DataSecretName: ptr.To("test-data"),
+					},
+				},
+			}, LinodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "default",
+				},
+				Spec: infrav1alpha2.LinodeMachineSpec{Region: "us-ord", Image: "kinvolk/flatcar-stable"},
+			}, LinodeCluster: &infrav1alpha2.LinodeCluster{
+				Spec: infrav1alpha2.LinodeClusterSpec{
+					ObjectStore: &infrav1alpha2.ObjectStore{CredentialsRef: corev1.SecretReference{Name: "fake"}},
+				},
+			}},
+			createConfig: &linodego.InstanceCreateOptions{},
+			wantMetadata: &linodego.InstanceMetadataOptions{UserData: "eyJpZ25pdGlvbiI6eyJjb25maWciOnsicmVwbGFjZSI6eyJzb3VyY2UiOiJodHRwczovL29iamVjdC5idWNrZXQuZXhhbXBsZS5jb20ifX0sInZlcnNpb24iOiIzLjMuMCJ9fQ=="},
+			expects: func(kMock *mock.MockK8sClient, s3Mock *mock.MockS3Client, s3PresignedMock *mock.MockS3PresignClient) {
+				kMock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+					largeData := make([]byte, maxBootstrapDataBytesCloudInit*10)
+					_, rerr := rand.Read(largeData)
+					require.NoError(t, rerr, "Failed to create bootstrap data")
+					*obj = corev1.Secret{
+						Data: map[string][]byte{
+							"value":  largeData,
+							"format": []byte("ignition"),
+						},
+					}
+					return nil
+				})
+				kMock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+					*obj = corev1.Secret{
+						Data: map[string][]byte{
+							"bucket":   []byte("fake"),
+							"endpoint": []byte("example.com"),
+							"access":   []byte("fake"),
+							"secret":   []byte("fake"),
+						},
+					}
+					return nil
+				})
+				s3Mock.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).Return(&s3.PutObjectOutput{}, nil)
+				s3PresignedMock.EXPECT().PresignGetObject(gomock.Any(), gomock.Any()).Return(&awssigner.PresignedHTTPRequest{URL: "https://object.bucket.example.com"}, nil)
+			},
+		},
+		{
+			name: "Error - SetUserData talos bootstrap data exceeds the limit",
+			machineScope: &scope.MachineScope{Machine: &v1beta2.Machine{
+				Spec: v1beta2.MachineSpec{
+					Bootstrap: v1beta2.Bootstrap{
+						DataSecretName: ptr.To("test-data"),
+					},
+				},
+			}, LinodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "default",
+				},
+			}, LinodeCluster: &infrav1alpha2.LinodeCluster{
+				Spec: infrav1alpha2.LinodeClusterSpec{
+					ObjectStore: &infrav1alpha2.ObjectStore{CredentialsRef: corev1.SecretReference{Name: "fake"}},
+				},
+			}},
+			createConfig: &linodego.InstanceCreateOptions{},
+			expects: func(kMock *mock.MockK8sClient, _ *mock.MockS3Client, _ *mock.MockS3PresignClient) {
+				kMock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+					*obj = corev1.Secret{
+						Data: map[string][]byte{
+							"value":  make([]byte, maxBootstrapDataBytesCloudInit*10),
+							"format": []byte("talos"),
+						},
+					}
+					return nil
+				})
+			},
+			expectedError: errBootstrapDataTooLarge,
+		},
+		{
+			name: "Error - SetUserData unsupported bootstrap data format",
+			machineScope: &scope.MachineScope{Machine: &v1beta2.Machine{
+				Spec: v1beta2.MachineSpec{
+					Bootstrap: v1beta2.Bootstrap{
+						DataSecretName: ptr.To("test-data"),
+					},
+				},
+			}, LinodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "default",
+				},
+			}},
+			createConfig: &linodego.InstanceCreateOptions{},
+			expects: func(kMock *mock.MockK8sClient, _ *mock.MockS3Client, _ *mock.MockS3PresignClient) {
+				kMock.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj *corev1.Secret, opts ...client.GetOption) error {
+					*obj = corev1.Secret{
+						Data: map[string][]byte{
+							"value":  []byte("test-data"),
+							"format": []byte("unknown"),
+						},
+					}
+					return nil
+				})
+			},
+			expectedError: errUnsupportedBootstrapDataFormat,
+		},
 		{
 			name: "Error - SetUserData get bootstrap data",
 			machineScope: &scope.MachineScope{Machine: &v1beta2.Machine{
@@ -339,6 +452,95 @@ func TestSetUserData(t *testing.T) {
 	}
 }
 
+func TestStoreBootstrapData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		delivery      infrav1alpha2.BootstrapDataDelivery
+		endpoint      bool
+		expects       func(endpoint *mock.MockBootstrapDataEndpoint)
+		wantURL       string
+		expectedError string
+	}{
+		{
+			name:     "Success - bootstrap data endpoint",
+			delivery: infrav1alpha2.BootstrapDataDeliveryEndpoint,
+			endpoint: true,
+			expects: func(endpoint *mock.MockBootstrapDataEndpoint) {
+				endpoint.EXPECT().Store(gomock.Any(), gomock.Any(), []byte("bootstrap-data")).Return("https://10.0.0.1:9444/bootstrap-data/token", nil)
+			},
+			wantURL: "https://10.0.0.1:9444/bootstrap-data/token",
+		},
+		{
+			name:          "Error - bootstrap data endpoint is not enabled",
+			delivery:      infrav1alpha2.BootstrapDataDeliveryEndpoint,
+			expectedError: "must enable the bootstrap data endpoint",
+		},
+		{
+			name:     "Error - bootstrap data endpoint failed to store",
+			delivery: infrav1alpha2.BootstrapDataDeliveryEndpoint,
+			endpoint: true,
+			expects: func(endpoint *mock.MockBootstrapDataEndpoint) {
+				endpoint.EXPECT().Store(gomock.Any(), gomock.Any(), []byte("bootstrap-data")).Return("", errors.New("fail"))
+			},
+			expectedError: "store bootstrap data: fail",
+		},
+		{
+			name:          "Error - cluster object store is not enabled",
+			endpoint:      true,
+			expectedError: "must enable cluster object store feature",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			machineScope := &scope.MachineScope{
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{BootstrapDataDelivery: testcase.delivery},
+				},
+				LinodeMachine: &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{UID: "uid"}},
+			}
+			if testcase.endpoint {
+				endpoint := mock.NewMockBootstrapDataEndpoint(ctrl)
+				if testcase.expects != nil {
+					testcase.expects(endpoint)
+				}
+				machineScope.BootstrapDataEndpoint = endpoint
+			}
+
+			url, err := storeBootstrapData(t.Context(), machineScope, []byte("bootstrap-data"), testr.New(t))
+			if testcase.expectedError != "" {
+				require.ErrorContains(t, err, testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testcase.wantURL, url)
+		})
+	}
+}
+
+func TestDeleteBootstrapDataRevokesToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	endpoint := mock.NewMockBootstrapDataEndpoint(ctrl)
+	endpoint.EXPECT().Revoke(gomock.Any(), gomock.Any()).Return(nil)
+
+	require.NoError(t, deleteBootstrapData(t.Context(), &scope.MachineScope{
+		LinodeCluster:         &infrav1alpha2.LinodeCluster{},
+		LinodeMachine:         &infrav1alpha2.LinodeMachine{ObjectMeta: metav1.ObjectMeta{UID: "uid"}},
+		BootstrapDataEndpoint: endpoint,
+	}))
+}
+
 func TestCreateInstanceConfigDeviceMap(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -432,6 +634,70 @@ func TestCreateInstanceConfigDeviceMap(t *testing.T) {
 		})
 	}
 }
+func TestAddVolumeDevice(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		devices         linodego.InstanceConfigDeviceMap
+		volumeID        int
+		expectedChanged bool
+		expectedDevices linodego.InstanceConfigDeviceMap
+	}{
+		{
+			name:            "volume is added to the first free device",
+			devices:         linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 100}, SDB: &linodego.InstanceConfigDevice{DiskID: 101}},
+			volumeID:        200,
+			expectedChanged: true,
+			expectedDevices: linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 100},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 101},
+				SDC: &linodego.InstanceConfigDevice{VolumeID: 200},
+			},
+		},
+		{
+			name:            "volume is already in the configuration",
+			devices:         linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 100}, SDD: &linodego.InstanceConfigDevice{VolumeID: 200}},
+			volumeID:        200,
+			expectedChanged: false,
+			expectedDevices: linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 100}, SDD: &linodego.InstanceConfigDevice{VolumeID: 200}},
+		},
+		{
+			name: "no free device",
+			devices: linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 100},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 101},
+				SDC: &linodego.InstanceConfigDevice{DiskID: 102},
+				SDD: &linodego.InstanceConfigDevice{DiskID: 103},
+				SDE: &linodego.InstanceConfigDevice{DiskID: 104},
+				SDF: &linodego.InstanceConfigDevice{DiskID: 105},
+				SDG: &linodego.InstanceConfigDevice{DiskID: 106},
+				SDH: &linodego.InstanceConfigDevice{DiskID: 107},
+			},
+			volumeID:        200,
+			expectedChanged: false,
+			expectedDevices: linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 100},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 101},
+				SDC: &linodego.InstanceConfigDevice{DiskID: 102},
+				SDD: &linodego.InstanceConfigDevice{DiskID: 103},
+				SDE: &linodego.InstanceConfigDevice{DiskID: 104},
+				SDF: &linodego.InstanceConfigDevice{DiskID: 105},
+				SDG: &linodego.InstanceConfigDevice{DiskID: 106},
+				SDH: &linodego.InstanceConfigDevice{DiskID: 107},
+			},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			devices := testcase.devices
+			assert.Equal(t, testcase.expectedChanged, addVolumeDevice(&devices, testcase.volumeID))
+			assert.Equal(t, testcase.expectedDevices, devices)
+		})
+	}
+}
+
 func disksEqual(t *testing.T, expected, actual *infrav1alpha2.InstanceDisks) {
 	t.Helper()
 	if expected == nil || actual == nil {
@@ -2161,562 +2427,2083 @@ func TestGetVPCLinodeInterfaceConfig(t *testing.T) {
 	}
 }
 
-func TestGetTags(t *testing.T) {
+func TestGetImageID(t *testing.T) {
 	t.Parallel()
 
-	for _, tc := range []struct {
-		name             string
-		clusterName      string
-		currInstanceTags []string
-		machine          *infrav1alpha2.LinodeMachine
-		expMachine       *infrav1alpha2.LinodeMachine
-		expInstanceTags  []string
+	testCases := []struct {
+		name         string
+		imageRef     *corev1.ObjectReference
+		mockSetup    func(mockK8sClient *mock.MockK8sClient)
+		expectErrMsg string
+		expectID     string
 	}{
 		{
-			name:            "Success - No Tags",
-			machine:         &infrav1alpha2.LinodeMachine{},
-			expInstanceTags: []string{},
-			expMachine: &infrav1alpha2.LinodeMachine{
-				Spec:   infrav1alpha2.LinodeMachineSpec{},
-				Status: infrav1alpha2.LinodeMachineStatus{},
+			name:     "Success - Image available in the machine region",
+			imageRef: &corev1.ObjectReference{Name: "test-image"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "test-image",
+					Namespace: "default",
+				}, gomock.Any()).DoAndReturn(func(_ context.Context, _ client.ObjectKey, image *infrav1alpha2.LinodeImage, _ ...client.GetOption) error {
+					image.Status.Ready = true
+					image.Status.ImageID = ptr.To("private/123")
+					image.Status.Regions = []infrav1alpha2.LinodeImageRegionStatus{
+						{Region: "us-sea", Status: "available"},
+						{Region: "us-ord", Status: "available"},
+					}
+					return nil
+				})
 			},
+			expectID: "private/123",
 		},
 		{
-			name:            "Success - add capl-auto-gen tags",
-			machine:         &infrav1alpha2.LinodeMachine{},
-			clusterName:     "test-cluster",
-			expInstanceTags: []string{"test-cluster"},
-			expMachine: &infrav1alpha2.LinodeMachine{
-				Spec:   infrav1alpha2.LinodeMachineSpec{},
-				Status: infrav1alpha2.LinodeMachineStatus{},
+			name:     "Error - Image not ready",
+			imageRef: &corev1.ObjectReference{Name: "test-image", Namespace: "custom-namespace"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "test-image",
+					Namespace: "custom-namespace",
+				}, gomock.Any()).Return(nil)
 			},
+			expectErrMsg: "image is not ready",
 		},
 		{
-			name:             "Success - add tags with no previous tags",
-			clusterName:      "test-cluster",
-			currInstanceTags: []string{"instance-manually-added-tag"},
-			machine: &infrav1alpha2.LinodeMachine{
-				Spec: infrav1alpha2.LinodeMachineSpec{
-					Tags: []string{"tag1", "tag2"},
-				},
-			},
-			expMachine: &infrav1alpha2.LinodeMachine{
-				Spec: infrav1alpha2.LinodeMachineSpec{
-					Tags: []string{"tag1", "tag2"},
-				},
-				Status: infrav1alpha2.LinodeMachineStatus{
-					Tags: []string{"tag1", "tag2"},
-				},
+			name:     "Error - Image not available in the machine region",
+			imageRef: &corev1.ObjectReference{Name: "test-image"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ client.ObjectKey, image *infrav1alpha2.LinodeImage, _ ...client.GetOption) error {
+					image.Status.Ready = true
+					image.Status.ImageID = ptr.To("private/123")
+					image.Status.Regions = []infrav1alpha2.LinodeImageRegionStatus{
+						{Region: "us-sea", Status: "available"},
+						{Region: "us-ord", Status: "replicating"},
+					}
+					return nil
+				})
 			},
-			expInstanceTags: []string{"test-cluster", "tag1", "tag2", "instance-manually-added-tag"},
+			expectErrMsg: "image is not available in region us-ord",
 		},
 		{
-			name:             "Success - add tags with previous tags",
-			clusterName:      "test-cluster",
-			currInstanceTags: []string{"instance-manually-added-tag", "tag1", "tag2"},
-			machine: &infrav1alpha2.LinodeMachine{
-				Spec: infrav1alpha2.LinodeMachineSpec{
-					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
-				},
-				Status: infrav1alpha2.LinodeMachineStatus{
-					Tags: []string{"tag1", "tag2"},
-				},
-			},
-			expMachine: &infrav1alpha2.LinodeMachine{
-				Spec: infrav1alpha2.LinodeMachineSpec{
-					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
-				},
-				Status: infrav1alpha2.LinodeMachineStatus{
-					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
-				},
+			name:     "Error - Failed to get LinodeImage",
+			imageRef: &corev1.ObjectReference{Name: "test-image"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("image not found"))
 			},
-			expInstanceTags: []string{"test-cluster", "tag1", "tag2", "instance-manually-added-tag", "tag3", "tag4"},
+			expectErrMsg: "image not found",
 		},
-		{
-			name:             "Success - remove tags",
-			clusterName:      "test-cluster",
-			currInstanceTags: []string{"instance-manually-added-tag", "tag1", "tag2", "tag3", "tag4"},
-			machine: &infrav1alpha2.LinodeMachine{
-				Spec: infrav1alpha2.LinodeMachineSpec{
-					Tags: []string{"tag3", "tag4"},
-				},
-				Status: infrav1alpha2.LinodeMachineStatus{
-					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
-				},
-			},
-			expMachine: &infrav1alpha2.LinodeMachine{
-				Spec: infrav1alpha2.LinodeMachineSpec{
-					Tags: []string{"tag3", "tag4"},
-				},
-				Status: infrav1alpha2.LinodeMachineStatus{
-					Tags: []string{"tag3", "tag4"},
-				},
-			},
-			expInstanceTags: []string{"test-cluster", "tag3", "tag4", "instance-manually-added-tag"},
-		},
-	} {
+	}
+
+	for _, tc := range testCases {
+		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			// Setup mock controller and clients
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			// Call the function being tested.
-			// ignoring error check since internal function calls throw errors.
-			// internal functions are tested on their corresponding unit-test.
-			out := getTags(&scope.MachineScope{
-				LinodeMachine: tc.machine,
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			tc.mockSetup(mockK8sClient)
+
+			machineScope := &scope.MachineScope{
+				Client: mockK8sClient,
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: tc.clusterName,
+						Namespace: "default",
+					},
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						Region:   "us-ord",
+						ImageRef: tc.imageRef,
 					},
 				},
-			}, tc.currInstanceTags)
-
-			// Check expectations
-			slices.Sort(tc.expInstanceTags)
-			slices.Sort(out)
+			}
 
-			require.Equal(t, tc.expInstanceTags, out)
-			require.Equal(t, tc.expMachine.Status.Tags, tc.machine.Spec.Tags)
+			imageID, err := getImageID(t.Context(), machineScope, testr.New(t))
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectID, imageID)
 		})
 	}
 }
 
-func TestBuildInstanceAddrs(t *testing.T) {
+func TestGetBackupID(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name          string
-		machineScope  *scope.MachineScope
-		instanceID    int
-		expectedAddrs []v1beta2.MachineAddress
-		expectedError error
-		expects       func(client *mock.MockLinodeClient)
+	testCases := []struct {
+		name         string
+		backupRef    *corev1.ObjectReference
+		mockSetup    func(mockK8sClient *mock.MockK8sClient)
+		expectErrMsg string
+		expectID     int
 	}{
 		{
-			name: "Success - basic public IPv4 and IPv6 SLAAC",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
-			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			name:      "Success - Snapshot ready in the machine region",
+			backupRef: &corev1.ObjectReference{Name: "test-snapshot"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "test-snapshot",
+					Namespace: "default",
+				}, gomock.Any()).DoAndReturn(func(_ context.Context, _ client.ObjectKey, snapshot *infrav1alpha2.LinodeInstanceSnapshot, _ ...client.GetOption) error {
+					snapshot.Status.Ready = true
+					snapshot.Status.BackupID = ptr.To(123)
+					snapshot.Status.Region = "us-ord"
+					return nil
+				})
 			},
-			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
-				}, nil)
+			expectID: 123,
+		},
+		{
+			name:      "Error - Snapshot not ready",
+			backupRef: &corev1.ObjectReference{Name: "test-snapshot", Namespace: "custom-namespace"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "test-snapshot",
+					Namespace: "custom-namespace",
+				}, gomock.Any()).Return(nil)
 			},
+			expectErrMsg: "snapshot is not ready",
 		},
 		{
-			name: "Success - with private IPv4",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
+			name:      "Error - Snapshot in another region",
+			backupRef: &corev1.ObjectReference{Name: "test-snapshot"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ client.ObjectKey, snapshot *infrav1alpha2.LinodeInstanceSnapshot, _ ...client.GetOption) error {
+					snapshot.Status.Ready = true
+					snapshot.Status.BackupID = ptr.To(123)
+					snapshot.Status.Region = "us-sea"
+					return nil
+				})
 			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
-				{Address: "192.168.0.2", Type: v1beta2.MachineInternalIP},
+			expectErrMsg: "snapshot is in region us-sea, not us-ord",
+		},
+		{
+			name:      "Error - Failed to get LinodeInstanceSnapshot",
+			backupRef: &corev1.ObjectReference{Name: "test-snapshot"},
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("snapshot not found"))
 			},
-			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public:  []linodego.InstanceIP{{Address: "172.0.0.2"}},
-						Private: []linodego.InstanceIP{{Address: "192.168.0.2"}},
+			expectErrMsg: "snapshot not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			tc.mockSetup(mockK8sClient)
+
+			machineScope := &scope.MachineScope{
+				Client: mockK8sClient,
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
 					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						Region:    "us-ord",
+						BackupRef: tc.backupRef,
 					},
-				}, nil)
+				},
+			}
+
+			backupID, err := getBackupID(t.Context(), machineScope, testr.New(t))
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectID, backupID)
+		})
+	}
+}
+
+func TestGetPlacementGroupID(t *testing.T) {
+	t.Parallel()
+
+	failureDomains := []infrav1alpha2.LinodeFailureDomain{
+		{Name: "pg-1", PlacementGroupRef: &corev1.ObjectReference{Name: "cluster-pg-1"}},
+		{Name: "pg-2", PlacementGroupRef: &corev1.ObjectReference{Name: "cluster-pg-2", Namespace: "custom-namespace"}},
+	}
+	readyPlacementGroup := func(_ context.Context, _ client.ObjectKey, pg *infrav1alpha2.LinodePlacementGroup, _ ...client.GetOption) error {
+		pg.Status.Ready = true
+		pg.Spec.PGID = ptr.To(123)
+		return nil
+	}
+
+	testCases := []struct {
+		name              string
+		placementGroupRef *corev1.ObjectReference
+		failureDomain     string
+		mockSetup         func(mockK8sClient *mock.MockK8sClient)
+		expectErrMsg      string
+		expectID          int
+	}{
+		{
+			name:              "Success - Placement group set on the machine",
+			placementGroupRef: &corev1.ObjectReference{Name: "test-pg"},
+			failureDomain:     "pg-1",
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "test-pg",
+					Namespace: "default",
+				}, gomock.Any()).DoAndReturn(readyPlacementGroup)
 			},
+			expectID: 123,
 		},
 		{
-			name: "Success - with VPC IPv4",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+			name:          "Success - Placement group from the failure domain",
+			failureDomain: "pg-2",
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "cluster-pg-2",
+					Namespace: "custom-namespace",
+				}, gomock.Any()).DoAndReturn(readyPlacementGroup)
+			},
+			expectID: 123,
+		},
+		{
+			name:          "Error - Unknown failure domain",
+			failureDomain: "pg-3",
+			mockSetup:     func(mockK8sClient *mock.MockK8sClient) {},
+			expectErrMsg:  "failure domain pg-3 is not defined on the LinodeCluster",
+		},
+		{
+			name:          "Error - Placement group not ready",
+			failureDomain: "pg-1",
+			mockSetup: func(mockK8sClient *mock.MockK8sClient) {
+				mockK8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{
+					Name:      "cluster-pg-1",
+					Namespace: "default",
+				}, gomock.Any()).Return(nil)
+			},
+			expectErrMsg: "placement group is not ready",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			tc.mockSetup(mockK8sClient)
+
+			machineScope := &scope.MachineScope{
+				Client: mockK8sClient,
+				Machine: &v1beta2.Machine{
+					Spec: v1beta2.MachineSpec{
+						FailureDomain: tc.failureDomain,
+					},
+				},
 				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+					},
 					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
+						FailureDomains: failureDomains,
 					},
 				},
-			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "10.0.0.5", Type: v1beta2.MachineInternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
-			},
-			expects: func(mockClient *mock.MockLinodeClient) {
-				vpcAddress := "10.0.0.5"
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-						VPC:    []linodego.VPCIP{{Address: &vpcAddress}},
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
 					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						PlacementGroupRef: tc.placementGroupRef,
 					},
+				},
+			}
+
+			pgID, err := getPlacementGroupID(t.Context(), machineScope, testr.New(t))
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectID, pgID)
+		})
+	}
+}
+
+func TestCheckCapacity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		image         string
+		instanceLimit int
+		mockSetup     func(mockLinodeClient *mock.MockLinodeClient)
+		expectReason  string
+		expectMessage string
+		expectErrMsg  string
+	}{
+		{
+			name:  "Success - Capacity available",
+			image: "linode/ubuntu22.04",
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return([]linodego.RegionAvailability{
+					{Region: "us-ord", Plan: "g6-standard-2", Available: true},
+					{Region: "us-ord", Plan: "g1-gpu-rtx6000-1", Available: false},
+				}, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{
+					Available:   []string{"Linodes"},
+					Unavailable: []string{"Kubernetes"},
 				}, nil)
 			},
 		},
 		{
-			name: "Success - with VPC IPv6 public (skips SLAAC)",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
-			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "2001:db8::1", Type: v1beta2.MachineExternalIP},
+			name:  "Success - Private image available in the region",
+			image: "private/123",
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{}, nil)
+				mockLinodeClient.EXPECT().GetImage(gomock.Any(), "private/123").Return(&linodego.Image{
+					Regions: []linodego.ImageRegion{{Region: "us-ord", Status: linodego.ImageRegionStatusAvailable}},
+				}, nil)
 			},
-			expects: func(mockClient *mock.MockLinodeClient) {
-				isPublic := true
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-						VPC: []linodego.VPCIP{
-							{
-								IPv6IsPublic:  &isPublic,
-								IPv6Addresses: []linodego.VPCIPIPv6Address{{SLAACAddress: "2001:db8::1"}},
-							},
-						},
-					},
+		},
+		{
+			name:  "Plan unavailable in the region",
+			image: "linode/ubuntu22.04",
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return([]linodego.RegionAvailability{
+					{Region: "us-ord", Plan: "g6-standard-2", Available: false},
 				}, nil)
 			},
+			expectReason:  PlanUnavailableReason,
+			expectMessage: "plan g6-standard-2 is not available in region us-ord",
 		},
 		{
-			name: "Success - with VPC IPv6 private (includes SLAAC)",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
+			name:  "Linodes unavailable to the account",
+			image: "linode/ubuntu22.04",
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{
+					Unavailable: []string{"Linodes", "Block Storage"},
+				}, nil)
 			},
-			instanceID: 123,
+			expectReason:  AccountUnavailableReason,
+			expectMessage: "the account can't create Linodes in region us-ord",
+		},
+		{
+			name:          "Success - Instances below the account limit",
+			image:         "linode/ubuntu22.04",
+			instanceLimit: 10,
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{}, nil)
+				mockLinodeClient.EXPECT().ListInstances(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, opts *linodego.ListOptions) ([]linodego.Instance, error) {
+					opts.Results = 9
+					return make([]linodego.Instance, 9), nil
+				})
+			},
+		},
+		{
+			name:          "Instance limit of the account reached",
+			image:         "linode/ubuntu22.04",
+			instanceLimit: 10,
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{}, nil)
+				mockLinodeClient.EXPECT().ListInstances(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, opts *linodego.ListOptions) ([]linodego.Instance, error) {
+					opts.Results = 10
+					return make([]linodego.Instance, 10), nil
+				})
+			},
+			expectReason:  InstanceLimitReachedReason,
+			expectMessage: "the account has 10 Linodes out of its limit of 10",
+		},
+		{
+			name:          "Error - Failed to list instances",
+			image:         "linode/ubuntu22.04",
+			instanceLimit: 10,
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{}, nil)
+				mockLinodeClient.EXPECT().ListInstances(gomock.Any(), gomock.Any()).Return(nil, errors.New("server error"))
+			},
+			expectErrMsg: "list instances: server error",
+		},
+		{
+			name:  "Private image unavailable in the region",
+			image: "private/123",
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, nil)
+				mockLinodeClient.EXPECT().GetAccountAvailability(gomock.Any(), "us-ord").Return(&linodego.AccountAvailability{}, nil)
+				mockLinodeClient.EXPECT().GetImage(gomock.Any(), "private/123").Return(&linodego.Image{
+					Regions: []linodego.ImageRegion{
+						{Region: "us-sea", Status: linodego.ImageRegionStatusAvailable},
+						{Region: "us-ord", Status: linodego.ImageRegionStatusPending},
+					},
+				}, nil)
+			},
+			expectReason:  ImageUnavailableReason,
+			expectMessage: "image private/123 is not available in region us-ord",
+		},
+		{
+			name:  "Error - Failed to get region availability",
+			image: "linode/ubuntu22.04",
+			mockSetup: func(mockLinodeClient *mock.MockLinodeClient) {
+				mockLinodeClient.EXPECT().GetRegionAvailability(gomock.Any(), "us-ord").Return(nil, errors.New("server error"))
+			},
+			expectErrMsg: "get region availability: server error",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLinodeClient := mock.NewMockLinodeClient(ctrl)
+			tc.mockSetup(mockLinodeClient)
+
+			machineScope := &scope.MachineScope{
+				LinodeClient: mockLinodeClient,
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						Region: "us-ord",
+						Type:   "g6-standard-2",
+						Image:  tc.image,
+					},
+				},
+			}
+
+			reason, message, err := checkCapacity(t.Context(), machineScope, tc.instanceLimit)
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectReason, reason)
+			assert.Equal(t, tc.expectMessage, message)
+		})
+	}
+}
+
+func TestIsInstanceLimitError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "instance limit",
+			err:  &linodego.Error{Code: http.StatusBadRequest, Message: "[400] Account Limit reached. Please open a support ticket."},
+			want: true,
+		},
+		{
+			name: "wrapped instance limit",
+			err:  fmt.Errorf("create instance: %w", &linodego.Error{Code: http.StatusBadRequest, Message: "[400] Account Limit reached."}),
+			want: true,
+		},
+		{
+			name: "other bad request",
+			err:  &linodego.Error{Code: http.StatusBadRequest, Message: "[400] [label] Label must be unique"},
+		},
+		{
+			name: "other limit",
+			err:  &linodego.Error{Code: http.StatusBadRequest, Message: "[400] [disk_size] Disk size exceeds the limit of the plan"},
+		},
+		{
+			name: "rate limit",
+			err:  &linodego.Error{Code: http.StatusTooManyRequests, Message: "[429] Rate limit exceeded"},
+		},
+		{
+			name: "no error",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.want, isInstanceLimitError(testcase.err))
+		})
+	}
+}
+
+func TestCapacityRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cond     *metav1.Condition
+		minDelay time.Duration
+		maxDelay time.Duration
+	}{
+		{
+			name:     "first check",
+			minDelay: reconciler.DefaultMachineControllerCapacityRetryDelay,
+			maxDelay: 2 * reconciler.DefaultMachineControllerCapacityRetryDelay,
+		},
+		{
+			name:     "unavailable for a few minutes",
+			cond:     &metav1.Condition{LastTransitionTime: metav1.NewTime(time.Now().Add(-3 * time.Minute))},
+			minDelay: 3 * time.Minute,
+			maxDelay: 5 * time.Minute,
+		},
+		{
+			name:     "unavailable for hours",
+			cond:     &metav1.Condition{LastTransitionTime: metav1.NewTime(time.Now().Add(-3 * time.Hour))},
+			minDelay: reconciler.DefaultMachineControllerCapacityMaxRetryDelay,
+			maxDelay: 2 * reconciler.DefaultMachineControllerCapacityMaxRetryDelay,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			delay := capacityRetryDelay(testcase.cond)
+			assert.GreaterOrEqual(t, delay, testcase.minDelay)
+			assert.LessOrEqual(t, delay, testcase.maxDelay)
+		})
+	}
+}
+
+func TestGetTags(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                string
+		clusterName         string
+		managementClusterID string
+		currInstanceTags    []string
+		machine             *infrav1alpha2.LinodeMachine
+		expMachine          *infrav1alpha2.LinodeMachine
+		expInstanceTags     []string
+	}{
+		{
+			name:            "Success - No Tags",
+			machine:         &infrav1alpha2.LinodeMachine{},
+			expInstanceTags: []string{},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec:   infrav1alpha2.LinodeMachineSpec{},
+				Status: infrav1alpha2.LinodeMachineStatus{},
+			},
+		},
+		{
+			name:            "Success - add capl-auto-gen tags",
+			machine:         &infrav1alpha2.LinodeMachine{},
+			clusterName:     "test-cluster",
+			expInstanceTags: []string{"test-cluster"},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec:   infrav1alpha2.LinodeMachineSpec{},
+				Status: infrav1alpha2.LinodeMachineStatus{},
+			},
+		},
+		{
+			name:             "Success - add tags with no previous tags",
+			clusterName:      "test-cluster",
+			currInstanceTags: []string{"instance-manually-added-tag"},
+			machine: &infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Tags: []string{"tag1", "tag2"},
+				},
+			},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Tags: []string{"tag1", "tag2"},
+				},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Tags: []string{"tag1", "tag2"},
+				},
+			},
+			expInstanceTags: []string{"test-cluster", "tag1", "tag2", "instance-manually-added-tag"},
+		},
+		{
+			name:             "Success - add tags with previous tags",
+			clusterName:      "test-cluster",
+			currInstanceTags: []string{"instance-manually-added-tag", "tag1", "tag2"},
+			machine: &infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
+				},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Tags: []string{"tag1", "tag2"},
+				},
+			},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
+				},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
+				},
+			},
+			expInstanceTags: []string{"test-cluster", "tag1", "tag2", "instance-manually-added-tag", "tag3", "tag4"},
+		},
+		{
+			name:             "Success - remove tags",
+			clusterName:      "test-cluster",
+			currInstanceTags: []string{"instance-manually-added-tag", "tag1", "tag2", "tag3", "tag4"},
+			machine: &infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Tags: []string{"tag3", "tag4"},
+				},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Tags: []string{"tag1", "tag2", "tag3", "tag4"},
+				},
+			},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Tags: []string{"tag3", "tag4"},
+				},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Tags: []string{"tag3", "tag4"},
+				},
+			},
+			expInstanceTags: []string{"test-cluster", "tag3", "tag4", "instance-manually-added-tag"},
+		},
+		{
+			name:                "Success - add owner tag",
+			clusterName:         "test-cluster",
+			managementClusterID: "test-management-cluster",
+			machine:             &infrav1alpha2.LinodeMachine{},
+			expInstanceTags:     []string{"test-cluster", "capl-owner:test-management-cluster"},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec:   infrav1alpha2.LinodeMachineSpec{},
+				Status: infrav1alpha2.LinodeMachineStatus{},
+			},
+		},
+		{
+			name:                "Success - claim the instance of a moved cluster",
+			clusterName:         "test-cluster",
+			managementClusterID: "test-management-cluster",
+			currInstanceTags:    []string{"test-cluster", "capl-owner:previous-management-cluster"},
+			machine:             &infrav1alpha2.LinodeMachine{},
+			expInstanceTags:     []string{"test-cluster", "capl-owner:test-management-cluster"},
+			expMachine: &infrav1alpha2.LinodeMachine{
+				Spec:   infrav1alpha2.LinodeMachineSpec{},
+				Status: infrav1alpha2.LinodeMachineStatus{},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup mock controller and clients
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Call the function being tested.
+			// ignoring error check since internal function calls throw errors.
+			// internal functions are tested on their corresponding unit-test.
+			out := getTags(&scope.MachineScope{
+				LinodeMachine: tc.machine,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: tc.clusterName,
+					},
+				},
+				ManagementClusterID: tc.managementClusterID,
+			}, tc.currInstanceTags)
+
+			// Check expectations
+			slices.Sort(tc.expInstanceTags)
+			slices.Sort(out)
+
+			require.Equal(t, tc.expInstanceTags, out)
+			require.Equal(t, tc.expMachine.Status.Tags, tc.machine.Spec.Tags)
+		})
+	}
+}
+
+func TestBuildInstanceAddrs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		machineScope  *scope.MachineScope
+		instanceID    int
+		expectedAddrs []v1beta2.MachineAddress
+		expectedError error
+		expects       func(client *mock.MockLinodeClient)
+	}{
+		{
+			name: "Success - basic public IPv4 and IPv6 SLAAC",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - with private IPv4",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+				{Address: "192.168.0.2", Type: v1beta2.MachineInternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public:  []linodego.InstanceIP{{Address: "172.0.0.2"}},
+						Private: []linodego.InstanceIP{{Address: "192.168.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - with VPC IPv4",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "10.0.0.5", Type: v1beta2.MachineInternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				vpcAddress := "10.0.0.5"
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+						VPC:    []linodego.VPCIP{{Address: &vpcAddress}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - with VPC IPv6 public (skips SLAAC)",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "2001:db8::1", Type: v1beta2.MachineExternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				isPublic := true
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+						VPC: []linodego.VPCIP{
+							{
+								IPv6IsPublic:  &isPublic,
+								IPv6Addresses: []linodego.VPCIPIPv6Address{{SLAACAddress: "2001:db8::1"}},
+							},
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - with VPC IPv6 private (includes SLAAC)",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "fd00:1::1", Type: v1beta2.MachineInternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				isPublic := false
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+						VPC: []linodego.VPCIP{
+							{
+								IPv6IsPublic:  &isPublic,
+								IPv6Addresses: []linodego.VPCIPIPv6Address{{SLAACAddress: "fd00:1::1"}},
+							},
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Error - GetInstanceIPAddresses fails",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID:    123,
+			expectedError: fmt.Errorf("get instance ips"),
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(nil, fmt.Errorf("API error"))
+			},
+		},
+		{
+			name: "Error - no public IPv4 addresses",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID:    123,
+			expectedError: errNoPublicIPv4Addrs,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Error - no IPv6 address",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID:    123,
+			expectedError: errNoPublicIPv6Addrs,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: nil,
+				}, nil)
+			},
+		},
+		{
+			name: "Error - no IPv6 SLAAC address",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID:    123,
+			expectedError: errNoPublicIPv6SLAACAddrs,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: nil,
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - with VLAN using LinodeInterfaces",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						LinodeInterfaces: []infrav1alpha2.LinodeInterfaceCreateOptions{
+							{
+								VLAN: &infrav1alpha2.VLANInterface{
+									VLANLabel:       "test-vlan",
+									VLANLabelLegacy: "test-vlan",
+								}},
+						},
+					},
+				},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							UseVlan: true,
+						},
+					},
+				},
+			},
+			instanceID: 123,
 			expectedAddrs: []v1beta2.MachineAddress{
 				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "fd00:1::1", Type: v1beta2.MachineInternalIP},
 				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+				{Address: "10.0.0.1", Type: v1beta2.MachineInternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+				vlanIPAM := "10.0.0.1/11"
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return([]linodego.LinodeInterface{
+					{VLAN: &linodego.VLANInterface{IPAMAddress: &vlanIPAM}},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - with VLAN using legacy Interfaces",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					Spec: infrav1alpha2.LinodeMachineSpec{},
+				},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							UseVlan: true,
+						},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+				{Address: "10.0.0.2", Type: v1beta2.MachineInternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{
+					{
+						Interfaces: []linodego.InstanceConfigInterface{
+							{Purpose: linodego.InterfacePurposeVLAN, IPAMAddress: "10.0.0.2/11"},
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Error - VLAN ListInterfaces fails",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						LinodeInterfaces: []infrav1alpha2.LinodeInterfaceCreateOptions{
+							{
+								VLAN: &infrav1alpha2.VLANInterface{
+									VLANLabel:       "test-vlan",
+									VLANLabelLegacy: "test-vlan",
+								}},
+						},
+					},
+				},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{
+							UseVlan: true,
+						},
+					},
+				},
+			},
+			instanceID:    123,
+			expectedError: fmt.Errorf("handle vlan ips"),
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(nil, fmt.Errorf("list interfaces error"))
+			},
+		},
+		{
+			name: "Success - complete scenario with all IP types",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "10.0.0.5", Type: v1beta2.MachineInternalIP},
+				{Address: "10.0.0.6", Type: v1beta2.MachineInternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+				{Address: "192.168.0.2", Type: v1beta2.MachineInternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				vpcAddr1 := "10.0.0.5"
+				vpcAddr2 := "10.0.0.6"
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public:  []linodego.InstanceIP{{Address: "172.0.0.2"}},
+						Private: []linodego.InstanceIP{{Address: "192.168.0.2"}},
+						VPC: []linodego.VPCIP{
+							{Address: &vpcAddr1},
+							{Address: &vpcAddr2},
+						},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success - VPC IPv4 with empty address is skipped",
+			machineScope: &scope.MachineScope{
+				LinodeMachine: &infrav1alpha2.LinodeMachine{},
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{
+						Network: infrav1alpha2.NetworkSpec{},
+					},
+				},
+			},
+			instanceID: 123,
+			expectedAddrs: []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "10.0.0.5", Type: v1beta2.MachineInternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				vpcAddr1 := "10.0.0.5"
+				emptyAddr := ""
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{
+						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
+						VPC: []linodego.VPCIP{
+							{Address: &vpcAddr1},
+							{Address: &emptyAddr},
+							{Address: nil},
+						},
+					},
+					IPv6: &linodego.InstanceIPv6Response{
+						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockLinodeClient(ctrl)
+			testcase.machineScope.LinodeClient = mockClient
+			testcase.expects(mockClient)
+
+			addrs, err := buildInstanceAddrs(t.Context(), testcase.machineScope, testcase.instanceID)
+			if testcase.expectedError != nil {
+				assert.ErrorContains(t, err, testcase.expectedError.Error())
+			} else {
+				require.NoError(t, err, "expected no error but got one")
+				assert.Equal(t, testcase.expectedAddrs, addrs)
+			}
+		})
+	}
+}
+
+func TestGetAdoptionSelector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		linodeMachine *infrav1alpha2.LinodeMachine
+		want          *infrav1alpha2.InstanceAdoptionSelector
+	}{
+		{
+			name:          "no adoption requested",
+			linodeMachine: &infrav1alpha2.LinodeMachine{},
+		},
+		{
+			name: "annotation with an ID",
+			linodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{infrav1alpha2.AdoptInstanceAnnotation: "123"}},
+			},
+			want: &infrav1alpha2.InstanceAdoptionSelector{ID: ptr.To(123)},
+		},
+		{
+			name: "annotation with a label",
+			linodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{infrav1alpha2.AdoptInstanceAnnotation: "worker-1"}},
+			},
+			want: &infrav1alpha2.InstanceAdoptionSelector{Label: "worker-1"},
+		},
+		{
+			name: "field takes precedence over the annotation",
+			linodeMachine: &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{infrav1alpha2.AdoptInstanceAnnotation: "123"}},
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					AdoptInstance: &infrav1alpha2.InstanceAdoptionSelector{Label: "worker-1"},
+				},
+			},
+			want: &infrav1alpha2.InstanceAdoptionSelector{Label: "worker-1"},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.want, getAdoptionSelector(testcase.linodeMachine))
+		})
+	}
+}
+
+func TestReconcileAdopt(t *testing.T) {
+	t.Parallel()
+
+	adoptableInstance := func() linodego.Instance {
+		return linodego.Instance{
+			ID:                  123,
+			Region:              "us-ord",
+			Type:                "g6-standard-2",
+			Status:              linodego.InstanceRunning,
+			Tags:                []string{"existing"},
+			InterfaceGeneration: linodego.GenerationLegacyConfig,
+		}
+	}
+	instanceConfig := linodego.InstanceConfig{
+		ID: 1,
+		Devices: &linodego.InstanceConfigDeviceMap{
+			SDA: &linodego.InstanceConfigDevice{DiskID: 10},
+			SDB: &linodego.InstanceConfigDevice{DiskID: 11},
+		},
+		Interfaces: []linodego.InstanceConfigInterface{{Purpose: linodego.InterfacePurposePublic}},
+	}
+
+	tests := []struct {
+		name           string
+		instances      []linodego.Instance
+		linodeMachines []infrav1alpha2.LinodeMachine
+		poolMachines   []infrav1alpha2.LinodeMachinePoolMachine
+		linodeClusters []infrav1alpha2.LinodeCluster
+		expects        func(mockClient *mock.MockLinodeClient)
+		expectAdopted  bool
+		expectMessage  string
+	}{
+		{
+			name:          "no instance matches the selector",
+			expectMessage: "no instance matches the adoption selector",
+		},
+		{
+			name:      "instance is used by another LinodeMachine",
+			instances: []linodego.Instance{adoptableInstance()},
+			linodeMachines: []infrav1alpha2.LinodeMachine{{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"},
+				Spec:       infrav1alpha2.LinodeMachineSpec{ProviderID: ptr.To("linode://123")},
+			}},
+			expectMessage: "instance 123 is already used by LinodeMachine default/other",
+		},
+		{
+			name:      "instance is used by a LinodeMachinePoolMachine",
+			instances: []linodego.Instance{adoptableInstance()},
+			poolMachines: []infrav1alpha2.LinodeMachinePoolMachine{{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool-machine", Namespace: "default"},
+				Spec:       infrav1alpha2.LinodeMachinePoolMachineSpec{ProviderID: ptr.To("linode://123")},
+			}},
+			expectMessage: "instance 123 is already used by LinodeMachinePoolMachine default/pool-machine",
+		},
+		{
+			name: "instance is tagged for another cluster",
+			instances: func() []linodego.Instance {
+				instance := adoptableInstance()
+				instance.Tags = append(instance.Tags, "other-cluster")
+				return []linodego.Instance{instance}
+			}(),
+			linodeClusters: []infrav1alpha2.LinodeCluster{
+				{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-cluster", Namespace: "default"}},
+			},
+			expectMessage: "instance 123 is tagged for LinodeCluster default/other-cluster",
+		},
+		{
+			name: "instance is owned by another management cluster",
+			instances: func() []linodego.Instance {
+				instance := adoptableInstance()
+				instance.Tags = append(instance.Tags, "capl-owner:other-management-cluster")
+				return []linodego.Instance{instance}
+			}(),
+			expectMessage: "instance 123 is owned by another management cluster: capl-owner:other-management-cluster",
+		},
+		{
+			name: "instance differs from the LinodeMachine",
+			instances: func() []linodego.Instance {
+				instance := adoptableInstance()
+				instance.Region = "us-sea"
+				instance.Type = "g6-standard-4"
+				return []linodego.Instance{instance}
+			}(),
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{{
+					ID:      1,
+					Devices: &linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 10}},
+				}}, nil)
+			},
+			expectMessage: "instance 123 differs from the LinodeMachine: region is us-sea, not us-ord; type is g6-standard-4, not g6-standard-2; " +
+				"interfaces are [], not [public]; no disk is attached as sdb",
+		},
+		{
+			name: "instance uses another interface generation",
+			instances: func() []linodego.Instance {
+				instance := adoptableInstance()
+				instance.InterfaceGeneration = linodego.GenerationLinode
+				return []linodego.Instance{instance}
+			}(),
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{instanceConfig}, nil)
 			},
+			expectMessage: "instance 123 differs from the LinodeMachine: interface generation is linode, not legacy_config",
+		},
+		{
+			name:      "instance is adopted",
+			instances: []linodego.Instance{adoptableInstance()},
+			linodeMachines: []infrav1alpha2.LinodeMachine{{
+				ObjectMeta: metav1.ObjectMeta{Name: "adopting", Namespace: "default", UID: "adopting-uid"},
+				Spec:       infrav1alpha2.LinodeMachineSpec{ProviderID: ptr.To("linode://123")},
+			}},
+			linodeClusters: []infrav1alpha2.LinodeCluster{{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}}},
 			expects: func(mockClient *mock.MockLinodeClient) {
-				isPublic := false
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{instanceConfig}, nil)
 				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
 					IPv4: &linodego.InstanceIPv4Response{
 						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
 					},
 					IPv6: &linodego.InstanceIPv6Response{
 						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-						VPC: []linodego.VPCIP{
-							{
-								IPv6IsPublic:  &isPublic,
-								IPv6Addresses: []linodego.VPCIPIPv6Address{{SLAACAddress: "fd00:1::1"}},
-							},
-						},
 					},
 				}, nil)
-			},
+				mockClient.EXPECT().UpdateInstance(gomock.Any(), 123, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int, opts linodego.InstanceUpdateOptions) (*linodego.Instance, error) {
+						assert.ElementsMatch(t, []string{"existing", "adopted", "test-cluster"}, opts.Tags)
+						return &linodego.Instance{ID: 123}, nil
+					})
+				mockClient.EXPECT().ListInstanceFirewalls(gomock.Any(), 123, gomock.Any()).Return(nil, nil)
+				mockClient.EXPECT().UpdateInstanceFirewalls(gomock.Any(), 123, linodego.InstanceFirewallUpdateOptions{
+					FirewallIDs: []int{5},
+				}).Return(nil, nil)
+			},
+			expectAdopted: true,
+			expectMessage: "adopted instance 123",
 		},
-		{
-			name: "Error - GetInstanceIPAddresses fails",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockLinodeClient(ctrl)
+			mockClient.EXPECT().ListInstances(gomock.Any(), gomock.Any()).Return(testcase.instances, nil)
+			if testcase.expects != nil {
+				testcase.expects(mockClient)
+			}
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			mockK8sClient.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					switch list := list.(type) {
+					case *infrav1alpha2.LinodeMachineList:
+						list.Items = testcase.linodeMachines
+					case *infrav1alpha2.LinodeMachinePoolMachineList:
+						list.Items = testcase.poolMachines
+					case *infrav1alpha2.LinodeClusterList:
+						list.Items = testcase.linodeClusters
+					}
+					return nil
+				}).AnyTimes()
+
+			machineScope := &scope.MachineScope{
+				Client:        mockK8sClient,
+				LinodeClient:  mockClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+				LinodeMachine: &infrav1alpha2.LinodeMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "adopting", Namespace: "default", UID: "adopting-uid"},
+					Spec: infrav1alpha2.LinodeMachineSpec{
+						Region:     "us-ord",
+						Type:       "g6-standard-2",
+						Tags:       []string{"adopted"},
+						FirewallID: 5,
+						Interfaces: []infrav1alpha2.InstanceConfigInterfaceCreateOptions{{Purpose: linodego.InterfacePurposePublic}},
+						DataDisks: &infrav1alpha2.InstanceDisks{
+							SDB: &infrav1alpha2.InstanceDisk{Size: resource.MustParse("10Gi")},
+						},
+						AdoptInstance: &infrav1alpha2.InstanceAdoptionSelector{ID: ptr.To(123)},
 					},
 				},
+			}
+			r := &LinodeMachineReconciler{Recorder: events.NewFakeRecorder(10)}
+
+			res, err := r.reconcileAdopt(t.Context(), testr.New(t), machineScope, machineScope.LinodeMachine.Spec.AdoptInstance)
+			require.NoError(t, err)
+			assert.True(t, res.IsZero())
+
+			linodeMachine := machineScope.LinodeMachine
+			cond := linodeMachine.GetCondition(ConditionAdopted)
+			require.NotNil(t, cond)
+			assert.Equal(t, testcase.expectMessage, cond.Message)
+			if !testcase.expectAdopted {
+				assert.Equal(t, AdoptionRefusedReason, cond.Reason)
+				assert.Nil(t, linodeMachine.Spec.ProviderID)
+				assert.Nil(t, linodeMachine.Status.InstanceState)
+				return
+			}
+			assert.Equal(t, InstanceAdoptedReason, cond.Reason)
+			assert.Equal(t, ptr.To("linode://123"), linodeMachine.Spec.ProviderID)
+			assert.Equal(t, ptr.To(linodego.InstanceRunning), linodeMachine.Status.InstanceState)
+			assert.Equal(t, 11, linodeMachine.Spec.DataDisks.SDB.DiskID)
+			assert.Equal(t, []v1beta2.MachineAddress{
+				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
+				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			}, linodeMachine.Status.Addresses)
+		})
+	}
+}
+
+func TestDiffLinodeInterfaces(t *testing.T) {
+	t.Parallel()
+
+	actual := []linodego.LinodeInterface{
+		{
+			ID:           1,
+			DefaultRoute: &linodego.InterfaceDefaultRoute{IPv4: ptr.To(true)},
+			Public:       &linodego.PublicInterface{IPv4: &linodego.PublicInterfaceIPv4{Addresses: []linodego.PublicInterfaceIPv4Address{{Address: "172.0.0.2"}}}},
+		},
+		{
+			ID: 2,
+			VPC: &linodego.VPCInterface{SubnetID: 10, IPv4: linodego.VPCInterfaceIPv4{
+				Addresses: []linodego.VPCInterfaceIPv4Address{{Address: "10.0.0.2", Primary: true}},
+			}},
+		},
+		{ID: 3, VLAN: &linodego.VLANInterface{VLANLabel: "old"}},
+	}
+
+	tests := []struct {
+		name    string
+		desired []linodego.LinodeInterfaceCreateOptions
+		want    interfaceChanges
+	}{
+		{
+			name: "up to date",
+			desired: []linodego.LinodeInterfaceCreateOptions{
+				{Public: &linodego.PublicInterfaceCreateOptions{}},
+				{VPC: &linodego.VPCInterfaceCreateOptions{SubnetID: 10, IPv4: &linodego.VPCInterfaceIPv4CreateOptions{
+					Addresses: []linodego.VPCInterfaceIPv4AddressCreateOptions{{Address: ptr.To("auto"), Primary: ptr.To(true)}},
+				}}},
+				{VLAN: &linodego.VLANInterfaceCreateOptions{VLANLabel: "old"}},
 			},
-			instanceID:    123,
-			expectedError: fmt.Errorf("get instance ips"),
-			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(nil, fmt.Errorf("API error"))
+			want: interfaceChanges{update: map[int]linodego.LinodeInterfaceUpdateOptions{}},
+		},
+		{
+			name: "interfaces replaced and updated",
+			desired: []linodego.LinodeInterfaceCreateOptions{
+				{DefaultRoute: &linodego.InterfaceDefaultRouteCreateOptions{IPv4: ptr.To(false)}, Public: &linodego.PublicInterfaceCreateOptions{}},
+				{VPC: &linodego.VPCInterfaceCreateOptions{SubnetID: 20}},
+				{VLAN: &linodego.VLANInterfaceCreateOptions{VLANLabel: "old"}},
+			},
+			want: interfaceChanges{
+				create: []linodego.LinodeInterfaceCreateOptions{{VPC: &linodego.VPCInterfaceCreateOptions{SubnetID: 20}}},
+				update: map[int]linodego.LinodeInterfaceUpdateOptions{
+					1: {DefaultRoute: &linodego.InterfaceDefaultRouteUpdateOptions{IPv4: ptr.To(false)}},
+				},
+				delete: []int{2},
 			},
 		},
 		{
-			name: "Error - no public IPv4 addresses",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
+			name: "address changed",
+			desired: []linodego.LinodeInterfaceCreateOptions{
+				{Public: &linodego.PublicInterfaceCreateOptions{}},
+				{VPC: &linodego.VPCInterfaceCreateOptions{SubnetID: 10, IPv4: &linodego.VPCInterfaceIPv4CreateOptions{
+					Addresses: []linodego.VPCInterfaceIPv4AddressCreateOptions{{Address: ptr.To("10.0.0.3"), Primary: ptr.To(true)}},
+				}}},
+			},
+			want: interfaceChanges{
+				update: map[int]linodego.LinodeInterfaceUpdateOptions{
+					2: {VPC: &linodego.VPCInterfaceUpdateOptions{IPv4: &linodego.VPCInterfaceIPv4CreateOptions{
+						Addresses: []linodego.VPCInterfaceIPv4AddressCreateOptions{{Address: ptr.To("10.0.0.3"), Primary: ptr.To(true)}},
+					}}},
 				},
+				delete: []int{3},
 			},
-			instanceID:    123,
-			expectedError: errNoPublicIPv4Addrs,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := diffLinodeInterfaces(testcase.desired, actual)
+			assert.Equal(t, testcase.want, changes)
+		})
+	}
+}
+
+func TestMergeInstanceConfigInterfaces(t *testing.T) {
+	t.Parallel()
+
+	actual := []linodego.InstanceConfigInterface{
+		{Purpose: linodego.InterfacePurposeVPC, Primary: true, SubnetID: ptr.To(10), IPv4: &linodego.VPCIPv4{VPC: "10.0.0.2", NAT1To1: ptr.To("any")}},
+		{Purpose: linodego.InterfacePurposePublic},
+	}
+
+	tests := []struct {
+		name        string
+		desired     []linodego.InstanceConfigInterfaceCreateOptions
+		want        []linodego.InstanceConfigInterfaceCreateOptions
+		wantChanged bool
+	}{
+		{
+			name: "up to date",
+			desired: []linodego.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposeVPC, SubnetID: ptr.To(10), IPv4: &linodego.VPCIPv4CreateOptions{NAT1To1: ptr.To("any")}},
+				{Purpose: linodego.InterfacePurposePublic},
+			},
+			want: []linodego.InstanceConfigInterfaceCreateOptions{actual[0].GetCreateOptions(), actual[1].GetCreateOptions()},
+		},
+		{
+			name: "VLAN interface added",
+			desired: []linodego.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposeVLAN, Label: "cluster", IPAMAddress: "10.0.0.2/11"},
+				{Purpose: linodego.InterfacePurposeVPC, SubnetID: ptr.To(10)},
+				{Purpose: linodego.InterfacePurposePublic},
+			},
+			want: []linodego.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposeVLAN, Label: "cluster", IPAMAddress: "10.0.0.2/11"},
+				actual[0].GetCreateOptions(),
+				actual[1].GetCreateOptions(),
+			},
+			wantChanged: true,
+		},
+		{
+			name: "VPC subnet changed",
+			desired: []linodego.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposeVPC, SubnetID: ptr.To(20)},
+				{Purpose: linodego.InterfacePurposePublic},
+			},
+			want: []linodego.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposeVPC, SubnetID: ptr.To(20)},
+				actual[1].GetCreateOptions(),
+			},
+			wantChanged: true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			merged, changed := mergeInstanceConfigInterfaces(testcase.desired, actual)
+			assert.Equal(t, testcase.want, merged)
+			assert.Equal(t, testcase.wantChanged, changed)
+		})
+	}
+}
+
+func TestReconcileInterfaces(t *testing.T) {
+	t.Parallel()
+
+	vpc := &linodego.VPC{ID: 5, Subnets: []linodego.VPCSubnet{{ID: 20}}}
+	actual := []linodego.LinodeInterface{
+		{ID: 1, Public: &linodego.PublicInterface{}},
+		{ID: 2, VPC: &linodego.VPCInterface{SubnetID: 10}},
+	}
+	instanceIPs := &linodego.InstanceIPAddressResponse{
+		IPv4: &linodego.InstanceIPv4Response{Public: []linodego.InstanceIP{{Address: "172.0.0.2"}}},
+		IPv6: &linodego.InstanceIPv6Response{SLAAC: &linodego.InstanceIP{Address: "fd00::"}},
+	}
+
+	tests := []struct {
+		name            string
+		condition       *metav1.Condition
+		status          linodego.InstanceStatus
+		expects         func(mockClient *mock.MockLinodeClient)
+		controlPlane    bool
+		expectRequeue   bool
+		expectCondition metav1.Condition
+		expectAddresses bool
+	}{
+		{
+			name:   "interfaces created with the instance",
+			status: linodego.InstanceRunning,
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionTrue, Reason: InterfacesUpToDateReason, ObservedGeneration: 2,
+			},
+		},
+		{
+			name:      "interfaces already updated",
+			condition: &metav1.Condition{Status: metav1.ConditionTrue, Reason: InterfacesUpdatedReason, ObservedGeneration: 2},
+			status:    linodego.InstanceRunning,
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionTrue, Reason: InterfacesUpdatedReason, ObservedGeneration: 2,
+			},
+		},
+		{
+			name:      "instance shut down for a new VPC",
+			condition: &metav1.Condition{Status: metav1.ConditionTrue, Reason: InterfacesUpToDateReason, ObservedGeneration: 1},
+			status:    linodego.InstanceRunning,
 			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
-				}, nil)
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(actual, nil)
+				mockClient.EXPECT().GetVPC(gomock.Any(), 5).Return(vpc, nil)
+				mockClient.EXPECT().ShutdownInstance(gomock.Any(), 123).Return(nil)
+			},
+			expectRequeue: true,
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionFalse, Reason: InterfacesShuttingDownReason, ObservedGeneration: 2,
 			},
 		},
 		{
-			name: "Error - no IPv6 address",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
+			name:         "control plane instance is not shut down",
+			condition:    &metav1.Condition{Status: metav1.ConditionTrue, Reason: InterfacesUpToDateReason, ObservedGeneration: 1},
+			status:       linodego.InstanceRunning,
+			controlPlane: true,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(actual, nil)
+				mockClient.EXPECT().GetVPC(gomock.Any(), 5).Return(vpc, nil)
 			},
-			instanceID:    123,
-			expectedError: errNoPublicIPv6Addrs,
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionFalse, Reason: InterfacesUpdateFailedReason, ObservedGeneration: 2,
+			},
+		},
+		{
+			name:      "addresses refreshed while the instance is running",
+			condition: &metav1.Condition{Status: metav1.ConditionTrue, Reason: InterfacesUpToDateReason, ObservedGeneration: 1},
+			status:    linodego.InstanceRunning,
 			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: nil,
-				}, nil)
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return([]linodego.LinodeInterface{
+					{ID: 1, Public: &linodego.PublicInterface{}},
+					{ID: 2, VPC: &linodego.VPCInterface{SubnetID: 20}},
+				}, nil).AnyTimes()
+				mockClient.EXPECT().GetVPC(gomock.Any(), 5).Return(vpc, nil)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(instanceIPs, nil)
+			},
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionTrue, Reason: InterfacesUpToDateReason, ObservedGeneration: 2,
 			},
+			expectAddresses: true,
 		},
 		{
-			name: "Error - no IPv6 SLAAC address",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
+			name:      "interfaces changed while the instance is offline",
+			condition: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesShuttingDownReason, ObservedGeneration: 2},
+			status:    linodego.InstanceOffline,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(actual, nil)
+				mockClient.EXPECT().GetVPC(gomock.Any(), 5).Return(vpc, nil)
+				deleted := mockClient.EXPECT().DeleteInterface(gomock.Any(), 123, 2).Return(nil)
+				mockClient.EXPECT().CreateInterface(gomock.Any(), 123, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int, opts linodego.LinodeInterfaceCreateOptions) (*linodego.LinodeInterface, error) {
+						require.NotNil(t, opts.VPC)
+						assert.Equal(t, 20, opts.VPC.SubnetID)
+						return &linodego.LinodeInterface{ID: 3}, nil
+					}).After(deleted)
+				mockClient.EXPECT().BootInstance(gomock.Any(), 123, gomock.Any()).Return(nil)
+			},
+			expectRequeue: true,
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionFalse, Reason: InterfacesBootingReason, ObservedGeneration: 2,
 			},
-			instanceID:    123,
-			expectedError: errNoPublicIPv6SLAACAddrs,
+		},
+		{
+			name:      "instance booted after the update",
+			condition: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesBootingReason, ObservedGeneration: 2},
+			status:    linodego.InstanceRunning,
 			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: nil,
-					},
-				}, nil)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(instanceIPs, nil)
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(nil, nil).AnyTimes()
+			},
+			expectCondition: metav1.Condition{
+				Status: metav1.ConditionTrue, Reason: InterfacesUpdatedReason, ObservedGeneration: 2,
+			},
+			expectAddresses: true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockLinodeClient(ctrl)
+			if testcase.expects != nil {
+				testcase.expects(mockClient)
+			}
+
+			linodeMachine := &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-machine", Generation: 2},
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Region:              "us-ord",
+					Type:                "g6-standard-2",
+					InterfaceGeneration: linodego.GenerationLinode,
+					LinodeInterfaces:    []infrav1alpha2.LinodeInterfaceCreateOptions{{Public: &infrav1alpha2.PublicInterfaceCreateOptions{}}},
+					VPCID:               ptr.To(5),
+				},
+			}
+			if testcase.condition != nil {
+				condition := *testcase.condition
+				condition.Type = ConditionInterfacesUpdated
+				linodeMachine.SetCondition(condition)
+			}
+			machineScope := &scope.MachineScope{
+				LinodeClient:  mockClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{},
+				LinodeMachine: linodeMachine,
+			}
+			if testcase.controlPlane {
+				machineScope.Machine = &v1beta2.Machine{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1beta2.MachineControlPlaneLabel: ""}},
+				}
+			}
+			linodeInstance := &linodego.Instance{ID: 123, Status: testcase.status, InterfaceGeneration: linodego.GenerationLinode}
+			r := &LinodeMachineReconciler{Recorder: events.NewFakeRecorder(10)}
+
+			res, err := r.reconcileInterfaces(t.Context(), testr.New(t), machineScope, linodeInstance)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectRequeue, res.RequeueAfter > 0)
+			condition := linodeMachine.GetCondition(ConditionInterfacesUpdated)
+			require.NotNil(t, condition)
+			assert.Equal(t, testcase.expectCondition.Status, condition.Status)
+			assert.Equal(t, testcase.expectCondition.Reason, condition.Reason)
+			assert.Equal(t, testcase.expectCondition.ObservedGeneration, condition.ObservedGeneration)
+			assert.Equal(t, testcase.expectAddresses, len(linodeMachine.Status.Addresses) > 0)
+		})
+	}
+}
+
+func TestInstanceConfigInterfacesToLinodeInterfaces(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		interfaces []infrav1alpha2.InstanceConfigInterfaceCreateOptions
+		want       []infrav1alpha2.LinodeInterfaceCreateOptions
+	}{
+		{
+			name: "no interfaces",
+		},
+		{
+			name: "public and VLAN interfaces",
+			interfaces: []infrav1alpha2.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposeVLAN, Label: "vlan", IPAMAddress: "10.0.0.1/24"},
+				{Purpose: linodego.InterfacePurposePublic},
+			},
+			want: []infrav1alpha2.LinodeInterfaceCreateOptions{
+				{VLAN: &infrav1alpha2.VLANInterface{VLANLabel: "vlan", IPAMAddress: ptr.To("10.0.0.1/24")}},
+				{
+					Public:       &infrav1alpha2.PublicInterfaceCreateOptions{},
+					DefaultRoute: &infrav1alpha2.InterfaceDefaultRoute{IPv4: ptr.To(true), IPv6: ptr.To(true)},
+				},
 			},
 		},
 		{
-			name: "Success - with VLAN using LinodeInterfaces",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{
-					Spec: infrav1alpha2.LinodeMachineSpec{
-						LinodeInterfaces: []infrav1alpha2.LinodeInterfaceCreateOptions{
-							{
-								VLAN: &infrav1alpha2.VLANInterface{
-									VLANLabel:       "test-vlan",
-									VLANLabelLegacy: "test-vlan",
-								}},
+			name: "primary VPC interface",
+			interfaces: []infrav1alpha2.InstanceConfigInterfaceCreateOptions{
+				{Purpose: linodego.InterfacePurposePublic},
+				{
+					Purpose:  linodego.InterfacePurposeVPC,
+					Primary:  true,
+					SubnetID: ptr.To(10),
+					IPv4:     &infrav1alpha2.VPCIPv4{VPC: "10.0.0.2", NAT1To1: "any"},
+					IPRanges: []string{"10.0.1.0/24"},
+				},
+				{Purpose: linodego.InterfacePurposeVPC, SubnetID: ptr.To(20)},
+			},
+			want: []infrav1alpha2.LinodeInterfaceCreateOptions{
+				{Public: &infrav1alpha2.PublicInterfaceCreateOptions{}},
+				{
+					DefaultRoute: &infrav1alpha2.InterfaceDefaultRoute{IPv4: ptr.To(true)},
+					VPC: &infrav1alpha2.VPCInterfaceCreateOptions{
+						SubnetID: ptr.To(10),
+						IPv4: &infrav1alpha2.VPCInterfaceIPv4CreateOptions{
+							Addresses: []infrav1alpha2.VPCInterfaceIPv4AddressCreateOptions{
+								{Address: "10.0.0.2", Primary: ptr.To(true), NAT1To1Address: ptr.To("auto")},
+							},
+							Ranges: []infrav1alpha2.VPCInterfaceIPv4RangeCreateOptions{{Range: "10.0.1.0/24"}},
 						},
 					},
 				},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{
-							UseVlan: true,
+				{
+					VPC: &infrav1alpha2.VPCInterfaceCreateOptions{
+						SubnetID: ptr.To(20),
+						IPv4: &infrav1alpha2.VPCInterfaceIPv4CreateOptions{
+							Addresses: []infrav1alpha2.VPCInterfaceIPv4AddressCreateOptions{{Address: "auto", Primary: ptr.To(true)}},
 						},
 					},
 				},
 			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
-				{Address: "10.0.0.1", Type: v1beta2.MachineInternalIP},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.want, instanceConfigInterfacesToLinodeInterfaces(testcase.interfaces))
+		})
+	}
+}
+
+func TestReconcileInterfaceUpgrade(t *testing.T) {
+	t.Parallel()
+
+	upgradedInterfaces := []linodego.LinodeInterface{
+		{ID: 1, Public: &linodego.PublicInterface{}},
+		{ID: 2, VLAN: &linodego.VLANInterface{VLANLabel: "vlan"}},
+	}
+
+	tests := []struct {
+		name                  string
+		annotated             bool
+		controlPlane          bool
+		condition             *metav1.Condition
+		instanceGeneration    linodego.InterfaceGeneration
+		status                linodego.InstanceStatus
+		expects               func(mockClient *mock.MockLinodeClient)
+		expectRequeue         bool
+		expectCondition       *metav1.Condition
+		expectAnnotation      bool
+		expectLinodeInterface bool
+	}{
+		{
+			name:               "upgrade not requested",
+			instanceGeneration: linodego.GenerationLegacyConfig,
+			status:             linodego.InstanceRunning,
+		},
+		{
+			name:               "instance shut down for the upgrade",
+			annotated:          true,
+			instanceGeneration: linodego.GenerationLegacyConfig,
+			status:             linodego.InstanceRunning,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{{ID: 7}}, nil)
+				mockClient.EXPECT().UpgradeInterfaces(gomock.Any(), 123, linodego.LinodeInterfacesUpgradeOptions{
+					ConfigID: ptr.To(7),
+					DryRun:   ptr.To(true),
+				}).Return(&linodego.LinodeInterfacesUpgrade{ConfigID: 7, DryRun: true, Interfaces: upgradedInterfaces}, nil)
+				mockClient.EXPECT().ShutdownInstance(gomock.Any(), 123).Return(nil)
+			},
+			expectRequeue:    true,
+			expectCondition:  &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeShuttingDownReason},
+			expectAnnotation: true,
+		},
+		{
+			name:               "upgrade refused in dry-run",
+			annotated:          true,
+			instanceGeneration: linodego.GenerationLegacyConfig,
+			status:             linodego.InstanceRunning,
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{{ID: 7}}, nil)
+				mockClient.EXPECT().UpgradeInterfaces(gomock.Any(), 123, gomock.Any()).Return(nil, &linodego.Error{Code: 400, Message: "Linode has multiple configs"})
 			},
+			expectCondition: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeFailedReason, ObservedGeneration: 2},
+		},
+		{
+			name:               "upgrade refused for control plane machines",
+			annotated:          true,
+			controlPlane:       true,
+			instanceGeneration: linodego.GenerationLegacyConfig,
+			status:             linodego.InstanceRunning,
+			expectCondition:    &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeFailedReason, ObservedGeneration: 2},
+		},
+		{
+			name:               "failed upgrade not retried",
+			condition:          &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeFailedReason, ObservedGeneration: 2},
+			instanceGeneration: linodego.GenerationLegacyConfig,
+			status:             linodego.InstanceRunning,
+			expectCondition:    &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeFailedReason, ObservedGeneration: 2},
+		},
+		{
+			name:               "interfaces upgraded while the instance is offline",
+			condition:          &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeShuttingDownReason},
+			instanceGeneration: linodego.GenerationLegacyConfig,
+			status:             linodego.InstanceOffline,
 			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
+				mockClient.EXPECT().ListInstanceFirewalls(gomock.Any(), 123, gomock.Any()).Return([]linodego.Firewall{{ID: 9}}, nil)
+				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{{ID: 7}}, nil)
+				mockClient.EXPECT().UpgradeInterfaces(gomock.Any(), 123, linodego.LinodeInterfacesUpgradeOptions{
+					ConfigID: ptr.To(7),
+					DryRun:   ptr.To(false),
+				}).Return(&linodego.LinodeInterfacesUpgrade{ConfigID: 7, Interfaces: upgradedInterfaces}, nil)
+				mockClient.EXPECT().ListFirewallDevices(gomock.Any(), 9, gomock.Any()).Return([]linodego.FirewallDevice{
+					{ID: 30, Entity: linodego.FirewallDeviceEntity{ID: 123, Type: linodego.FirewallDeviceLinode}},
 				}, nil)
-				vlanIPAM := "10.0.0.1/11"
-				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return([]linodego.LinodeInterface{
-					{VLAN: &linodego.VLANInterface{IPAMAddress: &vlanIPAM}},
+				attached := mockClient.EXPECT().CreateFirewallDevice(gomock.Any(), 9, linodego.FirewallDeviceCreateOptions{
+					ID:   1,
+					Type: linodego.FirewallDeviceLinodeInterface,
+				}).Return(&linodego.FirewallDevice{ID: 31}, nil)
+				mockClient.EXPECT().DeleteFirewallDevice(gomock.Any(), 9, 30).Return(nil).After(attached)
+				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
+					IPv4: &linodego.InstanceIPv4Response{Public: []linodego.InstanceIP{{Address: "172.0.0.2"}}},
+					IPv6: &linodego.InstanceIPv6Response{SLAAC: &linodego.InstanceIP{Address: "fd00::"}},
 				}, nil)
+				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(nil, nil).AnyTimes()
+				mockClient.EXPECT().BootInstance(gomock.Any(), 123, gomock.Any()).Return(nil)
 			},
+			expectRequeue:         true,
+			expectCondition:       &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeBootingReason},
+			expectLinodeInterface: true,
 		},
 		{
-			name: "Success - with VLAN using legacy Interfaces",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{
-					Spec: infrav1alpha2.LinodeMachineSpec{},
-				},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{
-							UseVlan: true,
-						},
+			name:               "instance booted after the upgrade",
+			condition:          &metav1.Condition{Status: metav1.ConditionFalse, Reason: InterfacesUpgradeBootingReason},
+			instanceGeneration: linodego.GenerationLinode,
+			status:             linodego.InstanceRunning,
+			expectCondition:    &metav1.Condition{Status: metav1.ConditionTrue, Reason: InterfacesUpgradedReason},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockLinodeClient(ctrl)
+			if testcase.expects != nil {
+				testcase.expects(mockClient)
+			}
+
+			linodeMachine := &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-machine", Generation: 2, Annotations: map[string]string{}},
+				Spec: infrav1alpha2.LinodeMachineSpec{
+					Region: "us-ord",
+					Type:   "g6-standard-2",
+					Interfaces: []infrav1alpha2.InstanceConfigInterfaceCreateOptions{
+						{Purpose: linodego.InterfacePurposePublic},
+						{Purpose: linodego.InterfacePurposeVLAN, Label: "vlan"},
 					},
 				},
-			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
-				{Address: "10.0.0.2", Type: v1beta2.MachineInternalIP},
-			},
+			}
+			if testcase.annotated {
+				linodeMachine.Annotations[infrav1alpha2.UpgradeInterfacesAnnotation] = ""
+			}
+			if testcase.condition != nil {
+				condition := *testcase.condition
+				condition.Type = ConditionInterfacesUpgraded
+				linodeMachine.SetCondition(condition)
+			}
+			linodeMachine.SetCondition(metav1.Condition{
+				Type:               ConditionInterfacesUpdated,
+				Status:             metav1.ConditionTrue,
+				Reason:             InterfacesUpToDateReason,
+				ObservedGeneration: 1,
+			})
+			machineScope := &scope.MachineScope{
+				LinodeClient:  mockClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{},
+				LinodeMachine: linodeMachine,
+			}
+			if testcase.controlPlane {
+				machineScope.Machine = &v1beta2.Machine{ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{v1beta2.MachineControlPlaneLabel: ""},
+				}}
+			}
+			linodeInstance := &linodego.Instance{ID: 123, Status: testcase.status, InterfaceGeneration: testcase.instanceGeneration}
+			r := &LinodeMachineReconciler{Recorder: events.NewFakeRecorder(10)}
+
+			res, err := r.reconcileInterfaceUpgrade(t.Context(), testr.New(t), machineScope, linodeInstance)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectRequeue, res.RequeueAfter > 0)
+			condition := linodeMachine.GetCondition(ConditionInterfacesUpgraded)
+			if testcase.expectCondition == nil {
+				assert.Nil(t, condition)
+			} else {
+				require.NotNil(t, condition)
+				assert.Equal(t, testcase.expectCondition.Status, condition.Status)
+				assert.Equal(t, testcase.expectCondition.Reason, condition.Reason)
+				assert.Equal(t, testcase.expectCondition.ObservedGeneration, condition.ObservedGeneration)
+			}
+
+			assert.Equal(t, testcase.expectAnnotation, metav1.HasAnnotation(linodeMachine.ObjectMeta, infrav1alpha2.UpgradeInterfacesAnnotation))
+			if !testcase.expectLinodeInterface {
+				return
+			}
+			assert.Equal(t, linodego.GenerationLinode, linodeMachine.Spec.InterfaceGeneration)
+			assert.Nil(t, linodeMachine.Spec.Interfaces)
+			assert.Len(t, linodeMachine.Spec.LinodeInterfaces, 2)
+			assert.NotEmpty(t, linodeMachine.Status.Addresses)
+			assert.Nil(t, linodeMachine.GetCondition(ConditionInterfacesUpdated))
+		})
+	}
+}
+
+func TestReconcileGracefulShutdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		gracefulShutdown *infrav1alpha2.GracefulShutdown
+		drained          *metav1.Condition
+		shutDown         *metav1.Condition
+		expects          func(mockClient *mock.MockLinodeClient)
+		expectRequeue    bool
+		expectDrained    *metav1.Condition
+		expectShutDown   *metav1.Condition
+		expectEvent      bool
+	}{
+		{
+			name: "graceful shutdown disabled",
+		},
+		{
+			name:             "NodeBalancer backends drained",
+			gracefulShutdown: &infrav1alpha2.GracefulShutdown{DrainNodeBalancer: true, DrainDuration: &metav1.Duration{Duration: time.Minute}},
 			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
+				mockClient.EXPECT().ListNodeBalancerNodes(gomock.Any(), 1, 2, gomock.Any()).Return([]linodego.NodeBalancerNode{
+					{ID: 10, Address: "192.168.0.2:6443", Mode: linodego.ModeAccept},
+					{ID: 11, Address: "192.168.0.3:6443", Mode: linodego.ModeAccept},
 				}, nil)
-				mockClient.EXPECT().ListInstanceConfigs(gomock.Any(), 123, gomock.Any()).Return([]linodego.InstanceConfig{
-					{
-						Interfaces: []linodego.InstanceConfigInterface{
-							{Purpose: linodego.InterfacePurposeVLAN, IPAMAddress: "10.0.0.2/11"},
-						},
-					},
+				mockClient.EXPECT().ListNodeBalancerNodes(gomock.Any(), 1, 3, gomock.Any()).Return([]linodego.NodeBalancerNode{
+					{ID: 12, Address: "192.168.0.2:8132", Mode: linodego.ModeDrain},
 				}, nil)
+				mockClient.EXPECT().UpdateNodeBalancerNode(gomock.Any(), 1, 2, 10, linodego.NodeBalancerNodeUpdateOptions{Mode: linodego.ModeDrain}).
+					Return(&linodego.NodeBalancerNode{ID: 10, Mode: linodego.ModeDrain}, nil)
 			},
+			expectRequeue: true,
+			expectDrained: &metav1.Condition{Status: metav1.ConditionFalse, Reason: NodeBalancerDrainingReason},
 		},
 		{
-			name: "Error - VLAN ListInterfaces fails",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{
-					Spec: infrav1alpha2.LinodeMachineSpec{
-						LinodeInterfaces: []infrav1alpha2.LinodeInterfaceCreateOptions{
-							{
-								VLAN: &infrav1alpha2.VLANInterface{
-									VLANLabel:       "test-vlan",
-									VLANLabelLegacy: "test-vlan",
-								}},
-						},
-					},
-				},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{
-							UseVlan: true,
-						},
-					},
-				},
+			name:             "instance shut down after the NodeBalancer drained",
+			gracefulShutdown: &infrav1alpha2.GracefulShutdown{DrainNodeBalancer: true, DrainDuration: &metav1.Duration{Duration: time.Minute}},
+			drained: &metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Reason:             NodeBalancerDrainingReason,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
 			},
-			instanceID:    123,
-			expectedError: fmt.Errorf("handle vlan ips"),
 			expects: func(mockClient *mock.MockLinodeClient) {
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
-				}, nil)
-				mockClient.EXPECT().ListInterfaces(gomock.Any(), 123, gomock.Any()).Return(nil, fmt.Errorf("list interfaces error"))
+				mockClient.EXPECT().GetInstance(gomock.Any(), 123).Return(&linodego.Instance{ID: 123, Status: linodego.InstanceRunning}, nil)
+				mockClient.EXPECT().ShutdownInstance(gomock.Any(), 123).Return(nil)
 			},
+			expectRequeue:  true,
+			expectDrained:  &metav1.Condition{Status: metav1.ConditionTrue, Reason: NodeBalancerDrainedReason},
+			expectShutDown: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InstanceShuttingDownReason},
 		},
 		{
-			name: "Success - complete scenario with all IP types",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
+			name:             "instance shutting down",
+			gracefulShutdown: &infrav1alpha2.GracefulShutdown{},
+			shutDown: &metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Reason:             InstanceShuttingDownReason,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
 			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "10.0.0.5", Type: v1beta2.MachineInternalIP},
-				{Address: "10.0.0.6", Type: v1beta2.MachineInternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
-				{Address: "192.168.0.2", Type: v1beta2.MachineInternalIP},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstance(gomock.Any(), 123).Return(&linodego.Instance{ID: 123, Status: linodego.InstanceShuttingDown}, nil)
 			},
+			expectRequeue:  true,
+			expectShutDown: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InstanceShuttingDownReason},
+		},
+		{
+			name:             "instance offline",
+			gracefulShutdown: &infrav1alpha2.GracefulShutdown{},
+			shutDown:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: InstanceShuttingDownReason},
 			expects: func(mockClient *mock.MockLinodeClient) {
-				vpcAddr1 := "10.0.0.5"
-				vpcAddr2 := "10.0.0.6"
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public:  []linodego.InstanceIP{{Address: "172.0.0.2"}},
-						Private: []linodego.InstanceIP{{Address: "192.168.0.2"}},
-						VPC: []linodego.VPCIP{
-							{Address: &vpcAddr1},
-							{Address: &vpcAddr2},
-						},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
-				}, nil)
+				mockClient.EXPECT().GetInstance(gomock.Any(), 123).Return(&linodego.Instance{ID: 123, Status: linodego.InstanceOffline}, nil)
 			},
+			expectShutDown: &metav1.Condition{Status: metav1.ConditionTrue, Reason: InstanceShutDownReason},
 		},
 		{
-			name: "Success - VPC IPv4 with empty address is skipped",
-			machineScope: &scope.MachineScope{
-				LinodeMachine: &infrav1alpha2.LinodeMachine{},
-				LinodeCluster: &infrav1alpha2.LinodeCluster{
-					Spec: infrav1alpha2.LinodeClusterSpec{
-						Network: infrav1alpha2.NetworkSpec{},
-					},
-				},
+			name:             "instance shutdown timed out",
+			gracefulShutdown: &infrav1alpha2.GracefulShutdown{Timeout: &metav1.Duration{Duration: time.Minute}},
+			shutDown: &metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Reason:             InstanceShuttingDownReason,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
 			},
-			instanceID: 123,
-			expectedAddrs: []v1beta2.MachineAddress{
-				{Address: "172.0.0.2", Type: v1beta2.MachineExternalIP},
-				{Address: "10.0.0.5", Type: v1beta2.MachineInternalIP},
-				{Address: "fd00::", Type: v1beta2.MachineExternalIP},
+			expects: func(mockClient *mock.MockLinodeClient) {
+				mockClient.EXPECT().GetInstance(gomock.Any(), 123).Return(&linodego.Instance{ID: 123, Status: linodego.InstanceShuttingDown}, nil)
 			},
+			expectShutDown: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InstanceShutdownTimedOutReason},
+			expectEvent:    true,
+		},
+		{
+			name:             "instance shutdown failed",
+			gracefulShutdown: &infrav1alpha2.GracefulShutdown{},
 			expects: func(mockClient *mock.MockLinodeClient) {
-				vpcAddr1 := "10.0.0.5"
-				emptyAddr := ""
-				mockClient.EXPECT().GetInstanceIPAddresses(gomock.Any(), 123).Return(&linodego.InstanceIPAddressResponse{
-					IPv4: &linodego.InstanceIPv4Response{
-						Public: []linodego.InstanceIP{{Address: "172.0.0.2"}},
-						VPC: []linodego.VPCIP{
-							{Address: &vpcAddr1},
-							{Address: &emptyAddr},
-							{Address: nil},
-						},
-					},
-					IPv6: &linodego.InstanceIPv6Response{
-						SLAAC: &linodego.InstanceIP{Address: "fd00::"},
-					},
-				}, nil)
+				mockClient.EXPECT().GetInstance(gomock.Any(), 123).Return(&linodego.Instance{ID: 123, Status: linodego.InstanceRunning}, nil)
+				mockClient.EXPECT().ShutdownInstance(gomock.Any(), 123).Return(&linodego.Error{Code: 403, Message: "forbidden"})
 			},
+			expectShutDown: &metav1.Condition{Status: metav1.ConditionFalse, Reason: InstanceShutdownFailedReason},
+			expectEvent:    true,
 		},
 	}
-
 	for _, tt := range tests {
 		testcase := tt
 		t.Run(testcase.name, func(t *testing.T) {
@@ -2726,16 +4513,58 @@ func TestBuildInstanceAddrs(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockClient := mock.NewMockLinodeClient(ctrl)
-			testcase.machineScope.LinodeClient = mockClient
-			testcase.expects(mockClient)
+			if testcase.expects != nil {
+				testcase.expects(mockClient)
+			}
 
-			addrs, err := buildInstanceAddrs(t.Context(), testcase.machineScope, testcase.instanceID)
-			if testcase.expectedError != nil {
-				assert.ErrorContains(t, err, testcase.expectedError.Error())
-			} else {
-				require.NoError(t, err, "expected no error but got one")
-				assert.Equal(t, testcase.expectedAddrs, addrs)
+			linodeMachine := &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-machine", DeletionTimestamp: &metav1.Time{Time: time.Now()}},
+				Spec:       infrav1alpha2.LinodeMachineSpec{GracefulShutdown: testcase.gracefulShutdown},
+				Status: infrav1alpha2.LinodeMachineStatus{
+					Addresses: []v1beta2.MachineAddress{{Type: v1beta2.MachineInternalIP, Address: "192.168.0.2"}},
+				},
+			}
+			if testcase.drained != nil {
+				condition := *testcase.drained
+				condition.Type = ConditionNodeBalancerDrained
+				linodeMachine.SetCondition(condition)
+			}
+			if testcase.shutDown != nil {
+				condition := *testcase.shutDown
+				condition.Type = ConditionInstanceShutDown
+				linodeMachine.SetCondition(condition)
+			}
+			machineScope := &scope.MachineScope{
+				LinodeClient: mockClient,
+				LinodeCluster: &infrav1alpha2.LinodeCluster{
+					Spec: infrav1alpha2.LinodeClusterSpec{Network: infrav1alpha2.NetworkSpec{
+						NodeBalancerID:                ptr.To(1),
+						ApiserverNodeBalancerConfigID: ptr.To(2),
+						AdditionalPorts:               []infrav1alpha2.LinodeNBPortConfig{{Port: 8132, NodeBalancerConfigID: ptr.To(3)}},
+					}},
+				},
+				LinodeMachine: linodeMachine,
+			}
+			recorder := events.NewFakeRecorder(10)
+			r := &LinodeMachineReconciler{Recorder: recorder}
+
+			res, err := r.reconcileGracefulShutdown(t.Context(), testr.New(t), machineScope, 123)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectRequeue, res.RequeueAfter > 0)
+			for conditionType, expected := range map[string]*metav1.Condition{
+				ConditionNodeBalancerDrained: testcase.expectDrained,
+				ConditionInstanceShutDown:    testcase.expectShutDown,
+			} {
+				condition := linodeMachine.GetCondition(conditionType)
+				if expected == nil {
+					assert.Nil(t, condition, conditionType)
+					continue
+				}
+				require.NotNil(t, condition, conditionType)
+				assert.Equal(t, expected.Status, condition.Status, conditionType)
+				assert.Equal(t, expected.Reason, condition.Reason, conditionType)
 			}
+			assert.Equal(t, testcase.expectEvent, len(recorder.Events) > 0)
 		})
 	}
 }
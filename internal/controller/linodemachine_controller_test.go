@@ -222,9 +222,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				CreateInstance(ctx, gomock.Any()).
-				After(getImage).
+				After(getAccountAvailability).
 				Return(&linodego.Instance{
 					ID:     123,
 					IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -381,9 +389,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				CreateInstance(ctx, gomock.Any()).
-				After(getImage).
+				After(getAccountAvailability).
 				Return(&linodego.Instance{
 					ID:     123,
 					IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -459,9 +475,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 			GetImage(ctx, gomock.Any()).
 			After(getRegion).
 			Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+		getRegionAvailability := mockLinodeClient.EXPECT().
+			GetRegionAvailability(ctx, gomock.Any()).
+			After(getImage).
+			Return([]linodego.RegionAvailability{}, nil)
+		getAccountAvailability := mockLinodeClient.EXPECT().
+			GetAccountAvailability(ctx, gomock.Any()).
+			After(getRegionAvailability).
+			Return(&linodego.AccountAvailability{}, nil)
 		createInst := mockLinodeClient.EXPECT().
 			CreateInstance(ctx, gomock.Any()).
-			After(getImage).
+			After(getAccountAvailability).
 			Return(&linodego.Instance{
 				ID:     123,
 				IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -552,9 +576,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 			GetImage(ctx, gomock.Any()).
 			After(getRegion).
 			Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+		getRegionAvailability := mockLinodeClient.EXPECT().
+			GetRegionAvailability(ctx, gomock.Any()).
+			After(getImage).
+			Return([]linodego.RegionAvailability{}, nil)
+		getAccountAvailability := mockLinodeClient.EXPECT().
+			GetAccountAvailability(ctx, gomock.Any()).
+			After(getRegionAvailability).
+			Return(&linodego.AccountAvailability{}, nil)
 		createInst := mockLinodeClient.EXPECT().
 			CreateInstance(ctx, gomock.Any()).
-			After(getImage).
+			After(getAccountAvailability).
 			Return(nil, &linodego.Error{Code: http.StatusBadRequest, Message: "[400] [label] Label must be unique among your linodes"})
 		listInst := mockLinodeClient.EXPECT().
 			ListInstances(ctx, gomock.Any()).
@@ -650,9 +682,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				CreateInstance(ctx, gomock.Any()).
-				After(getImage).
+				After(getAccountAvailability).
 				DoAndReturn(func(_, _ any) (*linodego.Instance, error) {
 					time.Sleep(time.Microsecond)
 					return nil, linodego.NewError(linodego.Error{Code: http.StatusRequestTimeout, Message: "time is up"})
@@ -701,9 +741,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				CreateInstance(ctx, gomock.Any()).
-				After(getImage).
+				After(getAccountAvailability).
 				DoAndReturn(func(_, _ any) (*linodego.Instance, error) {
 					return nil, linodego.NewError(errors.New("context deadline exceeded"))
 				})
@@ -740,9 +788,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				CreateInstance(ctx, gomock.Any()).
-				After(getImage).
+				After(getAccountAvailability).
 				DoAndReturn(func(_, _ any) (*linodego.Instance, error) {
 					return nil, linodego.NewError(linodego.Error{Code: 400, Message: "bad configuration"})
 				})
@@ -779,9 +835,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				GetType(ctx, nanodePlan).
-				After(getImage).
+				After(getAccountAvailability).
 				Return(nil, &linodego.Error{Code: http.StatusServiceUnavailable})
 			extraDisk := resource.MustParse("128Mi")
 			linodeMachine.Spec.DataDisks = &infrav1alpha2.InstanceDisks{
@@ -833,9 +897,17 @@ var _ = Describe("create", Label("machine", "create"), func() {
 				GetImage(ctx, gomock.Any()).
 				After(getRegion).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			getRegionAvailability := mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				After(getImage).
+				Return([]linodego.RegionAvailability{}, nil)
+			getAccountAvailability := mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				After(getRegionAvailability).
+				Return(&linodego.AccountAvailability{}, nil)
 			getInstType := mockLinodeClient.EXPECT().
 				GetType(ctx, nanodePlan).
-				After(getImage).
+				After(getAccountAvailability).
 				Return(&linodego.LinodeType{Label: nanodePlan, Disk: 15000}, nil)
 			mockLinodeClient.EXPECT().
 				CreateInstance(ctx, gomock.Any()).
@@ -1032,6 +1104,12 @@ var _ = Describe("create", Label("machine", "create"), func() {
 			mockLinodeClient.EXPECT().
 				GetImage(ctx, gomock.Any()).
 				Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+			mockLinodeClient.EXPECT().
+				GetRegionAvailability(ctx, gomock.Any()).
+				Return([]linodego.RegionAvailability{}, nil)
+			mockLinodeClient.EXPECT().
+				GetAccountAvailability(ctx, gomock.Any()).
+				Return(&linodego.AccountAvailability{}, nil)
 			mockLinodeClient.EXPECT().
 				GetType(ctx, nanodePlan).
 				Return(&linodego.LinodeType{Label: nanodePlan, Disk: 15000}, nil)
@@ -1411,9 +1489,17 @@ var _ = Describe("createDNS", Label("machine", "createDNS"), func() {
 			GetImage(ctx, gomock.Any()).
 			After(getRegion).
 			Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+		getRegionAvailability := mockLinodeClient.EXPECT().
+			GetRegionAvailability(ctx, gomock.Any()).
+			After(getImage).
+			Return([]linodego.RegionAvailability{}, nil)
+		getAccountAvailability := mockLinodeClient.EXPECT().
+			GetAccountAvailability(ctx, gomock.Any()).
+			After(getRegionAvailability).
+			Return(&linodego.AccountAvailability{}, nil)
 		createInst := mockLinodeClient.EXPECT().
 			CreateInstance(ctx, gomock.Any()).
-			After(getImage).
+			After(getAccountAvailability).
 			Return(&linodego.Instance{
 				ID:     123,
 				IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -1633,8 +1719,16 @@ var _ = Describe("machine-lifecycle", Ordered, Label("machine", "machine-lifecyc
 							GetImage(ctx, gomock.Any()).
 							After(getRegion).
 							Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
-						mck.LinodeClient.EXPECT().CreateInstance(gomock.Any(), gomock.Any()).
+						getRegionAvailability := mck.LinodeClient.EXPECT().
+							GetRegionAvailability(ctx, gomock.Any()).
 							After(getImage).
+							Return([]linodego.RegionAvailability{}, nil)
+						getAccountAvailability := mck.LinodeClient.EXPECT().
+							GetAccountAvailability(ctx, gomock.Any()).
+							After(getRegionAvailability).
+							Return(&linodego.AccountAvailability{}, nil)
+						mck.LinodeClient.EXPECT().CreateInstance(gomock.Any(), gomock.Any()).
+							After(getAccountAvailability).
 							Return(nil, &linodego.Error{Code: http.StatusBadGateway})
 						mck.LinodeClient.EXPECT().
 							OnAfterResponse(gomock.Any()).
@@ -1670,6 +1764,11 @@ var _ = Describe("machine-lifecycle", Ordered, Label("machine", "machine-lifecyc
 			Path(
 				Call("machine is not created because there were too many requests", func(ctx context.Context, mck Mock) {
 					linodeMachine.Spec.FirewallRef = nil
+					mScope.LinodeMachine.SetCondition(metav1.Condition{
+						Type:   ConditionPreflightCapacity,
+						Status: metav1.ConditionTrue,
+						Reason: CapacityAvailableReason, // We have to set the reason to not fail object patching
+					})
 				}),
 				OneOf(
 					Path(Result("create requeues when failing to create instance", func(ctx context.Context, mck Mock) {
@@ -1759,9 +1858,17 @@ var _ = Describe("machine-lifecycle", Ordered, Label("machine", "machine-lifecyc
 							GetImage(ctx, gomock.Any()).
 							After(getRegion).
 							Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+						getRegionAvailability := mck.LinodeClient.EXPECT().
+							GetRegionAvailability(ctx, gomock.Any()).
+							After(getImage).
+							Return([]linodego.RegionAvailability{}, nil)
+						getAccountAvailability := mck.LinodeClient.EXPECT().
+							GetAccountAvailability(ctx, gomock.Any()).
+							After(getRegionAvailability).
+							Return(&linodego.AccountAvailability{}, nil)
 						createInst := mck.LinodeClient.EXPECT().
 							CreateInstance(ctx, gomock.Any()).
-							After(getImage).
+							After(getAccountAvailability).
 							Return(&linodego.Instance{
 								ID:     123,
 								IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -3331,9 +3438,17 @@ var _ = Describe("machine in vlan", Label("machine", "vlan"), Ordered, func() {
 			GetImage(ctx, gomock.Any()).
 			After(getRegion).
 			Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+		getRegionAvailability := mockLinodeClient.EXPECT().
+			GetRegionAvailability(ctx, gomock.Any()).
+			After(getImage).
+			Return([]linodego.RegionAvailability{}, nil)
+		getAccountAvailability := mockLinodeClient.EXPECT().
+			GetAccountAvailability(ctx, gomock.Any()).
+			After(getRegionAvailability).
+			Return(&linodego.AccountAvailability{}, nil)
 		createInst := mockLinodeClient.EXPECT().
 			CreateInstance(ctx, gomock.Any()).
-			After(getImage).
+			After(getAccountAvailability).
 			Return(&linodego.Instance{
 				ID:     123,
 				IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -3513,9 +3628,17 @@ var _ = Describe("machine in vlan for new network interfaces", Label("machine",
 			GetImage(ctx, gomock.Any()).
 			After(getRegion).
 			Return(&linodego.Image{Capabilities: []string{"cloud-init"}}, nil)
+		getRegionAvailability := mockLinodeClient.EXPECT().
+			GetRegionAvailability(ctx, gomock.Any()).
+			After(getImage).
+			Return([]linodego.RegionAvailability{}, nil)
+		getAccountAvailability := mockLinodeClient.EXPECT().
+			GetAccountAvailability(ctx, gomock.Any()).
+			After(getRegionAvailability).
+			Return(&linodego.AccountAvailability{}, nil)
 		createInst := mockLinodeClient.EXPECT().
 			CreateInstance(ctx, gomock.Any()).
-			After(getImage).
+			After(getAccountAvailability).
 			Return(&linodego.Instance{
 				ID:     123,
 				IPv4:   []net.IP{net.IPv4(192, 168, 0, 2)},
@@ -4302,3 +4425,472 @@ var _ = Describe("direct vpc functions", Label("machine", "vpc", "functions"), O
 		})
 	})
 })
+
+var _ = Describe("machine-resize", Label("machine", "machine-resize"), func() {
+	var linodeMachine infrav1alpha2.LinodeMachine
+	var reconciler LinodeMachineReconciler
+	mScope := &scope.MachineScope{}
+
+	suite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{})
+
+	suite.BeforeEach(func(_ context.Context, mck Mock) {
+		linodeMachine = infrav1alpha2.LinodeMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "machine-resize",
+				Namespace:  defaultNamespace,
+				Generation: 2,
+			},
+			Spec: infrav1alpha2.LinodeMachineSpec{
+				Region:             "us-ord",
+				Type:               "g6-standard-2",
+				ProviderID:         util.Pointer("linode://123"),
+				AllowInPlaceResize: true,
+			},
+		}
+		mScope.LinodeMachine = &linodeMachine
+		mScope.LinodeClient = mck.LinodeClient
+		reconciler = LinodeMachineReconciler{Recorder: mck.Recorder()}
+	})
+
+	suite.Run(
+		OneOf(
+			Path(Result("type unchanged", func(ctx context.Context, mck Mock) {
+				res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: "g6-standard-2", Status: linodego.InstanceRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.IsZero()).To(BeTrue())
+				Expect(linodeMachine.GetCondition(ConditionResized)).To(BeNil())
+			})),
+			Path(Result("resize not allowed", func(ctx context.Context, mck Mock) {
+				linodeMachine.Spec.AllowInPlaceResize = false
+				res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.IsZero()).To(BeTrue())
+				Expect(linodeMachine.GetCondition(ConditionResized)).To(BeNil())
+			})),
+			Path(
+				Call("instance shuts down", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ShutdownInstance(ctx, 123).Return(nil)
+				}),
+				Result("shutting down", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceRunning})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(linodeMachine.GetCondition(ConditionResized).Reason).To(Equal(ResizeShuttingDownReason))
+				}),
+			),
+			Path(
+				Call("instance is resized", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ResizeInstance(ctx, 123, linodego.InstanceResizeOptions{
+						Type:                "g6-standard-2",
+						MigrationType:       linodego.ColdMigration,
+						AllowAutoDiskResize: util.Pointer(false),
+					}).Return(nil)
+				}),
+				Result("resizing", func(ctx context.Context, mck Mock) {
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionResized, Status: metav1.ConditionFalse, Reason: ResizeShuttingDownReason})
+					res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(linodeMachine.GetCondition(ConditionResized).Reason).To(Equal(ResizingReason))
+					Expect(mck.Events()).To(ContainSubstring("Resizing instance 123 from g6-nanode-1 to g6-standard-2"))
+				}),
+			),
+			Path(
+				Call("resize is rejected", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ResizeInstance(ctx, 123, gomock.Any()).Return(&linodego.Error{Code: http.StatusBadRequest, Message: "disks too large"})
+					mck.LinodeClient.EXPECT().BootInstance(ctx, 123, gomock.Any()).Return(nil)
+				}),
+				Result("resize failed", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					cond := linodeMachine.GetCondition(ConditionResized)
+					Expect(cond.Reason).To(Equal(ResizeFailedReason))
+					Expect(cond.ObservedGeneration).To(Equal(int64(2)))
+					Expect(mck.Events()).To(ContainSubstring("disks too large"))
+
+					// the failed resize is not retried until the LinodeMachine changes
+					res, err = reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceRunning})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.IsZero()).To(BeTrue())
+				}),
+			),
+			Path(
+				Call("resize event is in progress", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListEvents(ctx, gomock.Any()).Return([]linodego.Event{{ID: 1, Status: linodego.EventStarted}}, nil)
+				}),
+				Result("waits for resize", func(ctx context.Context, mck Mock) {
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionResized, Status: metav1.ConditionFalse, Reason: ResizingReason})
+					res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Instance resize not yet finished"))
+				}),
+			),
+			Path(
+				Call("resize event failed", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListEvents(ctx, gomock.Any()).Return([]linodego.Event{{ID: 1, Status: linodego.EventFailed}}, nil)
+					mck.LinodeClient.EXPECT().BootInstance(ctx, 123, gomock.Any()).Return(nil)
+				}),
+				Result("resize failed", func(ctx context.Context, mck Mock) {
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionResized, Status: metav1.ConditionFalse, Reason: ResizingReason})
+					_, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: nanodePlan, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(linodeMachine.GetCondition(ConditionResized).Reason).To(Equal(ResizeFailedReason))
+					Expect(mck.Events()).To(ContainSubstring("resize event 1 failed"))
+				}),
+			),
+			Path(
+				Call("instance is booted", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().BootInstance(ctx, 123, linodego.InstanceBootOptions{}).Return(nil)
+				}),
+				Result("booting", func(ctx context.Context, mck Mock) {
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionResized, Status: metav1.ConditionFalse, Reason: ResizingReason})
+					res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: "g6-standard-2", Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(linodeMachine.GetCondition(ConditionResized).Reason).To(Equal(ResizeBootingReason))
+				}),
+			),
+			Path(Result("resize completed", func(ctx context.Context, mck Mock) {
+				linodeMachine.SetCondition(metav1.Condition{Type: ConditionResized, Status: metav1.ConditionFalse, Reason: ResizeBootingReason})
+				res, err := reconciler.reconcileResize(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Type: "g6-standard-2", Status: linodego.InstanceRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.IsZero()).To(BeTrue())
+				cond := linodeMachine.GetCondition(ConditionResized)
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(ResizeCompletedReason))
+			})),
+		),
+	)
+})
+
+var _ = Describe("machine-rebuild", Label("machine", "machine-rebuild"), func() {
+	var linodeMachine infrav1alpha2.LinodeMachine
+	var reconciler LinodeMachineReconciler
+	mScope := &scope.MachineScope{}
+
+	suite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{}, mock.MockK8sClient{})
+
+	suite.BeforeEach(func(_ context.Context, mck Mock) {
+		linodeMachine = infrav1alpha2.LinodeMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "machine-rebuild",
+				Namespace:   defaultNamespace,
+				Annotations: map[string]string{infrav1alpha2.RebuildAnnotation: ""},
+			},
+			Spec: infrav1alpha2.LinodeMachineSpec{
+				Region:         "us-ord",
+				Type:           "g6-standard-2",
+				Image:          "linode/ubuntu24.04",
+				ProviderID:     util.Pointer("linode://123"),
+				AuthorizedKeys: []string{"ssh-ed25519 AAAA"},
+				DataDisks: &infrav1alpha2.InstanceDisks{
+					SDB: &infrav1alpha2.InstanceDisk{DiskID: 456, Size: resource.MustParse("10Gi")},
+				},
+			},
+		}
+		mScope.LinodeMachine = &linodeMachine
+		mScope.LinodeCluster = &infrav1alpha2.LinodeCluster{}
+		mScope.Machine = &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				Bootstrap: clusterv1.Bootstrap{
+					DataSecretName: ptr.To("bootstrap-secret"),
+				},
+			},
+		}
+		mScope.Client = mck.K8sClient
+		mScope.LinodeClient = mck.LinodeClient
+		reconciler = LinodeMachineReconciler{Recorder: mck.Recorder()}
+	})
+
+	suite.Run(
+		OneOf(
+			Path(Result("rebuild not requested", func(ctx context.Context, mck Mock) {
+				delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+				res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.IsZero()).To(BeTrue())
+				Expect(linodeMachine.GetCondition(ConditionRebuilt)).To(BeNil())
+			})),
+			Path(
+				Call("instance is rebuilt", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+							obj.(*corev1.Secret).Data = map[string][]byte{"value": []byte("userdata")}
+							return nil
+						})
+					mck.LinodeClient.EXPECT().ListInstanceConfigs(ctx, 123, gomock.Any()).Return([]linodego.InstanceConfig{{
+						ID: 1,
+						Interfaces: []linodego.InstanceConfigInterface{{
+							Purpose:  linodego.InterfacePurposeVPC,
+							Primary:  true,
+							SubnetID: ptr.To(10),
+							IPv4:     &linodego.VPCIPv4{VPC: "10.0.0.2", NAT1To1: ptr.To("192.0.2.1")},
+						}},
+					}}, nil)
+					mck.LinodeClient.EXPECT().RebuildInstance(ctx, 123, linodego.InstanceRebuildOptions{
+						Image:          "linode/ubuntu24.04",
+						AuthorizedKeys: []string{"ssh-ed25519 AAAA"},
+						Booted:         util.Pointer(false),
+						Metadata:       &linodego.InstanceMetadataOptions{UserData: "dXNlcmRhdGE="},
+					}).Return(&linodego.Instance{ID: 123, Status: linodego.InstanceRebuilding}, nil)
+				}),
+				Result("rebuilding", func(ctx context.Context, mck Mock) {
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionPreflightAdditionalDisksCreated, Status: metav1.ConditionTrue, Reason: "AdditionalDisksCreated"})
+					res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceRunning})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(linodeMachine.GetCondition(ConditionRebuilt).Reason).To(Equal(RebuildingReason))
+					Expect(linodeMachine.GetCondition(ConditionPreflightAdditionalDisksCreated)).To(BeNil())
+					Expect(linodeMachine.Annotations).NotTo(HaveKey(infrav1alpha2.RebuildAnnotation))
+					Expect(linodeMachine.Spec.DataDisks.SDB.DiskID).To(BeZero())
+					Expect(linodeMachine.Status.RebuildInterfaces).To(Equal([]infrav1alpha2.InstanceConfigInterfaceCreateOptions{{
+						Purpose:  linodego.InterfacePurposeVPC,
+						Primary:  true,
+						SubnetID: ptr.To(10),
+						IPv4:     &infrav1alpha2.VPCIPv4{VPC: "10.0.0.2", NAT1To1: "192.0.2.1"},
+					}}))
+					Expect(mck.Events()).To(ContainSubstring("Rebuilding instance 123 from image linode/ubuntu24.04"))
+				}),
+			),
+			Path(
+				Call("rebuild is rejected", func(ctx context.Context, mck Mock) {
+					mck.K8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+							obj.(*corev1.Secret).Data = map[string][]byte{"value": []byte("userdata")}
+							return nil
+						})
+					mck.LinodeClient.EXPECT().ListInstanceConfigs(ctx, 123, gomock.Any()).Return([]linodego.InstanceConfig{{ID: 1}}, nil)
+					mck.LinodeClient.EXPECT().RebuildInstance(ctx, 123, gomock.Any()).Return(nil, &linodego.Error{Code: http.StatusBadRequest, Message: "image not found"})
+				}),
+				Result("rebuild failed", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceRunning})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.IsZero()).To(BeTrue())
+					Expect(linodeMachine.GetCondition(ConditionRebuilt).Reason).To(Equal(RebuildFailedReason))
+					Expect(linodeMachine.Annotations).NotTo(HaveKey(infrav1alpha2.RebuildAnnotation))
+					Expect(linodeMachine.Spec.DataDisks.SDB.DiskID).To(Equal(456))
+					Expect(mck.Events()).To(ContainSubstring("image not found"))
+
+					// the failed rebuild is not retried until it is requested again
+					res, err = reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceRunning})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.IsZero()).To(BeTrue())
+				}),
+			),
+			Path(
+				Call("rebuild event is in progress", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListEvents(ctx, gomock.Any()).Return([]linodego.Event{{ID: 1, Status: linodego.EventStarted}}, nil)
+				}),
+				Result("waits for rebuild", func(ctx context.Context, mck Mock) {
+					delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionRebuilt, Status: metav1.ConditionFalse, Reason: RebuildingReason})
+					res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Instance rebuild not yet finished"))
+				}),
+			),
+			Path(
+				Call("rebuild event failed", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListEvents(ctx, gomock.Any()).Return([]linodego.Event{{ID: 1, Status: linodego.EventFailed}}, nil)
+				}),
+				Result("rebuild failed", func(ctx context.Context, mck Mock) {
+					delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionRebuilt, Status: metav1.ConditionFalse, Reason: RebuildingReason})
+					_, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(linodeMachine.GetCondition(ConditionRebuilt).Reason).To(Equal(RebuildFailedReason))
+					Expect(mck.Events()).To(ContainSubstring("rebuild event 1 failed"))
+				}),
+			),
+			Path(
+				Call("root disk is resized", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListEvents(ctx, gomock.Any()).Return([]linodego.Event{{ID: 1, Status: linodego.EventFinished}}, nil)
+					mck.LinodeClient.EXPECT().GetType(ctx, "g6-standard-2").Return(&linodego.LinodeType{Disk: 81920}, nil)
+					mck.LinodeClient.EXPECT().ListInstanceConfigs(ctx, 123, gomock.Any()).Return([]linodego.InstanceConfig{{
+						ID:      1,
+						Devices: &linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 789}},
+					}}, nil)
+					mck.LinodeClient.EXPECT().ResizeInstanceDisk(ctx, 123, 789, linodego.InstanceDiskResizeOptions{Size: 71182}).Return(nil)
+				}),
+				Result("configuring", func(ctx context.Context, mck Mock) {
+					delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionRebuilt, Status: metav1.ConditionFalse, Reason: RebuildingReason})
+					res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(linodeMachine.GetCondition(ConditionRebuilt).Reason).To(Equal(RebuildConfiguringReason))
+				}),
+			),
+			Path(
+				Call("instance is configured and booted", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().CreateInstanceDisk(ctx, 123, linodego.InstanceDiskCreateOptions{
+						Label:      "sdb",
+						Size:       10738,
+						Filesystem: string(linodego.FilesystemExt4),
+					}).Return(&linodego.InstanceDisk{ID: 1011}, nil)
+					mck.LinodeClient.EXPECT().ListInstanceConfigs(ctx, 123, gomock.Any()).Return([]linodego.InstanceConfig{{
+						ID:      1,
+						Devices: &linodego.InstanceConfigDeviceMap{SDA: &linodego.InstanceConfigDevice{DiskID: 789}},
+					}}, nil).Times(3)
+					mck.LinodeClient.EXPECT().UpdateInstanceConfig(ctx, 123, 1, gomock.Any()).Return(nil, nil).Times(2)
+					mck.LinodeClient.EXPECT().UpdateInstanceConfig(ctx, 123, 1, linodego.InstanceConfigUpdateOptions{
+						Interfaces: []linodego.InstanceConfigInterfaceCreateOptions{{
+							Purpose:  linodego.InterfacePurposeVPC,
+							Primary:  true,
+							SubnetID: ptr.To(10),
+							IPv4:     &linodego.VPCIPv4CreateOptions{VPC: "10.0.0.2"},
+						}},
+					}).Return(nil, nil)
+					mck.LinodeClient.EXPECT().BootInstance(ctx, 123, linodego.InstanceBootOptions{}).Return(nil)
+				}),
+				Result("booting", func(ctx context.Context, mck Mock) {
+					delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+					linodeMachine.Spec.DataDisks.SDB.DiskID = 0
+					linodeMachine.Status.RebuildInterfaces = []infrav1alpha2.InstanceConfigInterfaceCreateOptions{{
+						Purpose:  linodego.InterfacePurposeVPC,
+						Primary:  true,
+						SubnetID: ptr.To(10),
+						IPv4:     &infrav1alpha2.VPCIPv4{VPC: "10.0.0.2"},
+					}}
+					linodeMachine.SetCondition(metav1.Condition{Type: ConditionRebuilt, Status: metav1.ConditionFalse, Reason: RebuildConfiguringReason})
+					res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceOffline})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerWaitForRunningDelay))
+					Expect(linodeMachine.GetCondition(ConditionRebuilt).Reason).To(Equal(RebuildBootingReason))
+					Expect(linodeMachine.Spec.DataDisks.SDB.DiskID).To(Equal(1011))
+					Expect(linodeMachine.Status.RebuildInterfaces).To(BeNil())
+				}),
+			),
+			Path(Result("rebuild completed", func(ctx context.Context, mck Mock) {
+				delete(linodeMachine.Annotations, infrav1alpha2.RebuildAnnotation)
+				linodeMachine.SetCondition(metav1.Condition{Type: ConditionRebuilt, Status: metav1.ConditionFalse, Reason: RebuildBootingReason})
+				res, err := reconciler.reconcileRebuild(ctx, mck.Logger(), mScope, &linodego.Instance{ID: 123, Status: linodego.InstanceRunning})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.IsZero()).To(BeTrue())
+				cond := linodeMachine.GetCondition(ConditionRebuilt)
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(RebuildCompletedReason))
+				Expect(mck.Events()).To(ContainSubstring("Rebuilt instance 123"))
+			})),
+		),
+	)
+})
+
+var _ = Describe("machine-capacity", Label("machine", "capacity"), func() {
+	linodeMachine := infrav1alpha2.LinodeMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine-capacity",
+			Namespace: defaultNamespace,
+		},
+		Spec: infrav1alpha2.LinodeMachineSpec{
+			Region: "us-ord",
+			Type:   "g1-gpu-rtx6000-1",
+			Image:  rutil.DefaultMachineControllerLinodeImage,
+		},
+	}
+
+	ctlrSuite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{})
+	reconciler := LinodeMachineReconciler{}
+	mScope := &scope.MachineScope{}
+
+	ctlrSuite.BeforeEach(func(ctx context.Context, mck Mock) {
+		reconciler.Recorder = mck.Recorder()
+		mScope.LinodeClient = mck.LinodeClient
+		mScope.LinodeMachine = linodeMachine.DeepCopy()
+	})
+
+	ctlrSuite.Run(
+		OneOf(
+			Path(
+				Call("capacity is available", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetRegionAvailability(ctx, "us-ord").Return([]linodego.RegionAvailability{
+						{Region: "us-ord", Plan: "g1-gpu-rtx6000-1", Available: true},
+					}, nil)
+					mck.LinodeClient.EXPECT().GetAccountAvailability(ctx, "us-ord").Return(&linodego.AccountAvailability{}, nil)
+				}),
+				OneOf(
+					Path(Result("capacity condition is true", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcilePreflightCapacity(ctx, mck.Logger(), mScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(rutil.ConditionTrue(mScope.LinodeMachine.GetCondition(ConditionPreflightCapacity))).To(BeTrue())
+					})),
+					Path(Result("instance limit is left to the create call", func(ctx context.Context, mck Mock) {
+						since := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+						mScope.LinodeMachine.SetCondition(metav1.Condition{
+							Type:               ConditionPreflightCapacity,
+							Status:             metav1.ConditionFalse,
+							Reason:             InstanceLimitReachedReason,
+							LastTransitionTime: since,
+						})
+						res, err := reconciler.reconcilePreflightCapacity(ctx, mck.Logger(), mScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						cond := mScope.LinodeMachine.GetCondition(ConditionPreflightCapacity)
+						Expect(cond.Reason).To(Equal(InstanceLimitReachedReason))
+						Expect(cond.LastTransitionTime).To(Equal(since))
+					})),
+					Path(Result("create call backs off after the instance limit was reached", func(ctx context.Context, mck Mock) {
+						mScope.LinodeMachine.SetCondition(metav1.Condition{
+							Type:    ConditionPreflightCapacity,
+							Status:  metav1.ConditionFalse,
+							Reason:  InstanceLimitReachedReason,
+							Message: "Linode limit reached",
+						})
+						res, err := reconciler.reconcilePreflightCapacity(ctx, mck.Logger(), mScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+						Expect(res.RequeueAfter).To(BeNumerically("<=", rutil.DefaultMachineControllerCapacityRetryDelay))
+						Expect(mck.Logs()).To(ContainSubstring("Instance limit was reached, re-queuing"))
+					})),
+				),
+			),
+			Path(
+				Call("plan is not available", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetRegionAvailability(ctx, "us-ord").Return([]linodego.RegionAvailability{
+						{Region: "us-ord", Plan: "g1-gpu-rtx6000-1", Available: false},
+					}, nil)
+				}),
+				OneOf(
+					Path(Result("capacity condition is false", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcilePreflightCapacity(ctx, mck.Logger(), mScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rutil.DefaultMachineControllerCapacityRetryDelay))
+						cond := mScope.LinodeMachine.GetCondition(ConditionPreflightCapacity)
+						Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+						Expect(cond.Reason).To(Equal(PlanUnavailableReason))
+						Expect(mck.Events()).To(ContainSubstring("plan g1-gpu-rtx6000-1 is not available in region us-ord"))
+					})),
+					Path(Result("backs off while capacity is unavailable", func(ctx context.Context, mck Mock) {
+						since := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+						mScope.LinodeMachine.SetCondition(metav1.Condition{
+							Type:               ConditionPreflightCapacity,
+							Status:             metav1.ConditionFalse,
+							Reason:             PlanUnavailableReason,
+							LastTransitionTime: since,
+						})
+						res, err := reconciler.reconcilePreflightCapacity(ctx, mck.Logger(), mScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", 5*time.Minute))
+						Expect(mScope.LinodeMachine.GetCondition(ConditionPreflightCapacity).LastTransitionTime).To(Equal(since))
+						Expect(mck.Events()).To(BeEmpty())
+					})),
+				),
+			),
+			Path(
+				Call("region availability can't be fetched", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetRegionAvailability(ctx, "us-ord").Return(nil, &linodego.Error{Code: http.StatusTooManyRequests})
+				}),
+				Result("requeues", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcilePreflightCapacity(ctx, mck.Logger(), mScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(rutil.DefaultLinodeTooManyRequestsErrorRetryDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Failed to check capacity"))
+				}),
+			),
+		),
+	)
+})
@@ -0,0 +1,652 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	kutil "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/labels/format"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/paused"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
+	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+const (
+	// LinodeMachinePoolMachineKind is the kind of the infrastructure machines backing the instances of a LinodeMachinePool.
+	LinodeMachinePoolMachineKind = "LinodeMachinePoolMachine"
+
+	// reasons for the Ready condition of a LinodeMachinePool
+	MachinePoolReadyReason   = "MachinesReady"
+	MachinePoolScalingReason = "Scaling"
+	MachinePoolRollingReason = "RollingUpdate"
+)
+
+// LinodeMachinePoolReconciler reconciles a LinodeMachinePool object
+type LinodeMachinePoolReconciler struct {
+	client.Client
+	// APIReader lists the LinodeMachinePoolMachines of a pool without the cache, so that the machines created by the
+	// previous reconcile are counted before the cache observes them and the pool isn't scaled up twice.
+	APIReader          client.Reader
+	Recorder           events.EventRecorder
+	LinodeClientConfig scope.ClientConfig
+	WatchFilterValue   string
+	ReconcileTimeout   time.Duration
+	// BootstrapDataEndpoint is nil unless the bootstrap data endpoint of the manager is enabled.
+	BootstrapDataEndpoint clients.BootstrapDataEndpoint
+	// ManagementClusterID identifies the management cluster in the owner tags of the instances.
+	ManagementClusterID string
+	// Feature flags
+	GzipCompressionEnabled bool
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepoolmachines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepoolmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinepoolmachines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachinetemplates,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;watch;list
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;watch;list;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the LinodeMachinePool closer to the desired state.
+func (r *LinodeMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx).WithName("LinodeMachinePoolReconciler").WithValues("name", req.String())
+
+	linodeMachinePool := &infrav1alpha2.LinodeMachinePool{}
+	if err := r.TracedClient().Get(ctx, req.NamespacedName, linodeMachinePool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to fetch LinodeMachinePool")
+		return ctrl.Result{}, err
+	}
+
+	machinePool, err := kutil.GetOwnerMachinePool(ctx, r.TracedClient(), linodeMachinePool.ObjectMeta)
+	if err != nil || machinePool == nil {
+		return ctrl.Result{}, err
+	}
+	log = log.WithValues("MachinePool", machinePool.Name)
+
+	cluster, err := kutil.GetClusterFromMetadata(ctx, r.TracedClient(), machinePool.ObjectMeta)
+	if err != nil || cluster == nil {
+		return ctrl.Result{}, err
+	}
+
+	linodeClusterKey := client.ObjectKey{
+		Namespace: linodeMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	linodeCluster := &infrav1alpha2.LinodeCluster{}
+	if err := r.Get(ctx, linodeClusterKey, linodeCluster); err != nil {
+		if err = client.IgnoreNotFound(err); err != nil {
+			return ctrl.Result{}, fmt.Errorf("get linodecluster %q: %w", linodeClusterKey, err)
+		}
+	}
+	log = log.WithValues("LinodeCluster", linodeCluster.Name)
+
+	linodeMachineTemplateKey := client.ObjectKey{
+		Namespace: linodeMachinePool.Spec.MachineTemplateRef.Namespace,
+		Name:      linodeMachinePool.Spec.MachineTemplateRef.Name,
+	}
+	if linodeMachineTemplateKey.Namespace == "" {
+		linodeMachineTemplateKey.Namespace = linodeMachinePool.Namespace
+	}
+	linodeMachineTemplate := &infrav1alpha2.LinodeMachineTemplate{}
+	if err := r.Get(ctx, linodeMachineTemplateKey, linodeMachineTemplate); err != nil {
+		// The template is not needed for removing the instances of a deleted pool.
+		if !apierrors.IsNotFound(err) || linodeMachinePool.DeletionTimestamp.IsZero() {
+			log.Error(err, "Failed to fetch LinodeMachineTemplate")
+			return ctrl.Result{}, fmt.Errorf("get linodemachinetemplate %q: %w", linodeMachineTemplateKey, err)
+		}
+	}
+
+	machinePoolScope, err := scope.NewMachinePoolScope(
+		ctx,
+		r.LinodeClientConfig,
+		scope.MachinePoolScopeParams{
+			Client:            r.TracedClient(),
+			Cluster:           cluster,
+			MachinePool:       machinePool,
+			LinodeCluster:     linodeCluster,
+			LinodeMachinePool: linodeMachinePool,
+
+			LinodeMachineTemplate: linodeMachineTemplate,
+			BootstrapDataEndpoint: r.BootstrapDataEndpoint,
+			ManagementClusterID:   r.ManagementClusterID,
+		},
+	)
+	if err != nil {
+		log.Error(err, "Failed to create machine pool scope")
+		return ctrl.Result{}, fmt.Errorf("failed to create machine pool scope: %w", err)
+	}
+
+	isPaused, _, err := paused.EnsurePausedCondition(ctx, machinePoolScope.Client, machinePoolScope.Cluster, machinePoolScope.LinodeMachinePool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if isPaused {
+		log.Info("LinodeMachinePool or linked cluster is marked as paused, won't reconcile.")
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, log, machinePoolScope)
+}
+
+func (r *LinodeMachinePoolReconciler) reconcile(ctx context.Context, logger logr.Logger, machinePoolScope *scope.MachinePoolScope) (res ctrl.Result, err error) {
+	defer func() {
+		if err != nil {
+			r.Recorder.Eventf(machinePoolScope.LinodeMachinePool, nil, corev1.EventTypeWarning, "ReconcileError", "Reconcile", err.Error())
+		}
+
+		// Always close the scope when exiting this function so we can persist any LinodeMachinePool changes.
+		// This ignores any resource not found errors when reconciling deletions.
+		if patchErr := machinePoolScope.Close(ctx); patchErr != nil && utilerrors.FilterOut(util.UnwrapError(patchErr), apierrors.IsNotFound) != nil {
+			logger.Error(patchErr, "failed to patch LinodeMachinePool")
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	// Add the finalizer if not already there
+	if err = machinePoolScope.AddFinalizer(ctx); err != nil {
+		logger.Error(err, "Failed to add finalizer")
+		return ctrl.Result{}, err
+	}
+
+	// Override the controller credentials with ones from the pool's Secret reference (if supplied).
+	if machinePoolScope.LinodeMachinePool.Spec.CredentialsRef != nil || machinePoolScope.LinodeCluster.Spec.CredentialsRef != nil {
+		if err := machinePoolScope.SetCredentialRefTokenForLinodeClients(ctx); err != nil {
+			logger.Error(err, "failed to update linode client token from Credential Ref")
+			return ctrl.Result{}, err
+		}
+	}
+
+	machinePoolScope.LinodeMachinePool.Status.InfrastructureMachineKind = LinodeMachinePoolMachineKind
+
+	machines, err := r.listMachines(ctx, machinePoolScope)
+	if err != nil {
+		logger.Error(err, "Failed to list LinodeMachinePoolMachines")
+		return ctrl.Result{}, err
+	}
+
+	if !machinePoolScope.LinodeMachinePool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, logger, machinePoolScope, machines)
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		logger.Info("Bootstrap data secret is not yet available")
+		machinePoolScope.LinodeMachinePool.SetCondition(metav1.Condition{
+			Type:   ConditionPreflightBootstrapDataSecretReady,
+			Status: metav1.ConditionFalse,
+			Reason: WaitingForBootstrapDataReason,
+		})
+		return ctrl.Result{}, nil
+	}
+	machinePoolScope.LinodeMachinePool.SetCondition(metav1.Condition{
+		Type:   ConditionPreflightBootstrapDataSecretReady,
+		Status: metav1.ConditionTrue,
+		Reason: "BootstrapDataSecretReady", // We have to set the reason to not fail object patching
+	})
+
+	return r.reconcileMachines(ctx, logger, machinePoolScope, machines)
+}
+
+// reconcileMachines provisions the instances of the LinodeMachinePoolMachines and scales the pool towards the
+// desired number of up-to-date replicas.
+func (r *LinodeMachinePoolReconciler) reconcileMachines(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machines []infrav1alpha2.LinodeMachinePoolMachine,
+) (ctrl.Result, error) {
+	templateHash, err := machinePoolTemplateHash(machinePoolScope.LinodeMachineTemplate)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var (
+		result ctrl.Result
+		active = make([]infrav1alpha2.LinodeMachinePoolMachine, 0, len(machines))
+	)
+	for i := range machines {
+		machine := &machines[i]
+		var res ctrl.Result
+		if machine.DeletionTimestamp.IsZero() {
+			res, err = r.reconcileMachine(ctx, logger.WithValues("LinodeMachinePoolMachine", machine.Name), machinePoolScope, machine, templateHash)
+			active = append(active, *machine)
+		} else {
+			res, err = r.reconcileMachineDelete(ctx, logger.WithValues("LinodeMachinePoolMachine", machine.Name), machinePoolScope, machine, machine.DeletionTimestamp.Time)
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		result = kutil.LowestNonZeroResult(result, res)
+	}
+
+	strategy := machinePoolScope.LinodeMachinePool.Spec.Strategy
+	desired := int(ptr.Deref(machinePoolScope.MachinePool.Spec.Replicas, 1))
+	toCreate, toDelete := machinePoolRollout(active, desired,
+		int(ptr.Deref(strategy.MaxSurge, 1)), int(ptr.Deref(strategy.MaxUnavailable, 0)), templateHash)
+
+	for range toCreate {
+		machine, err := r.createMachine(ctx, machinePoolScope, templateHash)
+		if err != nil {
+			logger.Error(err, "Failed to create LinodeMachinePoolMachine")
+			return ctrl.Result{}, err
+		}
+		logger.Info("Created LinodeMachinePoolMachine", "LinodeMachinePoolMachine", machine.Name)
+	}
+	for i := range toDelete {
+		if err := r.removeMachine(ctx, logger, machinePoolScope, &toDelete[i]); err != nil {
+			logger.Error(err, "Failed to remove LinodeMachinePoolMachine", "LinodeMachinePoolMachine", toDelete[i].Name)
+			return ctrl.Result{}, err
+		}
+	}
+
+	progressing := toCreate > 0 || len(toDelete) > 0
+	setMachinePoolStatus(machinePoolScope.LinodeMachinePool, active, desired, progressing)
+	if progressing {
+		result = kutil.LowestNonZeroResult(result, ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachinePoolControllerReconcileDelay)})
+	}
+
+	return result, nil
+}
+
+// reconcileMachine creates the instance of a LinodeMachinePoolMachine, or updates its status until it is running.
+func (r *LinodeMachinePoolReconciler) reconcileMachine(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machine *infrav1alpha2.LinodeMachinePoolMachine,
+	templateHash string,
+) (res ctrl.Result, err error) {
+	patchHelper, err := patch.NewHelper(machine, machinePoolScope.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+	defer func() {
+		if patchErr := patchHelper.Patch(ctx, machine); patchErr != nil && !apierrors.IsNotFound(patchErr) {
+			logger.Error(patchErr, "failed to patch LinodeMachinePoolMachine")
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	machine.Status.LatestModelApplied = machine.Labels[infrav1alpha2.MachinePoolTemplateHashLabel] == templateHash
+
+	if machine.Spec.ProviderID == nil {
+		return r.reconcileMachineCreate(ctx, logger, machinePoolScope, machine)
+	}
+	if err := r.reconcileMachineOwnerTag(ctx, logger, machinePoolScope, machine); err != nil {
+		return retryIfTransient(err, logger)
+	}
+	if machine.Status.Ready {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcileMachineUpdate(ctx, logger, machinePoolScope, machine)
+}
+
+func (r *LinodeMachinePoolReconciler) reconcileMachineCreate(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machine *infrav1alpha2.LinodeMachinePoolMachine,
+) (ctrl.Result, error) {
+	machineScope := machinePoolScope.MachineScope(machine)
+	createOpts, err := newCreateConfig(ctx, machineScope, r.GzipCompressionEnabled, logger)
+	if err != nil {
+		logger.Error(err, "Failed to create Linode machine InstanceCreateOptions")
+		return retryIfTransient(err, logger)
+	}
+	// The instances of a pool are not configured after their creation, they are booted right away.
+	createOpts.Booted = util.Pointer(true)
+
+	linodeInstance, retryAfter, err := createInstance(ctx, logger, machineScope, createOpts)
+	if errors.Is(err, util.ErrRateLimit) {
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+	if err != nil {
+		logger.Error(err, "Failed to create Linode machine instance")
+		machine.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  util.CreateError,
+			Message: err.Error(),
+		})
+		return retryIfTransient(err, logger)
+	}
+
+	machine.Spec.ProviderID = util.Pointer(fmt.Sprintf("linode://%d", linodeInstance.ID))
+	machine.Spec.InstanceID = &linodeInstance.ID
+	if ownerTags := util.GetOwnerTags(machinePoolScope.ManagementClusterID); len(ownerTags) > 0 {
+		metav1.SetMetaDataAnnotation(&machine.ObjectMeta, infrav1alpha2.MachinePoolOwnerTagAnnotation, ownerTags[0])
+	}
+	machine.Status.InstanceState = &linodeInstance.Status
+	machine.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionFalse,
+		Reason: "InstanceCreated", // We have to set the reason to not fail object patching
+	})
+	r.Recorder.Eventf(machinePoolScope.LinodeMachinePool, machine, corev1.EventTypeNormal, "Created", "CreateInstance",
+		"Created instance %d for LinodeMachinePoolMachine %s", linodeInstance.ID, machine.Name)
+
+	return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+}
+
+// reconcileMachineOwnerTag replaces the owner tags of the instance of a LinodeMachinePoolMachine with the ones of the
+// management cluster, once the cluster was moved from another management cluster. Instances deleted out of band are
+// left to reconcileMachineUpdate.
+func (r *LinodeMachinePoolReconciler) reconcileMachineOwnerTag(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machine *infrav1alpha2.LinodeMachinePoolMachine,
+) error {
+	ownerTags := util.GetOwnerTags(machinePoolScope.ManagementClusterID)
+	if len(ownerTags) == 0 || machine.Annotations[infrav1alpha2.MachinePoolOwnerTagAnnotation] == ownerTags[0] {
+		return nil
+	}
+	instanceID, err := util.GetInstanceID(machine.Spec.ProviderID)
+	if err != nil {
+		return err
+	}
+	linodeInstance, err := machinePoolScope.LinodeClient.GetInstance(ctx, instanceID)
+	if err != nil {
+		return util.IgnoreLinodeAPIError(err, http.StatusNotFound)
+	}
+	tags := util.ClaimOwnerTags(linodeInstance.Tags, machinePoolScope.ManagementClusterID)
+	if !slices.Equal(tags, linodeInstance.Tags) {
+		logger.Info("Claiming instance for the management cluster", "id", instanceID)
+		if _, err := machinePoolScope.LinodeClient.UpdateInstance(ctx, instanceID, linodego.InstanceUpdateOptions{Tags: tags}); err != nil {
+			return err
+		}
+	}
+	metav1.SetMetaDataAnnotation(&machine.ObjectMeta, infrav1alpha2.MachinePoolOwnerTagAnnotation, ownerTags[0])
+
+	return nil
+}
+
+func (r *LinodeMachinePoolReconciler) reconcileMachineUpdate(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machine *infrav1alpha2.LinodeMachinePoolMachine,
+) (ctrl.Result, error) {
+	instanceID, err := util.GetInstanceID(machine.Spec.ProviderID)
+	if err != nil {
+		logger.Error(err, "Failed to parse instance ID from provider ID")
+		return ctrl.Result{}, err
+	}
+
+	linodeInstance, err := machinePoolScope.LinodeClient.GetInstance(ctx, instanceID)
+	if err != nil {
+		if util.IgnoreLinodeAPIError(err, http.StatusNotFound) == nil {
+			// The instance was deleted out of band, the machine is replaced.
+			logger.Info("Instance not found, removing LinodeMachinePoolMachine")
+			machine.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  "InstanceNotFound", // We have to set the reason to not fail object patching
+				Message: fmt.Sprintf("instance %d was not found", instanceID),
+			})
+			return ctrl.Result{}, r.removeMachine(ctx, logger, machinePoolScope, machine)
+		}
+		logger.Error(err, "Failed to get Linode instance")
+		return retryIfTransient(err, logger)
+	}
+	machine.Status.InstanceState = &linodeInstance.Status
+
+	if linodeInstance.Status != linodego.InstanceRunning {
+		logger.Info("Instance not yet running", "status", linodeInstance.Status)
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+	}
+
+	addrs, err := buildInstanceAddrs(ctx, machinePoolScope.MachineScope(machine), instanceID)
+	if err != nil {
+		logger.Error(err, "Failed to get instance ip addresses")
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerWaitForRunningDelay)}, nil
+	}
+	machine.Status.Addresses = addrs
+	machine.Status.Ready = true
+	machine.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: "InstanceRunning", // We have to set the reason to not fail object patching
+	})
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileMachineDelete deletes the instance of a LinodeMachinePoolMachine being deleted and removes its finalizer.
+func (r *LinodeMachinePoolReconciler) reconcileMachineDelete(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machine *infrav1alpha2.LinodeMachinePoolMachine,
+	deletedAt time.Time,
+) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(machine, infrav1alpha2.MachinePoolFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := deleteBootstrapData(ctx, machinePoolScope.MachineScope(machine)); err != nil {
+		logger.Error(err, "Failed to delete bootstrap data")
+	}
+
+	if machine.Spec.ProviderID != nil {
+		instanceID, err := util.GetInstanceID(machine.Spec.ProviderID)
+		if err != nil {
+			logger.Error(err, "Failed to parse instance ID from provider ID")
+			return ctrl.Result{}, err
+		}
+		if err := machinePoolScope.LinodeClient.DeleteInstance(ctx, instanceID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+			logger.Error(err, "Failed to delete Linode instance")
+			if deletedAt.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultMachineControllerRetryDelay)).After(time.Now()) {
+				logger.Info("re-queuing Linode instance deletion")
+				return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultMachineControllerRetryDelay)}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(machinePoolScope.LinodeMachinePool, machine, corev1.EventTypeNormal, "Deleted", "DeleteInstance",
+			"Deleted instance %d of LinodeMachinePoolMachine %s", instanceID, machine.Name)
+	}
+
+	patchHelper, err := patch.NewHelper(machine, machinePoolScope.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+	controllerutil.RemoveFinalizer(machine, infrav1alpha2.MachinePoolFinalizer)
+	if err := patchHelper.Patch(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to remove finalizer from LinodeMachinePoolMachine")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileDelete deletes the instances of all the machines of a LinodeMachinePool being deleted.
+func (r *LinodeMachinePoolReconciler) reconcileDelete(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machines []infrav1alpha2.LinodeMachinePoolMachine,
+) (ctrl.Result, error) {
+	logger.Info("deleting machine pool")
+
+	var result ctrl.Result
+	for i := range machines {
+		machine := &machines[i]
+		res, err := r.reconcileMachineDelete(ctx, logger.WithValues("LinodeMachinePoolMachine", machine.Name), machinePoolScope, machine,
+			machinePoolScope.LinodeMachinePool.DeletionTimestamp.Time)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		result = kutil.LowestNonZeroResult(result, res)
+	}
+	if !result.IsZero() {
+		return result, nil
+	}
+
+	machinePoolScope.LinodeMachinePool.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionFalse,
+		Reason: clusterv1.DeletionCompletedReason,
+	})
+	machinePoolScope.LinodeMachinePool.Spec.ProviderIDList = nil
+	machinePoolScope.LinodeMachinePool.Status.Replicas = 0
+	controllerutil.RemoveFinalizer(machinePoolScope.LinodeMachinePool, infrav1alpha2.MachinePoolFinalizer)
+
+	return ctrl.Result{}, nil
+}
+
+// listMachines returns the LinodeMachinePoolMachines controlled by the LinodeMachinePool, read from the API server.
+func (r *LinodeMachinePoolReconciler) listMachines(ctx context.Context, machinePoolScope *scope.MachinePoolScope) ([]infrav1alpha2.LinodeMachinePoolMachine, error) {
+	var machineList infrav1alpha2.LinodeMachinePoolMachineList
+	if err := r.APIReader.List(ctx, &machineList,
+		client.InNamespace(machinePoolScope.LinodeMachinePool.Namespace),
+		client.MatchingLabels(machinePoolMachineLabels(machinePoolScope)),
+	); err != nil {
+		return nil, err
+	}
+
+	machines := make([]infrav1alpha2.LinodeMachinePoolMachine, 0, len(machineList.Items))
+	for _, machine := range machineList.Items {
+		if metav1.IsControlledBy(&machine, machinePoolScope.LinodeMachinePool) {
+			machines = append(machines, machine)
+		}
+	}
+	slices.SortFunc(machines, func(a, b infrav1alpha2.LinodeMachinePoolMachine) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+
+	return machines, nil
+}
+
+// createMachine creates a LinodeMachinePoolMachine controlled by the LinodeMachinePool, its instance is created on the
+// next reconcile.
+func (r *LinodeMachinePoolReconciler) createMachine(
+	ctx context.Context,
+	machinePoolScope *scope.MachinePoolScope,
+	templateHash string,
+) (*infrav1alpha2.LinodeMachinePoolMachine, error) {
+	machine := &infrav1alpha2.LinodeMachinePoolMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: machinePoolScope.LinodeMachinePool.Name + "-",
+			Namespace:    machinePoolScope.LinodeMachinePool.Namespace,
+			Labels:       machinePoolMachineLabels(machinePoolScope),
+			Finalizers:   []string{infrav1alpha2.MachinePoolFinalizer},
+		},
+	}
+	machine.Labels[infrav1alpha2.MachinePoolTemplateHashLabel] = templateHash
+	if err := controllerutil.SetControllerReference(machinePoolScope.LinodeMachinePool, machine, machinePoolScope.Client.Scheme()); err != nil {
+		return nil, err
+	}
+	if err := machinePoolScope.Client.Create(ctx, machine); err != nil {
+		return nil, err
+	}
+
+	return machine, nil
+}
+
+// removeMachine deletes the Machine owning a LinodeMachinePoolMachine so that its node is drained first, or the
+// LinodeMachinePoolMachine itself if it has no Machine yet.
+func (r *LinodeMachinePoolReconciler) removeMachine(
+	ctx context.Context,
+	logger logr.Logger,
+	machinePoolScope *scope.MachinePoolScope,
+	machine *infrav1alpha2.LinodeMachinePoolMachine,
+) error {
+	owner, err := kutil.GetOwnerMachine(ctx, machinePoolScope.Client, machine.ObjectMeta)
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	if owner != nil {
+		logger.Info("Deleting Machine", "Machine", owner.Name)
+		return client.IgnoreNotFound(machinePoolScope.Client.Delete(ctx, owner))
+	}
+
+	logger.Info("Deleting LinodeMachinePoolMachine", "LinodeMachinePoolMachine", machine.Name)
+	return client.IgnoreNotFound(machinePoolScope.Client.Delete(ctx, machine))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LinodeMachinePoolReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.LinodeMachinePool{}).
+		Owns(&infrav1alpha2.LinodeMachinePoolMachine{}).
+		WithOptions(options).
+		Watches(
+			&clusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(kutil.MachinePoolToInfrastructureMapFunc(context.TODO(), infrav1alpha2.GroupVersion.WithKind("LinodeMachinePool"))),
+		).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), mgr.GetLogger(), r.WatchFilterValue)).
+		Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+	if err != nil {
+		return fmt.Errorf("failed to build controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LinodeMachinePoolReconciler) TracedClient() client.Client {
+	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
+}
+
+// machinePoolMachineLabels returns the labels identifying the LinodeMachinePoolMachines of a pool, they are also used by
+// the MachinePool controller to find the infrastructure machines of the pool.
+func machinePoolMachineLabels(machinePoolScope *scope.MachinePoolScope) map[string]string {
+	return map[string]string{
+		clusterv1.ClusterNameLabel:     machinePoolScope.Cluster.Name,
+		clusterv1.MachinePoolNameLabel: format.MustFormatValue(machinePoolScope.MachinePool.Name),
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+)
+
+// templateHashLength is the length of the template hash, it is short enough to be used as a label value.
+const templateHashLength = 10
+
+// machinePoolTemplateHash returns the hash of the LinodeMachineTemplate of the pool. Machines created from a
+// different template hash are outdated and replaced.
+func machinePoolTemplateHash(linodeMachineTemplate *infrav1alpha2.LinodeMachineTemplate) (string, error) {
+	template, err := json.Marshal(linodeMachineTemplate.Spec.Template.Spec)
+	if err != nil {
+		return "", fmt.Errorf("marshal template: %w", err)
+	}
+
+	return scope.GetHash(string(template))[:templateHashLength], nil
+}
+
+// machinePoolRollout returns the number of LinodeMachinePoolMachines to create and the ones to delete for moving the
+// pool towards the desired number of up-to-date machines. No more than maxSurge machines are created above the
+// desired number of replicas, and ready machines are only deleted while no more than maxUnavailable machines are
+// unavailable. Machines must be sorted from oldest to newest.
+func machinePoolRollout(
+	machines []infrav1alpha2.LinodeMachinePoolMachine,
+	desired, maxSurge, maxUnavailable int,
+	templateHash string,
+) (int, []infrav1alpha2.LinodeMachinePoolMachine) {
+	var (
+		outdated []infrav1alpha2.LinodeMachinePoolMachine
+		upToDate []infrav1alpha2.LinodeMachinePoolMachine
+		ready    int
+	)
+	for _, machine := range machines {
+		if machine.Status.Ready {
+			ready++
+		}
+		if machine.Labels[infrav1alpha2.MachinePoolTemplateHashLabel] == templateHash {
+			upToDate = append(upToDate, machine)
+		} else {
+			outdated = append(outdated, machine)
+		}
+	}
+
+	toCreate := max(0, min(desired-len(upToDate), desired+maxSurge-len(machines)))
+
+	// Machines that are not ready are deleted first, then outdated machines, then the oldest machines.
+	slices.SortStableFunc(upToDate, func(a, b infrav1alpha2.LinodeMachinePoolMachine) int {
+		return compareReady(a, b)
+	})
+	candidates := outdated
+	if excess := len(upToDate) - desired; excess > 0 {
+		candidates = append(candidates, upToDate[:excess]...)
+	}
+	slices.SortStableFunc(candidates, func(a, b infrav1alpha2.LinodeMachinePoolMachine) int {
+		return compareReady(a, b)
+	})
+
+	minAvailable := desired - maxUnavailable
+	var toDelete []infrav1alpha2.LinodeMachinePoolMachine
+	for _, machine := range candidates {
+		if machine.Status.Ready {
+			if ready <= minAvailable {
+				continue
+			}
+			ready--
+		}
+		toDelete = append(toDelete, machine)
+	}
+
+	return toCreate, toDelete
+}
+
+// compareReady orders machines that are not ready before the ones that are.
+func compareReady(a, b infrav1alpha2.LinodeMachinePoolMachine) int {
+	switch {
+	case a.Status.Ready == b.Status.Ready:
+		return 0
+	case !a.Status.Ready:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// setMachinePoolStatus reports the instances of the machines in the spec and status of the LinodeMachinePool.
+func setMachinePoolStatus(linodeMachinePool *infrav1alpha2.LinodeMachinePool, machines []infrav1alpha2.LinodeMachinePoolMachine, desired int, progressing bool) {
+	var (
+		providerIDs = make([]string, 0, len(machines))
+		ready       int
+		upToDate    int
+	)
+	for _, machine := range machines {
+		if machine.Spec.ProviderID != nil {
+			providerIDs = append(providerIDs, *machine.Spec.ProviderID)
+		}
+		if machine.Status.Ready {
+			ready++
+		}
+		if machine.Status.LatestModelApplied {
+			upToDate++
+		}
+	}
+
+	linodeMachinePool.Spec.ProviderIDList = providerIDs
+	linodeMachinePool.Status.Replicas = int32(len(providerIDs)) // #nosec G115: The number of instances of a pool can't overflow an int32
+	// The pool stays ready while scaling, the MachinePool only propagates the provider IDs of ready pools.
+	linodeMachinePool.Status.Ready = linodeMachinePool.Status.Ready || ready >= desired
+
+	switch {
+	case progressing && upToDate < len(machines):
+		linodeMachinePool.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  MachinePoolRollingReason,
+			Message: fmt.Sprintf("%d of %d machines are up to date", upToDate, desired),
+		})
+	case len(machines) != desired || ready < desired:
+		linodeMachinePool.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  MachinePoolScalingReason,
+			Message: fmt.Sprintf("%d of %d machines are ready", ready, desired),
+		})
+	default:
+		linodeMachinePool.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: MachinePoolReadyReason,
+		})
+	}
+}
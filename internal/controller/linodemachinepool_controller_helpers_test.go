@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+)
+
+func poolMachine(name, templateHash string, ready bool) infrav1alpha2.LinodeMachinePoolMachine {
+	return infrav1alpha2.LinodeMachinePoolMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{infrav1alpha2.MachinePoolTemplateHashLabel: templateHash},
+		},
+		Spec: infrav1alpha2.LinodeMachinePoolMachineSpec{
+			ProviderID: ptr.To("linode://" + name),
+		},
+		Status: infrav1alpha2.LinodeMachinePoolMachineStatus{
+			Ready:              ready,
+			LatestModelApplied: templateHash == "new",
+		},
+	}
+}
+
+func machineNames(machines []infrav1alpha2.LinodeMachinePoolMachine) []string {
+	var names []string
+	for _, machine := range machines {
+		names = append(names, machine.Name)
+	}
+	return names
+}
+
+func TestMachinePoolTemplateHash(t *testing.T) {
+	t.Parallel()
+
+	template := &infrav1alpha2.LinodeMachineTemplate{
+		Spec: infrav1alpha2.LinodeMachineTemplateSpec{
+			Template: infrav1alpha2.LinodeMachineTemplateResource{
+				Spec: infrav1alpha2.LinodeMachineSpec{Region: "us-ord", Type: "g6-standard-2"},
+			},
+		},
+	}
+	hash, err := machinePoolTemplateHash(template)
+	require.NoError(t, err)
+	assert.Len(t, hash, templateHashLength)
+
+	// Metadata and status of the template don't change the hash.
+	template.Name = "renamed"
+	template.Status.Tags = []string{"tag"}
+	sameHash, err := machinePoolTemplateHash(template)
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	template.Spec.Template.Spec.Type = "g6-standard-4"
+	newHash, err := machinePoolTemplateHash(template)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, newHash)
+}
+
+func TestMachinePoolRollout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		machines       []infrav1alpha2.LinodeMachinePoolMachine
+		desired        int
+		maxSurge       int
+		maxUnavailable int
+		wantCreate     int
+		wantDelete     []string
+	}{
+		{
+			name:       "scale up from zero",
+			desired:    3,
+			maxSurge:   1,
+			wantCreate: 3,
+		},
+		{
+			name: "up to date",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "new", true),
+				poolMachine("b", "new", true),
+			},
+			desired:  2,
+			maxSurge: 1,
+		},
+		{
+			name: "scale down deletes machines that are not ready first",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "new", true),
+				poolMachine("b", "new", false),
+				poolMachine("c", "new", true),
+			},
+			desired:    1,
+			maxSurge:   1,
+			wantDelete: []string{"b", "a"},
+		},
+		{
+			name: "rolling update surges before deleting",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "old", true),
+				poolMachine("b", "old", true),
+			},
+			desired:    2,
+			maxSurge:   1,
+			wantCreate: 1,
+		},
+		{
+			name: "rolling update deletes an outdated machine once a replacement is ready",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "old", true),
+				poolMachine("b", "old", true),
+				poolMachine("c", "new", true),
+			},
+			desired:    2,
+			maxSurge:   1,
+			wantDelete: []string{"a"},
+		},
+		{
+			name: "rolling update with maxUnavailable deletes without surging",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "old", true),
+				poolMachine("b", "old", true),
+			},
+			desired:        2,
+			maxUnavailable: 1,
+			wantDelete:     []string{"a"},
+		},
+		{
+			name: "outdated machines that are not ready are deleted right away",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "old", true),
+				poolMachine("b", "old", false),
+			},
+			desired:    2,
+			maxSurge:   1,
+			wantCreate: 1,
+			wantDelete: []string{"b"},
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			toCreate, toDelete := machinePoolRollout(testcase.machines, testcase.desired, testcase.maxSurge, testcase.maxUnavailable, "new")
+			assert.Equal(t, testcase.wantCreate, toCreate)
+			assert.Equal(t, testcase.wantDelete, machineNames(toDelete))
+		})
+	}
+}
+
+func TestSetMachinePoolStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		machines    []infrav1alpha2.LinodeMachinePoolMachine
+		desired     int
+		progressing bool
+		wasReady    bool
+		wantReady   bool
+		wantReason  string
+	}{
+		{
+			name: "all machines ready",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "new", true),
+				poolMachine("b", "new", true),
+			},
+			desired:    2,
+			wantReady:  true,
+			wantReason: MachinePoolReadyReason,
+		},
+		{
+			name: "scaling up",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "new", true),
+				poolMachine("b", "new", false),
+			},
+			desired:     2,
+			progressing: true,
+			wantReason:  MachinePoolScalingReason,
+		},
+		{
+			name: "rolling update keeps the pool ready",
+			machines: []infrav1alpha2.LinodeMachinePoolMachine{
+				poolMachine("a", "old", true),
+				poolMachine("b", "new", false),
+			},
+			desired:     1,
+			progressing: true,
+			wasReady:    true,
+			wantReady:   true,
+			wantReason:  MachinePoolRollingReason,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := &infrav1alpha2.LinodeMachinePool{
+				Status: infrav1alpha2.LinodeMachinePoolStatus{Ready: testcase.wasReady},
+			}
+			setMachinePoolStatus(pool, testcase.machines, testcase.desired, testcase.progressing)
+
+			assert.Len(t, pool.Spec.ProviderIDList, len(testcase.machines))
+			assert.Equal(t, int32(len(testcase.machines)), pool.Status.Replicas) // #nosec G115: test values are small
+			assert.Equal(t, testcase.wantReady, pool.Status.Ready)
+			cond := pool.GetCondition(clusterv1.ReadyCondition)
+			require.NotNil(t, cond)
+			assert.Equal(t, testcase.wantReason, cond.Reason)
+		})
+	}
+}
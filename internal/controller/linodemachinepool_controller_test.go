@@ -0,0 +1,232 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+// newTestMachinePoolScope returns the scope of a LinodeMachinePool with the desired number of replicas.
+func newTestMachinePoolScope(k8sClient *mock.MockK8sClient, linodeClient *mock.MockLinodeClient, replicas int32) *scope.MachinePoolScope {
+	return &scope.MachinePoolScope{
+		Client:       k8sClient,
+		LinodeClient: linodeClient,
+		Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+		MachinePool: &clusterv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+			Spec:       clusterv1.MachinePoolSpec{Replicas: ptr.To(replicas)},
+		},
+		LinodeCluster: &infrav1alpha2.LinodeCluster{},
+		LinodeMachinePool: &infrav1alpha2.LinodeMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default", UID: "pool-uid"},
+		},
+		LinodeMachineTemplate: &infrav1alpha2.LinodeMachineTemplate{
+			Spec: infrav1alpha2.LinodeMachineTemplateSpec{
+				Template: infrav1alpha2.LinodeMachineTemplateResource{
+					Spec: infrav1alpha2.LinodeMachineSpec{Region: "us-ord", Type: "g6-standard-2"},
+				},
+			},
+		},
+	}
+}
+
+// readyPoolMachines returns ready LinodeMachinePoolMachines controlled by the pool of the scope, created from its
+// template.
+func readyPoolMachines(t *testing.T, machinePoolScope *scope.MachinePoolScope, names ...string) []infrav1alpha2.LinodeMachinePoolMachine {
+	t.Helper()
+
+	templateHash, err := machinePoolTemplateHash(machinePoolScope.LinodeMachineTemplate)
+	require.NoError(t, err)
+
+	machines := make([]infrav1alpha2.LinodeMachinePoolMachine, 0, len(names))
+	for i, name := range names {
+		machine := poolMachine(name, templateHash, true)
+		machine.Namespace = "default"
+		machine.CreationTimestamp = metav1.NewTime(time.Unix(int64(i), 0))
+		machine.Finalizers = []string{infrav1alpha2.MachinePoolFinalizer}
+		machine.Spec.ProviderID = ptr.To("linode://" + strconv.Itoa(i+1))
+		machine.Status.LatestModelApplied = true
+		machine.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(machinePoolScope.LinodeMachinePool,
+			infrav1alpha2.GroupVersion.WithKind("LinodeMachinePool"))}
+		machines = append(machines, machine)
+	}
+
+	return machines
+}
+
+func poolTestScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = infrav1alpha2.AddToScheme(s)
+	_ = clusterv1.AddToScheme(s)
+	return s
+}
+
+func TestLinodeMachinePoolListMachines(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	mockReader := mock.NewMockK8sClient(ctrl)
+	machinePoolScope := newTestMachinePoolScope(mockK8sClient, nil, 2)
+	machines := readyPoolMachines(t, machinePoolScope, "pool-a", "pool-b")
+	other := poolMachine("other", "new", true)
+
+	// The machines are listed from the API server and sorted oldest first, the ones of other pools are ignored.
+	mockReader.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachinePoolMachineList{}), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, list *infrav1alpha2.LinodeMachinePoolMachineList, _ ...client.ListOption) error {
+			list.Items = []infrav1alpha2.LinodeMachinePoolMachine{machines[1], other, machines[0]}
+			return nil
+		})
+
+	r := &LinodeMachinePoolReconciler{Client: mockK8sClient, APIReader: mockReader}
+	listed, err := r.listMachines(t.Context(), machinePoolScope)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pool-a", "pool-b"}, machineNames(listed))
+}
+
+func TestLinodeMachinePoolReconcileMachines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		replicas    int32
+		machines    []string
+		expects     func(k8sClient *mock.MockK8sClient)
+		wantStatus  int32
+		wantRequeue bool
+	}{
+		{
+			name:     "scale up",
+			replicas: 3,
+			machines: []string{"pool-a"},
+			expects: func(k8sClient *mock.MockK8sClient) {
+				k8sClient.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachinePoolMachine{})).
+					DoAndReturn(func(_ context.Context, obj *infrav1alpha2.LinodeMachinePoolMachine, _ ...client.CreateOption) error {
+						assert.Equal(t, "pool-", obj.GenerateName)
+						assert.Equal(t, []string{infrav1alpha2.MachinePoolFinalizer}, obj.Finalizers)
+						assert.Equal(t, "cluster", obj.Labels[clusterv1.ClusterNameLabel])
+						assert.True(t, metav1.IsControlledBy(obj, &metav1.ObjectMeta{UID: "pool-uid"}))
+						return nil
+					}).Times(2)
+			},
+			wantStatus:  1,
+			wantRequeue: true,
+		},
+		{
+			name:     "scale down",
+			replicas: 1,
+			machines: []string{"pool-a", "pool-b", "pool-c"},
+			expects: func(k8sClient *mock.MockK8sClient) {
+				// The machines have no Machine yet, so they are deleted directly, oldest first.
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+					assert.Equal(t, "pool-a", obj.GetName())
+					return nil
+				})
+				k8sClient.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+					assert.Equal(t, "pool-b", obj.GetName())
+					return nil
+				})
+			},
+			wantStatus:  3,
+			wantRequeue: true,
+		},
+		{
+			name:       "scaled",
+			replicas:   2,
+			machines:   []string{"pool-a", "pool-b"},
+			expects:    func(k8sClient *mock.MockK8sClient) {},
+			wantStatus: 2,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockK8sClient := mock.NewMockK8sClient(ctrl)
+			mockK8sClient.EXPECT().Scheme().Return(poolTestScheme()).AnyTimes()
+			testcase.expects(mockK8sClient)
+
+			machinePoolScope := newTestMachinePoolScope(mockK8sClient, mock.NewMockLinodeClient(ctrl), testcase.replicas)
+			machines := readyPoolMachines(t, machinePoolScope, testcase.machines...)
+
+			r := &LinodeMachinePoolReconciler{Client: mockK8sClient, Recorder: events.NewFakeRecorder(10)}
+			res, err := r.reconcileMachines(t.Context(), testr.New(t), machinePoolScope, machines)
+			require.NoError(t, err)
+			assert.Equal(t, testcase.wantRequeue, res.RequeueAfter > 0)
+			assert.Equal(t, testcase.wantStatus, machinePoolScope.LinodeMachinePool.Status.Replicas)
+			assert.Len(t, machinePoolScope.LinodeMachinePool.Spec.ProviderIDList, int(testcase.wantStatus))
+		})
+	}
+}
+
+func TestLinodeMachinePoolReconcileDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockK8sClient := mock.NewMockK8sClient(ctrl)
+	mockLinodeClient := mock.NewMockLinodeClient(ctrl)
+	mockK8sClient.EXPECT().Scheme().Return(poolTestScheme()).AnyTimes()
+
+	machinePoolScope := newTestMachinePoolScope(mockK8sClient, mockLinodeClient, 2)
+	machinePoolScope.LinodeMachinePool.DeletionTimestamp = ptr.To(metav1.Now())
+	machinePoolScope.LinodeMachinePool.Finalizers = []string{infrav1alpha2.MachinePoolFinalizer}
+	machinePoolScope.LinodeMachinePool.Status.Replicas = 2
+	machines := readyPoolMachines(t, machinePoolScope, "pool-a", "pool-b")
+
+	// The instances of all the machines are deleted and the finalizers of the machines are removed.
+	mockLinodeClient.EXPECT().DeleteInstance(gomock.Any(), 1).Return(nil)
+	mockLinodeClient.EXPECT().DeleteInstance(gomock.Any(), 2).Return(nil)
+	mockK8sClient.EXPECT().Patch(gomock.Any(), gomock.AssignableToTypeOf(&infrav1alpha2.LinodeMachinePoolMachine{}), gomock.Any()).
+		DoAndReturn(func(_ context.Context, obj *infrav1alpha2.LinodeMachinePoolMachine, _ client.Patch, _ ...client.PatchOption) error {
+			assert.Empty(t, obj.Finalizers)
+			return nil
+		}).Times(2)
+
+	r := &LinodeMachinePoolReconciler{Client: mockK8sClient, Recorder: events.NewFakeRecorder(10)}
+	res, err := r.reconcileDelete(t.Context(), testr.New(t), machinePoolScope, machines)
+	require.NoError(t, err)
+	assert.Zero(t, res)
+	assert.Empty(t, machinePoolScope.LinodeMachinePool.Finalizers)
+	assert.Zero(t, machinePoolScope.LinodeMachinePool.Status.Replicas)
+	assert.Empty(t, machinePoolScope.LinodeMachinePool.Spec.ProviderIDList)
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
+	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+const (
+	// ConditionRemediated reports the progress of a LinodeMachineRemediation.
+	ConditionRemediated = "Remediated"
+
+	// reasons for the Remediated condition
+	RemediationMachineNotFoundReason = "MachineNotFound"
+	RemediationRebuildingReason      = "Rebuilding"
+	RemediationSucceededReason       = "Succeeded"
+	RemediationFailedReason          = "Failed"
+	RemediationTimedOutReason        = "TimedOut"
+)
+
+// LinodeMachineRemediationReconciler reconciles a LinodeMachineRemediation object
+type LinodeMachineRemediationReconciler struct {
+	client.Client
+	Recorder         events.EventRecorder
+	WatchFilterValue string
+	ReconcileTimeout time.Duration
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachineremediations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachineremediations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachineremediationtemplates,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodemachines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the LinodeMachineRemediation closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.16.0/pkg/reconcile
+func (r *LinodeMachineRemediationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx).WithName("LinodeMachineRemediationReconciler").WithValues("name", req.String())
+
+	remediation := &infrav1alpha2.LinodeMachineRemediation{}
+	if err := r.TracedClient().Get(ctx, req.NamespacedName, remediation); err != nil {
+		if err = client.IgnoreNotFound(err); err != nil {
+			log.Error(err, "Failed to fetch LinodeMachineRemediation")
+		}
+
+		return ctrl.Result{}, err
+	}
+	if !remediation.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(remediation, r.TracedClient())
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	return r.reconcile(ctx, log, remediation, patchHelper)
+}
+
+func (r *LinodeMachineRemediationReconciler) reconcile(
+	ctx context.Context,
+	logger logr.Logger,
+	remediation *infrav1alpha2.LinodeMachineRemediation,
+	patchHelper *patch.Helper,
+) (res ctrl.Result, err error) {
+	defer func() {
+		if patchErr := patchHelper.Patch(ctx, remediation); patchErr != nil && !apierrors.IsNotFound(patchErr) {
+			logger.Error(patchErr, "failed to patch LinodeMachineRemediation")
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	// Nothing left to do once the remediation is done, the MachineHealthCheck removes it when the Machine is healthy
+	if remediation.Status.Phase == infrav1alpha2.RemediationPhaseSucceeded || remediation.Status.Phase == infrav1alpha2.RemediationPhaseFailed {
+		return ctrl.Result{}, nil
+	}
+
+	// A remediation is created by the MachineHealthCheck with the same name as the Machine to remediate
+	machine := &clusterv1.Machine{}
+	if err := r.TracedClient().Get(ctx, client.ObjectKeyFromObject(remediation), machine); err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to fetch Machine")
+			return ctrl.Result{}, err
+		}
+		return r.failRemediation(remediation, RemediationMachineNotFoundReason, "machine not found"), nil
+	}
+	if machine.Spec.InfrastructureRef.Kind != "LinodeMachine" || machine.Spec.InfrastructureRef.Name == "" {
+		return r.failRemediation(remediation, RemediationMachineNotFoundReason, "machine is not backed by a LinodeMachine"), nil
+	}
+
+	linodeMachine := &infrav1alpha2.LinodeMachine{}
+	if err := r.TracedClient().Get(ctx, types.NamespacedName{Namespace: machine.Namespace, Name: machine.Spec.InfrastructureRef.Name}, linodeMachine); err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to fetch LinodeMachine")
+			return ctrl.Result{}, err
+		}
+		return r.failRemediation(remediation, RemediationMachineNotFoundReason, "linodemachine not found"), nil
+	}
+
+	if remediation.Status.Phase == "" {
+		return r.requestRebuild(ctx, logger, remediation, linodeMachine)
+	}
+
+	return r.reconcileRebuildProgress(remediation, linodeMachine), nil
+}
+
+// requestRebuild annotates the LinodeMachine so that its instance is rebuilt in place.
+func (r *LinodeMachineRemediationReconciler) requestRebuild(
+	ctx context.Context,
+	logger logr.Logger,
+	remediation *infrav1alpha2.LinodeMachineRemediation,
+	linodeMachine *infrav1alpha2.LinodeMachine,
+) (ctrl.Result, error) {
+	if linodeMachine.Spec.ProviderID == nil {
+		return r.failRemediation(remediation, RemediationFailedReason, "linodemachine has no instance to rebuild"), nil
+	}
+
+	helper, err := patch.NewHelper(linodeMachine, r.TracedClient())
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+	if linodeMachine.Annotations == nil {
+		linodeMachine.Annotations = map[string]string{}
+	}
+	linodeMachine.Annotations[infrav1alpha2.RebuildAnnotation] = remediation.Name
+	if err := helper.Patch(ctx, linodeMachine); err != nil {
+		logger.Error(err, "Failed to annotate LinodeMachine for rebuild")
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Eventf(remediation, linodeMachine, corev1.EventTypeNormal, RemediationRebuildingReason, "RemediateMachine",
+		"Requested rebuild of LinodeMachine %s", linodeMachine.Name)
+	remediation.Status.Phase = infrav1alpha2.RemediationPhaseRunning
+	remediation.Status.LastRemediated = &metav1.Time{Time: time.Now()}
+	remediation.SetCondition(metav1.Condition{
+		Type:    ConditionRemediated,
+		Status:  metav1.ConditionFalse,
+		Reason:  RemediationRebuildingReason,
+		Message: fmt.Sprintf("rebuilding LinodeMachine %s", linodeMachine.Name),
+	})
+
+	return ctrl.Result{RequeueAfter: reconciler.DefaultMachineRemediationReconcileDelay}, nil
+}
+
+// reconcileRebuildProgress follows the Rebuilt condition of the LinodeMachine until the rebuild requested by the
+// remediation is done, failed, or did not finish in time.
+func (r *LinodeMachineRemediationReconciler) reconcileRebuildProgress(
+	remediation *infrav1alpha2.LinodeMachineRemediation,
+	linodeMachine *infrav1alpha2.LinodeMachine,
+) ctrl.Result {
+	rebuilt := linodeMachine.GetCondition(ConditionRebuilt)
+	_, pending := linodeMachine.Annotations[infrav1alpha2.RebuildAnnotation]
+	// Only look at conditions that changed after the rebuild was requested
+	if !pending && rebuilt != nil && remediation.Status.LastRemediated != nil && !rebuilt.LastTransitionTime.Before(remediation.Status.LastRemediated) {
+		switch {
+		case rebuilt.Status == metav1.ConditionTrue:
+			r.Recorder.Eventf(remediation, linodeMachine, corev1.EventTypeNormal, RemediationSucceededReason, "RemediateMachine",
+				"Rebuilt LinodeMachine %s", linodeMachine.Name)
+			remediation.Status.Phase = infrav1alpha2.RemediationPhaseSucceeded
+			remediation.SetCondition(metav1.Condition{
+				Type:   ConditionRemediated,
+				Status: metav1.ConditionTrue,
+				Reason: RemediationSucceededReason,
+			})
+			return ctrl.Result{}
+		case rebuilt.Reason == RebuildFailedReason:
+			return r.failRemediation(remediation, RemediationFailedReason, rebuilt.Message)
+		}
+	}
+
+	timeout := reconciler.DefaultMachineRemediationTimeout
+	if remediation.Spec.Timeout != nil {
+		timeout = remediation.Spec.Timeout.Duration
+	}
+	if remediation.Status.LastRemediated != nil && time.Since(remediation.Status.LastRemediated.Time) > timeout {
+		return r.failRemediation(remediation, RemediationTimedOutReason, fmt.Sprintf("rebuild did not finish within %s", timeout))
+	}
+
+	return ctrl.Result{RequeueAfter: reconciler.DefaultMachineRemediationReconcileDelay}
+}
+
+// failRemediation marks the remediation as failed. It is not retried, the MachineHealthCheck falls back to
+// deleting the Machine once the remediation is removed.
+func (r *LinodeMachineRemediationReconciler) failRemediation(remediation *infrav1alpha2.LinodeMachineRemediation, reason, message string) ctrl.Result {
+	r.Recorder.Eventf(remediation, nil, corev1.EventTypeWarning, reason, "RemediateMachine", message)
+	remediation.Status.Phase = infrav1alpha2.RemediationPhaseFailed
+	remediation.SetCondition(metav1.Condition{
+		Type:    ConditionRemediated,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+
+	return ctrl.Result{}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LinodeMachineRemediationReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.LinodeMachineRemediation{}).
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), mgr.GetLogger(), r.WatchFilterValue)).
+		Watches(
+			&infrav1alpha2.LinodeMachine{},
+			handler.EnqueueRequestsFromMapFunc(linodeMachineToLinodeMachineRemediation),
+		).Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+	if err != nil {
+		return fmt.Errorf("failed to build controller: %w", err)
+	}
+
+	return nil
+}
+
+// linodeMachineToLinodeMachineRemediation maps a LinodeMachine to the remediation of its owner Machine, which has
+// the same name as the Machine.
+func linodeMachineToLinodeMachineRemediation(_ context.Context, o client.Object) []reconcile.Request {
+	for _, ref := range o.GetOwnerReferences() {
+		if ref.Kind == "Machine" && strings.HasPrefix(ref.APIVersion, clusterv1.GroupVersion.Group+"/") {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: ref.Name}}}
+		}
+	}
+
+	return nil
+}
+
+func (r *LinodeMachineRemediationReconciler) TracedClient() client.Client {
+	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
+}
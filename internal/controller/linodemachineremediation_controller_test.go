@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+func TestReconcileRebuildProgress(t *testing.T) {
+	t.Parallel()
+
+	requested := metav1.NewTime(time.Now().Add(-time.Minute))
+	tests := []struct {
+		name        string
+		timeout     *metav1.Duration
+		annotations map[string]string
+		rebuilt     *metav1.Condition
+		wantPhase   infrav1alpha2.RemediationPhase
+		wantReason  string
+		wantResult  reconcile.Result
+	}{
+		{
+			name:        "rebuild pending",
+			annotations: map[string]string{infrav1alpha2.RebuildAnnotation: "test"},
+			wantPhase:   infrav1alpha2.RemediationPhaseRunning,
+			wantReason:  RemediationRebuildingReason,
+			wantResult:  reconcile.Result{RequeueAfter: reconciler.DefaultMachineRemediationReconcileDelay},
+		},
+		{
+			name: "rebuild in progress",
+			rebuilt: &metav1.Condition{
+				Type:               ConditionRebuilt,
+				Status:             metav1.ConditionFalse,
+				Reason:             RebuildingReason,
+				LastTransitionTime: metav1.Now(),
+			},
+			wantPhase:  infrav1alpha2.RemediationPhaseRunning,
+			wantReason: RemediationRebuildingReason,
+			wantResult: reconcile.Result{RequeueAfter: reconciler.DefaultMachineRemediationReconcileDelay},
+		},
+		{
+			name: "rebuild completed",
+			rebuilt: &metav1.Condition{
+				Type:               ConditionRebuilt,
+				Status:             metav1.ConditionTrue,
+				Reason:             RebuildCompletedReason,
+				LastTransitionTime: metav1.Now(),
+			},
+			wantPhase:  infrav1alpha2.RemediationPhaseSucceeded,
+			wantReason: RemediationSucceededReason,
+		},
+		{
+			name: "previous rebuild completed",
+			rebuilt: &metav1.Condition{
+				Type:               ConditionRebuilt,
+				Status:             metav1.ConditionTrue,
+				Reason:             RebuildCompletedReason,
+				LastTransitionTime: metav1.NewTime(requested.Add(-time.Hour)),
+			},
+			wantPhase:  infrav1alpha2.RemediationPhaseRunning,
+			wantReason: RemediationRebuildingReason,
+			wantResult: reconcile.Result{RequeueAfter: reconciler.DefaultMachineRemediationReconcileDelay},
+		},
+		{
+			name: "rebuild failed",
+			rebuilt: &metav1.Condition{
+				Type:               ConditionRebuilt,
+				Status:             metav1.ConditionFalse,
+				Reason:             RebuildFailedReason,
+				Message:            "image not found",
+				LastTransitionTime: metav1.Now(),
+			},
+			wantPhase:  infrav1alpha2.RemediationPhaseFailed,
+			wantReason: RemediationFailedReason,
+		},
+		{
+			name:       "timed out",
+			timeout:    &metav1.Duration{Duration: time.Second},
+			wantPhase:  infrav1alpha2.RemediationPhaseFailed,
+			wantReason: RemediationTimedOutReason,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			remediation := &infrav1alpha2.LinodeMachineRemediation{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       infrav1alpha2.LinodeMachineRemediationSpec{Timeout: testcase.timeout},
+				Status: infrav1alpha2.LinodeMachineRemediationStatus{
+					Phase:          infrav1alpha2.RemediationPhaseRunning,
+					LastRemediated: &requested,
+					Conditions: []metav1.Condition{{
+						Type:   ConditionRemediated,
+						Status: metav1.ConditionFalse,
+						Reason: RemediationRebuildingReason,
+					}},
+				},
+			}
+			linodeMachine := &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: testcase.annotations},
+			}
+			if testcase.rebuilt != nil {
+				linodeMachine.SetCondition(*testcase.rebuilt)
+			}
+
+			r := &LinodeMachineRemediationReconciler{Recorder: events.NewFakeRecorder(10)}
+			res := r.reconcileRebuildProgress(remediation, linodeMachine)
+			assert.Equal(t, testcase.wantResult, res)
+			assert.Equal(t, testcase.wantPhase, remediation.Status.Phase)
+			assert.Equal(t, testcase.wantReason, remediation.GetCondition(ConditionRemediated).Reason)
+		})
+	}
+}
+
+func TestLinodeMachineToLinodeMachineRemediation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		ownerRefs []metav1.OwnerReference
+		want      []reconcile.Request
+	}{
+		{
+			name: "owned by machine",
+			ownerRefs: []metav1.OwnerReference{
+				{APIVersion: "cluster.x-k8s.io/v1beta2", Kind: "Machine", Name: "machine-0"},
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "machine-0"}}},
+		},
+		{
+			name: "owned by other kind",
+			ownerRefs: []metav1.OwnerReference{
+				{APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha2", Kind: "Machine", Name: "machine-0"},
+			},
+		},
+		{
+			name: "not owned",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			linodeMachine := &infrav1alpha2.LinodeMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", OwnerReferences: testcase.ownerRefs},
+			}
+			assert.Equal(t, testcase.want, linodeMachineToLinodeMachineRemediation(t.Context(), linodeMachine))
+		})
+	}
+}
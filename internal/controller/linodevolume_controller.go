@@ -0,0 +1,516 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	kutil "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/paused"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	wrappedruntimeclient "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimeclient"
+	wrappedruntimereconciler "github.com/linode/cluster-api-provider-linode/observability/wrappers/runtimereconciler"
+	"github.com/linode/cluster-api-provider-linode/util"
+	"github.com/linode/cluster-api-provider-linode/util/reconciler"
+)
+
+// LinodeVolumeReconciler reconciles a LinodeVolume object
+type LinodeVolumeReconciler struct {
+	client.Client
+	Recorder           events.EventRecorder
+	LinodeClientConfig scope.ClientConfig
+	WatchFilterValue   string
+	ReconcileTimeout   time.Duration
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodevolumes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodevolumes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodevolumes/finalizers,verbs=update
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the Volume closer to the desired state.
+//
+
+func (r *LinodeVolumeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx).WithName("LinodeVolumeReconciler").WithValues("name", req.String())
+
+	linodeVolume := &infrav1alpha2.LinodeVolume{}
+	if err := r.TracedClient().Get(ctx, req.NamespacedName, linodeVolume); err != nil {
+		if err = client.IgnoreNotFound(err); err != nil {
+			log.Error(err, "Failed to fetch LinodeVolume")
+		}
+
+		return ctrl.Result{}, err
+	}
+	var cluster *clusterv1.Cluster
+	var err error
+	if _, ok := linodeVolume.Labels[clusterv1.ClusterNameLabel]; ok {
+		cluster, err = kutil.GetClusterFromMetadata(ctx, r.TracedClient(), linodeVolume.ObjectMeta)
+		if err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				log.Error(err, "failed to fetch cluster from metadata")
+				return ctrl.Result{}, err
+			}
+			log.Info("Cluster not found but LinodeVolume is being deleted, continuing with deletion")
+		}
+
+		// Set ownerRef to LinodeCluster
+		// It will handle the case where the cluster is not found
+		if err := util.SetOwnerReferenceToLinodeCluster(ctx, r.TracedClient(), cluster, linodeVolume, r.Scheme()); err != nil {
+			log.Error(err, "Failed to set owner reference to LinodeCluster")
+			return ctrl.Result{}, err
+		}
+	}
+
+	volumeScope, err := scope.NewVolumeScope(
+		ctx,
+		r.LinodeClientConfig,
+		scope.VolumeScopeParams{
+			Client:       r.TracedClient(),
+			LinodeVolume: linodeVolume,
+			Cluster:      cluster,
+		},
+	)
+	if err != nil {
+		log.Error(err, "Failed to create Volume scope")
+
+		return ctrl.Result{}, fmt.Errorf("failed to create Volume scope: %w", err)
+	}
+
+	// Only check pause if not deleting or if cluster still exists.
+	if linodeVolume.DeletionTimestamp.IsZero() || cluster != nil {
+		isPaused, _, err := paused.EnsurePausedCondition(ctx, volumeScope.Client, volumeScope.Cluster, volumeScope.LinodeVolume)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if isPaused {
+			log.Info("linodevolume or linked cluster is paused, skipping reconciliation")
+			return ctrl.Result{}, nil
+		}
+	}
+
+	return r.reconcile(ctx, log, volumeScope)
+}
+
+func (r *LinodeVolumeReconciler) reconcile(
+	ctx context.Context,
+	logger logr.Logger,
+	volumeScope *scope.VolumeScope,
+) (res ctrl.Result, err error) {
+	res = ctrl.Result{}
+
+	volumeScope.LinodeVolume.Status.Ready = false
+	volumeScope.LinodeVolume.Status.FailureReason = nil
+	volumeScope.LinodeVolume.Status.FailureMessage = util.Pointer("")
+
+	failureReason := infrav1alpha2.LinodeVolumeStatusError("UnknownError")
+	//nolint:dupl // Code duplication is simplicity in this case.
+	defer func() {
+		if err != nil {
+			volumeScope.LinodeVolume.Status.FailureReason = util.Pointer(failureReason)
+			volumeScope.LinodeVolume.Status.FailureMessage = util.Pointer(err.Error())
+
+			volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(failureReason),
+				Message: err.Error(),
+			})
+
+			r.Recorder.Eventf(
+				volumeScope.LinodeVolume,
+				nil,
+				corev1.EventTypeWarning,
+				string(failureReason),
+				"Reconcile",
+				err.Error(),
+			)
+		}
+
+		// Always close the scope when exiting this function so we can persist any LinodeVolume changes.
+		// This ignores any resource not found errors when reconciling deletions.
+		if patchErr := volumeScope.Close(ctx); patchErr != nil && utilerrors.FilterOut(util.UnwrapError(patchErr), apierrors.IsNotFound) != nil {
+			logger.Error(patchErr, "failed to patch LinodeVolume")
+
+			err = errors.Join(err, patchErr)
+		}
+	}()
+
+	// Override the controller credentials with ones from the Volume's Secret reference (if supplied).
+	if err := volumeScope.SetCredentialRefTokenForLinodeClients(ctx); err != nil {
+		logger.Error(err, "failed to update linode client token from Credential Ref")
+		return res, err
+	}
+
+	// Delete
+	if !volumeScope.LinodeVolume.DeletionTimestamp.IsZero() {
+		failureReason = infrav1alpha2.DeleteVolumeError
+
+		res, err = r.reconcileDelete(ctx, logger, volumeScope)
+
+		return
+	}
+
+	// Add the finalizer if not already there
+	err = volumeScope.AddFinalizer(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to add finalizer")
+
+		return
+	}
+
+	// Create
+	if volumeScope.LinodeVolume.Spec.VolumeID == nil {
+		failureReason = infrav1alpha2.CreateVolumeError
+
+		err = r.reconcileCreate(ctx, logger, volumeScope)
+		if err != nil {
+			if !reconciler.HasStaleCondition(volumeScope.LinodeVolume.GetCondition(string(clusterv1.ReadyCondition)),
+				reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultVolumeControllerReconcileTimeout)) {
+				logger.Info("re-queuing Volume creation")
+
+				res = ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}
+				err = nil
+			}
+
+			return
+		}
+	}
+
+	// Update
+	failureReason = infrav1alpha2.UpdateVolumeError
+
+	logger = logger.WithValues("volumeID", *volumeScope.LinodeVolume.Spec.VolumeID)
+
+	res, err = r.reconcileUpdate(ctx, logger, volumeScope)
+	if err != nil && !reconciler.HasStaleCondition(volumeScope.LinodeVolume.GetCondition(string(clusterv1.ReadyCondition)),
+		reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultVolumeControllerReconcileTimeout)) {
+		logger.Info("re-queuing Volume update")
+
+		res = ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}
+		err = nil
+	}
+
+	return
+}
+
+//nolint:dupl // same as Placement Group - future generics candidate.
+func (r *LinodeVolumeReconciler) reconcileCreate(ctx context.Context, logger logr.Logger, volumeScope *scope.VolumeScope) error {
+	logger.Info("creating volume")
+
+	if err := volumeScope.AddCredentialsRefFinalizer(ctx); err != nil {
+		logger.Error(err, "Failed to update credentials secret")
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.CreateVolumeError),
+			Message: err.Error(),
+		})
+
+		return err
+	}
+
+	if err := r.reconcileVolume(ctx, volumeScope, logger); err != nil {
+		logger.Error(err, "Failed to create Volume")
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.CreateVolumeError),
+			Message: err.Error(),
+		})
+		r.Recorder.Eventf(
+			volumeScope.LinodeVolume,
+			nil,
+			corev1.EventTypeWarning,
+			string(infrav1alpha2.CreateVolumeError),
+			"CreateVolume",
+			err.Error(),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *LinodeVolumeReconciler) reconcileUpdate(ctx context.Context, logger logr.Logger, volumeScope *scope.VolumeScope) (ctrl.Result, error) {
+	logger.Info("updating volume")
+
+	volumeID := *volumeScope.LinodeVolume.Spec.VolumeID
+	volume, err := volumeScope.LinodeClient.GetVolume(ctx, volumeID)
+	if err != nil {
+		logger.Error(err, "Failed to fetch Volume")
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(infrav1alpha2.UpdateVolumeError),
+			Message: err.Error(),
+		})
+
+		return ctrl.Result{}, err
+	}
+
+	volumeScope.LinodeVolume.Status.Size = volume.Size
+	volumeScope.LinodeVolume.Status.LinodeID = volume.LinodeID
+	volumeScope.LinodeVolume.Status.FilesystemPath = volume.FilesystemPath
+
+	if volume.Status != linodego.VolumeActive {
+		logger.Info("Volume is not yet active", "status", volume.Status)
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: string(volume.Status), // We have to set the reason to not fail object patching
+		})
+
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}, nil
+	}
+
+	// Volumes can only grow, shrinking is rejected by the webhook.
+	if desiredSize := volumeSizeGB(volumeScope.LinodeVolume.Spec.Size); desiredSize > volume.Size {
+		logger.Info("resizing volume", "from", volume.Size, "to", desiredSize)
+		if err := volumeScope.LinodeClient.ResizeVolume(ctx, volumeID, linodego.VolumeResizeOptions{Size: desiredSize}); err != nil {
+			logger.Error(err, "Failed to resize Volume")
+			volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(infrav1alpha2.UpdateVolumeError),
+				Message: err.Error(),
+			})
+			r.Recorder.Eventf(
+				volumeScope.LinodeVolume,
+				nil,
+				corev1.EventTypeWarning,
+				string(infrav1alpha2.UpdateVolumeError),
+				"ResizeVolume",
+				err.Error(),
+			)
+
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(
+			volumeScope.LinodeVolume,
+			nil,
+			corev1.EventTypeNormal,
+			"Resized",
+			"ResizeVolume",
+			"Resizing Volume %d from %dGB to %dGB",
+			volumeID,
+			volume.Size,
+			desiredSize,
+		)
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:   clusterv1.ReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: "Resizing", // We have to set the reason to not fail object patching
+		})
+
+		return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}, nil
+	}
+
+	if tags := volumeTags(volumeScope.LinodeVolume); volumeTagsChanged(tags, volume.Tags) {
+		if _, err := volumeScope.LinodeClient.UpdateVolume(ctx, volumeID, linodego.VolumeUpdateOptions{Tags: tags}); err != nil {
+			logger.Error(err, "Failed to update Volume tags")
+			volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+				Type:    clusterv1.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  string(infrav1alpha2.UpdateVolumeError),
+				Message: err.Error(),
+			})
+
+			return ctrl.Result{}, err
+		}
+	}
+
+	volumeScope.LinodeVolume.Status.Ready = true
+	volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: "LinodeVolumeReady", // We have to set the reason to not fail object patching
+	})
+
+	return ctrl.Result{}, nil
+}
+
+//nolint:nestif // As simple as possible.
+func (r *LinodeVolumeReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, volumeScope *scope.VolumeScope) (ctrl.Result, error) {
+	logger.Info("deleting Volume")
+
+	switch {
+	case volumeScope.LinodeVolume.Spec.Retain:
+		logger.Info("Volume has retain flag, skipping Volume deletion")
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.NotDeletingReason,
+			Message: "Volume retained as requested, associated cloud resource was not deleted.",
+		})
+	case volumeScope.LinodeVolume.Spec.VolumeID != nil:
+		volumeID := *volumeScope.LinodeVolume.Spec.VolumeID
+		logger = logger.WithValues("volumeID", volumeID)
+
+		volume, err := volumeScope.LinodeClient.GetVolume(ctx, volumeID)
+		if err != nil {
+			if util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+				logger.Error(err, "Failed to fetch Volume from API")
+				if volumeScope.LinodeVolume.ObjectMeta.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultVolumeControllerReconcileTimeout)).After(time.Now()) {
+					logger.Info("re-queuing Volume deletion due to fetch error")
+					return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}, nil
+				}
+				return ctrl.Result{}, fmt.Errorf("failed to fetch volume %d after timeout: %w", volumeID, err)
+			}
+			logger.Info("Volume not found via API, assuming already deleted")
+		}
+
+		if volume != nil {
+			if volume.LinodeID != nil {
+				logger.Info("Volume is still attached", "linodeID", *volume.LinodeID)
+				waitTimeout := reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultVolumeControllerWaitForDetachTimeout)
+				if volumeScope.LinodeVolume.ObjectMeta.DeletionTimestamp.Add(waitTimeout).After(time.Now()) {
+					logger.Info("re-queuing Volume deletion to wait for detachment")
+					return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}, nil
+				}
+
+				volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+					Type:    clusterv1.ReadyCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  clusterv1.NotDeletingReason,
+					Message: fmt.Sprintf("skipped due to Volume still attached to linode %d after %s timeout", *volume.LinodeID, waitTimeout),
+				})
+				r.Recorder.Eventf(
+					volumeScope.LinodeVolume,
+					nil,
+					corev1.EventTypeWarning,
+					clusterv1.NotDeletingReason,
+					"DeleteVolume",
+					"Will not delete Volume %d attached to linode %d after %s timeout",
+					volumeID,
+					*volume.LinodeID,
+					waitTimeout,
+				)
+				return ctrl.Result{}, errors.New("will not delete Volume that is still attached")
+			}
+
+			if err := volumeScope.LinodeClient.DeleteVolume(ctx, volumeID); util.IgnoreLinodeAPIError(err, http.StatusNotFound) != nil {
+				logger.Error(err, "Failed to delete Volume via API")
+				if volumeScope.LinodeVolume.ObjectMeta.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultVolumeControllerReconcileTimeout)).After(time.Now()) {
+					logger.Info("re-queuing Volume deletion due to API delete error")
+					return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}, nil
+				}
+				return ctrl.Result{}, fmt.Errorf("failed to delete volume %d after timeout: %w", volumeID, err)
+			}
+		}
+
+		volumeScope.LinodeVolume.SetCondition(metav1.Condition{
+			Type:    clusterv1.ReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  clusterv1.DeletionCompletedReason,
+			Message: "Volume deleted",
+		})
+		volumeScope.LinodeVolume.Spec.VolumeID = nil
+	default:
+		logger.Info("Volume ID is missing, nothing to do")
+	}
+
+	if err := volumeScope.RemoveCredentialsRefFinalizer(ctx); err != nil {
+		logger.Error(err, "Failed to remove credentials secret finalizer")
+		if volumeScope.LinodeVolume.ObjectMeta.DeletionTimestamp.Add(reconciler.DefaultTimeout(r.ReconcileTimeout, reconciler.DefaultVolumeControllerReconcileTimeout)).After(time.Now()) {
+			logger.Info("re-queuing Volume deletion due to credential finalizer removal error")
+			return ctrl.Result{RequeueAfter: reconciler.WithJitter(reconciler.DefaultVolumeControllerReconcileDelay)}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to remove credential finalizer after timeout: %w", err)
+	}
+
+	controllerutil.RemoveFinalizer(volumeScope.LinodeVolume, infrav1alpha2.VolumeFinalizer)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+//
+//nolint:dupl // this is same as Placement Group, worth making generic later.
+func (r *LinodeVolumeReconciler) SetupWithManager(mgr ctrl.Manager, options crcontroller.Options) error {
+	linodeVolumeMapper, err := kutil.ClusterToTypedObjectsMapper(
+		r.TracedClient(),
+		&infrav1alpha2.LinodeVolumeList{},
+		mgr.GetScheme(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create mapper for LinodeVolumes: %w", err)
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.LinodeVolume{}).
+		WithOptions(options).
+		WithEventFilter(predicate.And(
+			predicates.ResourceHasFilterLabel(mgr.GetScheme(), mgr.GetLogger(), r.WatchFilterValue),
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.AnnotationChangedPredicate{},
+			),
+			predicate.Funcs{UpdateFunc: func(e event.UpdateEvent) bool {
+				oldObject, okOld := e.ObjectOld.(*infrav1alpha2.LinodeVolume)
+				newObject, okNew := e.ObjectNew.(*infrav1alpha2.LinodeVolume)
+				if okOld && okNew && oldObject.Spec.VolumeID == nil && newObject.Spec.VolumeID != nil {
+					// We just created the Volume, don't enqueue and update
+					return false
+				}
+				return true
+			}},
+		)).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(linodeVolumeMapper),
+			builder.WithPredicates(predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), mgr.GetLogger())),
+		).Complete(wrappedruntimereconciler.NewRuntimeReconcilerWithTracing(r, wrappedruntimereconciler.DefaultDecorator()))
+	if err != nil {
+		return fmt.Errorf("failed to build controller: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LinodeVolumeReconciler) TracedClient() client.Client {
+	return wrappedruntimeclient.NewRuntimeClientWithTracing(r.Client, wrappedruntimeclient.DefaultDecorator())
+}
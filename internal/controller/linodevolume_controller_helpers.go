@@ -0,0 +1,115 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/linode/linodego/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/util"
+)
+
+func (r *LinodeVolumeReconciler) reconcileVolume(ctx context.Context, volumeScope *scope.VolumeScope, logger logr.Logger) error {
+	createConfig := linodeVolumeSpecToVolumeCreateConfig(volumeScope.LinodeVolume)
+
+	listFilter := util.Filter{
+		ID:    volumeScope.LinodeVolume.Spec.VolumeID,
+		Label: createConfig.Label,
+		Tags:  nil,
+	}
+	filter, err := listFilter.String()
+	if err != nil {
+		return err
+	}
+	if volumes, err := volumeScope.LinodeClient.ListVolumes(ctx, linodego.NewListOptions(1, filter)); err != nil {
+		logger.Error(err, "Failed to list Volumes")
+		return err
+	} else if len(volumes) != 0 {
+		// Only adopt a Volume created for this LinodeVolume, another Volume with the same label could belong to anyone.
+		if !slices.Contains(volumes[0].Tags, string(volumeScope.LinodeVolume.UID)) {
+			return fmt.Errorf("existing volume %d with label %s was not created for this LinodeVolume, set volumeID to adopt it", volumes[0].ID, createConfig.Label)
+		}
+		if volumes[0].Region != createConfig.Region {
+			return fmt.Errorf("existing volume %d is in region %s, expected %s", volumes[0].ID, volumes[0].Region, createConfig.Region)
+		}
+		volumeScope.LinodeVolume.Spec.VolumeID = &volumes[0].ID
+		return nil
+	}
+
+	volume, err := volumeScope.LinodeClient.CreateVolume(ctx, createConfig)
+	if err != nil {
+		logger.Error(err, "Failed to create Volume")
+
+		return err
+	} else if volume == nil {
+		err = errors.New("missing Volume")
+
+		logger.Error(err, "Panic! Failed to create Volume")
+
+		return err
+	}
+
+	volumeScope.LinodeVolume.Spec.VolumeID = &volume.ID
+
+	return nil
+}
+
+func linodeVolumeSpecToVolumeCreateConfig(linodeVolume *infrav1alpha2.LinodeVolume) linodego.VolumeCreateOptions {
+	label := linodeVolume.Spec.Label
+	if label == "" {
+		label = linodeVolume.GetName()
+	}
+
+	return linodego.VolumeCreateOptions{
+		Label:      label,
+		Region:     linodeVolume.Spec.Region,
+		Size:       volumeSizeGB(linodeVolume.Spec.Size),
+		Tags:       volumeTags(linodeVolume),
+		Encryption: linodeVolume.Spec.Encryption,
+	}
+}
+
+// volumeSizeGB converts a Volume size to the whole number of gigabytes expected by the Linode API, rounding up. The
+// Linode API counts gigabytes in binary units, so that 10Gi makes a 10 GB Volume.
+func volumeSizeGB(size resource.Quantity) int {
+	const gibibyte = 1 << 30
+	return int((size.Value() + gibibyte - 1) / gibibyte)
+}
+
+// volumeTags returns the tags of the Volume of a LinodeVolume. The UID tag marks the Volume as created for the
+// LinodeVolume, so that it can be found again by its label.
+func volumeTags(linodeVolume *infrav1alpha2.LinodeVolume) []string {
+	return append(slices.Clone(linodeVolume.Spec.Tags), string(linodeVolume.UID))
+}
+
+// volumeTagsChanged reports whether the desired tags differ from the tags on the Volume, ignoring order.
+func volumeTagsChanged(desired, current []string) bool {
+	desired = slices.Clone(desired)
+	current = slices.Clone(current)
+	slices.Sort(desired)
+	slices.Sort(current)
+
+	return !slices.Equal(desired, current)
+}
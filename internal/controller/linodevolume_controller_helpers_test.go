@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestVolumeSizeGB(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		size string
+		want int
+	}{
+		{size: "10Gi", want: 10},
+		{size: "20Gi", want: 20},
+		{size: "10G", want: 10},
+		{size: "11G", want: 11},
+		{size: "1Ti", want: 1024},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.size, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.want, volumeSizeGB(resource.MustParse(testcase.size)))
+		})
+	}
+}
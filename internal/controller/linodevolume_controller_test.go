@@ -0,0 +1,331 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/linode/linodego/v2"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
+	"github.com/linode/cluster-api-provider-linode/mock"
+	rec "github.com/linode/cluster-api-provider-linode/util/reconciler"
+
+	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lifecycle", Ordered, Label("volume", "lifecycle"), func() {
+	suite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{})
+
+	linodeVolume := infrav1alpha2.LinodeVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "lifecycle",
+			Namespace: "default",
+		},
+		Spec: infrav1alpha2.LinodeVolumeSpec{
+			Region: "us-ord",
+			Size:   resource.MustParse("20Gi"),
+		},
+	}
+
+	objectKey := client.ObjectKeyFromObject(&linodeVolume)
+
+	var reconciler LinodeVolumeReconciler
+	var volumeScope scope.VolumeScope
+
+	BeforeAll(func(ctx SpecContext) {
+		volumeScope.Client = k8sClient
+		Expect(k8sClient.Create(ctx, &linodeVolume)).To(Succeed())
+	})
+
+	suite.BeforeEach(func(ctx context.Context, mck Mock) {
+		volumeScope.LinodeClient = mck.LinodeClient
+
+		Expect(k8sClient.Get(ctx, objectKey, &linodeVolume)).To(Succeed())
+		volumeScope.LinodeVolume = &linodeVolume
+
+		// Create patch helper with latest state of resource.
+		// This is only needed when relying on envtest's k8sClient.
+		patchHelper, err := patch.NewHelper(&linodeVolume, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		volumeScope.PatchHelper = patchHelper
+
+		// Reset reconciler for each test
+		reconciler = LinodeVolumeReconciler{
+			Recorder: mck.Recorder(),
+		}
+	})
+
+	suite.Run(
+		OneOf(
+			Path(
+				Call("unable to create", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListVolumes(ctx, gomock.Any()).Return([]linodego.Volume{}, nil)
+					mck.LinodeClient.EXPECT().CreateVolume(ctx, gomock.Any()).Return(nil, errors.New("server error"))
+				}),
+				OneOf(
+					Path(Result("create requeues", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+						Expect(res.RequeueAfter).To(BeNumerically("<=", rec.DefaultVolumeControllerReconcileDelay+time.Duration(float64(rec.DefaultVolumeControllerReconcileDelay)*rec.RetryJitterFraction)))
+						Expect(mck.Logs()).To(ContainSubstring("re-queuing Volume creation"))
+					})),
+					Path(Result("timeout error", func(ctx context.Context, mck Mock) {
+						reconciler.ReconcileTimeout = time.Nanosecond
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+						Expect(err).To(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(mck.Events()).To(ContainSubstring("server error"))
+					})),
+				),
+			),
+			Path(
+				Call("volume with the same label exists", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListVolumes(ctx, gomock.Any()).Return([]linodego.Volume{{
+						ID:     2,
+						Label:  "lifecycle",
+						Region: "us-ord",
+						Size:   20,
+						Status: linodego.VolumeActive,
+					}}, nil)
+				}),
+				Result("refuses to adopt it", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+					Expect(mck.Events()).To(ContainSubstring("existing volume 2 with label lifecycle was not created for this LinodeVolume"))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeVolume)).To(Succeed())
+					Expect(linodeVolume.Spec.VolumeID).To(BeNil())
+				}),
+			),
+			Path(
+				Call("able to create", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().ListVolumes(ctx, gomock.Any()).Return([]linodego.Volume{}, nil)
+					createVolume := mck.LinodeClient.EXPECT().CreateVolume(ctx, gomock.Any()).Return(&linodego.Volume{
+						ID:     1,
+						Region: "us-ord",
+						Size:   20,
+						Status: linodego.VolumeCreating,
+					}, nil)
+					mck.LinodeClient.EXPECT().GetVolume(ctx, 1).After(createVolume).Return(&linodego.Volume{
+						ID:     1,
+						Region: "us-ord",
+						Size:   20,
+						Status: linodego.VolumeCreating,
+					}, nil)
+				}),
+				Result("waits for the volume to become active", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+					Expect(mck.Logs()).To(ContainSubstring("Volume is not yet active"))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeVolume)).To(Succeed())
+					Expect(*linodeVolume.Spec.VolumeID).To(Equal(1))
+					Expect(linodeVolume.Status.Ready).To(BeFalse())
+				}),
+			),
+		),
+		OneOf(
+			Path(
+				Call("volume is smaller than desired", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetVolume(ctx, 1).Return(&linodego.Volume{
+						ID:     1,
+						Region: "us-ord",
+						Size:   10,
+						Status: linodego.VolumeActive,
+					}, nil)
+					mck.LinodeClient.EXPECT().ResizeVolume(ctx, 1, linodego.VolumeResizeOptions{Size: 20}).Return(nil)
+				}),
+				Result("resize requeues", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+					Expect(mck.Events()).To(ContainSubstring("Resizing Volume 1 from 10GB to 20GB"))
+				}),
+			),
+			Path(
+				Call("unable to resize", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetVolume(ctx, 1).Return(&linodego.Volume{
+						ID:     1,
+						Region: "us-ord",
+						Size:   10,
+						Status: linodego.VolumeActive,
+					}, nil)
+					mck.LinodeClient.EXPECT().ResizeVolume(ctx, 1, gomock.Any()).Return(errors.New("server error"))
+				}),
+				Result("update requeues", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+					Expect(mck.Logs()).To(ContainSubstring("re-queuing Volume update"))
+				}),
+			),
+			Path(
+				Call("volume is active", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetVolume(ctx, 1).Return(&linodego.Volume{
+						ID:             1,
+						Region:         "us-ord",
+						Size:           20,
+						Status:         linodego.VolumeActive,
+						FilesystemPath: "/dev/disk/by-id/scsi-0Linode_Volume_lifecycle",
+						Tags:           []string{string(linodeVolume.UID)},
+					}, nil)
+				}),
+				Result("ready", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+
+					Expect(k8sClient.Get(ctx, objectKey, &linodeVolume)).To(Succeed())
+					Expect(linodeVolume.Status.Ready).To(BeTrue())
+					Expect(linodeVolume.Status.Size).To(Equal(20))
+					Expect(linodeVolume.Status.FilesystemPath).To(Equal("/dev/disk/by-id/scsi-0Linode_Volume_lifecycle"))
+				}),
+			),
+		),
+		Once("delete", func(ctx context.Context, _ Mock) {
+			Expect(k8sClient.Delete(ctx, &linodeVolume)).To(Succeed())
+			Expect(k8sClient.Get(ctx, objectKey, &linodeVolume)).To(Succeed())
+		}),
+		OneOf(
+			Path(
+				Call("volume still attached", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetVolume(ctx, 1).Return(&linodego.Volume{
+						ID:       1,
+						Region:   "us-ord",
+						Size:     20,
+						Status:   linodego.VolumeActive,
+						LinodeID: ptr.To(123),
+					}, nil)
+				}),
+				OneOf(
+					Path(Result("delete requeues", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+						Expect(mck.Logs()).To(ContainSubstring("re-queuing Volume deletion to wait for detachment"))
+					})),
+					Path(Result("timeout error", func(ctx context.Context, mck Mock) {
+						reconciler.ReconcileTimeout = time.Nanosecond
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+						Expect(err).To(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(mck.Events()).To(ContainSubstring("Will not delete Volume 1 attached to linode 123"))
+					})),
+				),
+			),
+			Path(
+				Call("unable to delete", func(ctx context.Context, mck Mock) {
+					getVolume := mck.LinodeClient.EXPECT().GetVolume(ctx, 1).Return(&linodego.Volume{
+						ID:     1,
+						Region: "us-ord",
+						Size:   20,
+						Status: linodego.VolumeActive,
+					}, nil)
+					mck.LinodeClient.EXPECT().DeleteVolume(ctx, 1).After(getVolume).Return(errors.New("server error"))
+				}),
+				OneOf(
+					Path(Result("deletes are requeued", func(ctx context.Context, mck Mock) {
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(res.RequeueAfter).To(BeNumerically(">=", rec.DefaultVolumeControllerReconcileDelay))
+						Expect(mck.Logs()).To(ContainSubstring("Failed to delete Volume via API"))
+					})),
+					Path(Result("timeout error", func(ctx context.Context, mck Mock) {
+						reconciler.ReconcileTimeout = time.Nanosecond
+						res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+						Expect(err).To(HaveOccurred())
+						Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+						Expect(mck.Events()).To(ContainSubstring("server error"))
+					})),
+				),
+			),
+			Path(
+				Call("volume detached", func(ctx context.Context, mck Mock) {
+					getVolume := mck.LinodeClient.EXPECT().GetVolume(ctx, 1).Return(&linodego.Volume{
+						ID:     1,
+						Region: "us-ord",
+						Size:   20,
+						Status: linodego.VolumeActive,
+					}, nil)
+					mck.LinodeClient.EXPECT().DeleteVolume(ctx, 1).After(getVolume).Return(nil)
+				}),
+				Result("delete success", func(ctx context.Context, mck Mock) {
+					res, err := reconciler.reconcile(ctx, mck.Logger(), &volumeScope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+					Expect(apierrors.IsNotFound(k8sClient.Get(ctx, objectKey, &linodeVolume))).To(BeTrue())
+				}),
+			),
+		),
+	)
+})
+
+var _ = Describe("retained volume", Label("volume", "retain"), func() {
+	suite := NewControllerSuite(GinkgoT(), mock.MockLinodeClient{})
+
+	suite.Run(
+		Result("delete skips the Linode API", func(ctx context.Context, mck Mock) {
+			linodeVolume := infrav1alpha2.LinodeVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "retained",
+					Namespace:  "default",
+					Finalizers: []string{infrav1alpha2.VolumeFinalizer},
+				},
+				Spec: infrav1alpha2.LinodeVolumeSpec{
+					VolumeID: ptr.To(2),
+					Region:   "us-ord",
+					Size:     resource.MustParse("20Gi"),
+					Retain:   true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, &linodeVolume)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &linodeVolume)).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&linodeVolume), &linodeVolume)).To(Succeed())
+
+			patchHelper, err := patch.NewHelper(&linodeVolume, k8sClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			reconciler := LinodeVolumeReconciler{Recorder: mck.Recorder()}
+			res, err := reconciler.reconcile(ctx, mck.Logger(), &scope.VolumeScope{
+				Client:       k8sClient,
+				LinodeClient: mck.LinodeClient,
+				LinodeVolume: &linodeVolume,
+				PatchHelper:  patchHelper,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.RequeueAfter).To(Equal(time.Duration(0)))
+			Expect(mck.Logs()).To(ContainSubstring("Volume has retain flag, skipping Volume deletion"))
+			Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(&linodeVolume), &linodeVolume))).To(BeTrue())
+		}),
+	)
+})
@@ -58,6 +58,9 @@ type LinodeVPCReconciler struct {
 	LinodeClientConfig scope.ClientConfig
 	WatchFilterValue   string
 	ReconcileTimeout   time.Duration
+	GarbageCollector   *GarbageCollector
+	// ManagementClusterID identifies the management cluster in the description of the VPCs.
+	ManagementClusterID string
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=linodevpcs,verbs=get;list;watch;create;update;patch;delete
@@ -113,9 +116,10 @@ func (r *LinodeVPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		ctx,
 		r.LinodeClientConfig,
 		scope.VPCScopeParams{
-			Client:    r.TracedClient(),
-			LinodeVPC: linodeVPC,
-			Cluster:   cluster,
+			Client:              r.TracedClient(),
+			LinodeVPC:           linodeVPC,
+			Cluster:             cluster,
+			ManagementClusterID: r.ManagementClusterID,
 		},
 	)
 	if err != nil {
@@ -186,6 +190,7 @@ func (r *LinodeVPCReconciler) reconcile(
 		logger.Error(err, "failed to update linode client token from Credential Ref")
 		return res, err
 	}
+	r.GarbageCollector.Track(vpcScope.TokenHash, vpcScope.LinodeClient)
 
 	// Delete
 	if !vpcScope.LinodeVPC.DeletionTimestamp.IsZero() {
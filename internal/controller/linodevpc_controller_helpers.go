@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/linode/linodego/v2"
@@ -35,6 +36,9 @@ var (
 	ErrVPCNotFound = errors.New("VPC not found")
 )
 
+// maxVPCDescriptionLength is the length of the longest description accepted by the Linode API for a VPC.
+const maxVPCDescriptionLength = 255
+
 func reconcileVPC(ctx context.Context, vpcScope *scope.VPCScope, logger logr.Logger) error {
 	createConfig := linodeVPCSpecToVPCCreateConfig(vpcScope.LinodeVPC.Spec)
 	if createConfig == nil {
@@ -44,6 +48,7 @@ func reconcileVPC(ctx context.Context, vpcScope *scope.VPCScope, logger logr.Log
 	}
 
 	createConfig.Label = vpcScope.LinodeVPC.Name
+	createConfig.Description = vpcDescription(vpcScope)
 	listFilter := util.Filter{
 		ID:   vpcScope.LinodeVPC.Spec.VPCID,
 		Tags: nil,
@@ -96,6 +101,14 @@ func reconcileExistingVPC(ctx context.Context, vpcScope *scope.VPCScope, vpc *li
 		}
 	}
 
+	// claim the VPCs created by CAPL once the LinodeVPC was moved to another management cluster
+	if description := vpcDescription(vpcScope); util.HasOwnerTag(strings.Fields(vpc.Description)) && vpc.Description != description {
+		_, err := vpcScope.LinodeClient.UpdateVPC(ctx, vpc.ID, linodego.VPCUpdateOptions{Description: description})
+		if err != nil {
+			return err
+		}
+	}
+
 	// Build a map of VPC subnets by both label and ID. We check for
 	// the subnet ID but fallback to the label because the ID is not guaranteed
 	// to be set until we've processed the subnet at least once.
@@ -151,6 +164,24 @@ func reconcileExistingVPC(ctx context.Context, vpcScope *scope.VPCScope, vpc *li
 	return nil
 }
 
+// vpcDescription returns the description of the VPC followed by the UID of the LinodeVPC and the owner tag of the
+// management cluster. As VPCs cannot be tagged, the garbage collector finds the VPCs created by CAPL through their
+// description, which is left as is without a management cluster ID or when the markers don't fit in it.
+func vpcDescription(vpcScope *scope.VPCScope) string {
+	description := vpcScope.LinodeVPC.Spec.Description
+	if vpcScope.ManagementClusterID == "" {
+		return description
+	}
+
+	markers := append([]string{string(vpcScope.LinodeVPC.UID)}, util.GetOwnerTags(vpcScope.ManagementClusterID)...)
+	withMarkers := strings.TrimSpace(description + " " + strings.Join(markers, " "))
+	if len(withMarkers) > maxVPCDescriptionLength {
+		return description
+	}
+
+	return withMarkers
+}
+
 // updateVPCSpecSubnets updates Subnets in linodeVPC spec and adds linode specific ID to them
 func updateVPCSpecSubnets(vpcScope *scope.VPCScope, vpc *linodego.VPC) {
 	for idx, specSubnet := range vpcScope.LinodeVPC.Spec.Subnets {
@@ -2,12 +2,15 @@ package controller
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/linode/linodego/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/cloud/scope"
 )
 
 func Test_linodeVPCSpecToVPCCreateConfig(t *testing.T) {
@@ -285,3 +288,52 @@ func Test_linodeVPCSpecToVPCCreateConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_vpcDescription(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		description         string
+		managementClusterID string
+		want                string
+	}{
+		{
+			name:        "no management cluster ID",
+			description: "description",
+			want:        "description",
+		},
+		{
+			name:                "no description",
+			managementClusterID: "mgmt",
+			want:                liveVPCUID + " capl-owner:mgmt",
+		},
+		{
+			name:                "description",
+			description:         "description",
+			managementClusterID: "mgmt",
+			want:                "description " + liveVPCUID + " capl-owner:mgmt",
+		},
+		{
+			name:                "markers don't fit",
+			description:         strings.Repeat("a", 220),
+			managementClusterID: "mgmt",
+			want:                strings.Repeat("a", 220),
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			vpcScope := &scope.VPCScope{
+				LinodeVPC: &infrav1alpha2.LinodeVPC{
+					ObjectMeta: metav1.ObjectMeta{UID: liveVPCUID},
+					Spec:       infrav1alpha2.LinodeVPCSpec{Description: testcase.description},
+				},
+				ManagementClusterID: testcase.managementClusterID,
+			}
+			if got := vpcDescription(vpcScope); got != testcase.want {
+				t.Errorf("vpcDescription() = %q, want %q", got, testcase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+
+// ManagementClusterID identifies the management cluster in the owner tags of the cloud resources and in the DNS
+// ownership records of the LinodeClusters. Unless it is set explicitly, it defaults to the UID of the kube-system
+// Namespace, which is only read once a feature needs it.
+type ManagementClusterID struct {
+	// Reader reads the kube-system Namespace, it must not depend on the cache of the manager being started.
+	Reader client.Reader
+
+	mu sync.Mutex
+	id string
+}
+
+// NewManagementClusterID returns the ManagementClusterID set to id, or defaulting to the UID of the kube-system
+// Namespace read through reader when id is empty.
+func NewManagementClusterID(reader client.Reader, id string) *ManagementClusterID {
+	return &ManagementClusterID{Reader: reader, id: id}
+}
+
+// Get returns the ID of the management cluster, reading the UID of the kube-system Namespace on first use.
+func (m *ManagementClusterID) Get(ctx context.Context) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.id != "" {
+		return m.id, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := m.Reader.Get(ctx, client.ObjectKey{Name: metav1.NamespaceSystem}, namespace); err != nil {
+		return "", fmt.Errorf("get the UID of the %s namespace: %w", metav1.NamespaceSystem, err)
+	}
+	m.id = string(namespace.UID)
+
+	return m.id, nil
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/linode/cluster-api-provider-linode/mock"
+)
+
+func TestManagementClusterIDGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		id            string
+		expects       func(k8sClient *mock.MockK8sClient)
+		want          string
+		expectedError string
+	}{
+		{
+			name:    "Success - explicit ID is not read",
+			id:      "mgmt-prod",
+			expects: func(k8sClient *mock.MockK8sClient) {},
+			want:    "mgmt-prod",
+		},
+		{
+			name: "Success - UID of the kube-system Namespace is read once",
+			expects: func(k8sClient *mock.MockK8sClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Name: metav1.NamespaceSystem}, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Namespace, _ ...client.GetOption) error {
+						obj.UID = "kube-system-uid"
+						return nil
+					})
+			},
+			want: "kube-system-uid",
+		},
+		{
+			name: "Error - kube-system Namespace can't be read",
+			expects: func(k8sClient *mock.MockK8sClient) {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+			},
+			expectedError: "get the UID of the kube-system namespace: forbidden",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			k8sClient := mock.NewMockK8sClient(ctrl)
+			testcase.expects(k8sClient)
+
+			managementClusterID := NewManagementClusterID(k8sClient, testcase.id)
+			got, err := managementClusterID.Get(t.Context())
+			if testcase.expectedError != "" {
+				require.ErrorContains(t, err, testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testcase.want, got)
+
+			// The ID is only resolved once
+			got, err = managementClusterID.Get(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, testcase.want, got)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package v1alpha2
 
 import (
 	"context"
+	"net/netip"
 	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -79,8 +80,15 @@ func (r *linodeClusterValidator) ValidateCreate(ctx context.Context, cluster *in
 func (r *linodeClusterValidator) ValidateUpdate(_ context.Context, _, newCluster *infrav1alpha2.LinodeCluster) (admission.Warnings, error) {
 	linodeclusterlog.Info("validate update", "name", newCluster.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil, nil
+	// The NodeBalancer firewall and the DNS provider are the only parts of the spec validated upon update,
+	// everything else is immutable or validated by the API server.
+	errs := slices.Concat(validateNodeBalancerFirewall(newCluster.Spec), validateDNSProvider(newCluster.Spec))
+	if len(errs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeCluster"},
+		newCluster.Name, errs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -124,6 +132,17 @@ func (r *linodeClusterValidator) validateLinodeClusterSpec(ctx context.Context,
 		})
 	}
 
+	errs = slices.Concat(errs, validateNodeBalancerFirewall(spec), validateDNSProvider(spec))
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func validateNodeBalancerFirewall(spec infrav1alpha2.LinodeClusterSpec) field.ErrorList {
+	var errs field.ErrorList
+
 	if spec.Network.NodeBalancerFirewallID != nil && spec.NodeBalancerFirewallRef != nil {
 		errs = append(errs, &field.Error{
 			Field:  "spec.network.nodeBalancerFirewallID/spec.nodeBalancerFirewallRef",
@@ -132,8 +151,47 @@ func (r *linodeClusterValidator) validateLinodeClusterSpec(ctx context.Context,
 		})
 	}
 
-	if len(errs) == 0 {
-		return nil
+	if len(spec.Network.APIServerAllowedCIDRs) == 0 {
+		return errs
 	}
+
+	allowedCIDRsPath := field.NewPath("spec").Child("network").Child("apiServerAllowedCIDRs")
+	if spec.Network.NodeBalancerFirewallID != nil || spec.NodeBalancerFirewallRef != nil {
+		errs = append(errs, field.Invalid(allowedCIDRsPath, spec.Network.APIServerAllowedCIDRs,
+			"Cannot specify apiServerAllowedCIDRs together with NodeBalancerFirewallID or NodeBalancerFirewallRef"))
+	}
+	if spec.Network.LoadBalancerType != "" && spec.Network.LoadBalancerType != "NodeBalancer" {
+		errs = append(errs, field.Invalid(allowedCIDRsPath, spec.Network.APIServerAllowedCIDRs,
+			"apiServerAllowedCIDRs can only be used with the NodeBalancer LoadBalancerType"))
+	}
+	for i, cidr := range spec.Network.APIServerAllowedCIDRs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			errs = append(errs, field.Invalid(allowedCIDRsPath.Index(i), cidr, "must be a valid CIDR"))
+		}
+	}
+
+	return errs
+}
+
+func validateDNSProvider(spec infrav1alpha2.LinodeClusterSpec) field.ErrorList {
+	var errs field.ErrorList
+
+	rfc2136Path := field.NewPath("spec").Child("network").Child("dnsRFC2136")
+	config := spec.Network.DNSRFC2136
+	if spec.Network.DNSProvider != "rfc2136" {
+		if config != nil {
+			errs = append(errs, field.Forbidden(rfc2136Path, "dnsRFC2136 can only be set when dnsProvider is rfc2136"))
+		}
+		return errs
+	}
+
+	if config == nil {
+		return append(errs, field.Required(rfc2136Path, "dnsRFC2136 needs to be set when dnsProvider is rfc2136"))
+	}
+	if (config.TSIGKeyName == "") != (config.TSIGSecretRef == nil) {
+		errs = append(errs, field.Invalid(rfc2136Path.Child("tsigKeyName"), config.TSIGKeyName,
+			"tsigKeyName and tsigSecretRef must be set together"))
+	}
+
 	return errs
 }
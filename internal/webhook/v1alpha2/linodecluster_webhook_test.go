@@ -580,3 +580,160 @@ func TestValidateNodeBalancerFirewallIDAndNodeBalancerFirewallRef(t *testing.T)
 		),
 	)
 }
+
+func TestValidateAPIServerAllowedCIDRs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		spec    infrav1alpha2.LinodeClusterSpec
+		wantErr string
+	}{
+		{
+			name: "valid IPv4 and IPv6 CIDRs",
+			spec: infrav1alpha2.LinodeClusterSpec{
+				Network: infrav1alpha2.NetworkSpec{
+					LoadBalancerType:      "NodeBalancer",
+					APIServerAllowedCIDRs: []string{"203.0.113.0/24", "2001:db8::/32"},
+				},
+			},
+		},
+		{
+			name: "invalid CIDR",
+			spec: infrav1alpha2.LinodeClusterSpec{
+				Network: infrav1alpha2.NetworkSpec{
+					APIServerAllowedCIDRs: []string{"203.0.113.0/24", "203.0.113.1"},
+				},
+			},
+			wantErr: "spec.network.apiServerAllowedCIDRs[1]: Invalid value: \"203.0.113.1\": must be a valid CIDR",
+		},
+		{
+			name: "combined with NodeBalancerFirewallID",
+			spec: infrav1alpha2.LinodeClusterSpec{
+				Network: infrav1alpha2.NetworkSpec{
+					NodeBalancerFirewallID: ptr.To(5678),
+					APIServerAllowedCIDRs:  []string{"203.0.113.0/24"},
+				},
+			},
+			wantErr: "Cannot specify apiServerAllowedCIDRs together with NodeBalancerFirewallID or NodeBalancerFirewallRef",
+		},
+		{
+			name: "combined with dns LoadBalancerType",
+			spec: infrav1alpha2.LinodeClusterSpec{
+				Network: infrav1alpha2.NetworkSpec{
+					LoadBalancerType:      "dns",
+					APIServerAllowedCIDRs: []string{"203.0.113.0/24"},
+				},
+			},
+			wantErr: "apiServerAllowedCIDRs can only be used with the NodeBalancer LoadBalancerType",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validateNodeBalancerFirewall(testcase.spec)
+			if testcase.wantErr == "" {
+				assert.Empty(t, errs)
+			} else {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testcase.wantErr)
+			}
+
+			// The allowlist is also validated upon update
+			cluster := &infrav1alpha2.LinodeCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "example"},
+				Spec:       testcase.spec,
+			}
+			_, err := (&linodeClusterValidator{}).ValidateUpdate(t.Context(), cluster, cluster)
+			if testcase.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, testcase.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDNSProvider(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		network infrav1alpha2.NetworkSpec
+		wantErr string
+	}{
+		{
+			name: "rfc2136 with TSIG",
+			network: infrav1alpha2.NetworkSpec{
+				LoadBalancerType: "dns",
+				DNSRootDomain:    "example.com",
+				DNSProvider:      "rfc2136",
+				DNSRFC2136: &infrav1alpha2.DNSRFC2136Config{
+					Nameserver:    "ns1.example.com",
+					TSIGKeyName:   "capl",
+					TSIGSecretRef: &corev1.SecretReference{Name: "tsig"},
+				},
+			},
+		},
+		{
+			name: "rfc2136 without TSIG",
+			network: infrav1alpha2.NetworkSpec{
+				LoadBalancerType: "dns",
+				DNSRootDomain:    "example.com",
+				DNSProvider:      "rfc2136",
+				DNSRFC2136:       &infrav1alpha2.DNSRFC2136Config{Nameserver: "ns1.example.com"},
+			},
+		},
+		{
+			name: "rfc2136 without dnsRFC2136",
+			network: infrav1alpha2.NetworkSpec{
+				LoadBalancerType: "dns",
+				DNSRootDomain:    "example.com",
+				DNSProvider:      "rfc2136",
+			},
+			wantErr: "spec.network.dnsRFC2136: Required value: dnsRFC2136 needs to be set when dnsProvider is rfc2136",
+		},
+		{
+			name: "TSIG key without secret",
+			network: infrav1alpha2.NetworkSpec{
+				LoadBalancerType: "dns",
+				DNSRootDomain:    "example.com",
+				DNSProvider:      "rfc2136",
+				DNSRFC2136: &infrav1alpha2.DNSRFC2136Config{
+					Nameserver:  "ns1.example.com",
+					TSIGKeyName: "capl",
+				},
+			},
+			wantErr: "tsigKeyName and tsigSecretRef must be set together",
+		},
+		{
+			name: "dnsRFC2136 with another provider",
+			network: infrav1alpha2.NetworkSpec{
+				LoadBalancerType: "dns",
+				DNSRootDomain:    "example.com",
+				DNSProvider:      "linode",
+				DNSRFC2136:       &infrav1alpha2.DNSRFC2136Config{Nameserver: "ns1.example.com"},
+			},
+			wantErr: "dnsRFC2136 can only be set when dnsProvider is rfc2136",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := &infrav1alpha2.LinodeCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "example"},
+				Spec:       infrav1alpha2.LinodeClusterSpec{Network: testcase.network},
+			}
+			_, err := (&linodeClusterValidator{}).ValidateUpdate(t.Context(), cluster, cluster)
+			if testcase.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, testcase.wantErr)
+			}
+		})
+	}
+}
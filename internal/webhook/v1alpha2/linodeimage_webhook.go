@@ -0,0 +1,139 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+)
+
+// log is for logging in this package.
+var linodeimagelog = logf.Log.WithName("linodeimage-resource")
+
+// SetupLinodeImageWebhookWithManager registers the webhook for LinodeImage in the manager.
+func SetupLinodeImageWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &infrav1alpha2.LinodeImage{}).
+		WithValidator(&LinodeImageCustomValidator{
+			Client: mgr.GetClient(),
+		}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-linodeimage,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=linodeimages,verbs=create;update,versions=v1alpha2,name=validation.linodeimage.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
+
+// LinodeImageCustomValidator struct is responsible for validating the LinodeImage resource
+type LinodeImageCustomValidator struct {
+	Client client.Client
+}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type LinodeImage.
+func (v *LinodeImageCustomValidator) ValidateCreate(ctx context.Context, image *infrav1alpha2.LinodeImage) (admission.Warnings, error) {
+	linodeimagelog.Info("Validation for LinodeImage upon creation", "name", image.GetName())
+
+	skipAPIValidation, linodeClient, err := setupClientWithCredentials(ctx, v.Client, image.Spec.CredentialsRef,
+		image.Name, image.GetNamespace(), linodeimagelog)
+	if err != nil {
+		return admission.Warnings{}, err
+	}
+
+	if errs := v.validateLinodeImageSpec(ctx, linodeClient, image, skipAPIValidation); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeImage"},
+			image.Name, errs)
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type LinodeImage.
+func (v *LinodeImageCustomValidator) ValidateUpdate(ctx context.Context, oldImage, newImage *infrav1alpha2.LinodeImage) (admission.Warnings, error) {
+	linodeimagelog.Info("Validation for LinodeImage upon update", "name", newImage.GetName())
+
+	if slices.Equal(oldImage.Spec.ReplicaRegions, newImage.Spec.ReplicaRegions) {
+		return nil, nil
+	}
+
+	skipAPIValidation, linodeClient, err := setupClientWithCredentials(ctx, v.Client, newImage.Spec.CredentialsRef,
+		newImage.Name, newImage.GetNamespace(), linodeimagelog)
+	if err != nil {
+		return admission.Warnings{}, err
+	}
+	if skipAPIValidation {
+		return nil, nil
+	}
+
+	if errs := validateImageReplicaRegions(ctx, linodeClient, newImage.Spec.ReplicaRegions); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeImage"},
+			newImage.Name, errs)
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type LinodeImage.
+func (v *LinodeImageCustomValidator) ValidateDelete(_ context.Context, image *infrav1alpha2.LinodeImage) (admission.Warnings, error) {
+	linodeimagelog.Info("Validation for LinodeImage upon deletion", "name", image.GetName())
+
+	return nil, nil
+}
+
+func (v *LinodeImageCustomValidator) validateLinodeImageSpec(ctx context.Context, linodeclient clients.LinodeClient, image *infrav1alpha2.LinodeImage, skipAPIValidation bool) field.ErrorList {
+	var errs field.ErrorList
+
+	label, labelPath := image.Spec.Label, field.NewPath("spec").Child("label")
+	if label == "" {
+		label, labelPath = image.GetName(), field.NewPath("metadata").Child("name")
+	}
+	if err := validateLabelLength(label, labelPath); err != nil {
+		errs = append(errs, err)
+	}
+
+	if !skipAPIValidation {
+		if err := validateRegion(ctx, linodeclient, image.Spec.Region, field.NewPath("spec").Child("region")); err != nil {
+			errs = append(errs, err)
+		}
+		errs = slices.Concat(errs, validateImageReplicaRegions(ctx, linodeclient, image.Spec.ReplicaRegions))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func validateImageReplicaRegions(ctx context.Context, linodeclient clients.LinodeClient, regions []string) field.ErrorList {
+	var errs field.ErrorList
+	for idx, region := range regions {
+		if err := validateRegion(ctx, linodeclient, region, field.NewPath("spec").Child("replicaRegions").Index(idx)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
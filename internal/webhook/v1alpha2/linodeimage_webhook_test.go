@@ -0,0 +1,111 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+
+	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
+)
+
+func TestValidateLinodeImage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		image = infrav1alpha2.LinodeImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example",
+				Namespace: "example",
+			},
+			Spec: infrav1alpha2.LinodeImageSpec{
+				Region:         "example",
+				ReplicaRegions: []string{"replica"},
+				Source: infrav1alpha2.LinodeImageSource{
+					URL: "https://example.com/image.img.gz",
+				},
+			},
+		}
+		region                    = linodego.Region{ID: "test"}
+		invalidRegionError        = "spec.region: Not found: \"example\""
+		invalidReplicaRegionError = "spec.replicaRegions[0]: Not found: \"replica\""
+		invalidImageLabelError    = "spec.label: Invalid value: \"ab\": must be between 3 and 32 characters"
+		validator                 = LinodeImageCustomValidator{}
+	)
+
+	NewSuite(t, mock.MockLinodeClient{}).Run(
+		OneOf(
+			Path(
+				Call("valid", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+				}),
+				Result("success", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeImageSpec(ctx, mck.LinodeClient, &image, SkipAPIValidation)
+					require.Empty(t, errs)
+				}),
+			),
+			Path(
+				Call("invalid label", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+				}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					image := image
+					image.Spec.Label = "ab"
+					errs := validator.validateLinodeImageSpec(ctx, mck.LinodeClient, &image, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidImageLabelError)
+					}
+				}),
+			),
+		),
+		OneOf(
+			Path(Call("invalid region", func(ctx context.Context, mck Mock) {
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), "example").Return(nil, errors.New("invalid region")).AnyTimes()
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), "replica").Return(&region, nil).AnyTimes()
+			}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeImageSpec(ctx, mck.LinodeClient, &image, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidRegionError)
+					}
+				})),
+			Path(Call("invalid replica region", func(ctx context.Context, mck Mock) {
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), "example").Return(&region, nil).AnyTimes()
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), "replica").Return(nil, errors.New("invalid region")).AnyTimes()
+			}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeImageSpec(ctx, mck.LinodeClient, &image, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidReplicaRegionError)
+					}
+				})),
+		),
+	)
+}
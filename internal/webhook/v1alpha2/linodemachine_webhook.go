@@ -22,6 +22,7 @@ import (
 	"slices"
 
 	"github.com/linode/linodego/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -33,6 +34,7 @@ import (
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 	"github.com/linode/cluster-api-provider-linode/clients"
+	"github.com/linode/cluster-api-provider-linode/feature"
 )
 
 var linodemachinelog = logf.Log.WithName("linodemachine-resource")
@@ -48,7 +50,7 @@ func SetupLinodeMachineWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
-// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-linodemachine,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=linodemachines,verbs=create,versions=v1alpha2,name=validation.linodemachine.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-linodemachine,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=linodemachines,verbs=create;update,versions=v1alpha2,name=validation.linodemachine.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *linodeMachineValidator) ValidateCreate(ctx context.Context, machine *infrav1alpha2.LinodeMachine) (admission.Warnings, error) {
@@ -78,11 +80,31 @@ func (r *linodeMachineValidator) ValidateCreate(ctx context.Context, machine *in
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
-func (r *linodeMachineValidator) ValidateUpdate(_ context.Context, _, newMachine *infrav1alpha2.LinodeMachine) (admission.Warnings, error) {
+func (r *linodeMachineValidator) ValidateUpdate(ctx context.Context, oldMachine, newMachine *infrav1alpha2.LinodeMachine) (admission.Warnings, error) {
 	linodemachinelog.Info("validate update", "name", newMachine.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil, nil
+	// The type and the network interfaces are the only fields that are validated upon update, everything else is
+	// immutable or validated by the API server.
+	var errs field.ErrorList
+	if !machineInterfacesEqual(oldMachine.Spec, newMachine.Spec) {
+		errs = slices.Concat(errs, r.validateLinodeMachineInterfacesUpdate(oldMachine.Spec, newMachine.Spec))
+	}
+
+	if oldMachine.Spec.Type != newMachine.Spec.Type {
+		skipAPIValidation, linodeClient, err := setupClientWithCredentials(ctx, r.Client, newMachine.Spec.CredentialsRef,
+			newMachine.Name, newMachine.GetNamespace(), linodemachinelog)
+		if err != nil {
+			return admission.Warnings{}, err
+		}
+		errs = slices.Concat(errs, r.validateLinodeMachineResize(ctx, linodeClient, newMachine.Spec, skipAPIValidation))
+	}
+
+	if len(errs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeMachine"},
+		newMachine.Name, errs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -144,6 +166,90 @@ func (r *linodeMachineValidator) validateLinodeMachineSpec(ctx context.Context,
 	return errs
 }
 
+// validateLinodeMachineResize validates a change of the type of a LinodeMachine, which is only allowed for in-place resizes.
+func (r *linodeMachineValidator) validateLinodeMachineResize(ctx context.Context, linodeclient clients.LinodeClient, spec infrav1alpha2.LinodeMachineSpec, skipAPIValidation bool) field.ErrorList {
+	var errs field.ErrorList
+
+	if !spec.AllowInPlaceResize {
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("type"), "can only be changed when allowInPlaceResize is enabled"))
+		return errs
+	}
+
+	if !skipAPIValidation {
+		plan, err := validateLinodeType(ctx, linodeclient, spec.Type, field.NewPath("spec").Child("type"))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if err := r.validateLinodeMachineDisks(plan, spec); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validateLinodeMachineInterfacesUpdate validates a change of the network interfaces of a LinodeMachine. The
+// interfaces can only be upgraded from legacy configuration interfaces to Linode interfaces, and legacy interfaces
+// can only be changed when the LegacyInterfaceUpdates feature gate is enabled.
+func (r *linodeMachineValidator) validateLinodeMachineInterfacesUpdate(oldSpec, newSpec infrav1alpha2.LinodeMachineSpec) field.ErrorList {
+	var errs field.ErrorList
+
+	oldGeneration, generation := machineInterfaceGeneration(oldSpec), machineInterfaceGeneration(newSpec)
+	switch {
+	case oldGeneration == linodego.GenerationLinode && generation == linodego.GenerationLegacyConfig:
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("interfaceGeneration"),
+			fmt.Sprintf("cannot be changed from %s to %s", linodego.GenerationLinode, linodego.GenerationLegacyConfig)))
+	case generation == linodego.GenerationLegacyConfig && !feature.Gates.Enabled(feature.LegacyInterfaceUpdates):
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("interfaces"),
+			fmt.Sprintf("can only be changed when the %s feature gate is enabled", feature.LegacyInterfaceUpdates)))
+	case oldGeneration != generation && newSpec.LinodeInterfaces == nil && newSpec.PrivateIP != nil && *newSpec.PrivateIP:
+		errs = append(errs, &field.Error{
+			Field:  "spec.interfaceGeneration/spec.privateIP",
+			Type:   field.ErrorTypeInvalid,
+			Detail: "Linode Interfaces do not support private IPs",
+		})
+	}
+
+	if newSpec.VPCID != nil && newSpec.VPCRef != nil {
+		errs = append(errs, &field.Error{
+			Field:  "spec.vpcID/spec.vpcRef",
+			Type:   field.ErrorTypeInvalid,
+			Detail: "Cannot specify both VPCID and VPCRef",
+		})
+	}
+
+	if newSpec.LinodeInterfaces != nil {
+		if ifaceErrs := r.validateLinodeInterfaces(newSpec); ifaceErrs != nil {
+			errs = append(errs, ifaceErrs...)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// machineInterfacesEqual returns whether the fields of two LinodeMachine specs defining the network interfaces are equal.
+func machineInterfacesEqual(oldSpec, newSpec infrav1alpha2.LinodeMachineSpec) bool {
+	return machineInterfaceGeneration(oldSpec) == machineInterfaceGeneration(newSpec) &&
+		equality.Semantic.DeepEqual(oldSpec.Interfaces, newSpec.Interfaces) &&
+		equality.Semantic.DeepEqual(oldSpec.LinodeInterfaces, newSpec.LinodeInterfaces) &&
+		equality.Semantic.DeepEqual(oldSpec.VPCRef, newSpec.VPCRef) &&
+		equality.Semantic.DeepEqual(oldSpec.VPCID, newSpec.VPCID)
+}
+
+// machineInterfaceGeneration returns the generation of the network interfaces of a LinodeMachine.
+func machineInterfaceGeneration(spec infrav1alpha2.LinodeMachineSpec) linodego.InterfaceGeneration {
+	if len(spec.LinodeInterfaces) > 0 || spec.InterfaceGeneration == linodego.GenerationLinode {
+		return linodego.GenerationLinode
+	}
+	return linodego.GenerationLegacyConfig
+}
+
 func (r *linodeMachineValidator) validateLinodeInterfaces(spec infrav1alpha2.LinodeMachineSpec) field.ErrorList {
 	var errs field.ErrorList
 
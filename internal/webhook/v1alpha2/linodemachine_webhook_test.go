@@ -32,10 +32,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/feature"
 	"github.com/linode/cluster-api-provider-linode/mock"
 
 	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
@@ -433,6 +435,63 @@ func TestValidateLinodeMachineUpdate(t *testing.T) {
 	)
 }
 
+func TestValidateLinodeMachineResize(t *testing.T) {
+	t.Parallel()
+
+	var (
+		spec = infrav1alpha2.LinodeMachineSpec{
+			Region:             "example",
+			Type:               "g6-standard-2",
+			AllowInPlaceResize: true,
+		}
+		plan      = linodego.LinodeType{Disk: 81920}
+		validator = &linodeMachineValidator{}
+	)
+
+	NewSuite(t, mock.MockLinodeClient{}).Run(
+		OneOf(
+			Path(
+				Call("valid type", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetType(gomock.Any(), "g6-standard-2").Return(&plan, nil)
+				}),
+				Result("success", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeMachineResize(ctx, mck.LinodeClient, spec, SkipAPIValidation)
+					assert.Empty(t, errs)
+				}),
+			),
+			Path(
+				Call("invalid type", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetType(gomock.Any(), "g6-standard-2").Return(nil, errors.New("not found"))
+				}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeMachineResize(ctx, mck.LinodeClient, spec, SkipAPIValidation)
+					require.Len(t, errs, 1)
+					assert.ErrorContains(t, errs[0], "spec.type: Not found: \"g6-standard-2\"")
+				}),
+			),
+			Path(
+				Call("disks do not fit the new type", func(ctx context.Context, mck Mock) {
+					mck.LinodeClient.EXPECT().GetType(gomock.Any(), "g6-standard-2").Return(&plan, nil)
+				}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					spec := spec
+					spec.OSDisk = ptr.To(infrav1alpha2.InstanceDisk{Size: resource.MustParse("100G")})
+					errs := validator.validateLinodeMachineResize(ctx, mck.LinodeClient, spec, SkipAPIValidation)
+					require.Len(t, errs, 1)
+					assert.ErrorContains(t, errs[0], "spec.osDisk")
+				}),
+			),
+			Path(Result("resize not allowed", func(ctx context.Context, mck Mock) {
+				spec := spec
+				spec.AllowInPlaceResize = false
+				errs := validator.validateLinodeMachineResize(ctx, mck.LinodeClient, spec, SkipAPIValidation)
+				require.Len(t, errs, 1)
+				assert.ErrorContains(t, errs[0], "spec.type: Forbidden: can only be changed when allowInPlaceResize is enabled")
+			})),
+		),
+	)
+}
+
 func TestValidateLinodeMachineDelete(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -681,3 +740,108 @@ func TestValidateFirewallIDAndFirewallRef(t *testing.T) {
 		),
 	)
 }
+
+//nolint:paralleltest // the feature gates are global
+func TestValidateLinodeMachineInterfacesUpdate(t *testing.T) {
+	var (
+		legacySpec = infrav1alpha2.LinodeMachineSpec{
+			Region:     "us-ord",
+			Interfaces: []infrav1alpha2.InstanceConfigInterfaceCreateOptions{{Purpose: linodego.InterfacePurposePublic}},
+		}
+		linodeSpec = infrav1alpha2.LinodeMachineSpec{
+			Region:           "us-ord",
+			LinodeInterfaces: []infrav1alpha2.LinodeInterfaceCreateOptions{{Public: &infrav1alpha2.PublicInterfaceCreateOptions{}}},
+		}
+		validator = &linodeMachineValidator{}
+	)
+
+	tests := []struct {
+		name        string
+		oldSpec     func() infrav1alpha2.LinodeMachineSpec
+		newSpec     func() infrav1alpha2.LinodeMachineSpec
+		featureGate bool
+		wantErr     string
+	}{
+		{
+			name:    "linode interfaces changed",
+			oldSpec: func() infrav1alpha2.LinodeMachineSpec { return linodeSpec },
+			newSpec: func() infrav1alpha2.LinodeMachineSpec {
+				spec := linodeSpec
+				spec.VPCID = ptr.To(1234)
+				return spec
+			},
+		},
+		{
+			name:    "legacy interfaces changed with the feature gate",
+			oldSpec: func() infrav1alpha2.LinodeMachineSpec { return legacySpec },
+			newSpec: func() infrav1alpha2.LinodeMachineSpec {
+				spec := legacySpec
+				spec.VPCID = ptr.To(1234)
+				return spec
+			},
+			featureGate: true,
+		},
+		{
+			name:    "legacy interfaces changed without the feature gate",
+			oldSpec: func() infrav1alpha2.LinodeMachineSpec { return legacySpec },
+			newSpec: func() infrav1alpha2.LinodeMachineSpec {
+				spec := legacySpec
+				spec.VPCID = ptr.To(1234)
+				return spec
+			},
+			wantErr: "spec.interfaces: Forbidden: can only be changed when the LegacyInterfaceUpdates feature gate is enabled",
+		},
+		{
+			name:    "interface generation upgraded",
+			oldSpec: func() infrav1alpha2.LinodeMachineSpec { return legacySpec },
+			newSpec: func() infrav1alpha2.LinodeMachineSpec {
+				spec := legacySpec
+				spec.InterfaceGeneration = linodego.GenerationLinode
+				return spec
+			},
+		},
+		{
+			name:    "interface generation upgraded with private IP",
+			oldSpec: func() infrav1alpha2.LinodeMachineSpec { return legacySpec },
+			newSpec: func() infrav1alpha2.LinodeMachineSpec {
+				spec := legacySpec
+				spec.InterfaceGeneration = linodego.GenerationLinode
+				spec.PrivateIP = ptr.To(true)
+				return spec
+			},
+			wantErr: "Linode Interfaces do not support private IPs",
+		},
+		{
+			name:        "interface generation downgraded",
+			oldSpec:     func() infrav1alpha2.LinodeMachineSpec { return linodeSpec },
+			newSpec:     func() infrav1alpha2.LinodeMachineSpec { return legacySpec },
+			featureGate: true,
+			wantErr:     "spec.interfaceGeneration: Forbidden: cannot be changed from linode to legacy_config",
+		},
+		{
+			name:    "both vpcID and vpcRef",
+			oldSpec: func() infrav1alpha2.LinodeMachineSpec { return linodeSpec },
+			newSpec: func() infrav1alpha2.LinodeMachineSpec {
+				spec := linodeSpec
+				spec.VPCID = ptr.To(1234)
+				spec.VPCRef = &corev1.ObjectReference{Name: "example"}
+				return spec
+			},
+			wantErr: "Cannot specify both VPCID and VPCRef",
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, feature.Gates, feature.LegacyInterfaceUpdates, testcase.featureGate)
+
+			errs := validator.validateLinodeMachineInterfacesUpdate(testcase.oldSpec(), testcase.newSpec())
+			if testcase.wantErr == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			assert.ErrorContains(t, errs[0], testcase.wantErr)
+		})
+	}
+}
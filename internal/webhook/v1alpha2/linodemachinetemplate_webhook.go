@@ -17,21 +17,66 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 )
 
 // log is for logging in this package.
-//
-//nolint:unused // Package logger variable is intended for future use in webhook implementation
 var linodemachinetemplatelog = logf.Log.WithName("linodemachinetemplate-resource")
 
 // SetupLinodeMachineTemplateWebhookWithManager registers the webhook for LinodeMachineTemplate in the manager.
 func SetupLinodeMachineTemplateWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &infrav1alpha2.LinodeMachineTemplate{}).
+		WithValidator(&LinodeMachineTemplateCustomValidator{}).
 		Complete()
 }
 
-// TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-linodemachinetemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=linodemachinetemplates,verbs=create;update,versions=v1alpha2,name=validation.linodemachinetemplate.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
+
+// LinodeMachineTemplateCustomValidator struct is responsible for validating the LinodeMachineTemplate resource
+type LinodeMachineTemplateCustomValidator struct{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type LinodeMachineTemplate.
+func (v *LinodeMachineTemplateCustomValidator) ValidateCreate(_ context.Context, template *infrav1alpha2.LinodeMachineTemplate) (admission.Warnings, error) {
+	linodemachinetemplatelog.Info("Validation for LinodeMachineTemplate upon creation", "name", template.GetName())
+
+	return nil, validateLinodeMachineTemplate(template)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type LinodeMachineTemplate.
+func (v *LinodeMachineTemplateCustomValidator) ValidateUpdate(_ context.Context, _, template *infrav1alpha2.LinodeMachineTemplate) (admission.Warnings, error) {
+	linodemachinetemplatelog.Info("Validation for LinodeMachineTemplate upon update", "name", template.GetName())
+
+	return nil, validateLinodeMachineTemplate(template)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type LinodeMachineTemplate.
+func (v *LinodeMachineTemplateCustomValidator) ValidateDelete(_ context.Context, template *infrav1alpha2.LinodeMachineTemplate) (admission.Warnings, error) {
+	linodemachinetemplatelog.Info("Validation for LinodeMachineTemplate upon deletion", "name", template.GetName())
+
+	return nil, nil
+}
+
+func validateLinodeMachineTemplate(template *infrav1alpha2.LinodeMachineTemplate) error {
+	var errs field.ErrorList
+	// Every LinodeMachine created from the template would try to adopt the same instance.
+	if template.Spec.Template.Spec.AdoptInstance != nil {
+		errs = append(errs, field.Forbidden(field.NewPath("spec", "template", "spec", "adoptInstance"),
+			"instances can only be adopted by a LinodeMachine, not by a LinodeMachineTemplate"))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeMachineTemplate"},
+		template.Name, errs)
+}
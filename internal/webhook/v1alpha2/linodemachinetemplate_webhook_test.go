@@ -17,6 +17,12 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
 	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -52,3 +58,19 @@ var _ = Describe("LinodeMachineTemplate Webhook", func() {
 	})
 
 })
+
+func TestValidateLinodeMachineTemplate(t *testing.T) {
+	t.Parallel()
+
+	validator := LinodeMachineTemplateCustomValidator{}
+	template := &infrav1alpha2.LinodeMachineTemplate{}
+
+	_, err := validator.ValidateCreate(t.Context(), template)
+	require.NoError(t, err)
+
+	template.Spec.Template.Spec.AdoptInstance = &infrav1alpha2.InstanceAdoptionSelector{ID: ptr.To(123)}
+	_, err = validator.ValidateCreate(t.Context(), template)
+	assert.ErrorContains(t, err, "spec.template.spec.adoptInstance: Forbidden")
+	_, err = validator.ValidateUpdate(t.Context(), &infrav1alpha2.LinodeMachineTemplate{}, template)
+	assert.ErrorContains(t, err, "spec.template.spec.adoptInstance: Forbidden")
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/linode/linodego/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/clients"
+)
+
+var (
+	// The minimum and maximum Block Storage Volume sizes, see the [Linode API docs].
+	//
+	// [Linode API docs]: https://techdocs.akamai.com/linode-api/reference/post-volume
+	minVolumeSize = resource.MustParse("10Gi")
+	maxVolumeSize = resource.MustParse("16Ti")
+)
+
+// log is for logging in this package.
+var linodevolumelog = logf.Log.WithName("linodevolume-resource")
+
+// SetupLinodeVolumeWebhookWithManager registers the webhook for LinodeVolume in the manager.
+func SetupLinodeVolumeWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &infrav1alpha2.LinodeVolume{}).
+		WithValidator(&LinodeVolumeCustomValidator{
+			Client: mgr.GetClient(),
+		}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-linodevolume,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=linodevolumes,verbs=create;update,versions=v1alpha2,name=validation.linodevolume.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1
+
+// LinodeVolumeCustomValidator struct is responsible for validating the LinodeVolume resource
+type LinodeVolumeCustomValidator struct {
+	Client client.Client
+}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type LinodeVolume.
+func (v *LinodeVolumeCustomValidator) ValidateCreate(ctx context.Context, volume *infrav1alpha2.LinodeVolume) (admission.Warnings, error) {
+	linodevolumelog.Info("Validation for LinodeVolume upon creation", "name", volume.GetName())
+
+	skipAPIValidation, linodeClient, err := setupClientWithCredentials(ctx, v.Client, volume.Spec.CredentialsRef,
+		volume.Name, volume.GetNamespace(), linodevolumelog)
+	if err != nil {
+		return admission.Warnings{}, err
+	}
+
+	var errs field.ErrorList
+	if err := validateLabelLength(volume.GetName(), field.NewPath("metadata").Child("name")); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateLinodeVolumeSpec(ctx, linodeClient, volume, skipAPIValidation); err != nil {
+		errs = slices.Concat(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeVolume"},
+		volume.Name, errs)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type LinodeVolume.
+func (v *LinodeVolumeCustomValidator) ValidateUpdate(_ context.Context, oldVolume, newVolume *infrav1alpha2.LinodeVolume) (admission.Warnings, error) {
+	linodevolumelog.Info("Validation for LinodeVolume upon update", "name", newVolume.GetName())
+
+	var errs field.ErrorList
+	if newVolume.Spec.Size.Cmp(oldVolume.Spec.Size) < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("spec").Child("size"), newVolume.Spec.Size.String(), "volumes cannot be shrunk"))
+	}
+	if err := validateVolumeSize(newVolume.Spec.Size, field.NewPath("spec").Child("size")); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "LinodeVolume"},
+		newVolume.Name, errs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type LinodeVolume.
+func (v *LinodeVolumeCustomValidator) ValidateDelete(_ context.Context, volume *infrav1alpha2.LinodeVolume) (admission.Warnings, error) {
+	linodevolumelog.Info("Validation for LinodeVolume upon deletion", "name", volume.GetName())
+
+	return nil, nil
+}
+
+func (v *LinodeVolumeCustomValidator) validateLinodeVolumeSpec(ctx context.Context, linodeclient clients.LinodeClient, volume *infrav1alpha2.LinodeVolume, skipAPIValidation bool) field.ErrorList {
+	var errs field.ErrorList
+
+	if !skipAPIValidation {
+		capabilities := []linodego.RegionCapability{linodego.CapabilityBlockStorage}
+		if volume.Spec.Encryption == "enabled" {
+			capabilities = append(capabilities, linodego.CapabilityBlockStorageEncryption)
+		}
+		if err := validateRegion(ctx, linodeclient, volume.Spec.Region, field.NewPath("spec").Child("region"), capabilities...); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := validateVolumeSize(volume.Spec.Size, field.NewPath("spec").Child("size")); err != nil {
+		errs = append(errs, err)
+	}
+
+	label, labelPath := volume.Spec.Label, field.NewPath("spec").Child("label")
+	if label == "" {
+		label, labelPath = volume.GetName(), field.NewPath("metadata").Child("name")
+	}
+	if err := validateVolumeLabel(label, labelPath); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validateVolumeSize validates a size is within the bounds of a [Linode Block Storage Volume].
+//
+// [Linode Block Storage Volume]: https://techdocs.akamai.com/linode-api/reference/post-volume
+func validateVolumeSize(size resource.Quantity, path *field.Path) *field.Error {
+	if size.Cmp(minVolumeSize) < 0 || size.Cmp(maxVolumeSize) > 0 {
+		return field.Invalid(path, size.String(), fmt.Sprintf("must be between %s and %s", minVolumeSize.String(), maxVolumeSize.String()))
+	}
+	return nil
+}
+
+// validateVolumeLabel validates a label string is a valid [Linode Block Storage Volume Label].
+//
+// [Linode Block Storage Volume Label]: https://techdocs.akamai.com/linode-api/reference/post-volume
+func validateVolumeLabel(label string, path *field.Path) *field.Error {
+	var (
+		minLen = 1
+		maxLen = 32
+		errs   = []error{
+			fmt.Errorf("%d..%d characters", minLen, maxLen),
+			errors.New("can only contain ASCII letters, numbers, hyphens (-) and underscores (_), must start with a letter"),
+		}
+		regex = regexp.MustCompile(`^[[:alpha:]][-[:alnum:]_]*$`)
+	)
+	if len(label) < minLen || len(label) > maxLen {
+		return field.Invalid(path, label, errs[0].Error()) // #nosec G602: false positive
+	}
+	if !regex.MatchString(label) {
+		return field.Invalid(path, label, errs[1].Error()) // #nosec G602: false positive
+	}
+	return nil
+}
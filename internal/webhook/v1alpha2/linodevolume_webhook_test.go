@@ -0,0 +1,204 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha2 "github.com/linode/cluster-api-provider-linode/api/v1alpha2"
+	"github.com/linode/cluster-api-provider-linode/mock"
+
+	. "github.com/linode/cluster-api-provider-linode/mock/mocktest"
+)
+
+func TestValidateLinodeVolume(t *testing.T) {
+	t.Parallel()
+
+	var (
+		volume = infrav1alpha2.LinodeVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example",
+				Namespace: "example",
+			},
+			Spec: infrav1alpha2.LinodeVolumeSpec{
+				Region: "example",
+				Size:   resource.MustParse("20Gi"),
+			},
+		}
+		region                           = linodego.Region{ID: "test"}
+		capabilities                     = []string{string(linodego.CapabilityBlockStorage)}
+		capabilitiesEncryption           = []string{string(linodego.CapabilityBlockStorage), string(linodego.CapabilityBlockStorageEncryption)}
+		invalidRegionError               = "spec.region: Not found: \"example\""
+		invalidRegionNoVolumeCapability  = "spec.region: Invalid value: \"example\": no capability: Block Storage"
+		invalidRegionNoEncryptCapability = "spec.region: Invalid value: \"example\": no capability: Block Storage Encryption"
+		invalidVolumeSizeError           = "spec.size: Invalid value: \"5Gi\": must be between 10Gi and 16Ti"
+		invalidVolumeLabelError          = "spec.label: Invalid value: \"1volume\": can only contain ASCII letters, numbers, hyphens (-) and underscores (_), must start with a letter"
+		validator                        = LinodeVolumeCustomValidator{}
+	)
+
+	NewSuite(t, mock.MockLinodeClient{}).Run(
+		OneOf(
+			Path(
+				Call("valid", func(ctx context.Context, mck Mock) {
+					region := region
+					region.Capabilities = slices.Clone(capabilities)
+					mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+				}),
+				Result("success", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.Empty(t, errs)
+				}),
+			),
+			Path(
+				Call("valid encrypted", func(ctx context.Context, mck Mock) {
+					region := region
+					region.Capabilities = slices.Clone(capabilitiesEncryption)
+					mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+				}),
+				Result("success", func(ctx context.Context, mck Mock) {
+					volume := volume
+					volume.Spec.Encryption = "enabled"
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.Empty(t, errs)
+				}),
+			),
+		),
+		OneOf(
+			Path(Call("invalid region", func(ctx context.Context, mck Mock) {
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid region")).AnyTimes()
+			}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidRegionError)
+					}
+				})),
+			Path(Call("region not supported", func(ctx context.Context, mck Mock) {
+				region := region
+				region.Capabilities = []string{}
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+			}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidRegionNoVolumeCapability)
+					}
+				})),
+			Path(Call("region does not support encryption", func(ctx context.Context, mck Mock) {
+				region := region
+				region.Capabilities = slices.Clone(capabilities)
+				mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+			}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					volume := volume
+					volume.Spec.Encryption = "enabled"
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidRegionNoEncryptCapability)
+					}
+				})),
+		),
+		OneOf(
+			Path(
+				Call("invalid size", func(ctx context.Context, mck Mock) {
+					region := region
+					region.Capabilities = slices.Clone(capabilities)
+					mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+				}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					volume := volume
+					volume.Spec.Size = resource.MustParse("5Gi")
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidVolumeSizeError)
+					}
+				}),
+			),
+			Path(
+				Call("invalid label", func(ctx context.Context, mck Mock) {
+					region := region
+					region.Capabilities = slices.Clone(capabilities)
+					mck.LinodeClient.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&region, nil).AnyTimes()
+				}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					volume := volume
+					volume.Spec.Label = "1volume"
+					errs := validator.validateLinodeVolumeSpec(ctx, mck.LinodeClient, &volume, SkipAPIValidation)
+					require.NotEmpty(t, errs)
+					for _, err := range errs {
+						assert.ErrorContains(t, err, invalidVolumeLabelError)
+					}
+				}),
+			),
+		),
+	)
+}
+
+func TestValidateUpdateLinodeVolume(t *testing.T) {
+	t.Parallel()
+
+	var (
+		oldVolume = infrav1alpha2.LinodeVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example",
+				Namespace: "example",
+			},
+			Spec: infrav1alpha2.LinodeVolumeSpec{
+				Region: "example",
+				Size:   resource.MustParse("20Gi"),
+			},
+		}
+		validator = LinodeVolumeCustomValidator{}
+	)
+
+	NewSuite(t, mock.MockLinodeClient{}).Run(
+		OneOf(
+			Path(
+				Call("grow", func(ctx context.Context, mck Mock) {}),
+				Result("success", func(ctx context.Context, mck Mock) {
+					newVolume := oldVolume.DeepCopy()
+					newVolume.Spec.Size = resource.MustParse("40Gi")
+					_, err := validator.ValidateUpdate(ctx, &oldVolume, newVolume)
+					require.NoError(t, err)
+				}),
+			),
+			Path(
+				Call("shrink", func(ctx context.Context, mck Mock) {}),
+				Result("error", func(ctx context.Context, mck Mock) {
+					newVolume := oldVolume.DeepCopy()
+					newVolume.Spec.Size = resource.MustParse("10Gi")
+					_, err := validator.ValidateUpdate(ctx, &oldVolume, newVolume)
+					assert.ErrorContains(t, err, "volumes cannot be shrunk")
+				}),
+			),
+		),
+	)
+}
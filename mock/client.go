@@ -11,6 +11,7 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	http "net/http"
 	reflect "reflect"
 
@@ -64,6 +65,21 @@ func (mr *MockLinodeClientMockRecorder) AssignPlacementGroupLinodes(ctx, id, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignPlacementGroupLinodes", reflect.TypeOf((*MockLinodeClient)(nil).AssignPlacementGroupLinodes), ctx, id, options)
 }
 
+// AttachVolume mocks base method.
+func (m *MockLinodeClient) AttachVolume(ctx context.Context, volumeID int, opts *linodego.VolumeAttachOptions) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolume", ctx, volumeID, opts)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVolume indicates an expected call of AttachVolume.
+func (mr *MockLinodeClientMockRecorder) AttachVolume(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockLinodeClient)(nil).AttachVolume), ctx, volumeID, opts)
+}
+
 // BootInstance mocks base method.
 func (m *MockLinodeClient) BootInstance(ctx context.Context, linodeID int, copts linodego.InstanceBootOptions) error {
 	m.ctrl.T.Helper()
@@ -108,6 +124,37 @@ func (mr *MockLinodeClientMockRecorder) CreateFirewall(ctx, opts any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewall", reflect.TypeOf((*MockLinodeClient)(nil).CreateFirewall), ctx, opts)
 }
 
+// CreateFirewallDevice mocks base method.
+func (m *MockLinodeClient) CreateFirewallDevice(ctx context.Context, firewallID int, opts linodego.FirewallDeviceCreateOptions) (*linodego.FirewallDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallDevice", ctx, firewallID, opts)
+	ret0, _ := ret[0].(*linodego.FirewallDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallDevice indicates an expected call of CreateFirewallDevice.
+func (mr *MockLinodeClientMockRecorder) CreateFirewallDevice(ctx, firewallID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallDevice", reflect.TypeOf((*MockLinodeClient)(nil).CreateFirewallDevice), ctx, firewallID, opts)
+}
+
+// CreateImageUpload mocks base method.
+func (m *MockLinodeClient) CreateImageUpload(ctx context.Context, opts linodego.ImageCreateUploadOptions) (*linodego.Image, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImageUpload", ctx, opts)
+	ret0, _ := ret[0].(*linodego.Image)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateImageUpload indicates an expected call of CreateImageUpload.
+func (mr *MockLinodeClientMockRecorder) CreateImageUpload(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageUpload", reflect.TypeOf((*MockLinodeClient)(nil).CreateImageUpload), ctx, opts)
+}
+
 // CreateInstance mocks base method.
 func (m *MockLinodeClient) CreateInstance(ctx context.Context, opts linodego.InstanceCreateOptions) (*linodego.Instance, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +185,36 @@ func (mr *MockLinodeClientMockRecorder) CreateInstanceDisk(ctx, linodeID, opts a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceDisk", reflect.TypeOf((*MockLinodeClient)(nil).CreateInstanceDisk), ctx, linodeID, opts)
 }
 
+// CreateInstanceSnapshot mocks base method.
+func (m *MockLinodeClient) CreateInstanceSnapshot(ctx context.Context, linodeID int, opts linodego.InstanceSnapshotCreateOptions) (*linodego.InstanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceSnapshot", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.InstanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInstanceSnapshot indicates an expected call of CreateInstanceSnapshot.
+func (mr *MockLinodeClientMockRecorder) CreateInstanceSnapshot(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceSnapshot", reflect.TypeOf((*MockLinodeClient)(nil).CreateInstanceSnapshot), ctx, linodeID, opts)
+}
+
+// CreateInterface mocks base method.
+func (m *MockLinodeClient) CreateInterface(ctx context.Context, linodeID int, opts linodego.LinodeInterfaceCreateOptions) (*linodego.LinodeInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterface", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.LinodeInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterface indicates an expected call of CreateInterface.
+func (mr *MockLinodeClientMockRecorder) CreateInterface(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterface", reflect.TypeOf((*MockLinodeClient)(nil).CreateInterface), ctx, linodeID, opts)
+}
+
 // CreateNodeBalancer mocks base method.
 func (m *MockLinodeClient) CreateNodeBalancer(ctx context.Context, opts linodego.NodeBalancerCreateOptions) (*linodego.NodeBalancer, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +335,21 @@ func (mr *MockLinodeClientMockRecorder) CreateVPCSubnet(ctx, opts, vpcID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCSubnet", reflect.TypeOf((*MockLinodeClient)(nil).CreateVPCSubnet), ctx, opts, vpcID)
 }
 
+// CreateVolume mocks base method.
+func (m *MockLinodeClient) CreateVolume(ctx context.Context, opts linodego.VolumeCreateOptions) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", ctx, opts)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockLinodeClientMockRecorder) CreateVolume(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockLinodeClient)(nil).CreateVolume), ctx, opts)
+}
+
 // DeleteDomainRecord mocks base method.
 func (m *MockLinodeClient) DeleteDomainRecord(ctx context.Context, domainID, domainRecordID int) error {
 	m.ctrl.T.Helper()
@@ -300,6 +392,20 @@ func (mr *MockLinodeClientMockRecorder) DeleteFirewallDevice(ctx, firewallID, de
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallDevice", reflect.TypeOf((*MockLinodeClient)(nil).DeleteFirewallDevice), ctx, firewallID, deviceID)
 }
 
+// DeleteImage mocks base method.
+func (m *MockLinodeClient) DeleteImage(ctx context.Context, imageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockLinodeClientMockRecorder) DeleteImage(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockLinodeClient)(nil).DeleteImage), ctx, imageID)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockLinodeClient) DeleteInstance(ctx context.Context, linodeID int) error {
 	m.ctrl.T.Helper()
@@ -314,6 +420,20 @@ func (mr *MockLinodeClientMockRecorder) DeleteInstance(ctx, linodeID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockLinodeClient)(nil).DeleteInstance), ctx, linodeID)
 }
 
+// DeleteInterface mocks base method.
+func (m *MockLinodeClient) DeleteInterface(ctx context.Context, linodeID, interfaceID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInterface", ctx, linodeID, interfaceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInterface indicates an expected call of DeleteInterface.
+func (mr *MockLinodeClientMockRecorder) DeleteInterface(ctx, linodeID, interfaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInterface", reflect.TypeOf((*MockLinodeClient)(nil).DeleteInterface), ctx, linodeID, interfaceID)
+}
+
 // DeleteNodeBalancer mocks base method.
 func (m *MockLinodeClient) DeleteNodeBalancer(ctx context.Context, nodebalancerID int) error {
 	m.ctrl.T.Helper()
@@ -384,6 +504,20 @@ func (mr *MockLinodeClientMockRecorder) DeletePlacementGroup(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockLinodeClient)(nil).DeletePlacementGroup), ctx, id)
 }
 
+// DeleteReservedIPAddress mocks base method.
+func (m *MockLinodeClient) DeleteReservedIPAddress(ctx context.Context, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReservedIPAddress", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReservedIPAddress indicates an expected call of DeleteReservedIPAddress.
+func (mr *MockLinodeClientMockRecorder) DeleteReservedIPAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReservedIPAddress", reflect.TypeOf((*MockLinodeClient)(nil).DeleteReservedIPAddress), ctx, address)
+}
+
 // DeleteVPC mocks base method.
 func (m *MockLinodeClient) DeleteVPC(ctx context.Context, vpcID int) error {
 	m.ctrl.T.Helper()
@@ -412,6 +546,64 @@ func (mr *MockLinodeClientMockRecorder) DeleteVPCSubnet(ctx, vpcID, subnetID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCSubnet", reflect.TypeOf((*MockLinodeClient)(nil).DeleteVPCSubnet), ctx, vpcID, subnetID)
 }
 
+// DeleteVolume mocks base method.
+func (m *MockLinodeClient) DeleteVolume(ctx context.Context, volumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", ctx, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockLinodeClientMockRecorder) DeleteVolume(ctx, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockLinodeClient)(nil).DeleteVolume), ctx, volumeID)
+}
+
+// DetachVolume mocks base method.
+func (m *MockLinodeClient) DetachVolume(ctx context.Context, volumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolume", ctx, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachVolume indicates an expected call of DetachVolume.
+func (mr *MockLinodeClientMockRecorder) DetachVolume(ctx, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockLinodeClient)(nil).DetachVolume), ctx, volumeID)
+}
+
+// GetAccountAvailability mocks base method.
+func (m *MockLinodeClient) GetAccountAvailability(ctx context.Context, regionID string) (*linodego.AccountAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAvailability", ctx, regionID)
+	ret0, _ := ret[0].(*linodego.AccountAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAvailability indicates an expected call of GetAccountAvailability.
+func (mr *MockLinodeClientMockRecorder) GetAccountAvailability(ctx, regionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAvailability", reflect.TypeOf((*MockLinodeClient)(nil).GetAccountAvailability), ctx, regionID)
+}
+
+// GetEvent mocks base method.
+func (m *MockLinodeClient) GetEvent(ctx context.Context, eventID int) (*linodego.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID)
+	ret0, _ := ret[0].(*linodego.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockLinodeClientMockRecorder) GetEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockLinodeClient)(nil).GetEvent), ctx, eventID)
+}
+
 // GetFirewall mocks base method.
 func (m *MockLinodeClient) GetFirewall(ctx context.Context, firewallID int) (*linodego.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -457,6 +649,21 @@ func (mr *MockLinodeClientMockRecorder) GetFirewallRules(ctx, firewallID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRules", reflect.TypeOf((*MockLinodeClient)(nil).GetFirewallRules), ctx, firewallID)
 }
 
+// GetIPAddress mocks base method.
+func (m *MockLinodeClient) GetIPAddress(ctx context.Context, address string) (*linodego.InstanceIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPAddress", ctx, address)
+	ret0, _ := ret[0].(*linodego.InstanceIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPAddress indicates an expected call of GetIPAddress.
+func (mr *MockLinodeClientMockRecorder) GetIPAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPAddress", reflect.TypeOf((*MockLinodeClient)(nil).GetIPAddress), ctx, address)
+}
+
 // GetImage mocks base method.
 func (m *MockLinodeClient) GetImage(ctx context.Context, imageID string) (*linodego.Image, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +694,21 @@ func (mr *MockLinodeClientMockRecorder) GetInstance(ctx, linodeID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockLinodeClient)(nil).GetInstance), ctx, linodeID)
 }
 
+// GetInstanceBackups mocks base method.
+func (m *MockLinodeClient) GetInstanceBackups(ctx context.Context, linodeID int) (*linodego.InstanceBackupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceBackups", ctx, linodeID)
+	ret0, _ := ret[0].(*linodego.InstanceBackupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceBackups indicates an expected call of GetInstanceBackups.
+func (mr *MockLinodeClientMockRecorder) GetInstanceBackups(ctx, linodeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceBackups", reflect.TypeOf((*MockLinodeClient)(nil).GetInstanceBackups), ctx, linodeID)
+}
+
 // GetInstanceIPAddresses mocks base method.
 func (m *MockLinodeClient) GetInstanceIPAddresses(ctx context.Context, linodeID int) (*linodego.InstanceIPAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -607,6 +829,21 @@ func (mr *MockLinodeClientMockRecorder) GetRegion(ctx, regionID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegion", reflect.TypeOf((*MockLinodeClient)(nil).GetRegion), ctx, regionID)
 }
 
+// GetRegionAvailability mocks base method.
+func (m *MockLinodeClient) GetRegionAvailability(ctx context.Context, regionID string) ([]linodego.RegionAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionAvailability", ctx, regionID)
+	ret0, _ := ret[0].([]linodego.RegionAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionAvailability indicates an expected call of GetRegionAvailability.
+func (mr *MockLinodeClientMockRecorder) GetRegionAvailability(ctx, regionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionAvailability", reflect.TypeOf((*MockLinodeClient)(nil).GetRegionAvailability), ctx, regionID)
+}
+
 // GetType mocks base method.
 func (m *MockLinodeClient) GetType(ctx context.Context, typeID string) (*linodego.LinodeType, error) {
 	m.ctrl.T.Helper()
@@ -637,6 +874,35 @@ func (mr *MockLinodeClientMockRecorder) GetVPC(ctx, vpcID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPC", reflect.TypeOf((*MockLinodeClient)(nil).GetVPC), ctx, vpcID)
 }
 
+// GetVolume mocks base method.
+func (m *MockLinodeClient) GetVolume(ctx context.Context, volumeID int) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", ctx, volumeID)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockLinodeClientMockRecorder) GetVolume(ctx, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockLinodeClient)(nil).GetVolume), ctx, volumeID)
+}
+
+// InstancesAssignIPs mocks base method.
+func (m *MockLinodeClient) InstancesAssignIPs(ctx context.Context, opts linodego.LinodesAssignIPsOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstancesAssignIPs", ctx, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstancesAssignIPs indicates an expected call of InstancesAssignIPs.
+func (mr *MockLinodeClientMockRecorder) InstancesAssignIPs(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstancesAssignIPs", reflect.TypeOf((*MockLinodeClient)(nil).InstancesAssignIPs), ctx, opts)
+}
+
 // ListDomainRecords mocks base method.
 func (m *MockLinodeClient) ListDomainRecords(ctx context.Context, domainID int, opts *linodego.ListOptions) ([]linodego.DomainRecord, error) {
 	m.ctrl.T.Helper()
@@ -667,6 +933,36 @@ func (mr *MockLinodeClientMockRecorder) ListDomains(ctx, opts any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockLinodeClient)(nil).ListDomains), ctx, opts)
 }
 
+// ListEvents mocks base method.
+func (m *MockLinodeClient) ListEvents(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, opts)
+	ret0, _ := ret[0].([]linodego.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockLinodeClientMockRecorder) ListEvents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockLinodeClient)(nil).ListEvents), ctx, opts)
+}
+
+// ListFirewallDevices mocks base method.
+func (m *MockLinodeClient) ListFirewallDevices(ctx context.Context, firewallID int, opts *linodego.ListOptions) ([]linodego.FirewallDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallDevices", ctx, firewallID, opts)
+	ret0, _ := ret[0].([]linodego.FirewallDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallDevices indicates an expected call of ListFirewallDevices.
+func (mr *MockLinodeClientMockRecorder) ListFirewallDevices(ctx, firewallID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallDevices", reflect.TypeOf((*MockLinodeClient)(nil).ListFirewallDevices), ctx, firewallID, opts)
+}
+
 // ListFirewalls mocks base method.
 func (m *MockLinodeClient) ListFirewalls(ctx context.Context, options *linodego.ListOptions) ([]linodego.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -682,6 +978,21 @@ func (mr *MockLinodeClientMockRecorder) ListFirewalls(ctx, options any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewalls", reflect.TypeOf((*MockLinodeClient)(nil).ListFirewalls), ctx, options)
 }
 
+// ListImages mocks base method.
+func (m *MockLinodeClient) ListImages(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx, opts)
+	ret0, _ := ret[0].([]linodego.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockLinodeClientMockRecorder) ListImages(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockLinodeClient)(nil).ListImages), ctx, opts)
+}
+
 // ListInstanceConfigs mocks base method.
 func (m *MockLinodeClient) ListInstanceConfigs(ctx context.Context, linodeID int, opts *linodego.ListOptions) ([]linodego.InstanceConfig, error) {
 	m.ctrl.T.Helper()
@@ -757,6 +1068,21 @@ func (mr *MockLinodeClientMockRecorder) ListInterfaces(ctx, linodeID, opts any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterfaces", reflect.TypeOf((*MockLinodeClient)(nil).ListInterfaces), ctx, linodeID, opts)
 }
 
+// ListNodeBalancerFirewalls mocks base method.
+func (m *MockLinodeClient) ListNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *linodego.ListOptions) ([]linodego.Firewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodeBalancerFirewalls", ctx, nodebalancerID, opts)
+	ret0, _ := ret[0].([]linodego.Firewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodeBalancerFirewalls indicates an expected call of ListNodeBalancerFirewalls.
+func (mr *MockLinodeClientMockRecorder) ListNodeBalancerFirewalls(ctx, nodebalancerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancerFirewalls", reflect.TypeOf((*MockLinodeClient)(nil).ListNodeBalancerFirewalls), ctx, nodebalancerID, opts)
+}
+
 // ListNodeBalancerNodes mocks base method.
 func (m *MockLinodeClient) ListNodeBalancerNodes(ctx context.Context, nodebalancerID, configID int, opts *linodego.ListOptions) ([]linodego.NodeBalancerNode, error) {
 	m.ctrl.T.Helper()
@@ -772,6 +1098,21 @@ func (mr *MockLinodeClientMockRecorder) ListNodeBalancerNodes(ctx, nodebalancerI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancerNodes", reflect.TypeOf((*MockLinodeClient)(nil).ListNodeBalancerNodes), ctx, nodebalancerID, configID, opts)
 }
 
+// ListNodeBalancerTypes mocks base method.
+func (m *MockLinodeClient) ListNodeBalancerTypes(ctx context.Context, opts *linodego.ListOptions) ([]linodego.NodeBalancerType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodeBalancerTypes", ctx, opts)
+	ret0, _ := ret[0].([]linodego.NodeBalancerType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodeBalancerTypes indicates an expected call of ListNodeBalancerTypes.
+func (mr *MockLinodeClientMockRecorder) ListNodeBalancerTypes(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancerTypes", reflect.TypeOf((*MockLinodeClient)(nil).ListNodeBalancerTypes), ctx, opts)
+}
+
 // ListNodeBalancers mocks base method.
 func (m *MockLinodeClient) ListNodeBalancers(ctx context.Context, opts *linodego.ListOptions) ([]linodego.NodeBalancer, error) {
 	m.ctrl.T.Helper()
@@ -802,6 +1143,21 @@ func (mr *MockLinodeClientMockRecorder) ListPlacementGroups(ctx, options any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementGroups", reflect.TypeOf((*MockLinodeClient)(nil).ListPlacementGroups), ctx, options)
 }
 
+// ListReservedIPAddresses mocks base method.
+func (m *MockLinodeClient) ListReservedIPAddresses(ctx context.Context, opts *linodego.ListOptions) ([]linodego.InstanceIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReservedIPAddresses", ctx, opts)
+	ret0, _ := ret[0].([]linodego.InstanceIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReservedIPAddresses indicates an expected call of ListReservedIPAddresses.
+func (mr *MockLinodeClientMockRecorder) ListReservedIPAddresses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReservedIPAddresses", reflect.TypeOf((*MockLinodeClient)(nil).ListReservedIPAddresses), ctx, opts)
+}
+
 // ListVPCs mocks base method.
 func (m *MockLinodeClient) ListVPCs(ctx context.Context, opts *linodego.ListOptions) ([]linodego.VPC, error) {
 	m.ctrl.T.Helper()
@@ -817,6 +1173,21 @@ func (mr *MockLinodeClientMockRecorder) ListVPCs(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCs", reflect.TypeOf((*MockLinodeClient)(nil).ListVPCs), ctx, opts)
 }
 
+// ListVolumes mocks base method.
+func (m *MockLinodeClient) ListVolumes(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", ctx, opts)
+	ret0, _ := ret[0].([]linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockLinodeClientMockRecorder) ListVolumes(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockLinodeClient)(nil).ListVolumes), ctx, opts)
+}
+
 // OnAfterResponse mocks base method.
 func (m_2 *MockLinodeClient) OnAfterResponse(m func(*http.Response) error) {
 	m_2.ctrl.T.Helper()
@@ -829,88 +1200,218 @@ func (mr *MockLinodeClientMockRecorder) OnAfterResponse(m any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnAfterResponse", reflect.TypeOf((*MockLinodeClient)(nil).OnAfterResponse), m)
 }
 
-// SetToken mocks base method.
-func (m *MockLinodeClient) SetToken(token string) *linodego.Client {
+// RebuildInstance mocks base method.
+func (m *MockLinodeClient) RebuildInstance(ctx context.Context, linodeID int, opts linodego.InstanceRebuildOptions) (*linodego.Instance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetToken", token)
-	ret0, _ := ret[0].(*linodego.Client)
-	return ret0
+	ret := m.ctrl.Call(m, "RebuildInstance", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetToken indicates an expected call of SetToken.
-func (mr *MockLinodeClientMockRecorder) SetToken(token any) *gomock.Call {
+// RebuildInstance indicates an expected call of RebuildInstance.
+func (mr *MockLinodeClientMockRecorder) RebuildInstance(ctx, linodeID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetToken", reflect.TypeOf((*MockLinodeClient)(nil).SetToken), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildInstance", reflect.TypeOf((*MockLinodeClient)(nil).RebuildInstance), ctx, linodeID, opts)
 }
 
-// UnassignPlacementGroupLinodes mocks base method.
-func (m *MockLinodeClient) UnassignPlacementGroupLinodes(ctx context.Context, id int, options linodego.PlacementGroupUnAssignOptions) (*linodego.PlacementGroup, error) {
+// ReplicateImage mocks base method.
+func (m *MockLinodeClient) ReplicateImage(ctx context.Context, imageID string, opts linodego.ImageReplicateOptions) (*linodego.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnassignPlacementGroupLinodes", ctx, id, options)
-	ret0, _ := ret[0].(*linodego.PlacementGroup)
+	ret := m.ctrl.Call(m, "ReplicateImage", ctx, imageID, opts)
+	ret0, _ := ret[0].(*linodego.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UnassignPlacementGroupLinodes indicates an expected call of UnassignPlacementGroupLinodes.
-func (mr *MockLinodeClientMockRecorder) UnassignPlacementGroupLinodes(ctx, id, options any) *gomock.Call {
+// ReplicateImage indicates an expected call of ReplicateImage.
+func (mr *MockLinodeClientMockRecorder) ReplicateImage(ctx, imageID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignPlacementGroupLinodes", reflect.TypeOf((*MockLinodeClient)(nil).UnassignPlacementGroupLinodes), ctx, id, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateImage", reflect.TypeOf((*MockLinodeClient)(nil).ReplicateImage), ctx, imageID, opts)
 }
 
-// UpdateDomainRecord mocks base method.
-func (m *MockLinodeClient) UpdateDomainRecord(ctx context.Context, domainID, domainRecordID int, recordReq linodego.DomainRecordUpdateOptions) (*linodego.DomainRecord, error) {
+// ReserveIPAddress mocks base method.
+func (m *MockLinodeClient) ReserveIPAddress(ctx context.Context, opts linodego.ReserveIPOptions) (*linodego.InstanceIP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDomainRecord", ctx, domainID, domainRecordID, recordReq)
-	ret0, _ := ret[0].(*linodego.DomainRecord)
+	ret := m.ctrl.Call(m, "ReserveIPAddress", ctx, opts)
+	ret0, _ := ret[0].(*linodego.InstanceIP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateDomainRecord indicates an expected call of UpdateDomainRecord.
-func (mr *MockLinodeClientMockRecorder) UpdateDomainRecord(ctx, domainID, domainRecordID, recordReq any) *gomock.Call {
+// ReserveIPAddress indicates an expected call of ReserveIPAddress.
+func (mr *MockLinodeClientMockRecorder) ReserveIPAddress(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainRecord", reflect.TypeOf((*MockLinodeClient)(nil).UpdateDomainRecord), ctx, domainID, domainRecordID, recordReq)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIPAddress", reflect.TypeOf((*MockLinodeClient)(nil).ReserveIPAddress), ctx, opts)
 }
 
-// UpdateFirewall mocks base method.
-func (m *MockLinodeClient) UpdateFirewall(ctx context.Context, firewallID int, opts linodego.FirewallUpdateOptions) (*linodego.Firewall, error) {
+// ResizeInstance mocks base method.
+func (m *MockLinodeClient) ResizeInstance(ctx context.Context, linodeID int, opts linodego.InstanceResizeOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFirewall", ctx, firewallID, opts)
-	ret0, _ := ret[0].(*linodego.Firewall)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ResizeInstance", ctx, linodeID, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// UpdateFirewall indicates an expected call of UpdateFirewall.
-func (mr *MockLinodeClientMockRecorder) UpdateFirewall(ctx, firewallID, opts any) *gomock.Call {
+// ResizeInstance indicates an expected call of ResizeInstance.
+func (mr *MockLinodeClientMockRecorder) ResizeInstance(ctx, linodeID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewall", reflect.TypeOf((*MockLinodeClient)(nil).UpdateFirewall), ctx, firewallID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeInstance", reflect.TypeOf((*MockLinodeClient)(nil).ResizeInstance), ctx, linodeID, opts)
 }
 
-// UpdateFirewallRules mocks base method.
-func (m *MockLinodeClient) UpdateFirewallRules(ctx context.Context, firewallID int, rules linodego.FirewallRulesUpdateOptions) (*linodego.FirewallRules, error) {
+// ResizeInstanceDisk mocks base method.
+func (m *MockLinodeClient) ResizeInstanceDisk(ctx context.Context, linodeID, diskID int, opts linodego.InstanceDiskResizeOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFirewallRules", ctx, firewallID, rules)
-	ret0, _ := ret[0].(*linodego.FirewallRules)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ResizeInstanceDisk", ctx, linodeID, diskID, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// UpdateFirewallRules indicates an expected call of UpdateFirewallRules.
-func (mr *MockLinodeClientMockRecorder) UpdateFirewallRules(ctx, firewallID, rules any) *gomock.Call {
+// ResizeInstanceDisk indicates an expected call of ResizeInstanceDisk.
+func (mr *MockLinodeClientMockRecorder) ResizeInstanceDisk(ctx, linodeID, diskID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRules", reflect.TypeOf((*MockLinodeClient)(nil).UpdateFirewallRules), ctx, firewallID, rules)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeInstanceDisk", reflect.TypeOf((*MockLinodeClient)(nil).ResizeInstanceDisk), ctx, linodeID, diskID, opts)
 }
 
-// UpdateInstance mocks base method.
-func (m *MockLinodeClient) UpdateInstance(ctx context.Context, linodeId int, opts linodego.InstanceUpdateOptions) (*linodego.Instance, error) {
+// ResizeVolume mocks base method.
+func (m *MockLinodeClient) ResizeVolume(ctx context.Context, volumeID int, opts linodego.VolumeResizeOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateInstance", ctx, linodeId, opts)
-	ret0, _ := ret[0].(*linodego.Instance)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
+	ret := m.ctrl.Call(m, "ResizeVolume", ctx, volumeID, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeVolume indicates an expected call of ResizeVolume.
+func (mr *MockLinodeClientMockRecorder) ResizeVolume(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockLinodeClient)(nil).ResizeVolume), ctx, volumeID, opts)
+}
+
+// SetToken mocks base method.
+func (m *MockLinodeClient) SetToken(token string) *linodego.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetToken", token)
+	ret0, _ := ret[0].(*linodego.Client)
+	return ret0
+}
+
+// SetToken indicates an expected call of SetToken.
+func (mr *MockLinodeClientMockRecorder) SetToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetToken", reflect.TypeOf((*MockLinodeClient)(nil).SetToken), token)
+}
+
+// ShareIPAddresses mocks base method.
+func (m *MockLinodeClient) ShareIPAddresses(ctx context.Context, opts linodego.IPAddressesShareOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareIPAddresses", ctx, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareIPAddresses indicates an expected call of ShareIPAddresses.
+func (mr *MockLinodeClientMockRecorder) ShareIPAddresses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareIPAddresses", reflect.TypeOf((*MockLinodeClient)(nil).ShareIPAddresses), ctx, opts)
+}
+
+// ShutdownInstance mocks base method.
+func (m *MockLinodeClient) ShutdownInstance(ctx context.Context, linodeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShutdownInstance", ctx, linodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShutdownInstance indicates an expected call of ShutdownInstance.
+func (mr *MockLinodeClientMockRecorder) ShutdownInstance(ctx, linodeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownInstance", reflect.TypeOf((*MockLinodeClient)(nil).ShutdownInstance), ctx, linodeID)
+}
+
+// UnassignPlacementGroupLinodes mocks base method.
+func (m *MockLinodeClient) UnassignPlacementGroupLinodes(ctx context.Context, id int, options linodego.PlacementGroupUnAssignOptions) (*linodego.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignPlacementGroupLinodes", ctx, id, options)
+	ret0, _ := ret[0].(*linodego.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignPlacementGroupLinodes indicates an expected call of UnassignPlacementGroupLinodes.
+func (mr *MockLinodeClientMockRecorder) UnassignPlacementGroupLinodes(ctx, id, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignPlacementGroupLinodes", reflect.TypeOf((*MockLinodeClient)(nil).UnassignPlacementGroupLinodes), ctx, id, options)
+}
+
+// UpdateDomainRecord mocks base method.
+func (m *MockLinodeClient) UpdateDomainRecord(ctx context.Context, domainID, domainRecordID int, recordReq linodego.DomainRecordUpdateOptions) (*linodego.DomainRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomainRecord", ctx, domainID, domainRecordID, recordReq)
+	ret0, _ := ret[0].(*linodego.DomainRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDomainRecord indicates an expected call of UpdateDomainRecord.
+func (mr *MockLinodeClientMockRecorder) UpdateDomainRecord(ctx, domainID, domainRecordID, recordReq any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainRecord", reflect.TypeOf((*MockLinodeClient)(nil).UpdateDomainRecord), ctx, domainID, domainRecordID, recordReq)
+}
+
+// UpdateFirewall mocks base method.
+func (m *MockLinodeClient) UpdateFirewall(ctx context.Context, firewallID int, opts linodego.FirewallUpdateOptions) (*linodego.Firewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewall", ctx, firewallID, opts)
+	ret0, _ := ret[0].(*linodego.Firewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewall indicates an expected call of UpdateFirewall.
+func (mr *MockLinodeClientMockRecorder) UpdateFirewall(ctx, firewallID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewall", reflect.TypeOf((*MockLinodeClient)(nil).UpdateFirewall), ctx, firewallID, opts)
+}
+
+// UpdateFirewallRules mocks base method.
+func (m *MockLinodeClient) UpdateFirewallRules(ctx context.Context, firewallID int, rules linodego.FirewallRulesUpdateOptions) (*linodego.FirewallRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewallRules", ctx, firewallID, rules)
+	ret0, _ := ret[0].(*linodego.FirewallRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewallRules indicates an expected call of UpdateFirewallRules.
+func (mr *MockLinodeClientMockRecorder) UpdateFirewallRules(ctx, firewallID, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRules", reflect.TypeOf((*MockLinodeClient)(nil).UpdateFirewallRules), ctx, firewallID, rules)
+}
+
+// UpdateImage mocks base method.
+func (m *MockLinodeClient) UpdateImage(ctx context.Context, imageID string, opts linodego.ImageUpdateOptions) (*linodego.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, imageID, opts)
+	ret0, _ := ret[0].(*linodego.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockLinodeClientMockRecorder) UpdateImage(ctx, imageID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockLinodeClient)(nil).UpdateImage), ctx, imageID, opts)
+}
+
+// UpdateInstance mocks base method.
+func (m *MockLinodeClient) UpdateInstance(ctx context.Context, linodeId int, opts linodego.InstanceUpdateOptions) (*linodego.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstance", ctx, linodeId, opts)
+	ret0, _ := ret[0].(*linodego.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
 
 // UpdateInstance indicates an expected call of UpdateInstance.
 func (mr *MockLinodeClientMockRecorder) UpdateInstance(ctx, linodeId, opts any) *gomock.Call {
@@ -948,6 +1449,66 @@ func (mr *MockLinodeClientMockRecorder) UpdateInstanceFirewalls(ctx, linodeID, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceFirewalls", reflect.TypeOf((*MockLinodeClient)(nil).UpdateInstanceFirewalls), ctx, linodeID, opts)
 }
 
+// UpdateInterface mocks base method.
+func (m *MockLinodeClient) UpdateInterface(ctx context.Context, linodeID, interfaceID int, opts linodego.LinodeInterfaceUpdateOptions) (*linodego.LinodeInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInterface", ctx, linodeID, interfaceID, opts)
+	ret0, _ := ret[0].(*linodego.LinodeInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInterface indicates an expected call of UpdateInterface.
+func (mr *MockLinodeClientMockRecorder) UpdateInterface(ctx, linodeID, interfaceID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInterface", reflect.TypeOf((*MockLinodeClient)(nil).UpdateInterface), ctx, linodeID, interfaceID, opts)
+}
+
+// UpdateNodeBalancer mocks base method.
+func (m *MockLinodeClient) UpdateNodeBalancer(ctx context.Context, nodebalancerID int, opts linodego.NodeBalancerUpdateOptions) (*linodego.NodeBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeBalancer", ctx, nodebalancerID, opts)
+	ret0, _ := ret[0].(*linodego.NodeBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNodeBalancer indicates an expected call of UpdateNodeBalancer.
+func (mr *MockLinodeClientMockRecorder) UpdateNodeBalancer(ctx, nodebalancerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeBalancer", reflect.TypeOf((*MockLinodeClient)(nil).UpdateNodeBalancer), ctx, nodebalancerID, opts)
+}
+
+// UpdateNodeBalancerConfig mocks base method.
+func (m *MockLinodeClient) UpdateNodeBalancerConfig(ctx context.Context, nodebalancerID, configID int, opts linodego.NodeBalancerConfigUpdateOptions) (*linodego.NodeBalancerConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeBalancerConfig", ctx, nodebalancerID, configID, opts)
+	ret0, _ := ret[0].(*linodego.NodeBalancerConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNodeBalancerConfig indicates an expected call of UpdateNodeBalancerConfig.
+func (mr *MockLinodeClientMockRecorder) UpdateNodeBalancerConfig(ctx, nodebalancerID, configID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeBalancerConfig", reflect.TypeOf((*MockLinodeClient)(nil).UpdateNodeBalancerConfig), ctx, nodebalancerID, configID, opts)
+}
+
+// UpdateNodeBalancerNode mocks base method.
+func (m *MockLinodeClient) UpdateNodeBalancerNode(ctx context.Context, nodebalancerID, configID, nodeID int, opts linodego.NodeBalancerNodeUpdateOptions) (*linodego.NodeBalancerNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeBalancerNode", ctx, nodebalancerID, configID, nodeID, opts)
+	ret0, _ := ret[0].(*linodego.NodeBalancerNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNodeBalancerNode indicates an expected call of UpdateNodeBalancerNode.
+func (mr *MockLinodeClientMockRecorder) UpdateNodeBalancerNode(ctx, nodebalancerID, configID, nodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeBalancerNode", reflect.TypeOf((*MockLinodeClient)(nil).UpdateNodeBalancerNode), ctx, nodebalancerID, configID, nodeID, opts)
+}
+
 // UpdateObjectStorageBucketAccess mocks base method.
 func (m *MockLinodeClient) UpdateObjectStorageBucketAccess(ctx context.Context, clusterOrRegionID, label string, opts linodego.ObjectStorageBucketUpdateAccessOptions) error {
 	m.ctrl.T.Helper()
@@ -992,6 +1553,50 @@ func (mr *MockLinodeClientMockRecorder) UpdateVPC(ctx, vpcID, opts any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPC", reflect.TypeOf((*MockLinodeClient)(nil).UpdateVPC), ctx, vpcID, opts)
 }
 
+// UpdateVolume mocks base method.
+func (m *MockLinodeClient) UpdateVolume(ctx context.Context, volumeID int, opts linodego.VolumeUpdateOptions) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolume", ctx, volumeID, opts)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockLinodeClientMockRecorder) UpdateVolume(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockLinodeClient)(nil).UpdateVolume), ctx, volumeID, opts)
+}
+
+// UpgradeInterfaces mocks base method.
+func (m *MockLinodeClient) UpgradeInterfaces(ctx context.Context, linodeID int, opts linodego.LinodeInterfacesUpgradeOptions) (*linodego.LinodeInterfacesUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeInterfaces", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.LinodeInterfacesUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeInterfaces indicates an expected call of UpgradeInterfaces.
+func (mr *MockLinodeClientMockRecorder) UpgradeInterfaces(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeInterfaces", reflect.TypeOf((*MockLinodeClient)(nil).UpgradeInterfaces), ctx, linodeID, opts)
+}
+
+// UploadImageToURL mocks base method.
+func (m *MockLinodeClient) UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImageToURL", ctx, uploadURL, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadImageToURL indicates an expected call of UploadImageToURL.
+func (mr *MockLinodeClientMockRecorder) UploadImageToURL(ctx, uploadURL, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImageToURL", reflect.TypeOf((*MockLinodeClient)(nil).UploadImageToURL), ctx, uploadURL, image)
+}
+
 // MockAkamClient is a mock of AkamClient interface.
 type MockAkamClient struct {
 	ctrl     *gomock.Controller
@@ -1017,60 +1622,60 @@ func (m *MockAkamClient) EXPECT() *MockAkamClientMockRecorder {
 }
 
 // CreateRecord mocks base method.
-func (m *MockAkamClient) CreateRecord(arg0 context.Context, arg1 dns.CreateRecordRequest) error {
+func (m *MockAkamClient) CreateRecord(ctx context.Context, params dns.CreateRecordRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateRecord", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateRecord indicates an expected call of CreateRecord.
-func (mr *MockAkamClientMockRecorder) CreateRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamClientMockRecorder) CreateRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockAkamClient)(nil).CreateRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockAkamClient)(nil).CreateRecord), ctx, params)
 }
 
 // DeleteRecord mocks base method.
-func (m *MockAkamClient) DeleteRecord(arg0 context.Context, arg1 dns.DeleteRecordRequest) error {
+func (m *MockAkamClient) DeleteRecord(ctx context.Context, params dns.DeleteRecordRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteRecord", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRecord indicates an expected call of DeleteRecord.
-func (mr *MockAkamClientMockRecorder) DeleteRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamClientMockRecorder) DeleteRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockAkamClient)(nil).DeleteRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockAkamClient)(nil).DeleteRecord), ctx, params)
 }
 
 // GetRecord mocks base method.
-func (m *MockAkamClient) GetRecord(arg0 context.Context, arg1 dns.GetRecordRequest) (*dns.GetRecordResponse, error) {
+func (m *MockAkamClient) GetRecord(ctx context.Context, params dns.GetRecordRequest) (*dns.GetRecordResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetRecord", ctx, params)
 	ret0, _ := ret[0].(*dns.GetRecordResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecord indicates an expected call of GetRecord.
-func (mr *MockAkamClientMockRecorder) GetRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamClientMockRecorder) GetRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockAkamClient)(nil).GetRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockAkamClient)(nil).GetRecord), ctx, params)
 }
 
 // UpdateRecord mocks base method.
-func (m *MockAkamClient) UpdateRecord(arg0 context.Context, arg1 dns.UpdateRecordRequest) error {
+func (m *MockAkamClient) UpdateRecord(ctx context.Context, params dns.UpdateRecordRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateRecord", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateRecord indicates an expected call of UpdateRecord.
-func (mr *MockAkamClientMockRecorder) UpdateRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamClientMockRecorder) UpdateRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecord", reflect.TypeOf((*MockAkamClient)(nil).UpdateRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecord", reflect.TypeOf((*MockAkamClient)(nil).UpdateRecord), ctx, params)
 }
 
 // MockAkamEdgeDNSClient is a mock of AkamEdgeDNSClient interface.
@@ -1098,60 +1703,60 @@ func (m *MockAkamEdgeDNSClient) EXPECT() *MockAkamEdgeDNSClientMockRecorder {
 }
 
 // CreateRecord mocks base method.
-func (m *MockAkamEdgeDNSClient) CreateRecord(arg0 context.Context, arg1 dns.CreateRecordRequest) error {
+func (m *MockAkamEdgeDNSClient) CreateRecord(ctx context.Context, params dns.CreateRecordRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateRecord", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateRecord indicates an expected call of CreateRecord.
-func (mr *MockAkamEdgeDNSClientMockRecorder) CreateRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamEdgeDNSClientMockRecorder) CreateRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).CreateRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).CreateRecord), ctx, params)
 }
 
 // DeleteRecord mocks base method.
-func (m *MockAkamEdgeDNSClient) DeleteRecord(arg0 context.Context, arg1 dns.DeleteRecordRequest) error {
+func (m *MockAkamEdgeDNSClient) DeleteRecord(ctx context.Context, params dns.DeleteRecordRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteRecord", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRecord indicates an expected call of DeleteRecord.
-func (mr *MockAkamEdgeDNSClientMockRecorder) DeleteRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamEdgeDNSClientMockRecorder) DeleteRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).DeleteRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).DeleteRecord), ctx, params)
 }
 
 // GetRecord mocks base method.
-func (m *MockAkamEdgeDNSClient) GetRecord(arg0 context.Context, arg1 dns.GetRecordRequest) (*dns.GetRecordResponse, error) {
+func (m *MockAkamEdgeDNSClient) GetRecord(ctx context.Context, params dns.GetRecordRequest) (*dns.GetRecordResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetRecord", ctx, params)
 	ret0, _ := ret[0].(*dns.GetRecordResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecord indicates an expected call of GetRecord.
-func (mr *MockAkamEdgeDNSClientMockRecorder) GetRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamEdgeDNSClientMockRecorder) GetRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).GetRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).GetRecord), ctx, params)
 }
 
 // UpdateRecord mocks base method.
-func (m *MockAkamEdgeDNSClient) UpdateRecord(arg0 context.Context, arg1 dns.UpdateRecordRequest) error {
+func (m *MockAkamEdgeDNSClient) UpdateRecord(ctx context.Context, params dns.UpdateRecordRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateRecord", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateRecord indicates an expected call of UpdateRecord.
-func (mr *MockAkamEdgeDNSClientMockRecorder) UpdateRecord(arg0, arg1 any) *gomock.Call {
+func (mr *MockAkamEdgeDNSClientMockRecorder) UpdateRecord(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).UpdateRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecord", reflect.TypeOf((*MockAkamEdgeDNSClient)(nil).UpdateRecord), ctx, params)
 }
 
 // MockLinodeInstanceClient is a mock of LinodeInstanceClient interface.
@@ -1222,6 +1827,21 @@ func (mr *MockLinodeInstanceClientMockRecorder) CreateInstanceDisk(ctx, linodeID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceDisk", reflect.TypeOf((*MockLinodeInstanceClient)(nil).CreateInstanceDisk), ctx, linodeID, opts)
 }
 
+// CreateInstanceSnapshot mocks base method.
+func (m *MockLinodeInstanceClient) CreateInstanceSnapshot(ctx context.Context, linodeID int, opts linodego.InstanceSnapshotCreateOptions) (*linodego.InstanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceSnapshot", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.InstanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInstanceSnapshot indicates an expected call of CreateInstanceSnapshot.
+func (mr *MockLinodeInstanceClientMockRecorder) CreateInstanceSnapshot(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceSnapshot", reflect.TypeOf((*MockLinodeInstanceClient)(nil).CreateInstanceSnapshot), ctx, linodeID, opts)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockLinodeInstanceClient) DeleteInstance(ctx context.Context, linodeID int) error {
 	m.ctrl.T.Helper()
@@ -1236,6 +1856,21 @@ func (mr *MockLinodeInstanceClientMockRecorder) DeleteInstance(ctx, linodeID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockLinodeInstanceClient)(nil).DeleteInstance), ctx, linodeID)
 }
 
+// GetAccountAvailability mocks base method.
+func (m *MockLinodeInstanceClient) GetAccountAvailability(ctx context.Context, regionID string) (*linodego.AccountAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAvailability", ctx, regionID)
+	ret0, _ := ret[0].(*linodego.AccountAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAvailability indicates an expected call of GetAccountAvailability.
+func (mr *MockLinodeInstanceClientMockRecorder) GetAccountAvailability(ctx, regionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAvailability", reflect.TypeOf((*MockLinodeInstanceClient)(nil).GetAccountAvailability), ctx, regionID)
+}
+
 // GetImage mocks base method.
 func (m *MockLinodeInstanceClient) GetImage(ctx context.Context, imageID string) (*linodego.Image, error) {
 	m.ctrl.T.Helper()
@@ -1266,6 +1901,21 @@ func (mr *MockLinodeInstanceClientMockRecorder) GetInstance(ctx, linodeID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockLinodeInstanceClient)(nil).GetInstance), ctx, linodeID)
 }
 
+// GetInstanceBackups mocks base method.
+func (m *MockLinodeInstanceClient) GetInstanceBackups(ctx context.Context, linodeID int) (*linodego.InstanceBackupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceBackups", ctx, linodeID)
+	ret0, _ := ret[0].(*linodego.InstanceBackupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceBackups indicates an expected call of GetInstanceBackups.
+func (mr *MockLinodeInstanceClientMockRecorder) GetInstanceBackups(ctx, linodeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceBackups", reflect.TypeOf((*MockLinodeInstanceClient)(nil).GetInstanceBackups), ctx, linodeID)
+}
+
 // GetInstanceIPAddresses mocks base method.
 func (m *MockLinodeInstanceClient) GetInstanceIPAddresses(ctx context.Context, linodeID int) (*linodego.InstanceIPAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -1296,6 +1946,21 @@ func (mr *MockLinodeInstanceClientMockRecorder) GetRegion(ctx, regionID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegion", reflect.TypeOf((*MockLinodeInstanceClient)(nil).GetRegion), ctx, regionID)
 }
 
+// GetRegionAvailability mocks base method.
+func (m *MockLinodeInstanceClient) GetRegionAvailability(ctx context.Context, regionID string) ([]linodego.RegionAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionAvailability", ctx, regionID)
+	ret0, _ := ret[0].([]linodego.RegionAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionAvailability indicates an expected call of GetRegionAvailability.
+func (mr *MockLinodeInstanceClientMockRecorder) GetRegionAvailability(ctx, regionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionAvailability", reflect.TypeOf((*MockLinodeInstanceClient)(nil).GetRegionAvailability), ctx, regionID)
+}
+
 // GetType mocks base method.
 func (m *MockLinodeInstanceClient) GetType(ctx context.Context, typeID string) (*linodego.LinodeType, error) {
 	m.ctrl.T.Helper()
@@ -1356,6 +2021,63 @@ func (mr *MockLinodeInstanceClientMockRecorder) ListInstances(ctx, opts any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstances", reflect.TypeOf((*MockLinodeInstanceClient)(nil).ListInstances), ctx, opts)
 }
 
+// RebuildInstance mocks base method.
+func (m *MockLinodeInstanceClient) RebuildInstance(ctx context.Context, linodeID int, opts linodego.InstanceRebuildOptions) (*linodego.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildInstance", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildInstance indicates an expected call of RebuildInstance.
+func (mr *MockLinodeInstanceClientMockRecorder) RebuildInstance(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildInstance", reflect.TypeOf((*MockLinodeInstanceClient)(nil).RebuildInstance), ctx, linodeID, opts)
+}
+
+// ResizeInstance mocks base method.
+func (m *MockLinodeInstanceClient) ResizeInstance(ctx context.Context, linodeID int, opts linodego.InstanceResizeOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeInstance", ctx, linodeID, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeInstance indicates an expected call of ResizeInstance.
+func (mr *MockLinodeInstanceClientMockRecorder) ResizeInstance(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeInstance", reflect.TypeOf((*MockLinodeInstanceClient)(nil).ResizeInstance), ctx, linodeID, opts)
+}
+
+// ResizeInstanceDisk mocks base method.
+func (m *MockLinodeInstanceClient) ResizeInstanceDisk(ctx context.Context, linodeID, diskID int, opts linodego.InstanceDiskResizeOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeInstanceDisk", ctx, linodeID, diskID, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeInstanceDisk indicates an expected call of ResizeInstanceDisk.
+func (mr *MockLinodeInstanceClientMockRecorder) ResizeInstanceDisk(ctx, linodeID, diskID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeInstanceDisk", reflect.TypeOf((*MockLinodeInstanceClient)(nil).ResizeInstanceDisk), ctx, linodeID, diskID, opts)
+}
+
+// ShutdownInstance mocks base method.
+func (m *MockLinodeInstanceClient) ShutdownInstance(ctx context.Context, linodeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShutdownInstance", ctx, linodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShutdownInstance indicates an expected call of ShutdownInstance.
+func (mr *MockLinodeInstanceClientMockRecorder) ShutdownInstance(ctx, linodeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownInstance", reflect.TypeOf((*MockLinodeInstanceClient)(nil).ShutdownInstance), ctx, linodeID)
+}
+
 // UpdateInstance mocks base method.
 func (m *MockLinodeInstanceClient) UpdateInstance(ctx context.Context, linodeId int, opts linodego.InstanceUpdateOptions) (*linodego.Instance, error) {
 	m.ctrl.T.Helper()
@@ -1655,6 +2377,21 @@ func (mr *MockLinodeNodeBalancerClientMockRecorder) GetNodeBalancerConfig(ctx, n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeBalancerConfig", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).GetNodeBalancerConfig), ctx, nodebalancerID, configID)
 }
 
+// ListNodeBalancerFirewalls mocks base method.
+func (m *MockLinodeNodeBalancerClient) ListNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *linodego.ListOptions) ([]linodego.Firewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodeBalancerFirewalls", ctx, nodebalancerID, opts)
+	ret0, _ := ret[0].([]linodego.Firewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodeBalancerFirewalls indicates an expected call of ListNodeBalancerFirewalls.
+func (mr *MockLinodeNodeBalancerClientMockRecorder) ListNodeBalancerFirewalls(ctx, nodebalancerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancerFirewalls", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).ListNodeBalancerFirewalls), ctx, nodebalancerID, opts)
+}
+
 // ListNodeBalancerNodes mocks base method.
 func (m *MockLinodeNodeBalancerClient) ListNodeBalancerNodes(ctx context.Context, nodebalancerID, configID int, opts *linodego.ListOptions) ([]linodego.NodeBalancerNode, error) {
 	m.ctrl.T.Helper()
@@ -1670,6 +2407,21 @@ func (mr *MockLinodeNodeBalancerClientMockRecorder) ListNodeBalancerNodes(ctx, n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancerNodes", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).ListNodeBalancerNodes), ctx, nodebalancerID, configID, opts)
 }
 
+// ListNodeBalancerTypes mocks base method.
+func (m *MockLinodeNodeBalancerClient) ListNodeBalancerTypes(ctx context.Context, opts *linodego.ListOptions) ([]linodego.NodeBalancerType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodeBalancerTypes", ctx, opts)
+	ret0, _ := ret[0].([]linodego.NodeBalancerType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodeBalancerTypes indicates an expected call of ListNodeBalancerTypes.
+func (mr *MockLinodeNodeBalancerClientMockRecorder) ListNodeBalancerTypes(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancerTypes", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).ListNodeBalancerTypes), ctx, opts)
+}
+
 // ListNodeBalancers mocks base method.
 func (m *MockLinodeNodeBalancerClient) ListNodeBalancers(ctx context.Context, opts *linodego.ListOptions) ([]linodego.NodeBalancer, error) {
 	m.ctrl.T.Helper()
@@ -1685,7 +2437,52 @@ func (mr *MockLinodeNodeBalancerClientMockRecorder) ListNodeBalancers(ctx, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodeBalancers", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).ListNodeBalancers), ctx, opts)
 }
 
-// MockLinodeObjectStorageClient is a mock of LinodeObjectStorageClient interface.
+// UpdateNodeBalancer mocks base method.
+func (m *MockLinodeNodeBalancerClient) UpdateNodeBalancer(ctx context.Context, nodebalancerID int, opts linodego.NodeBalancerUpdateOptions) (*linodego.NodeBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeBalancer", ctx, nodebalancerID, opts)
+	ret0, _ := ret[0].(*linodego.NodeBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNodeBalancer indicates an expected call of UpdateNodeBalancer.
+func (mr *MockLinodeNodeBalancerClientMockRecorder) UpdateNodeBalancer(ctx, nodebalancerID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeBalancer", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).UpdateNodeBalancer), ctx, nodebalancerID, opts)
+}
+
+// UpdateNodeBalancerConfig mocks base method.
+func (m *MockLinodeNodeBalancerClient) UpdateNodeBalancerConfig(ctx context.Context, nodebalancerID, configID int, opts linodego.NodeBalancerConfigUpdateOptions) (*linodego.NodeBalancerConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeBalancerConfig", ctx, nodebalancerID, configID, opts)
+	ret0, _ := ret[0].(*linodego.NodeBalancerConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNodeBalancerConfig indicates an expected call of UpdateNodeBalancerConfig.
+func (mr *MockLinodeNodeBalancerClientMockRecorder) UpdateNodeBalancerConfig(ctx, nodebalancerID, configID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeBalancerConfig", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).UpdateNodeBalancerConfig), ctx, nodebalancerID, configID, opts)
+}
+
+// UpdateNodeBalancerNode mocks base method.
+func (m *MockLinodeNodeBalancerClient) UpdateNodeBalancerNode(ctx context.Context, nodebalancerID, configID, nodeID int, opts linodego.NodeBalancerNodeUpdateOptions) (*linodego.NodeBalancerNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeBalancerNode", ctx, nodebalancerID, configID, nodeID, opts)
+	ret0, _ := ret[0].(*linodego.NodeBalancerNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNodeBalancerNode indicates an expected call of UpdateNodeBalancerNode.
+func (mr *MockLinodeNodeBalancerClientMockRecorder) UpdateNodeBalancerNode(ctx, nodebalancerID, configID, nodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeBalancerNode", reflect.TypeOf((*MockLinodeNodeBalancerClient)(nil).UpdateNodeBalancerNode), ctx, nodebalancerID, configID, nodeID, opts)
+}
+
+// MockLinodeObjectStorageClient is a mock of LinodeObjectStorageClient interface.
 type MockLinodeObjectStorageClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockLinodeObjectStorageClientMockRecorder
@@ -2091,6 +2888,21 @@ func (mr *MockLinodeFirewallClientMockRecorder) CreateFirewall(ctx, opts any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewall", reflect.TypeOf((*MockLinodeFirewallClient)(nil).CreateFirewall), ctx, opts)
 }
 
+// CreateFirewallDevice mocks base method.
+func (m *MockLinodeFirewallClient) CreateFirewallDevice(ctx context.Context, firewallID int, opts linodego.FirewallDeviceCreateOptions) (*linodego.FirewallDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallDevice", ctx, firewallID, opts)
+	ret0, _ := ret[0].(*linodego.FirewallDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallDevice indicates an expected call of CreateFirewallDevice.
+func (mr *MockLinodeFirewallClientMockRecorder) CreateFirewallDevice(ctx, firewallID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallDevice", reflect.TypeOf((*MockLinodeFirewallClient)(nil).CreateFirewallDevice), ctx, firewallID, opts)
+}
+
 // DeleteFirewall mocks base method.
 func (m *MockLinodeFirewallClient) DeleteFirewall(ctx context.Context, firewallID int) error {
 	m.ctrl.T.Helper()
@@ -2164,6 +2976,21 @@ func (mr *MockLinodeFirewallClientMockRecorder) GetFirewallRules(ctx, firewallID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRules", reflect.TypeOf((*MockLinodeFirewallClient)(nil).GetFirewallRules), ctx, firewallID)
 }
 
+// ListFirewallDevices mocks base method.
+func (m *MockLinodeFirewallClient) ListFirewallDevices(ctx context.Context, firewallID int, opts *linodego.ListOptions) ([]linodego.FirewallDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallDevices", ctx, firewallID, opts)
+	ret0, _ := ret[0].([]linodego.FirewallDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallDevices indicates an expected call of ListFirewallDevices.
+func (mr *MockLinodeFirewallClientMockRecorder) ListFirewallDevices(ctx, firewallID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallDevices", reflect.TypeOf((*MockLinodeFirewallClient)(nil).ListFirewallDevices), ctx, firewallID, opts)
+}
+
 // ListFirewalls mocks base method.
 func (m *MockLinodeFirewallClient) ListFirewalls(ctx context.Context, options *linodego.ListOptions) ([]linodego.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -2233,6 +3060,35 @@ func (m *MockLinodeInterfacesClient) EXPECT() *MockLinodeInterfacesClientMockRec
 	return m.recorder
 }
 
+// CreateInterface mocks base method.
+func (m *MockLinodeInterfacesClient) CreateInterface(ctx context.Context, linodeID int, opts linodego.LinodeInterfaceCreateOptions) (*linodego.LinodeInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterface", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.LinodeInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterface indicates an expected call of CreateInterface.
+func (mr *MockLinodeInterfacesClientMockRecorder) CreateInterface(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterface", reflect.TypeOf((*MockLinodeInterfacesClient)(nil).CreateInterface), ctx, linodeID, opts)
+}
+
+// DeleteInterface mocks base method.
+func (m *MockLinodeInterfacesClient) DeleteInterface(ctx context.Context, linodeID, interfaceID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInterface", ctx, linodeID, interfaceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInterface indicates an expected call of DeleteInterface.
+func (mr *MockLinodeInterfacesClientMockRecorder) DeleteInterface(ctx, linodeID, interfaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInterface", reflect.TypeOf((*MockLinodeInterfacesClient)(nil).DeleteInterface), ctx, linodeID, interfaceID)
+}
+
 // ListInterfaceFirewalls mocks base method.
 func (m *MockLinodeInterfacesClient) ListInterfaceFirewalls(ctx context.Context, linodeID, interfaceID int, opts *linodego.ListOptions) ([]linodego.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -2263,6 +3119,455 @@ func (mr *MockLinodeInterfacesClientMockRecorder) ListInterfaces(ctx, linodeID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterfaces", reflect.TypeOf((*MockLinodeInterfacesClient)(nil).ListInterfaces), ctx, linodeID, opts)
 }
 
+// UpdateInterface mocks base method.
+func (m *MockLinodeInterfacesClient) UpdateInterface(ctx context.Context, linodeID, interfaceID int, opts linodego.LinodeInterfaceUpdateOptions) (*linodego.LinodeInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInterface", ctx, linodeID, interfaceID, opts)
+	ret0, _ := ret[0].(*linodego.LinodeInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInterface indicates an expected call of UpdateInterface.
+func (mr *MockLinodeInterfacesClientMockRecorder) UpdateInterface(ctx, linodeID, interfaceID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInterface", reflect.TypeOf((*MockLinodeInterfacesClient)(nil).UpdateInterface), ctx, linodeID, interfaceID, opts)
+}
+
+// UpgradeInterfaces mocks base method.
+func (m *MockLinodeInterfacesClient) UpgradeInterfaces(ctx context.Context, linodeID int, opts linodego.LinodeInterfacesUpgradeOptions) (*linodego.LinodeInterfacesUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeInterfaces", ctx, linodeID, opts)
+	ret0, _ := ret[0].(*linodego.LinodeInterfacesUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeInterfaces indicates an expected call of UpgradeInterfaces.
+func (mr *MockLinodeInterfacesClientMockRecorder) UpgradeInterfaces(ctx, linodeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeInterfaces", reflect.TypeOf((*MockLinodeInterfacesClient)(nil).UpgradeInterfaces), ctx, linodeID, opts)
+}
+
+// MockLinodeVolumeClient is a mock of LinodeVolumeClient interface.
+type MockLinodeVolumeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinodeVolumeClientMockRecorder
+	isgomock struct{}
+}
+
+// MockLinodeVolumeClientMockRecorder is the mock recorder for MockLinodeVolumeClient.
+type MockLinodeVolumeClientMockRecorder struct {
+	mock *MockLinodeVolumeClient
+}
+
+// NewMockLinodeVolumeClient creates a new mock instance.
+func NewMockLinodeVolumeClient(ctrl *gomock.Controller) *MockLinodeVolumeClient {
+	mock := &MockLinodeVolumeClient{ctrl: ctrl}
+	mock.recorder = &MockLinodeVolumeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinodeVolumeClient) EXPECT() *MockLinodeVolumeClientMockRecorder {
+	return m.recorder
+}
+
+// AttachVolume mocks base method.
+func (m *MockLinodeVolumeClient) AttachVolume(ctx context.Context, volumeID int, opts *linodego.VolumeAttachOptions) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolume", ctx, volumeID, opts)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVolume indicates an expected call of AttachVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) AttachVolume(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).AttachVolume), ctx, volumeID, opts)
+}
+
+// CreateVolume mocks base method.
+func (m *MockLinodeVolumeClient) CreateVolume(ctx context.Context, opts linodego.VolumeCreateOptions) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", ctx, opts)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) CreateVolume(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).CreateVolume), ctx, opts)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockLinodeVolumeClient) DeleteVolume(ctx context.Context, volumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", ctx, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) DeleteVolume(ctx, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).DeleteVolume), ctx, volumeID)
+}
+
+// DetachVolume mocks base method.
+func (m *MockLinodeVolumeClient) DetachVolume(ctx context.Context, volumeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolume", ctx, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachVolume indicates an expected call of DetachVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) DetachVolume(ctx, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).DetachVolume), ctx, volumeID)
+}
+
+// GetVolume mocks base method.
+func (m *MockLinodeVolumeClient) GetVolume(ctx context.Context, volumeID int) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", ctx, volumeID)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) GetVolume(ctx, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).GetVolume), ctx, volumeID)
+}
+
+// ListVolumes mocks base method.
+func (m *MockLinodeVolumeClient) ListVolumes(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", ctx, opts)
+	ret0, _ := ret[0].([]linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockLinodeVolumeClientMockRecorder) ListVolumes(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockLinodeVolumeClient)(nil).ListVolumes), ctx, opts)
+}
+
+// ResizeVolume mocks base method.
+func (m *MockLinodeVolumeClient) ResizeVolume(ctx context.Context, volumeID int, opts linodego.VolumeResizeOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeVolume", ctx, volumeID, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeVolume indicates an expected call of ResizeVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) ResizeVolume(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).ResizeVolume), ctx, volumeID, opts)
+}
+
+// UpdateVolume mocks base method.
+func (m *MockLinodeVolumeClient) UpdateVolume(ctx context.Context, volumeID int, opts linodego.VolumeUpdateOptions) (*linodego.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolume", ctx, volumeID, opts)
+	ret0, _ := ret[0].(*linodego.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockLinodeVolumeClientMockRecorder) UpdateVolume(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockLinodeVolumeClient)(nil).UpdateVolume), ctx, volumeID, opts)
+}
+
+// MockLinodeIPClient is a mock of LinodeIPClient interface.
+type MockLinodeIPClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinodeIPClientMockRecorder
+	isgomock struct{}
+}
+
+// MockLinodeIPClientMockRecorder is the mock recorder for MockLinodeIPClient.
+type MockLinodeIPClientMockRecorder struct {
+	mock *MockLinodeIPClient
+}
+
+// NewMockLinodeIPClient creates a new mock instance.
+func NewMockLinodeIPClient(ctrl *gomock.Controller) *MockLinodeIPClient {
+	mock := &MockLinodeIPClient{ctrl: ctrl}
+	mock.recorder = &MockLinodeIPClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinodeIPClient) EXPECT() *MockLinodeIPClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteReservedIPAddress mocks base method.
+func (m *MockLinodeIPClient) DeleteReservedIPAddress(ctx context.Context, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReservedIPAddress", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReservedIPAddress indicates an expected call of DeleteReservedIPAddress.
+func (mr *MockLinodeIPClientMockRecorder) DeleteReservedIPAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReservedIPAddress", reflect.TypeOf((*MockLinodeIPClient)(nil).DeleteReservedIPAddress), ctx, address)
+}
+
+// GetIPAddress mocks base method.
+func (m *MockLinodeIPClient) GetIPAddress(ctx context.Context, address string) (*linodego.InstanceIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPAddress", ctx, address)
+	ret0, _ := ret[0].(*linodego.InstanceIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPAddress indicates an expected call of GetIPAddress.
+func (mr *MockLinodeIPClientMockRecorder) GetIPAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPAddress", reflect.TypeOf((*MockLinodeIPClient)(nil).GetIPAddress), ctx, address)
+}
+
+// InstancesAssignIPs mocks base method.
+func (m *MockLinodeIPClient) InstancesAssignIPs(ctx context.Context, opts linodego.LinodesAssignIPsOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstancesAssignIPs", ctx, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstancesAssignIPs indicates an expected call of InstancesAssignIPs.
+func (mr *MockLinodeIPClientMockRecorder) InstancesAssignIPs(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstancesAssignIPs", reflect.TypeOf((*MockLinodeIPClient)(nil).InstancesAssignIPs), ctx, opts)
+}
+
+// ListReservedIPAddresses mocks base method.
+func (m *MockLinodeIPClient) ListReservedIPAddresses(ctx context.Context, opts *linodego.ListOptions) ([]linodego.InstanceIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReservedIPAddresses", ctx, opts)
+	ret0, _ := ret[0].([]linodego.InstanceIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReservedIPAddresses indicates an expected call of ListReservedIPAddresses.
+func (mr *MockLinodeIPClientMockRecorder) ListReservedIPAddresses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReservedIPAddresses", reflect.TypeOf((*MockLinodeIPClient)(nil).ListReservedIPAddresses), ctx, opts)
+}
+
+// ReserveIPAddress mocks base method.
+func (m *MockLinodeIPClient) ReserveIPAddress(ctx context.Context, opts linodego.ReserveIPOptions) (*linodego.InstanceIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIPAddress", ctx, opts)
+	ret0, _ := ret[0].(*linodego.InstanceIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveIPAddress indicates an expected call of ReserveIPAddress.
+func (mr *MockLinodeIPClientMockRecorder) ReserveIPAddress(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIPAddress", reflect.TypeOf((*MockLinodeIPClient)(nil).ReserveIPAddress), ctx, opts)
+}
+
+// ShareIPAddresses mocks base method.
+func (m *MockLinodeIPClient) ShareIPAddresses(ctx context.Context, opts linodego.IPAddressesShareOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareIPAddresses", ctx, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareIPAddresses indicates an expected call of ShareIPAddresses.
+func (mr *MockLinodeIPClientMockRecorder) ShareIPAddresses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareIPAddresses", reflect.TypeOf((*MockLinodeIPClient)(nil).ShareIPAddresses), ctx, opts)
+}
+
+// MockLinodeImageClient is a mock of LinodeImageClient interface.
+type MockLinodeImageClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinodeImageClientMockRecorder
+	isgomock struct{}
+}
+
+// MockLinodeImageClientMockRecorder is the mock recorder for MockLinodeImageClient.
+type MockLinodeImageClientMockRecorder struct {
+	mock *MockLinodeImageClient
+}
+
+// NewMockLinodeImageClient creates a new mock instance.
+func NewMockLinodeImageClient(ctrl *gomock.Controller) *MockLinodeImageClient {
+	mock := &MockLinodeImageClient{ctrl: ctrl}
+	mock.recorder = &MockLinodeImageClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinodeImageClient) EXPECT() *MockLinodeImageClientMockRecorder {
+	return m.recorder
+}
+
+// CreateImageUpload mocks base method.
+func (m *MockLinodeImageClient) CreateImageUpload(ctx context.Context, opts linodego.ImageCreateUploadOptions) (*linodego.Image, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImageUpload", ctx, opts)
+	ret0, _ := ret[0].(*linodego.Image)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateImageUpload indicates an expected call of CreateImageUpload.
+func (mr *MockLinodeImageClientMockRecorder) CreateImageUpload(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageUpload", reflect.TypeOf((*MockLinodeImageClient)(nil).CreateImageUpload), ctx, opts)
+}
+
+// DeleteImage mocks base method.
+func (m *MockLinodeImageClient) DeleteImage(ctx context.Context, imageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockLinodeImageClientMockRecorder) DeleteImage(ctx, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockLinodeImageClient)(nil).DeleteImage), ctx, imageID)
+}
+
+// ListImages mocks base method.
+func (m *MockLinodeImageClient) ListImages(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx, opts)
+	ret0, _ := ret[0].([]linodego.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockLinodeImageClientMockRecorder) ListImages(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockLinodeImageClient)(nil).ListImages), ctx, opts)
+}
+
+// ReplicateImage mocks base method.
+func (m *MockLinodeImageClient) ReplicateImage(ctx context.Context, imageID string, opts linodego.ImageReplicateOptions) (*linodego.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateImage", ctx, imageID, opts)
+	ret0, _ := ret[0].(*linodego.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicateImage indicates an expected call of ReplicateImage.
+func (mr *MockLinodeImageClientMockRecorder) ReplicateImage(ctx, imageID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateImage", reflect.TypeOf((*MockLinodeImageClient)(nil).ReplicateImage), ctx, imageID, opts)
+}
+
+// UpdateImage mocks base method.
+func (m *MockLinodeImageClient) UpdateImage(ctx context.Context, imageID string, opts linodego.ImageUpdateOptions) (*linodego.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, imageID, opts)
+	ret0, _ := ret[0].(*linodego.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockLinodeImageClientMockRecorder) UpdateImage(ctx, imageID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockLinodeImageClient)(nil).UpdateImage), ctx, imageID, opts)
+}
+
+// UploadImageToURL mocks base method.
+func (m *MockLinodeImageClient) UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImageToURL", ctx, uploadURL, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadImageToURL indicates an expected call of UploadImageToURL.
+func (mr *MockLinodeImageClientMockRecorder) UploadImageToURL(ctx, uploadURL, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImageToURL", reflect.TypeOf((*MockLinodeImageClient)(nil).UploadImageToURL), ctx, uploadURL, image)
+}
+
+// MockLinodeEventClient is a mock of LinodeEventClient interface.
+type MockLinodeEventClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinodeEventClientMockRecorder
+	isgomock struct{}
+}
+
+// MockLinodeEventClientMockRecorder is the mock recorder for MockLinodeEventClient.
+type MockLinodeEventClientMockRecorder struct {
+	mock *MockLinodeEventClient
+}
+
+// NewMockLinodeEventClient creates a new mock instance.
+func NewMockLinodeEventClient(ctrl *gomock.Controller) *MockLinodeEventClient {
+	mock := &MockLinodeEventClient{ctrl: ctrl}
+	mock.recorder = &MockLinodeEventClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinodeEventClient) EXPECT() *MockLinodeEventClientMockRecorder {
+	return m.recorder
+}
+
+// GetEvent mocks base method.
+func (m *MockLinodeEventClient) GetEvent(ctx context.Context, eventID int) (*linodego.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID)
+	ret0, _ := ret[0].(*linodego.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockLinodeEventClientMockRecorder) GetEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockLinodeEventClient)(nil).GetEvent), ctx, eventID)
+}
+
+// ListEvents mocks base method.
+func (m *MockLinodeEventClient) ListEvents(ctx context.Context, opts *linodego.ListOptions) ([]linodego.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, opts)
+	ret0, _ := ret[0].([]linodego.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockLinodeEventClientMockRecorder) ListEvents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockLinodeEventClient)(nil).ListEvents), ctx, opts)
+}
+
 // MockK8sClient is a mock of K8sClient interface.
 type MockK8sClient struct {
 	ctrl     *gomock.Controller
@@ -2770,3 +4075,56 @@ func (mr *MockLinodeTokenClientMockRecorder) SetToken(token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetToken", reflect.TypeOf((*MockLinodeTokenClient)(nil).SetToken), token)
 }
+
+// MockBootstrapDataEndpoint is a mock of BootstrapDataEndpoint interface.
+type MockBootstrapDataEndpoint struct {
+	ctrl     *gomock.Controller
+	recorder *MockBootstrapDataEndpointMockRecorder
+	isgomock struct{}
+}
+
+// MockBootstrapDataEndpointMockRecorder is the mock recorder for MockBootstrapDataEndpoint.
+type MockBootstrapDataEndpointMockRecorder struct {
+	mock *MockBootstrapDataEndpoint
+}
+
+// NewMockBootstrapDataEndpoint creates a new mock instance.
+func NewMockBootstrapDataEndpoint(ctrl *gomock.Controller) *MockBootstrapDataEndpoint {
+	mock := &MockBootstrapDataEndpoint{ctrl: ctrl}
+	mock.recorder = &MockBootstrapDataEndpointMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBootstrapDataEndpoint) EXPECT() *MockBootstrapDataEndpointMockRecorder {
+	return m.recorder
+}
+
+// Revoke mocks base method.
+func (m *MockBootstrapDataEndpoint) Revoke(ctx context.Context, machine client.Object) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, machine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockBootstrapDataEndpointMockRecorder) Revoke(ctx, machine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockBootstrapDataEndpoint)(nil).Revoke), ctx, machine)
+}
+
+// Store mocks base method.
+func (m *MockBootstrapDataEndpoint) Store(ctx context.Context, machine client.Object, data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", ctx, machine, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockBootstrapDataEndpointMockRecorder) Store(ctx, machine, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockBootstrapDataEndpoint)(nil).Store), ctx, machine, data)
+}
@@ -34,6 +34,7 @@ const (
 	LinodeIDParam       = "linodeID"
 	KeyIDParam          = "keyID"
 	VpcIDParam          = "vpcID"
+	VolumeIDParam       = "volumeID"
 	ImageIDParam        = "imageID"
 	ClusterParam        = "cluster"
 	LabelParam          = "label"
@@ -101,6 +102,12 @@ func DefaultDecorator() func(span trace.Span, params, results map[string]interfa
 			)
 		}
 
+		if val, ok := wrappers.GetValue[int](params, VolumeIDParam); ok {
+			attr = append(attr,
+				attribute.Int("req.volume_id", val),
+			)
+		}
+
 		if val, ok := wrappers.GetValue[int](params, SizeParam); ok {
 			attr = append(attr,
 				attribute.Int("req.size", val),
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 Akamai Technologies, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linodeclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DryRunReason is the reason of the Events recorded for the calls intercepted in dry-run mode.
+	DryRunReason = "DryRun"
+
+	// redacted replaces the values of the arguments which must not be logged.
+	redacted = "REDACTED"
+	// maxDryRunArgsLength is the maximum length of the arguments in the note of an Event, which the API server limits to 1kB.
+	maxDryRunArgsLength = 960
+)
+
+// redactedArgs are the JSON fields of the arguments holding secrets, such as passwords and bootstrap data.
+var redactedArgs = map[string]struct{}{
+	"root_pass": {},
+	"user_data": {},
+}
+
+// DryRunObjects remembers the objects on behalf of which a call creating, reserving or deleting a resource was
+// intercepted. The state of such objects relies on synthetic results, such as resources without ID, empty addresses or
+// deleted resources which still exist, so their writes to the management cluster must not be persisted.
+type DryRunObjects struct {
+	mu   sync.Mutex
+	uids map[types.UID]struct{}
+}
+
+// NewDryRunObjects returns an empty DryRunObjects.
+func NewDryRunObjects() *DryRunObjects {
+	return &DryRunObjects{uids: make(map[types.UID]struct{})}
+}
+
+// Contains reports whether a call creating, reserving or deleting a resource was intercepted on behalf of obj.
+// A nil DryRunObjects contains no object.
+func (o *DryRunObjects) Contains(obj runtime.Object) bool {
+	uid := objectUID(obj)
+	if o == nil || uid == "" {
+		return false
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, ok := o.uids[uid]
+
+	return ok
+}
+
+func (o *DryRunObjects) add(method string, regarding runtime.Object) {
+	uid := objectUID(regarding)
+	if o == nil || uid == "" || !hasSyntheticState(method) {
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.uids[uid] = struct{}{}
+}
+
+// Client returns a client sending the updates and patches of the objects o contains as server-side dry-run requests,
+// which are validated by the API server without being persisted. The writes of other objects are sent as usual.
+func (o *DryRunObjects) Client(c client.WithWatch) client.WithWatch {
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if o.Contains(obj) {
+				opts = append(opts, client.DryRunAll)
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if o.Contains(obj) {
+				opts = append(opts, client.DryRunAll)
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if o.Contains(obj) {
+				opts = append(opts, client.DryRunAll)
+			}
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if o.Contains(obj) {
+				opts = append(opts, client.DryRunAll)
+			}
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	})
+}
+
+// hasSyntheticState reports whether the synthetic result of an intercepted call leaves its object in a state that
+// must not be persisted: created resources have no ID, reserved addresses are empty, and deleted resources still exist.
+func hasSyntheticState(method string) bool {
+	for _, prefix := range []string{"Create", "Reserve", "Delete", "RFC2136"} {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func objectUID(obj runtime.Object) types.UID {
+	if obj == nil {
+		return ""
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+
+	return accessor.GetUID()
+}
+
+// DryRunInterceptor returns an interceptor for LinodeClientWithDryRun, S3ClientWithDryRun and AkamClientWithDryRun
+// which logs the intercepted calls with their arguments, and records them as Events on the regarding object if it is set.
+// The regarding object is added to objects when the call creates, reserves or deletes a resource.
+func DryRunInterceptor(recorder events.EventRecorder, objects *DryRunObjects, regarding runtime.Object) func(ctx context.Context, method string, params map[string]interface{}) {
+	return func(ctx context.Context, method string, params map[string]interface{}) {
+		args := dryRunArgs(params)
+		logf.FromContext(ctx).Info("Intercepted API call in dry-run mode", "method", method, "args", args)
+		objects.add(method, regarding)
+
+		if recorder == nil || regarding == nil {
+			return
+		}
+		encoded, err := json.Marshal(args)
+		if err != nil {
+			encoded = []byte(err.Error())
+		}
+		if len(encoded) > maxDryRunArgsLength {
+			encoded = encoded[:maxDryRunArgsLength]
+		}
+		recorder.Eventf(regarding, nil, corev1.EventTypeNormal, DryRunReason, method, "Intercepted %s %s", method, encoded)
+	}
+}
+
+// dryRunArgs returns the arguments of a call without its context and secrets, as they are serialized to JSON.
+func dryRunArgs(params map[string]interface{}) map[string]interface{} {
+	args := make(map[string]interface{}, len(params))
+	for name, value := range params {
+		if _, ok := value.(context.Context); ok {
+			continue
+		}
+		// Round-trip the value so that it is logged with the field names of the Linode API.
+		var arg interface{}
+		raw, err := json.Marshal(value)
+		if err != nil || json.Unmarshal(raw, &arg) != nil {
+			// Such as the functional options of the S3 client
+			args[name] = fmt.Sprintf("%T", value)
+			continue
+		}
+		args[name] = redact(arg)
+	}
+
+	return args
+}
+
+func redact(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typed {
+			if _, ok := redactedArgs[key]; ok && field != nil {
+				typed[key] = redacted
+				continue
+			}
+			typed[key] = redact(field)
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redact(item)
+		}
+	}
+
+	return value
+}
+
+// syntheticResult returns the result of an intercepted call. Results pointing to a struct, such as created
+// resources, are filled with the fields of the same name and type in the options of the call, others are zero.
+func syntheticResult[T any](params map[string]interface{}) T {
+	var result T
+	value := reflect.ValueOf(&result).Elem()
+	if value.Kind() != reflect.Pointer || value.Type().Elem().Kind() != reflect.Struct {
+		return result
+	}
+	value.Set(reflect.New(value.Type().Elem()))
+	for _, param := range params {
+		copyFields(value.Elem(), reflect.ValueOf(param))
+	}
+
+	return result
+}
+
+func copyFields(dst, src reflect.Value) {
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			return
+		}
+		src = src.Elem()
+	}
+	if src.Kind() != reflect.Struct {
+		return
+	}
+	for i := range src.NumField() {
+		field := src.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		target := dst.FieldByName(field.Name)
+		if target.IsValid() && target.CanSet() && field.Type.AssignableTo(target.Type()) {
+			target.Set(src.Field(i))
+		}
+	}
+}
@@ -8,6 +8,7 @@ package linodeclient
 
 import (
 	"context"
+	"io"
 
 	_sourceClients "github.com/linode/cluster-api-provider-linode/clients"
 	"github.com/linode/cluster-api-provider-linode/observability/tracing"
@@ -61,6 +62,32 @@ func (_d LinodeClientWithTracing) AssignPlacementGroupLinodes(ctx context.Contex
 	return _d.LinodeClient.AssignPlacementGroupLinodes(ctx, id, options)
 }
 
+// AttachVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) AttachVolume(ctx context.Context, volumeID int, opts *linodego.VolumeAttachOptions) (vp1 *linodego.Volume, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.AttachVolume")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"volumeID": volumeID,
+				"opts":     opts}, map[string]interface{}{
+				"vp1": vp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.AttachVolume(ctx, volumeID, opts)
+}
+
 // BootInstance implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) BootInstance(ctx context.Context, linodeID int, copts linodego.InstanceBootOptions) (err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.BootInstance")
@@ -137,6 +164,58 @@ func (_d LinodeClientWithTracing) CreateFirewall(ctx context.Context, opts linod
 	return _d.LinodeClient.CreateFirewall(ctx, opts)
 }
 
+// CreateFirewallDevice implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) CreateFirewallDevice(ctx context.Context, firewallID int, opts linodego.FirewallDeviceCreateOptions) (fp1 *linodego.FirewallDevice, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateFirewallDevice")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"firewallID": firewallID,
+				"opts":       opts}, map[string]interface{}{
+				"fp1": fp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.CreateFirewallDevice(ctx, firewallID, opts)
+}
+
+// CreateImageUpload implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) CreateImageUpload(ctx context.Context, opts linodego.ImageCreateUploadOptions) (ip1 *linodego.Image, s1 string, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateImageUpload")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"ip1": ip1,
+				"s1":  s1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.CreateImageUpload(ctx, opts)
+}
+
 // CreateInstance implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) CreateInstance(ctx context.Context, opts linodego.InstanceCreateOptions) (ip1 *linodego.Instance, err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateInstance")
@@ -188,6 +267,58 @@ func (_d LinodeClientWithTracing) CreateInstanceDisk(ctx context.Context, linode
 	return _d.LinodeClient.CreateInstanceDisk(ctx, linodeID, opts)
 }
 
+// CreateInstanceSnapshot implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) CreateInstanceSnapshot(ctx context.Context, linodeID int, opts linodego.InstanceSnapshotCreateOptions) (ip1 *linodego.InstanceSnapshot, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateInstanceSnapshot")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"opts":     opts}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.CreateInstanceSnapshot(ctx, linodeID, opts)
+}
+
+// CreateInterface implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) CreateInterface(ctx context.Context, linodeID int, opts linodego.LinodeInterfaceCreateOptions) (lp1 *linodego.LinodeInterface, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateInterface")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"opts":     opts}, map[string]interface{}{
+				"lp1": lp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.CreateInterface(ctx, linodeID, opts)
+}
+
 // CreateNodeBalancer implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) CreateNodeBalancer(ctx context.Context, opts linodego.NodeBalancerCreateOptions) (np1 *linodego.NodeBalancer, err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateNodeBalancer")
@@ -392,6 +523,31 @@ func (_d LinodeClientWithTracing) CreateVPCSubnet(ctx context.Context, opts lino
 	return _d.LinodeClient.CreateVPCSubnet(ctx, opts, vpcID)
 }
 
+// CreateVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) CreateVolume(ctx context.Context, opts linodego.VolumeCreateOptions) (vp1 *linodego.Volume, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.CreateVolume")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"vp1": vp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.CreateVolume(ctx, opts)
+}
+
 // DeleteDomainRecord implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) DeleteDomainRecord(ctx context.Context, domainID int, domainRecordID int) (err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteDomainRecord")
@@ -466,6 +622,30 @@ func (_d LinodeClientWithTracing) DeleteFirewallDevice(ctx context.Context, fire
 	return _d.LinodeClient.DeleteFirewallDevice(ctx, firewallID, deviceID)
 }
 
+// DeleteImage implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) DeleteImage(ctx context.Context, imageID string) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteImage")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"imageID": imageID}, map[string]interface{}{
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.DeleteImage(ctx, imageID)
+}
+
 // DeleteInstance implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) DeleteInstance(ctx context.Context, linodeID int) (err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteInstance")
@@ -490,6 +670,31 @@ func (_d LinodeClientWithTracing) DeleteInstance(ctx context.Context, linodeID i
 	return _d.LinodeClient.DeleteInstance(ctx, linodeID)
 }
 
+// DeleteInterface implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) DeleteInterface(ctx context.Context, linodeID int, interfaceID int) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteInterface")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"linodeID":    linodeID,
+				"interfaceID": interfaceID}, map[string]interface{}{
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.DeleteInterface(ctx, linodeID, interfaceID)
+}
+
 // DeleteNodeBalancer implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) DeleteNodeBalancer(ctx context.Context, nodebalancerID int) (err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteNodeBalancer")
@@ -613,6 +818,30 @@ func (_d LinodeClientWithTracing) DeletePlacementGroup(ctx context.Context, id i
 	return _d.LinodeClient.DeletePlacementGroup(ctx, id)
 }
 
+// DeleteReservedIPAddress implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) DeleteReservedIPAddress(ctx context.Context, address string) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteReservedIPAddress")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"address": address}, map[string]interface{}{
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.DeleteReservedIPAddress(ctx, address)
+}
+
 // DeleteVPC implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) DeleteVPC(ctx context.Context, vpcID int) (err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteVPC")
@@ -662,15 +891,14 @@ func (_d LinodeClientWithTracing) DeleteVPCSubnet(ctx context.Context, vpcID int
 	return _d.LinodeClient.DeleteVPCSubnet(ctx, vpcID, subnetID)
 }
 
-// GetFirewall implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetFirewall(ctx context.Context, firewallID int) (fp1 *linodego.Firewall, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetFirewall")
+// DeleteVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) DeleteVolume(ctx context.Context, volumeID int) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DeleteVolume")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"firewallID": firewallID}, map[string]interface{}{
-				"fp1": fp1,
+				"ctx":      ctx,
+				"volumeID": volumeID}, map[string]interface{}{
 				"err": err})
 		}
 
@@ -684,19 +912,17 @@ func (_d LinodeClientWithTracing) GetFirewall(ctx context.Context, firewallID in
 
 		_span.End()
 	}()
-	return _d.LinodeClient.GetFirewall(ctx, firewallID)
+	return _d.LinodeClient.DeleteVolume(ctx, volumeID)
 }
 
-// GetFirewallDevice implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetFirewallDevice(ctx context.Context, firewallID int, deviceID int) (fp1 *linodego.FirewallDevice, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetFirewallDevice")
+// DetachVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) DetachVolume(ctx context.Context, volumeID int) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.DetachVolume")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"firewallID": firewallID,
-				"deviceID":   deviceID}, map[string]interface{}{
-				"fp1": fp1,
+				"ctx":      ctx,
+				"volumeID": volumeID}, map[string]interface{}{
 				"err": err})
 		}
 
@@ -710,18 +936,18 @@ func (_d LinodeClientWithTracing) GetFirewallDevice(ctx context.Context, firewal
 
 		_span.End()
 	}()
-	return _d.LinodeClient.GetFirewallDevice(ctx, firewallID, deviceID)
+	return _d.LinodeClient.DetachVolume(ctx, volumeID)
 }
 
-// GetFirewallRules implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetFirewallRules(ctx context.Context, firewallID int) (fp1 *linodego.FirewallRules, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetFirewallRules")
+// GetAccountAvailability implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetAccountAvailability(ctx context.Context, regionID string) (ap1 *linodego.AccountAvailability, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetAccountAvailability")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":        ctx,
-				"firewallID": firewallID}, map[string]interface{}{
-				"fp1": fp1,
+				"ctx":      ctx,
+				"regionID": regionID}, map[string]interface{}{
+				"ap1": ap1,
 				"err": err})
 		}
 
@@ -735,18 +961,18 @@ func (_d LinodeClientWithTracing) GetFirewallRules(ctx context.Context, firewall
 
 		_span.End()
 	}()
-	return _d.LinodeClient.GetFirewallRules(ctx, firewallID)
+	return _d.LinodeClient.GetAccountAvailability(ctx, regionID)
 }
 
-// GetImage implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetImage(ctx context.Context, imageID string) (ip1 *linodego.Image, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetImage")
+// GetEvent implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetEvent(ctx context.Context, eventID int) (ep1 *linodego.Event, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetEvent")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":     ctx,
-				"imageID": imageID}, map[string]interface{}{
-				"ip1": ip1,
+				"eventID": eventID}, map[string]interface{}{
+				"ep1": ep1,
 				"err": err})
 		}
 
@@ -760,18 +986,18 @@ func (_d LinodeClientWithTracing) GetImage(ctx context.Context, imageID string)
 
 		_span.End()
 	}()
-	return _d.LinodeClient.GetImage(ctx, imageID)
+	return _d.LinodeClient.GetEvent(ctx, eventID)
 }
 
-// GetInstance implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetInstance(ctx context.Context, linodeID int) (ip1 *linodego.Instance, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetInstance")
+// GetFirewall implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetFirewall(ctx context.Context, firewallID int) (fp1 *linodego.Firewall, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetFirewall")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"linodeID": linodeID}, map[string]interface{}{
-				"ip1": ip1,
+				"ctx":        ctx,
+				"firewallID": firewallID}, map[string]interface{}{
+				"fp1": fp1,
 				"err": err})
 		}
 
@@ -785,18 +1011,19 @@ func (_d LinodeClientWithTracing) GetInstance(ctx context.Context, linodeID int)
 
 		_span.End()
 	}()
-	return _d.LinodeClient.GetInstance(ctx, linodeID)
+	return _d.LinodeClient.GetFirewall(ctx, firewallID)
 }
 
-// GetInstanceIPAddresses implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetInstanceIPAddresses(ctx context.Context, linodeID int) (ip1 *linodego.InstanceIPAddressResponse, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetInstanceIPAddresses")
+// GetFirewallDevice implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetFirewallDevice(ctx context.Context, firewallID int, deviceID int) (fp1 *linodego.FirewallDevice, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetFirewallDevice")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"linodeID": linodeID}, map[string]interface{}{
-				"ip1": ip1,
+				"ctx":        ctx,
+				"firewallID": firewallID,
+				"deviceID":   deviceID}, map[string]interface{}{
+				"fp1": fp1,
 				"err": err})
 		}
 
@@ -810,16 +1037,166 @@ func (_d LinodeClientWithTracing) GetInstanceIPAddresses(ctx context.Context, li
 
 		_span.End()
 	}()
-	return _d.LinodeClient.GetInstanceIPAddresses(ctx, linodeID)
+	return _d.LinodeClient.GetFirewallDevice(ctx, firewallID, deviceID)
 }
 
-// GetNodeBalancer implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) GetNodeBalancer(ctx context.Context, nodebalancerID int) (np1 *linodego.NodeBalancer, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetNodeBalancer")
+// GetFirewallRules implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetFirewallRules(ctx context.Context, firewallID int) (fp1 *linodego.FirewallRules, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetFirewallRules")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":            ctx,
+				"ctx":        ctx,
+				"firewallID": firewallID}, map[string]interface{}{
+				"fp1": fp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetFirewallRules(ctx, firewallID)
+}
+
+// GetIPAddress implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetIPAddress(ctx context.Context, address string) (ip1 *linodego.InstanceIP, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetIPAddress")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"address": address}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetIPAddress(ctx, address)
+}
+
+// GetImage implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetImage(ctx context.Context, imageID string) (ip1 *linodego.Image, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetImage")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"imageID": imageID}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetImage(ctx, imageID)
+}
+
+// GetInstance implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetInstance(ctx context.Context, linodeID int) (ip1 *linodego.Instance, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetInstance")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetInstance(ctx, linodeID)
+}
+
+// GetInstanceBackups implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetInstanceBackups(ctx context.Context, linodeID int) (ip1 *linodego.InstanceBackupsResponse, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetInstanceBackups")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetInstanceBackups(ctx, linodeID)
+}
+
+// GetInstanceIPAddresses implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetInstanceIPAddresses(ctx context.Context, linodeID int) (ip1 *linodego.InstanceIPAddressResponse, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetInstanceIPAddresses")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetInstanceIPAddresses(ctx, linodeID)
+}
+
+// GetNodeBalancer implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetNodeBalancer(ctx context.Context, nodebalancerID int) (np1 *linodego.NodeBalancer, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetNodeBalancer")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
 				"nodebalancerID": nodebalancerID}, map[string]interface{}{
 				"np1": np1,
 				"err": err})
@@ -991,6 +1368,31 @@ func (_d LinodeClientWithTracing) GetRegion(ctx context.Context, regionID string
 	return _d.LinodeClient.GetRegion(ctx, regionID)
 }
 
+// GetRegionAvailability implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetRegionAvailability(ctx context.Context, regionID string) (ra1 []linodego.RegionAvailability, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetRegionAvailability")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"regionID": regionID}, map[string]interface{}{
+				"ra1": ra1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetRegionAvailability(ctx, regionID)
+}
+
 // GetType implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) GetType(ctx context.Context, typeID string) (lp1 *linodego.LinodeType, err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetType")
@@ -1041,6 +1443,55 @@ func (_d LinodeClientWithTracing) GetVPC(ctx context.Context, vpcID int) (vp1 *l
 	return _d.LinodeClient.GetVPC(ctx, vpcID)
 }
 
+// GetVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) GetVolume(ctx context.Context, volumeID int) (vp1 *linodego.Volume, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.GetVolume")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"volumeID": volumeID}, map[string]interface{}{
+				"vp1": vp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.GetVolume(ctx, volumeID)
+}
+
+// InstancesAssignIPs implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) InstancesAssignIPs(ctx context.Context, opts linodego.LinodesAssignIPsOptions) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.InstancesAssignIPs")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.InstancesAssignIPs(ctx, opts)
+}
+
 // ListDomainRecords implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) ListDomainRecords(ctx context.Context, domainID int, opts *linodego.ListOptions) (da1 []linodego.DomainRecord, err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListDomainRecords")
@@ -1092,6 +1543,57 @@ func (_d LinodeClientWithTracing) ListDomains(ctx context.Context, opts *linodeg
 	return _d.LinodeClient.ListDomains(ctx, opts)
 }
 
+// ListEvents implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListEvents(ctx context.Context, opts *linodego.ListOptions) (ea1 []linodego.Event, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListEvents")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"ea1": ea1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListEvents(ctx, opts)
+}
+
+// ListFirewallDevices implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListFirewallDevices(ctx context.Context, firewallID int, opts *linodego.ListOptions) (fa1 []linodego.FirewallDevice, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListFirewallDevices")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"firewallID": firewallID,
+				"opts":       opts}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListFirewallDevices(ctx, firewallID, opts)
+}
+
 // ListFirewalls implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) ListFirewalls(ctx context.Context, options *linodego.ListOptions) (fa1 []linodego.Firewall, err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListFirewalls")
@@ -1117,16 +1619,348 @@ func (_d LinodeClientWithTracing) ListFirewalls(ctx context.Context, options *li
 	return _d.LinodeClient.ListFirewalls(ctx, options)
 }
 
-// ListInstanceConfigs implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListInstanceConfigs(ctx context.Context, linodeID int, opts *linodego.ListOptions) (ia1 []linodego.InstanceConfig, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInstanceConfigs")
+// ListImages implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListImages(ctx context.Context, opts *linodego.ListOptions) (ia1 []linodego.Image, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListImages")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"ia1": ia1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListImages(ctx, opts)
+}
+
+// ListInstanceConfigs implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListInstanceConfigs(ctx context.Context, linodeID int, opts *linodego.ListOptions) (ia1 []linodego.InstanceConfig, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInstanceConfigs")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"opts":     opts}, map[string]interface{}{
+				"ia1": ia1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListInstanceConfigs(ctx, linodeID, opts)
+}
+
+// ListInstanceFirewalls implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListInstanceFirewalls(ctx context.Context, linodeID int, opts *linodego.ListOptions) (fa1 []linodego.Firewall, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInstanceFirewalls")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"opts":     opts}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListInstanceFirewalls(ctx, linodeID, opts)
+}
+
+// ListInstances implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListInstances(ctx context.Context, opts *linodego.ListOptions) (ia1 []linodego.Instance, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInstances")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"ia1": ia1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListInstances(ctx, opts)
+}
+
+// ListInterfaceFirewalls implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListInterfaceFirewalls(ctx context.Context, linodeID int, interfaceID int, opts *linodego.ListOptions) (fa1 []linodego.Firewall, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInterfaceFirewalls")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"linodeID":    linodeID,
+				"interfaceID": interfaceID,
+				"opts":        opts}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListInterfaceFirewalls(ctx, linodeID, interfaceID, opts)
+}
+
+// ListInterfaces implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListInterfaces(ctx context.Context, linodeID int, opts *linodego.ListOptions) (la1 []linodego.LinodeInterface, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInterfaces")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"opts":     opts}, map[string]interface{}{
+				"la1": la1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListInterfaces(ctx, linodeID, opts)
+}
+
+// ListNodeBalancerFirewalls implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListNodeBalancerFirewalls(ctx context.Context, nodebalancerID int, opts *linodego.ListOptions) (fa1 []linodego.Firewall, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListNodeBalancerFirewalls")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"nodebalancerID": nodebalancerID,
+				"opts":           opts}, map[string]interface{}{
+				"fa1": fa1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListNodeBalancerFirewalls(ctx, nodebalancerID, opts)
+}
+
+// ListNodeBalancerNodes implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *linodego.ListOptions) (na1 []linodego.NodeBalancerNode, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListNodeBalancerNodes")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"nodebalancerID": nodebalancerID,
+				"configID":       configID,
+				"opts":           opts}, map[string]interface{}{
+				"na1": na1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListNodeBalancerNodes(ctx, nodebalancerID, configID, opts)
+}
+
+// ListNodeBalancerTypes implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListNodeBalancerTypes(ctx context.Context, opts *linodego.ListOptions) (na1 []linodego.NodeBalancerType, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListNodeBalancerTypes")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"na1": na1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListNodeBalancerTypes(ctx, opts)
+}
+
+// ListNodeBalancers implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListNodeBalancers(ctx context.Context, opts *linodego.ListOptions) (na1 []linodego.NodeBalancer, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListNodeBalancers")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"na1": na1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListNodeBalancers(ctx, opts)
+}
+
+// ListPlacementGroups implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListPlacementGroups(ctx context.Context, options *linodego.ListOptions) (pa1 []linodego.PlacementGroup, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListPlacementGroups")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"options": options}, map[string]interface{}{
+				"pa1": pa1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListPlacementGroups(ctx, options)
+}
+
+// ListReservedIPAddresses implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListReservedIPAddresses(ctx context.Context, opts *linodego.ListOptions) (ia1 []linodego.InstanceIP, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListReservedIPAddresses")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"ia1": ia1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListReservedIPAddresses(ctx, opts)
+}
+
+// ListVPCs implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListVPCs(ctx context.Context, opts *linodego.ListOptions) (va1 []linodego.VPC, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListVPCs")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"va1": va1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.ListVPCs(ctx, opts)
+}
+
+// ListVolumes implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ListVolumes(ctx context.Context, opts *linodego.ListOptions) (va1 []linodego.Volume, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListVolumes")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":      ctx,
-				"linodeID": linodeID,
-				"opts":     opts}, map[string]interface{}{
-				"ia1": ia1,
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"va1": va1,
 				"err": err})
 		}
 
@@ -1140,19 +1974,19 @@ func (_d LinodeClientWithTracing) ListInstanceConfigs(ctx context.Context, linod
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListInstanceConfigs(ctx, linodeID, opts)
+	return _d.LinodeClient.ListVolumes(ctx, opts)
 }
 
-// ListInstanceFirewalls implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListInstanceFirewalls(ctx context.Context, linodeID int, opts *linodego.ListOptions) (fa1 []linodego.Firewall, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInstanceFirewalls")
+// RebuildInstance implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) RebuildInstance(ctx context.Context, linodeID int, opts linodego.InstanceRebuildOptions) (ip1 *linodego.Instance, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.RebuildInstance")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":      ctx,
 				"linodeID": linodeID,
 				"opts":     opts}, map[string]interface{}{
-				"fa1": fa1,
+				"ip1": ip1,
 				"err": err})
 		}
 
@@ -1166,18 +2000,19 @@ func (_d LinodeClientWithTracing) ListInstanceFirewalls(ctx context.Context, lin
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListInstanceFirewalls(ctx, linodeID, opts)
+	return _d.LinodeClient.RebuildInstance(ctx, linodeID, opts)
 }
 
-// ListInstances implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListInstances(ctx context.Context, opts *linodego.ListOptions) (ia1 []linodego.Instance, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInstances")
+// ReplicateImage implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ReplicateImage(ctx context.Context, imageID string, opts linodego.ImageReplicateOptions) (ip1 *linodego.Image, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ReplicateImage")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":  ctx,
-				"opts": opts}, map[string]interface{}{
-				"ia1": ia1,
+				"ctx":     ctx,
+				"imageID": imageID,
+				"opts":    opts}, map[string]interface{}{
+				"ip1": ip1,
 				"err": err})
 		}
 
@@ -1191,20 +2026,18 @@ func (_d LinodeClientWithTracing) ListInstances(ctx context.Context, opts *linod
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListInstances(ctx, opts)
+	return _d.LinodeClient.ReplicateImage(ctx, imageID, opts)
 }
 
-// ListInterfaceFirewalls implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListInterfaceFirewalls(ctx context.Context, linodeID int, interfaceID int, opts *linodego.ListOptions) (fa1 []linodego.Firewall, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInterfaceFirewalls")
+// ReserveIPAddress implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ReserveIPAddress(ctx context.Context, opts linodego.ReserveIPOptions) (ip1 *linodego.InstanceIP, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ReserveIPAddress")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":         ctx,
-				"linodeID":    linodeID,
-				"interfaceID": interfaceID,
-				"opts":        opts}, map[string]interface{}{
-				"fa1": fa1,
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
+				"ip1": ip1,
 				"err": err})
 		}
 
@@ -1218,19 +2051,18 @@ func (_d LinodeClientWithTracing) ListInterfaceFirewalls(ctx context.Context, li
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListInterfaceFirewalls(ctx, linodeID, interfaceID, opts)
+	return _d.LinodeClient.ReserveIPAddress(ctx, opts)
 }
 
-// ListInterfaces implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListInterfaces(ctx context.Context, linodeID int, opts *linodego.ListOptions) (la1 []linodego.LinodeInterface, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListInterfaces")
+// ResizeInstance implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ResizeInstance(ctx context.Context, linodeID int, opts linodego.InstanceResizeOptions) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ResizeInstance")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":      ctx,
 				"linodeID": linodeID,
 				"opts":     opts}, map[string]interface{}{
-				"la1": la1,
 				"err": err})
 		}
 
@@ -1244,20 +2076,19 @@ func (_d LinodeClientWithTracing) ListInterfaces(ctx context.Context, linodeID i
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListInterfaces(ctx, linodeID, opts)
+	return _d.LinodeClient.ResizeInstance(ctx, linodeID, opts)
 }
 
-// ListNodeBalancerNodes implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *linodego.ListOptions) (na1 []linodego.NodeBalancerNode, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListNodeBalancerNodes")
+// ResizeInstanceDisk implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ResizeInstanceDisk(ctx context.Context, linodeID int, diskID int, opts linodego.InstanceDiskResizeOptions) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ResizeInstanceDisk")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":            ctx,
-				"nodebalancerID": nodebalancerID,
-				"configID":       configID,
-				"opts":           opts}, map[string]interface{}{
-				"na1": na1,
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"diskID":   diskID,
+				"opts":     opts}, map[string]interface{}{
 				"err": err})
 		}
 
@@ -1271,18 +2102,18 @@ func (_d LinodeClientWithTracing) ListNodeBalancerNodes(ctx context.Context, nod
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListNodeBalancerNodes(ctx, nodebalancerID, configID, opts)
+	return _d.LinodeClient.ResizeInstanceDisk(ctx, linodeID, diskID, opts)
 }
 
-// ListNodeBalancers implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListNodeBalancers(ctx context.Context, opts *linodego.ListOptions) (na1 []linodego.NodeBalancer, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListNodeBalancers")
+// ResizeVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ResizeVolume(ctx context.Context, volumeID int, opts linodego.VolumeResizeOptions) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ResizeVolume")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":  ctx,
-				"opts": opts}, map[string]interface{}{
-				"na1": na1,
+				"ctx":      ctx,
+				"volumeID": volumeID,
+				"opts":     opts}, map[string]interface{}{
 				"err": err})
 		}
 
@@ -1296,18 +2127,17 @@ func (_d LinodeClientWithTracing) ListNodeBalancers(ctx context.Context, opts *l
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListNodeBalancers(ctx, opts)
+	return _d.LinodeClient.ResizeVolume(ctx, volumeID, opts)
 }
 
-// ListPlacementGroups implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListPlacementGroups(ctx context.Context, options *linodego.ListOptions) (pa1 []linodego.PlacementGroup, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListPlacementGroups")
+// ShareIPAddresses implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ShareIPAddresses(ctx context.Context, opts linodego.IPAddressesShareOptions) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ShareIPAddresses")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":     ctx,
-				"options": options}, map[string]interface{}{
-				"pa1": pa1,
+				"ctx":  ctx,
+				"opts": opts}, map[string]interface{}{
 				"err": err})
 		}
 
@@ -1321,18 +2151,17 @@ func (_d LinodeClientWithTracing) ListPlacementGroups(ctx context.Context, optio
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListPlacementGroups(ctx, options)
+	return _d.LinodeClient.ShareIPAddresses(ctx, opts)
 }
 
-// ListVPCs implements _sourceClients.LinodeClient
-func (_d LinodeClientWithTracing) ListVPCs(ctx context.Context, opts *linodego.ListOptions) (va1 []linodego.VPC, err error) {
-	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ListVPCs")
+// ShutdownInstance implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) ShutdownInstance(ctx context.Context, linodeID int) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.ShutdownInstance")
 	defer func() {
 		if _d._spanDecorator != nil {
 			_d._spanDecorator(_span, map[string]interface{}{
-				"ctx":  ctx,
-				"opts": opts}, map[string]interface{}{
-				"va1": va1,
+				"ctx":      ctx,
+				"linodeID": linodeID}, map[string]interface{}{
 				"err": err})
 		}
 
@@ -1346,7 +2175,7 @@ func (_d LinodeClientWithTracing) ListVPCs(ctx context.Context, opts *linodego.L
 
 		_span.End()
 	}()
-	return _d.LinodeClient.ListVPCs(ctx, opts)
+	return _d.LinodeClient.ShutdownInstance(ctx, linodeID)
 }
 
 // UnassignPlacementGroupLinodes implements _sourceClients.LinodeClient
@@ -1454,6 +2283,32 @@ func (_d LinodeClientWithTracing) UpdateFirewallRules(ctx context.Context, firew
 	return _d.LinodeClient.UpdateFirewallRules(ctx, firewallID, rules)
 }
 
+// UpdateImage implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpdateImage(ctx context.Context, imageID string, opts linodego.ImageUpdateOptions) (ip1 *linodego.Image, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateImage")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"imageID": imageID,
+				"opts":    opts}, map[string]interface{}{
+				"ip1": ip1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpdateImage(ctx, imageID, opts)
+}
+
 // UpdateInstance implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) UpdateInstance(ctx context.Context, linodeId int, opts linodego.InstanceUpdateOptions) (ip1 *linodego.Instance, err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateInstance")
@@ -1533,6 +2388,114 @@ func (_d LinodeClientWithTracing) UpdateInstanceFirewalls(ctx context.Context, l
 	return _d.LinodeClient.UpdateInstanceFirewalls(ctx, linodeID, opts)
 }
 
+// UpdateInterface implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpdateInterface(ctx context.Context, linodeID int, interfaceID int, opts linodego.LinodeInterfaceUpdateOptions) (lp1 *linodego.LinodeInterface, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateInterface")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"linodeID":    linodeID,
+				"interfaceID": interfaceID,
+				"opts":        opts}, map[string]interface{}{
+				"lp1": lp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpdateInterface(ctx, linodeID, interfaceID, opts)
+}
+
+// UpdateNodeBalancer implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpdateNodeBalancer(ctx context.Context, nodebalancerID int, opts linodego.NodeBalancerUpdateOptions) (np1 *linodego.NodeBalancer, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateNodeBalancer")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"nodebalancerID": nodebalancerID,
+				"opts":           opts}, map[string]interface{}{
+				"np1": np1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpdateNodeBalancer(ctx, nodebalancerID, opts)
+}
+
+// UpdateNodeBalancerConfig implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpdateNodeBalancerConfig(ctx context.Context, nodebalancerID int, configID int, opts linodego.NodeBalancerConfigUpdateOptions) (np1 *linodego.NodeBalancerConfig, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateNodeBalancerConfig")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"nodebalancerID": nodebalancerID,
+				"configID":       configID,
+				"opts":           opts}, map[string]interface{}{
+				"np1": np1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpdateNodeBalancerConfig(ctx, nodebalancerID, configID, opts)
+}
+
+// UpdateNodeBalancerNode implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpdateNodeBalancerNode(ctx context.Context, nodebalancerID int, configID int, nodeID int, opts linodego.NodeBalancerNodeUpdateOptions) (np1 *linodego.NodeBalancerNode, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateNodeBalancerNode")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":            ctx,
+				"nodebalancerID": nodebalancerID,
+				"configID":       configID,
+				"nodeID":         nodeID,
+				"opts":           opts}, map[string]interface{}{
+				"np1": np1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpdateNodeBalancerNode(ctx, nodebalancerID, configID, nodeID, opts)
+}
+
 // UpdateObjectStorageBucketAccess implements _sourceClients.LinodeClient
 func (_d LinodeClientWithTracing) UpdateObjectStorageBucketAccess(ctx context.Context, clusterOrRegionID string, label string, opts linodego.ObjectStorageBucketUpdateAccessOptions) (err error) {
 	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateObjectStorageBucketAccess")
@@ -1610,3 +2573,80 @@ func (_d LinodeClientWithTracing) UpdateVPC(ctx context.Context, vpcID int, opts
 	}()
 	return _d.LinodeClient.UpdateVPC(ctx, vpcID, opts)
 }
+
+// UpdateVolume implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpdateVolume(ctx context.Context, volumeID int, opts linodego.VolumeUpdateOptions) (vp1 *linodego.Volume, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpdateVolume")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"volumeID": volumeID,
+				"opts":     opts}, map[string]interface{}{
+				"vp1": vp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpdateVolume(ctx, volumeID, opts)
+}
+
+// UpgradeInterfaces implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UpgradeInterfaces(ctx context.Context, linodeID int, opts linodego.LinodeInterfacesUpgradeOptions) (lp1 *linodego.LinodeInterfacesUpgrade, err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UpgradeInterfaces")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"linodeID": linodeID,
+				"opts":     opts}, map[string]interface{}{
+				"lp1": lp1,
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UpgradeInterfaces(ctx, linodeID, opts)
+}
+
+// UploadImageToURL implements _sourceClients.LinodeClient
+func (_d LinodeClientWithTracing) UploadImageToURL(ctx context.Context, uploadURL string, image io.Reader) (err error) {
+	ctx, _span := tracing.Start(ctx, "_sourceClients.LinodeClient.UploadImageToURL")
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":       ctx,
+				"uploadURL": uploadURL,
+				"image":     image}, map[string]interface{}{
+				"err": err})
+		}
+
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.LinodeClient.UploadImageToURL(ctx, uploadURL, image)
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -83,6 +84,60 @@ func GetAutoGenTags(cluster *infrav1alpha2.LinodeCluster) []string {
 	return []string{cluster.Name}
 }
 
+// ownerTagPrefix prefixes the tag marking the cloud resources created by CAPL on behalf of a management cluster.
+const ownerTagPrefix = "capl-owner:"
+
+// MaxManagementClusterIDLength is the length of the longest management cluster ID which fits in an owner tag, Linode
+// tags are at most 50 characters long.
+const MaxManagementClusterIDLength = 50 - len(ownerTagPrefix)
+
+// GetOwnerTags returns the tags marking the cloud resources created by CAPL on behalf of a management cluster. Only
+// the garbage collector of that management cluster deletes the resources carrying them.
+func GetOwnerTags(managementClusterID string) []string {
+	if managementClusterID == "" {
+		return []string{}
+	}
+	return []string{ownerTagPrefix + managementClusterID}
+}
+
+// ClaimOwnerTags returns the tags with the owner tag of the management cluster in place of the owner tags of other
+// management clusters. Once a cluster is moved by clusterctl, its new management cluster claims its resources so that
+// the garbage collector of the previous one stops considering them. Tags are returned as is without a management
+// cluster ID.
+func ClaimOwnerTags(tags []string, managementClusterID string) []string {
+	if managementClusterID == "" {
+		return tags
+	}
+
+	claimed := make([]string, 0, len(tags)+1)
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, ownerTagPrefix) {
+			claimed = append(claimed, tag)
+		}
+	}
+
+	return append(claimed, GetOwnerTags(managementClusterID)...)
+}
+
+// HasOwnerTag reports whether the tags contain the owner tag of any management cluster.
+func HasOwnerTag(tags []string) bool {
+	return slices.ContainsFunc(tags, func(tag string) bool {
+		return strings.HasPrefix(tag, ownerTagPrefix)
+	})
+}
+
+// ForeignOwnerTag returns the first owner tag of another management cluster than the one with the ID among the tags,
+// or an empty string if there is none. Every owner tag is foreign without a management cluster ID.
+func ForeignOwnerTag(tags []string, managementClusterID string) string {
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, ownerTagPrefix) && tag != ownerTagPrefix+managementClusterID {
+			return tag
+		}
+	}
+
+	return ""
+}
+
 // IsLinodePrivateIP checks if an IP address belongs to the Linode private IP range (192.168.128.0/17)
 func IsLinodePrivateIP(ipAddress string) bool {
 	// Parse the IP address
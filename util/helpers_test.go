@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"testing"
 
@@ -167,6 +168,92 @@ func TestGetInstanceID(t *testing.T) {
 	}
 }
 
+func TestClaimOwnerTags(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		tags                []string
+		managementClusterID string
+		want                []string
+	}{{
+		name:                "no management cluster ID",
+		tags:                []string{"cluster", "capl-owner:previous"},
+		managementClusterID: "",
+		want:                []string{"cluster", "capl-owner:previous"},
+	}, {
+		name:                "untagged",
+		tags:                []string{"cluster"},
+		managementClusterID: "current",
+		want:                []string{"cluster", "capl-owner:current"},
+	}, {
+		name:                "already claimed",
+		tags:                []string{"capl-owner:current", "cluster"},
+		managementClusterID: "current",
+		want:                []string{"cluster", "capl-owner:current"},
+	}, {
+		name:                "moved",
+		tags:                []string{"cluster", "capl-owner:previous"},
+		managementClusterID: "current",
+		want:                []string{"cluster", "capl-owner:current"},
+	}}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			got := ClaimOwnerTags(testcase.tags, testcase.managementClusterID)
+			if !slices.Equal(got, testcase.want) {
+				t.Errorf("wanted %v, got %v", testcase.want, got)
+			}
+		})
+	}
+}
+
+func TestHasOwnerTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		tags []string
+		want bool
+	}{
+		{name: "no tags", tags: nil, want: false},
+		{name: "untagged", tags: []string{"cluster"}, want: false},
+		{name: "tagged", tags: []string{"cluster", "capl-owner:previous"}, want: true},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			if got := HasOwnerTag(testcase.tags); got != testcase.want {
+				t.Errorf("wanted %v, got %v", testcase.want, got)
+			}
+		})
+	}
+}
+
+func TestForeignOwnerTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		tags                []string
+		managementClusterID string
+		want                string
+	}{
+		{name: "untagged", tags: []string{"cluster"}, managementClusterID: "current", want: ""},
+		{name: "own owner tag", tags: []string{"cluster", "capl-owner:current"}, managementClusterID: "current", want: ""},
+		{name: "foreign owner tag", tags: []string{"capl-owner:current", "capl-owner:other"}, managementClusterID: "current", want: "capl-owner:other"},
+		{name: "owner tag without management cluster ID", tags: []string{"capl-owner:other"}, want: "capl-owner:other"},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ForeignOwnerTag(testcase.tags, testcase.managementClusterID); got != testcase.want {
+				t.Errorf("wanted %q, got %q", testcase.want, got)
+			}
+		})
+	}
+}
+
 func TestIsLinodePrivateIP(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
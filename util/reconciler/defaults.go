@@ -35,8 +35,20 @@ const (
 	DefaultMachineControllerWaitForPreflightTimeout = 5 * time.Minute
 	// DefaultMachineControllerWaitForRunningTimeout is the default timeout if instance is not running.
 	DefaultMachineControllerWaitForRunningTimeout = 20 * time.Minute
+	// DefaultMachineControllerGracefulShutdownTimeout is the default timeout for an instance to be offline after being
+	// shut down before its deletion.
+	DefaultMachineControllerGracefulShutdownTimeout = 5 * time.Minute
 	// DefaultMachineControllerRetryDelay is the default requeue delay if there is an error.
 	DefaultMachineControllerRetryDelay = 8 * time.Second
+	// DefaultMachineControllerCapacityRetryDelay is the minimum requeue delay while an instance can't be created
+	// because of missing capacity or account limits.
+	DefaultMachineControllerCapacityRetryDelay = 30 * time.Second
+	// DefaultMachineControllerCapacityMaxRetryDelay is the maximum requeue delay while an instance can't be created
+	// because of missing capacity or account limits.
+	DefaultMachineControllerCapacityMaxRetryDelay = 10 * time.Minute
+	// DefaultMachinePoolControllerReconcileDelay is the default requeue delay while a machine pool is scaled or its
+	// machines are replaced.
+	DefaultMachinePoolControllerReconcileDelay = 10 * time.Second
 	// DefaultLinodeTooManyRequestsErrorRetryDelay is the default requeue delay if there is a Linode API error.
 	DefaultLinodeTooManyRequestsErrorRetryDelay = time.Minute
 
@@ -67,6 +79,55 @@ const (
 	// DefaultObjectStorageBucketControllerReconcileDelay is the default requeue delay when a reconcile operation fails.
 	DefaultObjectStorageBucketControllerReconcileDelay = 3 * time.Second
 
+	// DefaultVolumeControllerReconcileDelay is the default requeue delay when a Volume reconcile operation fails.
+	DefaultVolumeControllerReconcileDelay = 5 * time.Second
+	// DefaultVolumeControllerReconcileTimeout is the default timeout when Volume reconcile operations fail.
+	DefaultVolumeControllerReconcileTimeout = 20 * time.Minute
+	// DefaultVolumeControllerWaitForDetachTimeout is the default timeout when waiting for a Volume to be detached.
+	DefaultVolumeControllerWaitForDetachTimeout = 20 * time.Minute
+
+	// DefaultImageControllerReconcileDelay is the default requeue delay when an Image reconcile operation fails
+	// or the Image is still being processed or replicated.
+	DefaultImageControllerReconcileDelay = 15 * time.Second
+	// DefaultImageControllerReconcileTimeout is the default timeout when Image reconcile operations fail.
+	DefaultImageControllerReconcileTimeout = 20 * time.Minute
+	// DefaultImageControllerUploadTimeout is the default timeout for uploading an Image from its source.
+	DefaultImageControllerUploadTimeout = 1 * time.Hour
+
+	// DefaultMachineRemediationReconcileDelay is the default requeue delay while a LinodeMachine is being remediated.
+	DefaultMachineRemediationReconcileDelay = 30 * time.Second
+	// DefaultMachineRemediationTimeout is the default timeout for a LinodeMachine to be rebuilt by a remediation.
+	DefaultMachineRemediationTimeout = 30 * time.Minute
+
+	// DefaultInstanceSnapshotControllerReconcileDelay is the default requeue delay while an instance snapshot is in progress.
+	DefaultInstanceSnapshotControllerReconcileDelay = 30 * time.Second
+	// DefaultInstanceSnapshotControllerReconcileTimeout is the default timeout when instance snapshot reconcile operations fail.
+	DefaultInstanceSnapshotControllerReconcileTimeout = 20 * time.Minute
+	// DefaultInstanceSnapshotControllerRefreshDelay is the default delay between checks that a completed snapshot is still available.
+	DefaultInstanceSnapshotControllerRefreshDelay = 10 * time.Minute
+
+	// DefaultBootstrapDataTokenTTL is the default duration after which an unused token of the bootstrap data endpoint
+	// expires.
+	DefaultBootstrapDataTokenTTL = 30 * time.Minute
+
+	// DefaultEventPollerInterval is the default interval between two polls of the Linode Events API.
+	DefaultEventPollerInterval = 30 * time.Second
+	// DefaultEventPollerFallbackDelay is the default requeue delay used instead of short polling delays when
+	// reconciles are driven by Linode events.
+	DefaultEventPollerFallbackDelay = 5 * time.Minute
+
+	// DefaultGarbageCollectorInterval is the default interval between two collections of orphaned cloud resources.
+	DefaultGarbageCollectorInterval = 10 * time.Minute
+
+	// DefaultCostEstimatorInterval is the default interval between two estimates of the cost of a cluster.
+	DefaultCostEstimatorInterval = 15 * time.Minute
+	// DefaultObjectStoragePricePerGB is the default monthly price in US dollars of a GB stored in Object Storage.
+	DefaultObjectStoragePricePerGB = 0.02
+
+	// DefaultNodeBalancerBackendHealthInterval is the default interval between two polls of the health of the
+	// NodeBalancer backends of a cluster.
+	DefaultNodeBalancerBackendHealthInterval = time.Minute
+
 	// DefaultDNSTTLSec is the default TTL used for DNS entries for api server loadbalancing
 	DefaultDNSTTLSec = 30
 )